@@ -34,6 +34,15 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter, or only
+	// the ones for the provided method name if it is set.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
+	// Regrant grants, on behalf of the granter, an authorization the delegator
+	// was granted with a delegatable grant to another grantee. The executions of
+	// the new grant are also subject to the parent grants.
+	Regrant(ctx context.Context, in *MsgRegrant, opts ...grpc.CallOption) (*MsgRegrantResponse, error)
+	// RevokeRegrant revokes a grant issued by the delegator with a MsgRegrant.
+	RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error)
 }
 
 type msgClient struct {
@@ -71,6 +80,33 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Regrant(ctx context.Context, in *MsgRegrant, opts ...grpc.CallOption) (*MsgRegrantResponse, error) {
+	out := new(MsgRegrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/Regrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error) {
+	out := new(MsgRevokeRegrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeRegrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -87,6 +123,15 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter, or only
+	// the ones for the provided method name if it is set.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
+	// Regrant grants, on behalf of the granter, an authorization the delegator
+	// was granted with a delegatable grant to another grantee. The executions of
+	// the new grant are also subject to the parent grants.
+	Regrant(context.Context, *MsgRegrant) (*MsgRegrantResponse, error)
+	// RevokeRegrant revokes a grant issued by the delegator with a MsgRegrant.
+	RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -103,6 +148,15 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedMsgServer) RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
+func (UnimplementedMsgServer) Regrant(context.Context, *MsgRegrant) (*MsgRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Regrant not implemented")
+}
+func (UnimplementedMsgServer) RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRegrant not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +224,60 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Regrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Regrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/Regrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Regrant(ctx, req.(*MsgRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRegrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRegrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeRegrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRegrant(ctx, req.(*MsgRevokeRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +297,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
+		{
+			MethodName: "Regrant",
+			Handler:    _Msg_Regrant_Handler,
+		},
+		{
+			MethodName: "RevokeRegrant",
+			Handler:    _Msg_RevokeRegrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -30,6 +30,16 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// AllowancePool returns the allowance pool funded by the granter and its
+	// remaining balance.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowancePool(ctx context.Context, in *QueryAllowancePoolRequest, opts ...grpc.CallOption) (*QueryAllowancePoolResponse, error)
+	// AllowancePoolUsage returns the usage of the allowance pool funded by the
+	// granter by the grantee.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowancePoolUsage(ctx context.Context, in *QueryAllowancePoolUsageRequest, opts ...grpc.CallOption) (*QueryAllowancePoolUsageResponse, error)
 }
 
 type queryClient struct {
@@ -67,6 +77,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) AllowancePool(ctx context.Context, in *QueryAllowancePoolRequest, opts ...grpc.CallOption) (*QueryAllowancePoolResponse, error) {
+	out := new(QueryAllowancePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowancePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowancePoolUsage(ctx context.Context, in *QueryAllowancePoolUsageRequest, opts ...grpc.CallOption) (*QueryAllowancePoolUsageResponse, error) {
+	out := new(QueryAllowancePoolUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowancePoolUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -79,6 +107,16 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// AllowancePool returns the allowance pool funded by the granter and its
+	// remaining balance.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowancePool(context.Context, *QueryAllowancePoolRequest) (*QueryAllowancePoolResponse, error)
+	// AllowancePoolUsage returns the usage of the allowance pool funded by the
+	// granter by the grantee.
+	//
+	// Since: cosmos-sdk 0.47
+	AllowancePoolUsage(context.Context, *QueryAllowancePoolUsageRequest) (*QueryAllowancePoolUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -95,6 +133,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) AllowancePool(context.Context, *QueryAllowancePoolRequest) (*QueryAllowancePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowancePool not implemented")
+}
+func (UnimplementedQueryServer) AllowancePoolUsage(context.Context, *QueryAllowancePoolUsageRequest) (*QueryAllowancePoolUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowancePoolUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +206,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowancePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowancePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowancePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowancePool(ctx, req.(*QueryAllowancePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowancePoolUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancePoolUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowancePoolUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowancePoolUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowancePoolUsage(ctx, req.(*QueryAllowancePoolUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +261,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "AllowancePool",
+			Handler:    _Query_AllowancePool_Handler,
+		},
+		{
+			MethodName: "AllowancePoolUsage",
+			Handler:    _Query_AllowancePoolUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
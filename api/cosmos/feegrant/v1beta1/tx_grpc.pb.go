@@ -28,6 +28,16 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// SetAllowancePool creates or updates an allowance pool funded by the
+	// granter's account, which any account can use to pay its fees.
+	//
+	// Since: cosmos-sdk 0.47
+	SetAllowancePool(ctx context.Context, in *MsgSetAllowancePool, opts ...grpc.CallOption) (*MsgSetAllowancePoolResponse, error)
+	// RemoveAllowancePool removes the allowance pool funded by the granter's
+	// account.
+	//
+	// Since: cosmos-sdk 0.47
+	RemoveAllowancePool(ctx context.Context, in *MsgRemoveAllowancePool, opts ...grpc.CallOption) (*MsgRemoveAllowancePoolResponse, error)
 }
 
 type msgClient struct {
@@ -56,6 +66,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) SetAllowancePool(ctx context.Context, in *MsgSetAllowancePool, opts ...grpc.CallOption) (*MsgSetAllowancePoolResponse, error) {
+	out := new(MsgSetAllowancePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/SetAllowancePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowancePool(ctx context.Context, in *MsgRemoveAllowancePool, opts ...grpc.CallOption) (*MsgRemoveAllowancePoolResponse, error) {
+	out := new(MsgRemoveAllowancePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RemoveAllowancePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -66,6 +94,16 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// SetAllowancePool creates or updates an allowance pool funded by the
+	// granter's account, which any account can use to pay its fees.
+	//
+	// Since: cosmos-sdk 0.47
+	SetAllowancePool(context.Context, *MsgSetAllowancePool) (*MsgSetAllowancePoolResponse, error)
+	// RemoveAllowancePool removes the allowance pool funded by the granter's
+	// account.
+	//
+	// Since: cosmos-sdk 0.47
+	RemoveAllowancePool(context.Context, *MsgRemoveAllowancePool) (*MsgRemoveAllowancePoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -79,6 +117,12 @@ func (UnimplementedMsgServer) GrantAllowance(context.Context, *MsgGrantAllowance
 func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (UnimplementedMsgServer) SetAllowancePool(context.Context, *MsgSetAllowancePool) (*MsgSetAllowancePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowancePool not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowancePool(context.Context, *MsgRemoveAllowancePool) (*MsgRemoveAllowancePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowancePool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -128,6 +172,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAllowancePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowancePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowancePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/SetAllowancePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowancePool(ctx, req.(*MsgSetAllowancePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowancePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowancePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowancePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RemoveAllowancePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowancePool(ctx, req.(*MsgRemoveAllowancePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -143,6 +223,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "SetAllowancePool",
+			Handler:    _Msg_SetAllowancePool_Handler,
+		},
+		{
+			MethodName: "RemoveAllowancePool",
+			Handler:    _Msg_RemoveAllowancePool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
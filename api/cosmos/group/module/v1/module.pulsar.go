@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	md_Module                      protoreflect.MessageDescriptor
-	fd_Module_max_execution_period protoreflect.FieldDescriptor
-	fd_Module_max_metadata_len     protoreflect.FieldDescriptor
+	md_Module                        protoreflect.MessageDescriptor
+	fd_Module_max_execution_period   protoreflect.FieldDescriptor
+	fd_Module_max_metadata_len       protoreflect.FieldDescriptor
+	fd_Module_max_auto_execution_gas protoreflect.FieldDescriptor
+	fd_Module_max_nesting_depth      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +28,8 @@ func init() {
 	md_Module = File_cosmos_group_module_v1_module_proto.Messages().ByName("Module")
 	fd_Module_max_execution_period = md_Module.Fields().ByName("max_execution_period")
 	fd_Module_max_metadata_len = md_Module.Fields().ByName("max_metadata_len")
+	fd_Module_max_auto_execution_gas = md_Module.Fields().ByName("max_auto_execution_gas")
+	fd_Module_max_nesting_depth = md_Module.Fields().ByName("max_nesting_depth")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -105,6 +109,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxAutoExecutionGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAutoExecutionGas)
+		if !f(fd_Module_max_auto_execution_gas, value) {
+			return
+		}
+	}
+	if x.MaxNestingDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNestingDepth)
+		if !f(fd_Module_max_nesting_depth, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +140,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxExecutionPeriod != nil
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		return x.MaxMetadataLen != uint64(0)
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		return x.MaxAutoExecutionGas != uint64(0)
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		return x.MaxNestingDepth != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -144,6 +164,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxExecutionPeriod = nil
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		x.MaxMetadataLen = uint64(0)
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		x.MaxAutoExecutionGas = uint64(0)
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		x.MaxNestingDepth = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -166,6 +190,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		value := x.MaxMetadataLen
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		value := x.MaxAutoExecutionGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		value := x.MaxNestingDepth
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -190,6 +220,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxExecutionPeriod = value.Message().Interface().(*durationpb.Duration)
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		x.MaxMetadataLen = value.Uint()
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		x.MaxAutoExecutionGas = value.Uint()
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		x.MaxNestingDepth = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -217,6 +251,10 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfMessage(x.MaxExecutionPeriod.ProtoReflect())
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		panic(fmt.Errorf("field max_metadata_len of message cosmos.group.module.v1.Module is not mutable"))
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		panic(fmt.Errorf("field max_auto_execution_gas of message cosmos.group.module.v1.Module is not mutable"))
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		panic(fmt.Errorf("field max_nesting_depth of message cosmos.group.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -235,6 +273,10 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.module.v1.Module.max_auto_execution_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.module.v1.Module.max_nesting_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -311,6 +353,12 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.MaxMetadataLen != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxMetadataLen))
 		}
+		if x.MaxAutoExecutionGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAutoExecutionGas))
+		}
+		if x.MaxNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNestingDepth))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -340,6 +388,16 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNestingDepth))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxAutoExecutionGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAutoExecutionGas))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.MaxMetadataLen != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLen))
 			i--
@@ -463,6 +521,44 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAutoExecutionGas", wireType)
+				}
+				x.MaxAutoExecutionGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAutoExecutionGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+				}
+				x.MaxNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNestingDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -523,6 +619,12 @@ type Module struct {
 	// max_metadata_len defines the max length of the metadata bytes field for various entities within the group module.
 	// Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64 `protobuf:"varint,2,opt,name=max_metadata_len,json=maxMetadataLen,proto3" json:"max_metadata_len,omitempty"`
+	// max_auto_execution_gas defines the max gas which can be consumed by the automatic execution of a single proposal
+	// in EndBlock. Defaults to 10,000,000 if not explicitly set.
+	MaxAutoExecutionGas uint64 `protobuf:"varint,3,opt,name=max_auto_execution_gas,json=maxAutoExecutionGas,proto3" json:"max_auto_execution_gas,omitempty"`
+	// max_nesting_depth defines the max number of levels of groups which can be nested in a hierarchy of groups through
+	// their group policy members. Defaults to 5 if not explicitly set.
+	MaxNestingDepth uint64 `protobuf:"varint,4,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -559,6 +661,20 @@ func (x *Module) GetMaxMetadataLen() uint64 {
 	return 0
 }
 
+func (x *Module) GetMaxAutoExecutionGas() uint64 {
+	if x != nil {
+		return x.MaxAutoExecutionGas
+	}
+	return 0
+}
+
+func (x *Module) GetMaxNestingDepth() uint64 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
+}
+
 var File_cosmos_group_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
@@ -571,7 +687,7 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
 	0x12, 0x55, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
@@ -580,23 +696,30 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65,
-	0x6e, 0x3a, 0x2c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x26, 0x0a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0xd6, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b,
-	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x3a, 0x2c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x26, 0x0a, 0x24, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0xd6, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -52,6 +52,11 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// GroupMemberTree queries the members of a group, recursively resolving the
+	// members which are group policy accounts into the members of their group.
+	//
+	// Since: cosmos-sdk 0.47
+	GroupMemberTree(ctx context.Context, in *QueryGroupMemberTreeRequest, opts ...grpc.CallOption) (*QueryGroupMemberTreeResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +184,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) GroupMemberTree(ctx context.Context, in *QueryGroupMemberTreeRequest, opts ...grpc.CallOption) (*QueryGroupMemberTreeResponse, error) {
+	out := new(QueryGroupMemberTreeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupMemberTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +227,11 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// GroupMemberTree queries the members of a group, recursively resolving the
+	// members which are group policy accounts into the members of their group.
+	//
+	// Since: cosmos-sdk 0.47
+	GroupMemberTree(context.Context, *QueryGroupMemberTreeRequest) (*QueryGroupMemberTreeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -259,6 +278,9 @@ func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMe
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) GroupMemberTree(context.Context, *QueryGroupMemberTreeRequest) (*QueryGroupMemberTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMemberTree not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -506,6 +528,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupMemberTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMemberTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupMemberTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupMemberTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupMemberTree(ctx, req.(*QueryGroupMemberTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +605,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "GroupMemberTree",
+			Handler:    _Query_GroupMemberTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -50,6 +50,11 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// SubmitSubProposal submits a proposal to a group policy, member of another
+	// group, to vote on a proposal of that parent group.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitSubProposal(ctx context.Context, in *MsgSubmitSubProposal, opts ...grpc.CallOption) (*MsgSubmitSubProposalResponse, error)
 }
 
 type msgClient struct {
@@ -186,6 +191,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SubmitSubProposal(ctx context.Context, in *MsgSubmitSubProposal, opts ...grpc.CallOption) (*MsgSubmitSubProposalResponse, error) {
+	out := new(MsgSubmitSubProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitSubProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -218,6 +232,11 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// SubmitSubProposal submits a proposal to a group policy, member of another
+	// group, to vote on a proposal of that parent group.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitSubProposal(context.Context, *MsgSubmitSubProposal) (*MsgSubmitSubProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -267,6 +286,9 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) SubmitSubProposal(context.Context, *MsgSubmitSubProposal) (*MsgSubmitSubProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSubProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -532,6 +554,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitSubProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSubProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSubProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitSubProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSubProposal(ctx, req.(*MsgSubmitSubProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -595,6 +635,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "SubmitSubProposal",
+			Handler:    _Msg_SubmitSubProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
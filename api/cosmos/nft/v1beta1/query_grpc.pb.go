@@ -37,6 +37,15 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassConfig queries the permissions of an NFT class created with Msg/CreateClass
+	ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error)
+	// Royalty queries the royalty of an NFT class and the amount due on a sale price, same as royaltyInfo in ERC2981
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
+	// Approved queries the account approved to send an NFT, same as getApproved in ERC721
+	Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error)
+	// IsApprovedForAll queries whether an operator is approved to send all the NFTs of a class owned by an owner, same
+	// as isApprovedForAll in ERC721
+	IsApprovedForAll(ctx context.Context, in *QueryIsApprovedForAllRequest, opts ...grpc.CallOption) (*QueryIsApprovedForAllResponse, error)
 }
 
 type queryClient struct {
@@ -110,6 +119,42 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error) {
+	out := new(QueryClassConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Royalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error) {
+	out := new(QueryApprovedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Approved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsApprovedForAll(ctx context.Context, in *QueryIsApprovedForAllRequest, opts ...grpc.CallOption) (*QueryIsApprovedForAllResponse, error) {
+	out := new(QueryIsApprovedForAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/IsApprovedForAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -129,6 +174,15 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassConfig queries the permissions of an NFT class created with Msg/CreateClass
+	ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error)
+	// Royalty queries the royalty of an NFT class and the amount due on a sale price, same as royaltyInfo in ERC2981
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
+	// Approved queries the account approved to send an NFT, same as getApproved in ERC721
+	Approved(context.Context, *QueryApprovedRequest) (*QueryApprovedResponse, error)
+	// IsApprovedForAll queries whether an operator is approved to send all the NFTs of a class owned by an owner, same
+	// as isApprovedForAll in ERC721
+	IsApprovedForAll(context.Context, *QueryIsApprovedForAllRequest) (*QueryIsApprovedForAllResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -157,6 +211,18 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassConfig not implemented")
+}
+func (UnimplementedQueryServer) Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
+func (UnimplementedQueryServer) Approved(context.Context, *QueryApprovedRequest) (*QueryApprovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approved not implemented")
+}
+func (UnimplementedQueryServer) IsApprovedForAll(context.Context, *QueryIsApprovedForAllRequest) (*QueryIsApprovedForAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsApprovedForAll not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +362,78 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassConfig(ctx, req.(*QueryClassConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Royalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Approved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Approved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Approved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Approved(ctx, req.(*QueryApprovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsApprovedForAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsApprovedForAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsApprovedForAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/IsApprovedForAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsApprovedForAll(ctx, req.(*QueryIsApprovedForAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +469,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassConfig",
+			Handler:    _Query_ClassConfig_Handler,
+		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
+		{
+			MethodName: "Approved",
+			Handler:    _Query_Approved_Handler,
+		},
+		{
+			MethodName: "IsApprovedForAll",
+			Handler:    _Query_IsApprovedForAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
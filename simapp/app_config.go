@@ -117,6 +117,7 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 					{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+					{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 					{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 					{Account: nft.ModuleName},
 				},
@@ -153,7 +154,9 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 		},
 		{
 			Name:   authz.ModuleName,
-			Config: appconfig.WrapAny(&authzmodulev1.Module{}),
+			Config: appconfig.WrapAny(&authzmodulev1.Module{
+				MaxDelegationDepth: 3,
+			}),
 		},
 		{
 			Name:   upgradetypes.ModuleName,
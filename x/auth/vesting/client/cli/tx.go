@@ -18,6 +18,9 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +37,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -166,35 +171,81 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			startTime, periods, err := readVestingData(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Create a new vesting account funded with an allocation of tokens, subject to clawback by the
+funder (the sender of the transaction) of the tokens which are not vested yet. Tokens become transferable
+once they are both unlocked and vested. The lockup and vesting schedules are given by the '--lockup' and
+'--vesting' flags, which take the path to a periods JSON file in the same format as for the
+create-periodic-vesting-account command. A missing schedule unlocks or vests all the tokens at the start
+time. When both schedules are given, they must have the same start time and total amount.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-			err = json.Unmarshal(contents, &vestingData)
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("at least one of --%s or --%s must be provided", FlagLockup, FlagVesting)
+			}
+
+			var (
+				startTime                     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
 
-			for i, p := range vestingData.Periods {
+			if lockupFile != "" {
+				startTime, lockupPeriods, err = readVestingData(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+			if vestingFile != "" {
+				var vestingStart int64
+				vestingStart, vestingPeriods, err = readVestingData(vestingFile)
 				if err != nil {
 					return err
 				}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+				if lockupFile != "" && vestingStart != startTime {
+					return fmt.Errorf("lockup start time %d does not match vesting start time %d", startTime, vestingStart)
 				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
+				startTime = vestingStart
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -203,7 +254,87 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagLockup, "", "Path to the periods JSON file of the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to the periods JSON file of the vesting schedule")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a
+// MsgClawback transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the tokens of a clawback vesting account which are not vested yet, including
+staked ones. Only the funder of the account may claw back its tokens. The tokens are sent to the
+address given by the '--dest' flag, or to the funder if it is omitted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr, _ := cmd.Flags().GetString(FlagDest); destStr != "" {
+				dest, err = sdk.AccAddressFromBech32(destStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readVestingData reads the start time and the periods of a vesting schedule
+// from a periods JSON file.
+func readVestingData(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
@@ -2,6 +2,8 @@ package testutil
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
@@ -221,3 +223,108 @@ func (s *IntegrationTestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
 		s.T().Logf("Height now: %d", height)
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	dir := s.T().TempDir()
+	lockupFile := filepath.Join(dir, "lockup.json")
+	s.Require().NoError(os.WriteFile(lockupFile, []byte(fmt.Sprintf(`{"start_time": 1625204910, "periods": [{"coins": "100%s", "length_seconds": 2592000}]}`, s.cfg.BondDenom)), 0o600))
+	vestingFile := filepath.Join(dir, "vesting.json")
+	s.Require().NoError(os.WriteFile(vestingFile, []byte(fmt.Sprintf(`{"start_time": 1625204910, "periods": [{"coins": "50%s", "length_seconds": 2592000}, {"coins": "50%s", "length_seconds": 2592000}]}`, s.cfg.BondDenom, s.cfg.BondDenom)), 0o600))
+	otherStartFile := filepath.Join(dir, "other_start.json")
+	s.Require().NoError(os.WriteFile(otherStartFile, []byte(fmt.Sprintf(`{"start_time": 1625204911, "periods": [{"coins": "100%s", "length_seconds": 2592000}]}`, s.cfg.BondDenom)), 0o600))
+
+	testCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"create a clawback vesting account": {
+			args: []string{
+				sdk.AccAddress("addr5_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockupFile),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"create a clawback vesting account with only a vesting schedule": {
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"no schedule": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"mismatched start times": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, otherStartFile),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid address": {
+			args: []string{
+				"addr7",
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	// Synchronize height between test runs, to ensure sequence numbers are
+	// properly updated.
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err, "Getting initial latest height")
+	s.T().Logf("Initial latest height: %d", height)
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+		next, err := s.network.WaitForHeight(height + 1)
+		s.Require().NoError(err, "Waiting for height...")
+		height = next
+		s.T().Logf("Height now: %d", height)
+	}
+}
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	"github.com/armon/go-metrics"
 
@@ -16,12 +17,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -213,3 +215,215 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	)
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	lockupPeriods := types.Periods(msg.LockupPeriods)
+	vestingPeriods := types.Periods(msg.VestingPeriods)
+
+	// an empty schedule releases all the coins at the start time
+	totalCoins := vestingPeriods.TotalAmount()
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+	if len(vestingPeriods) == 0 {
+		totalCoins = lockupPeriods.TotalAmount()
+		vestingPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, lockupPeriods, vestingPeriods)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	clawedBack, err := s.clawback(ctx, va, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgClawbackResponse{Amount: clawedBack}, nil
+}
+
+// clawback removes the unvested coins of the account and sends them to dest. The
+// unbonded coins are sent first, then the unbonding delegations and eventually the
+// delegations of the account are transferred to dest, as the coins of the account
+// may only be missing from its balance because they are staked. It returns the
+// coins actually clawed back, which may be less than the unvested coins if the
+// delegations of the account have been slashed.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	// update the delegation bookkeeping of the account, and save it so that the
+	// clawed back coins are unlocked
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := bk.GetAllBalances(ctx, addr)
+	toClawBack = va.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+	ak.SetAccount(ctx, va)
+
+	// send the unbonded coins first
+	toSend := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if err := bk.SendCoins(ctx, addr, dest, toSend); err != nil {
+		return nil, err
+	}
+	clawedBack := sdk.NewCoins(toSend...)
+
+	// then transfer the unbonding delegations
+	want := toClawBack.AmountOf(bondDenom).Sub(toSend.AmountOf(bondDenom))
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, transferred))
+		want = want.Sub(transferred)
+	}
+
+	// and eventually the delegations
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+
+		transferredShares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := validator.TokensFromShares(transferredShares).RoundInt()
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, transferred))
+		want = want.Sub(transferred)
+	}
+
+	return clawedBack, nil
+}
@@ -0,0 +1,130 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	funder    sdk.AccAddress
+	validator stakingtypes.Validator
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+
+	_, _, s.funder = testdata.KeyTestPubAddr()
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, s.funder, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+
+	validators := s.app.StakingKeeper.GetAllValidators(s.ctx)
+	s.Require().Len(validators, 1)
+	s.validator = validators[0]
+}
+
+// createClawbackVestingAccount creates a clawback vesting account vesting 100
+// coins in 4 periods of 100 seconds each.
+func (s *MsgServerTestSuite) createClawbackVestingAccount() sdk.AccAddress {
+	_, _, addr := testdata.KeyTestPubAddr()
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))},
+	}
+
+	msg := types.NewMsgCreateClawbackVestingAccount(s.funder, addr, s.ctx.BlockTime().Unix(), nil, periods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return addr
+}
+
+func (s *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	addr := s.createClawbackVestingAccount()
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr)
+	s.Require().IsType(&types.ClawbackVestingAccount{}, acc)
+	va := acc.(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.funder.String(), va.FunderAddress)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), va.OriginalVesting)
+	s.Require().Equal(types.Periods{{Length: 0, Amount: va.OriginalVesting}}, va.GetLockupPeriods())
+	s.Require().Equal(s.ctx.BlockTime().Unix()+400, va.GetEndTime())
+	s.Require().NoError(va.Validate())
+
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), s.app.BankKeeper.GetBalance(s.ctx, addr, sdk.DefaultBondDenom))
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), s.app.BankKeeper.GetBalance(s.ctx, s.funder, sdk.DefaultBondDenom))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// the account already exists
+	msg := types.NewMsgCreateClawbackVestingAccount(s.funder, addr, s.ctx.BlockTime().Unix(), nil, va.GetVestingPeriods())
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	addr := s.createClawbackVestingAccount()
+	_, _, dest := testdata.KeyTestPubAddr()
+	valAddr := s.validator.GetOperator()
+
+	// delegate 60 coins and undelegate 20 of them
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(60), stakingtypes.Unbonded, s.validator, true)
+	s.Require().NoError(err)
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().True(found)
+	shares, err := validator.SharesFromTokens(sdk.NewInt(20))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, valAddr, shares)
+	s.Require().NoError(err)
+
+	// only the funder can claw back
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(150 * time.Second))
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(dest, addr, dest))
+	s.Require().Error(err)
+
+	// claw back the 75 unvested coins: the 40 unbonded coins first, then the 20
+	// unbonding coins and eventually 15 of the bonded coins
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(s.funder, addr, dest))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 75)), res.Amount)
+
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40), s.app.BankKeeper.GetBalance(ctx, dest, sdk.DefaultBondDenom))
+	s.Require().Equal(sdk.NewInt(20), s.app.StakingKeeper.GetDelegatorUnbonding(ctx, dest))
+	s.Require().Equal(sdk.NewInt(15), s.app.StakingKeeper.GetDelegatorBonded(ctx, dest))
+
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).IsZero())
+	s.Require().True(s.app.StakingKeeper.GetDelegatorUnbonding(ctx, addr).IsZero())
+	s.Require().Equal(sdk.NewInt(25), s.app.StakingKeeper.GetDelegatorBonded(ctx, addr))
+
+	va := s.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), va.OriginalVesting)
+	s.Require().True(va.DelegatedVesting.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), va.DelegatedFree)
+	s.Require().NoError(va.Validate())
+
+	// nothing left to claw back
+	res, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(s.funder, addr, dest))
+	s.Require().NoError(err)
+	s.Require().True(res.Amount.IsZero())
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -16,12 +16,22 @@ const TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account
 // TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreateVestingAccount.
 const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //nolint:interfacer
 func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
@@ -186,3 +196,137 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if err := validatePeriods(msg.LockupPeriods, "lockup"); err != nil {
+		return err
+	}
+	if err := validatePeriods(msg.VestingPeriods, "vesting"); err != nil {
+		return err
+	}
+
+	lockupCoins := Periods(msg.LockupPeriods).TotalAmount()
+	vestingCoins := Periods(msg.VestingPeriods).TotalAmount()
+	switch {
+	case len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0:
+		return sdkerrors.ErrInvalidRequest.Wrap("lockup and vesting periods cannot both be empty")
+	case len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !lockupCoins.IsEqual(vestingCoins):
+		return sdkerrors.ErrInvalidCoins.Wrapf("lockup (%s) and vesting (%s) amounts must be equal", lockupCoins, vestingCoins)
+	}
+
+	return nil
+}
+
+// validatePeriods checks that the periods have non-negative lengths and valid,
+// positive amounts.
+func validatePeriods(periods []Period, name string) error {
+	for i, period := range periods {
+		if period.Length < 0 {
+			return fmt.Errorf("invalid %s period length of %d in period %d, length must not be negative", name, period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid %s period amount %s in period %d", name, period.Amount, i)
+		}
+	}
+	return nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destString string
+	if dest != nil {
+		destString = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destString,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	if msg.Address == msg.DestAddress {
+		return sdkerrors.ErrInvalidRequest.Wrap("the destination cannot be the vesting account")
+	}
+
+	return nil
+}
@@ -58,3 +58,44 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// readSchedule returns the coins released by the periods at the given time,
+// where each period releases its amount once it has fully elapsed since the
+// end of the previous one, the first one starting at startTime.
+func readSchedule(startTime int64, periods Periods, time int64) sdk.Coins {
+	released := sdk.NewCoins()
+	if time < startTime {
+		return released
+	}
+
+	endTime := startTime
+	for _, period := range periods {
+		endTime += period.Length
+		if time < endTime {
+			break
+		}
+		released = released.Add(period.Amount...)
+	}
+	return released
+}
+
+// capPeriods returns a copy of the periods releasing at most max coins in
+// total. The coins of the periods are released at the same time until the
+// cap is reached, and the trailing periods releasing nothing are dropped.
+func capPeriods(periods Periods, max sdk.Coins) Periods {
+	capped := make(Periods, 0, len(periods))
+	released := sdk.NewCoins()
+	for _, period := range periods {
+		prevCapped := released.Min(max)
+		released = released.Add(period.Amount...)
+		capped = append(capped, Period{
+			Length: period.Length,
+			Amount: released.Min(max).Sub(prevCapped...),
+		})
+	}
+
+	for len(capped) > 0 && capped[len(capped)-1].Amount.IsZero() {
+		capped = capped[:len(capped)-1]
+	}
+	return capped
+}
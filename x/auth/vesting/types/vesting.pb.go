@@ -279,6 +279,55 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It provides
+// an account that can hold contributions subject to separate "lockup" and
+// "vesting" schedules, with the unvested coins being recoverable by the
+// funder with Msg/Clawback. Coins become spendable once they are both vested
+// and unlocked.
+//
+// Since: cosmos-sdk 0.47
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the account which funded the vesting account, and is
+	// the only one allowed to claw back its unvested coins.
+	FunderAddress  string   `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	LockupPeriods  []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -286,6 +335,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -293,41 +343,45 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x35, 0x21, 0x94, 0x2b, 0xb4, 0xe5, 0x54, 0xa2, 0xb4, 0x12, 0x4e, 0x54, 0x31, 0x44,
-	0x48, 0x38, 0xa4, 0x6c, 0xdd, 0x70, 0x11, 0x12, 0x02, 0x24, 0x64, 0x21, 0x06, 0x96, 0xe8, 0x6c,
-	0x3f, 0x9c, 0x53, 0xe3, 0xbb, 0xc8, 0x77, 0x8e, 0xda, 0x0f, 0x00, 0x42, 0x62, 0x61, 0x64, 0xec,
-	0x86, 0xc4, 0x27, 0xe9, 0x98, 0x91, 0xa9, 0xa0, 0x64, 0x63, 0xe6, 0x03, 0x20, 0xdf, 0x9d, 0xdd,
-	0x2a, 0x05, 0xa6, 0xf2, 0x67, 0xb2, 0xdf, 0x9f, 0xfb, 0xfd, 0xf1, 0x7b, 0x3e, 0x7c, 0x2b, 0x12,
-	0x32, 0x15, 0xb2, 0x37, 0x01, 0xa9, 0x18, 0x4f, 0x7a, 0x93, 0x7e, 0x08, 0x8a, 0xf6, 0xcb, 0xd8,
-	0x1b, 0x67, 0x42, 0x09, 0xd2, 0x34, 0x5d, 0x5e, 0x99, 0xb5, 0x5d, 0x5b, 0x1b, 0x89, 0x48, 0x84,
-	0x6e, 0xe9, 0x15, 0x6f, 0xa6, 0x7b, 0xcb, 0xb5, 0x98, 0x21, 0x95, 0x50, 0x01, 0x46, 0x82, 0xf1,
-	0x85, 0x3a, 0xcd, 0xd5, 0xb0, 0xaa, 0x17, 0x81, 0xa9, 0x6f, 0x7f, 0xab, 0x61, 0xe2, 0x53, 0x09,
-	0x2f, 0x0c, 0xdb, 0xfd, 0x28, 0x12, 0x39, 0x57, 0xe4, 0x11, 0xbe, 0x5a, 0x20, 0x0e, 0xa8, 0x89,
-	0x5b, 0xa8, 0x83, 0xba, 0x2b, 0x3b, 0x1d, 0xcf, 0x6a, 0xd3, 0x00, 0x16, 0xcd, 0x2b, 0x8e, 0xdb,
-	0x73, 0x7e, 0x7d, 0x7a, 0xd2, 0x46, 0xc1, 0x4a, 0x78, 0x9a, 0x22, 0x13, 0xbc, 0x2e, 0x32, 0x96,
-	0x30, 0x4e, 0x47, 0x03, 0xeb, 0xa9, 0xb5, 0xd4, 0xa9, 0x75, 0x57, 0x76, 0x36, 0x4b, 0xb8, 0xa2,
-	0xbd, 0x82, 0xdb, 0x13, 0x8c, 0xfb, 0x77, 0x8f, 0x4f, 0xda, 0xce, 0xa7, 0x2f, 0xed, 0x6e, 0xc2,
-	0xd4, 0x30, 0x0f, 0xbd, 0x48, 0xa4, 0x3d, 0xeb, 0xc4, 0x3c, 0xee, 0xc8, 0x78, 0xbf, 0xa7, 0x0e,
-	0xc7, 0x20, 0xf5, 0x01, 0x19, 0xac, 0x95, 0x24, 0xd6, 0x09, 0xc9, 0xf0, 0x6a, 0x0c, 0x23, 0x48,
-	0xa8, 0x82, 0x78, 0xf0, 0x2a, 0x03, 0x68, 0xd5, 0x2e, 0x9e, 0xf5, 0x5a, 0x45, 0xf1, 0x30, 0x03,
-	0x20, 0x07, 0xf8, 0xfa, 0x29, 0x67, 0x69, 0xb6, 0x7e, 0xf1, 0xb4, 0xeb, 0x15, 0x4b, 0xe9, 0x76,
-	0x13, 0x2f, 0x03, 0x8f, 0x07, 0x8a, 0xa5, 0xd0, 0xba, 0xd4, 0x41, 0xdd, 0x5a, 0x70, 0x19, 0x78,
-	0xfc, 0x9c, 0xa5, 0xb0, 0xbb, 0xfc, 0xf6, 0xa8, 0xed, 0x7c, 0x38, 0x6a, 0x3b, 0xdb, 0x1f, 0x11,
-	0x6e, 0xed, 0x09, 0xae, 0x18, 0xcf, 0x45, 0x2e, 0x17, 0x46, 0x1e, 0xe2, 0x0d, 0x3d, 0x72, 0x2b,
-	0x7b, 0x61, 0xf4, 0xb7, 0xbd, 0x9f, 0xaf, 0xa5, 0x77, 0x7e, 0x79, 0xec, 0x12, 0x90, 0xf0, 0xfc,
-	0x5a, 0xdd, 0xc4, 0x58, 0x2a, 0x9a, 0x29, 0xa3, 0x73, 0x49, 0xeb, 0xbc, 0xa2, 0x33, 0x0b, 0x4a,
-	0x5f, 0x23, 0x7c, 0xe3, 0x01, 0x8c, 0xe8, 0x61, 0xe5, 0xf0, 0x2f, 0xca, 0x3c, 0xa3, 0xe3, 0x1d,
-	0xc2, 0x8d, 0x67, 0x90, 0x31, 0x11, 0x93, 0x26, 0x6e, 0x8c, 0x80, 0x27, 0x6a, 0xa8, 0xa9, 0x6a,
-	0x81, 0x8d, 0x48, 0x84, 0x1b, 0x34, 0xd5, 0x12, 0xfe, 0xc0, 0x56, 0x5b, 0xe8, 0xdd, 0xba, 0x56,
-	0xf3, 0x1d, 0xe1, 0xa6, 0x51, 0xc3, 0xa2, 0xff, 0x6e, 0x7a, 0xe4, 0x29, 0x5e, 0x2b, 0xd9, 0xc7,
-	0x5a, 0xa4, 0xb4, 0x7f, 0x9c, 0xfb, 0x2b, 0x76, 0xe3, 0xc5, 0xaf, 0x17, 0x9f, 0x25, 0x58, 0xb5,
-	0x55, 0x93, 0x94, 0x67, 0x86, 0xf0, 0xc6, 0xd8, 0x4e, 0x29, 0x07, 0xae, 0x9e, 0x88, 0x68, 0x1f,
-	0xe2, 0x7f, 0xb2, 0x0d, 0xfe, 0xe3, 0xe3, 0x99, 0x8b, 0xa6, 0x33, 0x17, 0x7d, 0x9d, 0xb9, 0xe8,
-	0xfd, 0xdc, 0x75, 0xa6, 0x73, 0xd7, 0xf9, 0x3c, 0x77, 0x9d, 0x97, 0xfd, 0xdf, 0x4e, 0xf4, 0xc0,
-	0x5e, 0xbf, 0xf6, 0xde, 0xd7, 0x03, 0x0e, 0x1b, 0xfa, 0x02, 0xbe, 0xf7, 0x23, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0x5c, 0x2d, 0x10, 0x16, 0x06, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xde, 0xed, 0xa6, 0xb1, 0x9d, 0xda, 0xb4, 0x2e, 0x35, 0xa4, 0x05, 0x37, 0xa1, 0x28, 0x04,
+	0xc1, 0x8d, 0xad, 0xb7, 0xde, 0x9a, 0x8a, 0x20, 0x55, 0x90, 0x45, 0x3c, 0x78, 0x09, 0xb3, 0xbb,
+	0xaf, 0x9b, 0x25, 0xbb, 0x33, 0x61, 0x67, 0x36, 0xb6, 0x7f, 0x80, 0x22, 0x78, 0xf1, 0xe8, 0xb1,
+	0x37, 0xc1, 0xbf, 0xa4, 0xc7, 0x1c, 0x3d, 0x55, 0x49, 0x2e, 0xe2, 0xd9, 0x3f, 0x40, 0x76, 0x66,
+	0x76, 0x5b, 0xb6, 0x55, 0x10, 0xa2, 0xf5, 0x94, 0xcc, 0xfb, 0xf1, 0x7d, 0xdf, 0xdb, 0xef, 0x0d,
+	0x83, 0x6e, 0x7b, 0x94, 0xc5, 0x94, 0x75, 0x46, 0xc0, 0x78, 0x48, 0x82, 0xce, 0x68, 0xcb, 0x05,
+	0x8e, 0xb7, 0xf2, 0xb3, 0x3d, 0x4c, 0x28, 0xa7, 0x66, 0x5d, 0x56, 0xd9, 0x79, 0x54, 0x55, 0x6d,
+	0xac, 0x05, 0x34, 0xa0, 0xa2, 0xa4, 0x93, 0xfd, 0x93, 0xd5, 0x1b, 0x96, 0xc2, 0x74, 0x31, 0x83,
+	0x02, 0xd0, 0xa3, 0x21, 0x29, 0xe5, 0x71, 0xca, 0xfb, 0x45, 0x3e, 0x3b, 0xc8, 0xfc, 0xe6, 0x77,
+	0x03, 0x99, 0x5d, 0xcc, 0xe0, 0x85, 0x64, 0xdb, 0xf5, 0x3c, 0x9a, 0x12, 0x6e, 0x3e, 0x46, 0xd7,
+	0x33, 0xc4, 0x1e, 0x96, 0xe7, 0x86, 0xde, 0xd2, 0xdb, 0x4b, 0xdb, 0x2d, 0x5b, 0x69, 0x13, 0x00,
+	0x0a, 0xcd, 0xce, 0xda, 0x55, 0x5f, 0xb7, 0x32, 0x3e, 0x6d, 0xea, 0xce, 0x92, 0x7b, 0x16, 0x32,
+	0x47, 0x68, 0x95, 0x26, 0x61, 0x10, 0x12, 0x1c, 0xf5, 0xd4, 0x4c, 0x8d, 0xb9, 0x96, 0xd1, 0x5e,
+	0xda, 0x5e, 0xcf, 0xe1, 0xb2, 0xf2, 0x02, 0x6e, 0x8f, 0x86, 0xa4, 0x7b, 0xff, 0xe4, 0xb4, 0xa9,
+	0x7d, 0xfa, 0xd2, 0x6c, 0x07, 0x21, 0xef, 0xa7, 0xae, 0xed, 0xd1, 0xb8, 0xa3, 0x26, 0x91, 0x3f,
+	0xf7, 0x98, 0x3f, 0xe8, 0xf0, 0xa3, 0x21, 0x30, 0xd1, 0xc0, 0x9c, 0x95, 0x9c, 0x44, 0x4d, 0x62,
+	0x26, 0xa8, 0xe6, 0x43, 0x04, 0x01, 0xe6, 0xe0, 0xf7, 0x0e, 0x12, 0x80, 0x86, 0x31, 0x7b, 0xd6,
+	0xe5, 0x82, 0xe2, 0x51, 0x02, 0x60, 0x1e, 0xa2, 0x1b, 0x67, 0x9c, 0xf9, 0xb0, 0x95, 0xd9, 0xd3,
+	0xae, 0x16, 0x2c, 0xf9, 0xb4, 0xeb, 0x68, 0x01, 0x88, 0xdf, 0xe3, 0x61, 0x0c, 0x8d, 0xf9, 0x96,
+	0xde, 0x36, 0x9c, 0x6b, 0x40, 0xfc, 0xe7, 0x61, 0x0c, 0x3b, 0x0b, 0x6f, 0x8f, 0x9b, 0xda, 0x87,
+	0xe3, 0xa6, 0xb6, 0xf9, 0x51, 0x47, 0x8d, 0x3d, 0x4a, 0x78, 0x48, 0x52, 0x9a, 0xb2, 0x92, 0xe5,
+	0x2e, 0x5a, 0x13, 0x96, 0x2b, 0xd9, 0x25, 0xeb, 0xef, 0xda, 0x97, 0xaf, 0xa5, 0x7d, 0x71, 0x79,
+	0xd4, 0x12, 0x98, 0xee, 0xc5, 0xb5, 0xba, 0x85, 0x10, 0xe3, 0x38, 0xe1, 0x52, 0xe7, 0x9c, 0xd0,
+	0xb9, 0x28, 0x22, 0x25, 0xa5, 0xaf, 0x75, 0x74, 0xf3, 0x21, 0x44, 0xf8, 0x08, 0xfc, 0x12, 0xc4,
+	0x3f, 0x90, 0x79, 0x4e, 0xc7, 0x3b, 0x1d, 0x55, 0x9f, 0x41, 0x12, 0x52, 0xdf, 0xac, 0xa3, 0x6a,
+	0x04, 0x24, 0xe0, 0x7d, 0x41, 0x65, 0x38, 0xea, 0x64, 0x7a, 0xa8, 0x8a, 0x63, 0x21, 0xe1, 0x2f,
+	0x6c, 0xb5, 0x82, 0xde, 0xa9, 0x08, 0x35, 0x3f, 0x74, 0x54, 0x97, 0x6a, 0x42, 0xef, 0xbf, 0x73,
+	0xcf, 0x7c, 0x8a, 0x56, 0x72, 0xf6, 0xa1, 0x10, 0xc9, 0xd4, 0x8d, 0xb3, 0x7e, 0xc5, 0x2e, 0x67,
+	0xe9, 0x56, 0xb2, 0xcf, 0xe2, 0xd4, 0x54, 0x56, 0x06, 0xd9, 0x39, 0x13, 0xde, 0xc8, 0xb1, 0x63,
+	0x4c, 0x80, 0xf0, 0x27, 0xd4, 0x1b, 0x80, 0x7f, 0x35, 0xdb, 0xf0, 0x6d, 0x0e, 0xd5, 0xf7, 0x22,
+	0xfc, 0xca, 0xc5, 0xde, 0xe0, 0x0a, 0xbe, 0xff, 0x1d, 0x54, 0x3b, 0x48, 0x89, 0x0f, 0x49, 0x0f,
+	0xfb, 0x7e, 0x02, 0x8c, 0x09, 0x0f, 0x16, 0x9d, 0x65, 0x19, 0xdd, 0x95, 0xc1, 0x92, 0x4d, 0x46,
+	0xd9, 0xa6, 0x7d, 0x54, 0x8b, 0xa8, 0x37, 0x48, 0x87, 0x85, 0x4b, 0x95, 0x3f, 0x70, 0x69, 0x59,
+	0xf6, 0xca, 0x18, 0xbb, 0xcc, 0xf3, 0xf9, 0x59, 0x78, 0xde, 0xdd, 0x3f, 0x99, 0x58, 0xfa, 0x78,
+	0x62, 0xe9, 0x5f, 0x27, 0x96, 0xfe, 0x7e, 0x6a, 0x69, 0xe3, 0xa9, 0xa5, 0x7d, 0x9e, 0x5a, 0xda,
+	0xcb, 0xad, 0xdf, 0x5e, 0x9e, 0x43, 0xf5, 0xd2, 0xa9, 0x27, 0x56, 0xdc, 0x25, 0xb7, 0x2a, 0xde,
+	0xba, 0x07, 0x3f, 0x07, 0x00, 0x60, 0xd1, 0x6d, 0x9f, 0x81, 0x07, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -618,6 +672,81 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -745,6 +874,38 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1477,6 +1638,211 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -591,6 +595,201 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. Both the lockup
+// and the vesting periods must release the original vesting coins in total.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule, regardless
+// of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins released by the vesting schedule, regardless
+// of the lockup schedule. These are the coins which can't be clawed back.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// GetVestedCoins returns the total number of coins that are both vested and
+// unlocked. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vestedCoins := va.GetVestedOnly(blockTime).Min(va.GetUnlockedOnly(blockTime))
+	if vestedCoins.IsZero() {
+		return nil
+	}
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of coins that are either still
+// vesting or still locked. If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and unlocking start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetLockupPeriods returns the lockup periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// ComputeClawback removes the vesting periods which haven't elapsed at the given
+// time from the account, and returns the total of their coins. The lockup periods
+// are capped to the remaining original vesting coins, keeping the unlocking times
+// of the vested coins. The delegated vesting and delegated free coins are left
+// unchanged, and must be updated with UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vested := sdk.NewCoins()
+	unvested := sdk.NewCoins()
+	vestedPeriods := 0
+	endTime := va.StartTime
+	for i, period := range va.VestingPeriods {
+		endTime += period.Length
+		if endTime <= clawbackTime {
+			vested = vested.Add(period.Amount...)
+			vestedPeriods = i + 1
+		} else {
+			unvested = unvested.Add(period.Amount...)
+		}
+	}
+
+	if unvested.IsZero() {
+		return unvested
+	}
+
+	va.VestingPeriods = va.VestingPeriods[:vestedPeriods]
+	va.LockupPeriods = capPeriods(va.LockupPeriods, vested)
+	va.OriginalVesting = vested
+	va.EndTime = va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength())
+	return unvested
+}
+
+// UpdateDelegation sets the delegated vesting and delegated free coins of the account
+// after a clawback, and returns the coins which can actually be clawed back, given
+// the current bonded, unbonding and unbonded (i.e. balance) coins of the account:
+//
+//   - the coins to claw back are capped to the coins held by the account, which may
+//     be less than expected if delegations have been slashed
+//   - the unbonded coins are clawed back first, then the delegated ones
+//   - the coins lost to slashing are still accounted for in the delegated coins, as
+//     they were delegated from the account
+//   - the remaining delegated coins are vesting up to the encumbered coins, i.e. the
+//     vesting coins of the account after ComputeClawback, and free otherwise.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	total := delegated.Add(unbonded...)
+	toClawBack = toClawBack.Min(total)
+	newDelegated := delegated.Min(total.Sub(toClawBack...)).Add(slashed...)
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting...)
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if endTime := va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength()); endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
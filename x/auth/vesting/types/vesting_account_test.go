@@ -679,6 +679,210 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require coins vested after the first vesting period to be still locked
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.True(t, va.GetUnlockedOnly(now.Add(6*time.Hour)).IsZero())
+	require.Nil(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require 50% of coins vested and unlocked at the end of the lockup period
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestingCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.True(t, va.GetVestingCoins(now.Add(18*time.Hour)).IsZero())
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require that there exist no spendable coins at the beginning of the
+	// vesting schedule
+	lockedCoins := va.LockedCoins(now)
+	require.Equal(t, origCoins, lockedCoins)
+
+	// require that all vested and unlocked coins are spendable
+	lockedCoins = va.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
+
+	// delegate 75 locked coins, only the remaining 25 vesting coins are locked
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.DelegatedFree)
+	lockedCoins = va.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, lockedCoins)
+}
+
+func TestComputeClawback(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require nothing to claw back once all coins are vested
+	va2 := *va
+	clawback := va2.ComputeClawback(now.Add(24 * time.Hour).Unix())
+	require.True(t, clawback.IsZero())
+	require.Equal(t, *va, va2)
+
+	// require the unvested periods to be clawed back, and the lockup periods to
+	// be capped to the vested coins
+	clawback = va.ComputeClawback(now.Add(7 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.OriginalVesting)
+	require.Equal(t, vestingPeriods[:1], va.GetVestingPeriods())
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}, va.GetLockupPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// require the vested coins to unlock at the end of the lockup period
+	require.Nil(t, va.GetVestedCoins(now.Add(7*time.Hour)))
+	require.Equal(t, va.OriginalVesting, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require everything to be clawed back before the first vesting period ends
+	_, _, addr := testdata.KeyTestPubAddr()
+	va = types.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	clawback = va.ComputeClawback(now.Unix())
+	require.Equal(t, origCoins, clawback)
+	require.True(t, va.OriginalVesting.IsZero())
+	require.Empty(t, va.GetVestingPeriods())
+	require.Empty(t, va.GetLockupPeriods())
+	require.NoError(t, va.Validate())
+}
+
+func TestUpdateDelegation(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	testCases := []struct {
+		name             string
+		delegatedVesting sdk.Coins
+		delegatedFree    sdk.Coins
+		encumbered       sdk.Coins
+		toClawBack       sdk.Coins
+		bonded           sdk.Coins
+		unbonding        sdk.Coins
+		unbonded         sdk.Coins
+		expClawBack      sdk.Coins
+		expVesting       sdk.Coins
+		expFree          sdk.Coins
+	}{
+		{
+			"nothing delegated",
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+		{
+			"unbonded coins clawed back first",
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 30)),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 70)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 30)),
+			sdk.NewCoins(),
+		},
+		{
+			"delegated coins clawed back",
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 90)),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 80)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)),
+			sdk.NewCoins(),
+		},
+		{
+			"slashed delegation",
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 90)),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 80)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 45)),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 55)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20)),
+			sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 25)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			bacc, _ := initBaseAccount()
+			_, _, funder := testdata.KeyTestPubAddr()
+			va := types.NewClawbackVestingAccount(bacc, funder, periods.TotalAmount(), now.Unix(), periods, periods)
+			va.DelegatedVesting = tc.delegatedVesting
+			va.DelegatedFree = tc.delegatedFree
+
+			clawBack := va.UpdateDelegation(tc.encumbered, tc.toClawBack, tc.bonded, tc.unbonding, tc.unbonded)
+			require.True(t, tc.expClawBack.IsEqual(clawBack), clawBack)
+			require.True(t, tc.expVesting.IsEqual(va.DelegatedVesting), va.DelegatedVesting)
+			require.True(t, tc.expFree.IsEqual(va.DelegatedFree), va.DelegatedFree)
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -744,6 +948,36 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback funder address",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -828,6 +1062,25 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}}, types.Periods{types.Period{7200, coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +30,47 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConstraintOperator defines how the value of a field is compared to the values
+// of a FieldConstraint.
+type ConstraintOperator int32
+
+const (
+	// CONSTRAINT_OPERATOR_UNSPECIFIED specifies an unknown operator
+	ConstraintOperator_CONSTRAINT_OPERATOR_UNSPECIFIED ConstraintOperator = 0
+	// CONSTRAINT_OPERATOR_IN requires the field to equal one of the values
+	ConstraintOperator_CONSTRAINT_OPERATOR_IN ConstraintOperator = 1
+	// CONSTRAINT_OPERATOR_NOT_IN requires the field to differ from all the values
+	ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN ConstraintOperator = 2
+	// CONSTRAINT_OPERATOR_GTE requires the field to be greater than or equal to the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_GTE ConstraintOperator = 3
+	// CONSTRAINT_OPERATOR_LTE requires the field to be less than or equal to the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_LTE ConstraintOperator = 4
+)
+
+var ConstraintOperator_name = map[int32]string{
+	0: "CONSTRAINT_OPERATOR_UNSPECIFIED",
+	1: "CONSTRAINT_OPERATOR_IN",
+	2: "CONSTRAINT_OPERATOR_NOT_IN",
+	3: "CONSTRAINT_OPERATOR_GTE",
+	4: "CONSTRAINT_OPERATOR_LTE",
+}
+
+var ConstraintOperator_value = map[string]int32{
+	"CONSTRAINT_OPERATOR_UNSPECIFIED": 0,
+	"CONSTRAINT_OPERATOR_IN":          1,
+	"CONSTRAINT_OPERATOR_NOT_IN":      2,
+	"CONSTRAINT_OPERATOR_GTE":         3,
+	"CONSTRAINT_OPERATOR_LTE":         4,
+}
+
+func (x ConstraintOperator) String() string {
+	return proto.EnumName(ConstraintOperator_name, int32(x))
+}
+
+func (ConstraintOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -69,6 +111,183 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// MaxExecutionsAuthorization wraps an authorization to limit the number of
+// times it can be executed. The grant is deleted after its last execution.
+type MaxExecutionsAuthorization struct {
+	// authorization is the wrapped authorization.
+	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// remaining_executions is the number of times the authorization can still be
+	// executed.
+	RemainingExecutions uint64 `protobuf:"varint,2,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+}
+
+func (m *MaxExecutionsAuthorization) Reset()         { *m = MaxExecutionsAuthorization{} }
+func (m *MaxExecutionsAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MaxExecutionsAuthorization) ProtoMessage()    {}
+func (*MaxExecutionsAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *MaxExecutionsAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxExecutionsAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxExecutionsAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxExecutionsAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxExecutionsAuthorization.Merge(m, src)
+}
+func (m *MaxExecutionsAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxExecutionsAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxExecutionsAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxExecutionsAuthorization proto.InternalMessageInfo
+
+// CooldownAuthorization wraps an authorization to require a minimum duration
+// between two of its executions.
+type CooldownAuthorization struct {
+	// authorization is the wrapped authorization.
+	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// cooldown is the minimum duration between two executions.
+	Cooldown time.Duration `protobuf:"bytes,2,opt,name=cooldown,proto3,stdduration" json:"cooldown"`
+	// last_execution is the block time of the last execution, if any.
+	LastExecution *time.Time `protobuf:"bytes,3,opt,name=last_execution,json=lastExecution,proto3,stdtime" json:"last_execution,omitempty"`
+}
+
+func (m *CooldownAuthorization) Reset()         { *m = CooldownAuthorization{} }
+func (m *CooldownAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CooldownAuthorization) ProtoMessage()    {}
+func (*CooldownAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *CooldownAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CooldownAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CooldownAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CooldownAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CooldownAuthorization.Merge(m, src)
+}
+func (m *CooldownAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CooldownAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CooldownAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CooldownAuthorization proto.InternalMessageInfo
+
+// ContentFilteredAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account, as long as the message
+// satisfies all of the given constraints on its content.
+type ContentFilteredAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// constraints are the constraints the content of the message must satisfy.
+	Constraints []FieldConstraint `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *ContentFilteredAuthorization) Reset()         { *m = ContentFilteredAuthorization{} }
+func (m *ContentFilteredAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContentFilteredAuthorization) ProtoMessage()    {}
+func (*ContentFilteredAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *ContentFilteredAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentFilteredAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentFilteredAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentFilteredAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentFilteredAuthorization.Merge(m, src)
+}
+func (m *ContentFilteredAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentFilteredAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentFilteredAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentFilteredAuthorization proto.InternalMessageInfo
+
+// FieldConstraint restricts the value of a field of a message.
+type FieldConstraint struct {
+	// path is the dot separated path of the field in the proto JSON encoding of
+	// the message, using the original proto field names, e.g. `amount.denom`.
+	// A numeric element selects an item of a repeated field, otherwise the rest
+	// of the path must be satisfied by all of its items.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// operator is the comparison the field value must satisfy.
+	Operator ConstraintOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.authz.v1beta1.ConstraintOperator" json:"operator,omitempty"`
+	// values are the values the field is compared to. GTE and LTE constraints
+	// take a single numeric value.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -77,13 +296,21 @@ type Grant struct {
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// delegatable allows the grantee to grant the authorization, or a narrower
+	// one, to other accounts on behalf of the granter with a MsgRegrant.
+	Delegatable bool `protobuf:"varint,3,opt,name=delegatable,proto3" json:"delegatable,omitempty"`
+	// delegator is the grantee of the parent grant who issued this grant on behalf
+	// of the granter. It is empty for grants issued by the granter.
+	Delegator string `protobuf:"bytes,4,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// depth is the number of parent grants between the granter and this grant.
+	Depth uint32 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,13 +346,16 @@ type GrantAuthorization struct {
 	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Delegatable   bool       `protobuf:"varint,5,opt,name=delegatable,proto3" json:"delegatable,omitempty"`
+	Delegator     string     `protobuf:"bytes,6,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Depth         uint32     `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +394,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{7}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +424,12 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.ConstraintOperator", ConstraintOperator_name, ConstraintOperator_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*MaxExecutionsAuthorization)(nil), "cosmos.authz.v1beta1.MaxExecutionsAuthorization")
+	proto.RegisterType((*CooldownAuthorization)(nil), "cosmos.authz.v1beta1.CooldownAuthorization")
+	proto.RegisterType((*ContentFilteredAuthorization)(nil), "cosmos.authz.v1beta1.ContentFilteredAuthorization")
+	proto.RegisterType((*FieldConstraint)(nil), "cosmos.authz.v1beta1.FieldConstraint")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -203,34 +438,56 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbd, 0xae, 0xda, 0x30,
-	0x18, 0x8d, 0x81, 0xfe, 0x60, 0x44, 0xd5, 0x46, 0x19, 0x80, 0x21, 0xa0, 0xa8, 0x03, 0x0b, 0x89,
-	0xa0, 0x9d, 0xda, 0xa5, 0x44, 0x95, 0x50, 0x87, 0x0e, 0x4d, 0xe9, 0xd2, 0x05, 0x25, 0xe0, 0x9a,
-	0xa8, 0x38, 0x8e, 0x6c, 0xa7, 0x22, 0xbc, 0x43, 0x25, 0x1e, 0xa0, 0x8f, 0xc1, 0x43, 0xa0, 0x4e,
-	0xa8, 0x53, 0xa7, 0xfe, 0xc0, 0x8b, 0x5c, 0x61, 0x27, 0xba, 0xe4, 0x66, 0xb8, 0x57, 0xba, 0x53,
-	0xfc, 0x1d, 0x9f, 0x73, 0xfc, 0x7d, 0x27, 0x1f, 0xec, 0xcd, 0x29, 0x27, 0x94, 0x3b, 0x7e, 0x22,
-	0x96, 0x1b, 0xe7, 0xdb, 0x30, 0x40, 0xc2, 0x1f, 0xaa, 0xca, 0x8e, 0x19, 0x15, 0x54, 0x37, 0x14,
-	0xc3, 0x56, 0x58, 0xc6, 0xe8, 0xb4, 0x15, 0x3a, 0x93, 0x1c, 0x27, 0xa3, 0xc8, 0xa2, 0xd3, 0xc5,
-	0x94, 0xe2, 0x15, 0x72, 0x64, 0x15, 0x24, 0x5f, 0x1c, 0x11, 0x12, 0xc4, 0x85, 0x4f, 0xe2, 0x8c,
-	0x60, 0x60, 0x8a, 0xa9, 0x12, 0x9e, 0x4f, 0x19, 0xda, 0xbe, 0x29, 0xf3, 0xa3, 0x54, 0x5d, 0x59,
-	0xaf, 0xa1, 0x31, 0x41, 0x11, 0x62, 0xe1, 0x7c, 0x9c, 0x88, 0x25, 0x65, 0xe1, 0xc6, 0x17, 0x21,
-	0x8d, 0xf4, 0xa7, 0xb0, 0x4a, 0x38, 0x6e, 0x81, 0x1e, 0xe8, 0xd7, 0xbd, 0xf3, 0xf1, 0xd5, 0xb3,
-	0x9f, 0xbb, 0x41, 0xb3, 0x40, 0xb2, 0x7e, 0x00, 0xf8, 0x60, 0xc2, 0xfc, 0x48, 0xe8, 0xef, 0x61,
-	0xd3, 0xbf, 0xbc, 0x92, 0xc2, 0xc6, 0xc8, 0xb0, 0xd5, 0xcb, 0x76, 0xfe, 0xb2, 0x3d, 0x8e, 0x52,
-	0xb7, 0xec, 0xe4, 0x15, 0xd5, 0xfa, 0x5b, 0x08, 0xd1, 0x3a, 0x0e, 0x99, 0xf2, 0xaa, 0x48, 0xaf,
-	0x4e, 0xc9, 0x6b, 0x9a, 0x0f, 0xef, 0x3e, 0xde, 0xff, 0xe9, 0x82, 0xed, 0xdf, 0x2e, 0xf0, 0x2e,
-	0x74, 0xd6, 0xf7, 0x0a, 0xd4, 0x65, 0x7b, 0xc5, 0xd1, 0x46, 0xf0, 0x11, 0x3e, 0xa3, 0x88, 0xa9,
-	0xf1, 0xdc, 0xd6, 0xaf, 0xdd, 0x20, 0xff, 0x15, 0xe3, 0xc5, 0x82, 0x21, 0xce, 0x3f, 0x0a, 0x16,
-	0x46, 0xd8, 0xcb, 0x89, 0xd7, 0x1a, 0x24, 0xbb, 0xb9, 0x83, 0x06, 0x95, 0x33, 0xa9, 0xde, 0x2b,
-	0x93, 0x37, 0x85, 0x4c, 0x6a, 0xb7, 0x66, 0x52, 0x2b, 0xe5, 0xf1, 0x12, 0x3e, 0x91, 0x71, 0x7c,
-	0x48, 0x50, 0x82, 0xde, 0x09, 0x44, 0x74, 0x0b, 0x36, 0x09, 0xc7, 0x33, 0x91, 0xc6, 0x68, 0x96,
-	0xb0, 0x15, 0x6f, 0x81, 0x5e, 0xb5, 0x5f, 0xf7, 0x1a, 0x84, 0xe3, 0x69, 0x1a, 0xa3, 0x4f, 0x6c,
-	0xc5, 0x5d, 0x77, 0xff, 0xdf, 0xd4, 0xf6, 0x47, 0x13, 0x1c, 0x8e, 0x26, 0xf8, 0x77, 0x34, 0xc1,
-	0xf6, 0x64, 0x6a, 0x87, 0x93, 0xa9, 0xfd, 0x3e, 0x99, 0xda, 0xe7, 0xe7, 0x38, 0x14, 0xcb, 0x24,
-	0xb0, 0xe7, 0x94, 0x64, 0xab, 0x9a, 0x7d, 0x06, 0x7c, 0xf1, 0xd5, 0x59, 0xab, 0x75, 0x0f, 0x1e,
-	0xca, 0xfe, 0x5e, 0x5c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb3, 0xf3, 0x46, 0x13, 0x03, 0x00,
-	0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbb, 0x6e, 0xe3, 0x46,
+	0x14, 0xd5, 0xe8, 0x61, 0xcb, 0x23, 0xc8, 0x51, 0x26, 0x8a, 0x43, 0x2b, 0x01, 0x25, 0x28, 0x09,
+	0x20, 0x04, 0x30, 0x05, 0x2b, 0x41, 0x8a, 0xa4, 0x48, 0xf4, 0xb2, 0x21, 0x24, 0x96, 0x1c, 0x9a,
+	0x6e, 0xd2, 0x08, 0x23, 0x71, 0x42, 0x11, 0x21, 0x39, 0xc4, 0x70, 0xe8, 0x48, 0x6e, 0x53, 0xa4,
+	0x49, 0x61, 0x6c, 0xb5, 0x7f, 0xb1, 0x58, 0xc0, 0x1f, 0x61, 0x6c, 0x65, 0x6c, 0xb5, 0xd5, 0x3e,
+	0xec, 0xef, 0x58, 0x60, 0xc1, 0x87, 0x5e, 0x16, 0x17, 0xda, 0x67, 0xa5, 0xb9, 0xf7, 0x9e, 0x73,
+	0xe6, 0xf2, 0xdc, 0x0b, 0x0d, 0x2c, 0x0d, 0xa9, 0x63, 0x52, 0xa7, 0x8a, 0x5d, 0x3e, 0x3a, 0xaf,
+	0x9e, 0xed, 0x0f, 0x08, 0xc7, 0xfb, 0x41, 0x24, 0xd9, 0x8c, 0x72, 0x8a, 0xf2, 0x01, 0x42, 0x0a,
+	0x72, 0x21, 0xa2, 0xb0, 0x1b, 0x64, 0xfb, 0x3e, 0xa6, 0x1a, 0x42, 0xfc, 0xa0, 0x50, 0xd4, 0x28,
+	0xd5, 0x0c, 0x52, 0xf5, 0xa3, 0x81, 0xfb, 0x57, 0x95, 0xeb, 0x26, 0x71, 0x38, 0x36, 0xed, 0x10,
+	0x20, 0xde, 0x05, 0xa8, 0x2e, 0xc3, 0x5c, 0xa7, 0x56, 0x58, 0xcf, 0x6b, 0x54, 0xa3, 0x81, 0xb0,
+	0x77, 0x0a, 0xb3, 0xbb, 0x77, 0x59, 0xd8, 0x9a, 0x04, 0xa5, 0xf2, 0xcf, 0x30, 0x7f, 0x48, 0x2c,
+	0xc2, 0xf4, 0x61, 0xdd, 0xe5, 0x23, 0xca, 0xf4, 0x73, 0x5f, 0x0e, 0xe5, 0x60, 0xc2, 0x74, 0x34,
+	0x01, 0x94, 0x40, 0x65, 0x4b, 0xf6, 0x8e, 0x3f, 0x7d, 0xfa, 0xe8, 0x72, 0x2f, 0xbb, 0x04, 0x2a,
+	0x3f, 0x04, 0xb0, 0x70, 0x84, 0xc7, 0xed, 0x31, 0x19, 0xba, 0x5e, 0xc2, 0x59, 0xd6, 0x38, 0x82,
+	0x59, 0xbc, 0x98, 0xf0, 0xd5, 0x32, 0xb5, 0xbc, 0x14, 0xb4, 0x23, 0x4d, 0xdb, 0x91, 0xea, 0xd6,
+	0xa4, 0xb1, 0x2a, 0x2f, 0x2f, 0xb3, 0xd1, 0x3e, 0xcc, 0x33, 0x62, 0x62, 0xdd, 0xd2, 0x2d, 0xad,
+	0x4f, 0x66, 0x77, 0x0a, 0xf1, 0x12, 0xa8, 0x24, 0xe5, 0xcf, 0x66, 0xb5, 0x79, 0x3b, 0x51, 0x3d,
+	0xff, 0x17, 0x87, 0x9f, 0x37, 0x29, 0x35, 0x54, 0xfa, 0x8f, 0xf5, 0x51, 0xdb, 0xfd, 0x05, 0xa6,
+	0x87, 0xe1, 0x3d, 0x7e, 0x8b, 0x99, 0xda, 0xee, 0x8a, 0x52, 0x2b, 0x9c, 0x5e, 0x23, 0x7d, 0xf5,
+	0xb4, 0x18, 0xbb, 0xff, 0xac, 0x08, 0xe4, 0x19, 0x09, 0xfd, 0x06, 0xb7, 0x0d, 0xec, 0xf0, 0xf9,
+	0xa7, 0x0a, 0x09, 0x5f, 0xa6, 0xb0, 0x22, 0xa3, 0x4c, 0xb7, 0xc4, 0xd7, 0x01, 0x17, 0x9e, 0x4e,
+	0xd6, 0xe3, 0xce, 0xac, 0x88, 0x72, 0xe2, 0x1e, 0x80, 0x5f, 0x35, 0xa9, 0xc5, 0x89, 0xc5, 0x0f,
+	0x74, 0x83, 0x13, 0x46, 0xd4, 0x35, 0x3b, 0x80, 0x8e, 0x60, 0x66, 0x48, 0x2d, 0x87, 0x33, 0xac,
+	0x5b, 0xdc, 0x73, 0x3e, 0x51, 0xc9, 0xd4, 0xbe, 0x95, 0xa2, 0xd6, 0x5c, 0x3a, 0xd0, 0x89, 0xa1,
+	0x36, 0x67, 0xe8, 0x46, 0xd2, 0xfb, 0x44, 0x79, 0x91, 0x1f, 0xd5, 0xd4, 0xbf, 0x00, 0x7e, 0x72,
+	0x87, 0x89, 0x10, 0x4c, 0xda, 0x98, 0x8f, 0xc2, 0x46, 0xfc, 0x33, 0x6a, 0xc1, 0x34, 0xb5, 0x09,
+	0xc3, 0x9c, 0x32, 0xdf, 0xdd, 0xed, 0x5a, 0x25, 0xba, 0x8d, 0xb9, 0x4e, 0x2f, 0xc4, 0xcb, 0x33,
+	0x26, 0xda, 0x81, 0x1b, 0x67, 0xd8, 0x70, 0x89, 0x23, 0x24, 0x4a, 0x89, 0xca, 0x96, 0x1c, 0x46,
+	0xe5, 0xff, 0xe3, 0x30, 0x75, 0xc8, 0xb0, 0xc5, 0x3f, 0xf4, 0x52, 0xb4, 0x20, 0x24, 0x63, 0x5b,
+	0x0f, 0xa6, 0x2e, 0xc4, 0xdf, 0x62, 0x9e, 0x0b, 0x3c, 0x54, 0x82, 0x19, 0x95, 0x18, 0x44, 0xc3,
+	0x1c, 0x0f, 0x0c, 0xe2, 0xaf, 0x45, 0x5a, 0x5e, 0x4c, 0xa1, 0x1f, 0xe1, 0x56, 0x18, 0x52, 0x26,
+	0x24, 0x3d, 0xdf, 0x1a, 0xc2, 0xe3, 0xcb, 0xbd, 0xe9, 0x1f, 0x52, 0x5d, 0x55, 0x19, 0x71, 0x9c,
+	0x13, 0xce, 0x74, 0x4b, 0x93, 0xe7, 0x50, 0x94, 0x87, 0x29, 0x95, 0xd8, 0x7c, 0x24, 0xa4, 0x4a,
+	0xa0, 0x92, 0x95, 0x83, 0xa0, 0xfc, 0x32, 0x0e, 0x91, 0x6f, 0xc7, 0xf2, 0x7e, 0xd4, 0xe0, 0xa6,
+	0xe6, 0x65, 0x09, 0x13, 0xc0, 0x9a, 0x2b, 0xa6, 0xc0, 0x39, 0x87, 0x08, 0xf1, 0x37, 0xe3, 0x90,
+	0xd5, 0x19, 0x24, 0xde, 0x6b, 0x06, 0xbf, 0x2e, 0xcd, 0x20, 0xb9, 0x76, 0x06, 0xc9, 0x75, 0xfe,
+	0xa7, 0xd6, 0xf8, 0xbf, 0xf1, 0x0e, 0xfe, 0x6f, 0x2e, 0xfa, 0xff, 0x03, 0xdc, 0xf6, 0xed, 0xff,
+	0xc3, 0x25, 0x2e, 0xe9, 0x70, 0x62, 0xa2, 0x32, 0xcc, 0x9a, 0x8e, 0xd6, 0xe7, 0x13, 0x9b, 0xf4,
+	0x5d, 0x66, 0x38, 0x02, 0xf0, 0xf7, 0x37, 0x63, 0x3a, 0x9a, 0x32, 0xb1, 0xc9, 0x29, 0x33, 0x9c,
+	0xef, 0x1e, 0x00, 0x88, 0x56, 0xb7, 0x1f, 0x7d, 0x0d, 0x8b, 0xcd, 0x5e, 0xf7, 0x44, 0x91, 0xeb,
+	0x9d, 0xae, 0xd2, 0xef, 0x1d, 0xb7, 0xe5, 0xba, 0xd2, 0x93, 0xfb, 0xa7, 0xdd, 0x93, 0xe3, 0x76,
+	0xb3, 0x73, 0xd0, 0x69, 0xb7, 0x72, 0x31, 0x54, 0x80, 0x3b, 0x51, 0xa0, 0x4e, 0x37, 0x07, 0x90,
+	0x08, 0x0b, 0x51, 0xb5, 0x6e, 0x4f, 0xf1, 0xea, 0x71, 0xf4, 0x25, 0xfc, 0x22, 0xaa, 0x7e, 0xa8,
+	0xb4, 0x73, 0x89, 0xd7, 0x15, 0x7f, 0x57, 0xda, 0xb9, 0x64, 0xa3, 0x71, 0xf5, 0x42, 0x8c, 0x5d,
+	0xdd, 0x88, 0xe0, 0xfa, 0x46, 0x04, 0xcf, 0x6f, 0x44, 0x70, 0x71, 0x2b, 0xc6, 0xae, 0x6f, 0xc5,
+	0xd8, 0x93, 0x5b, 0x31, 0xf6, 0xe7, 0x37, 0x9a, 0xce, 0x47, 0xee, 0x40, 0x1a, 0x52, 0x33, 0x7c,
+	0x35, 0xc3, 0x9f, 0x3d, 0x47, 0xfd, 0xbb, 0x3a, 0x0e, 0x5e, 0xde, 0xc1, 0x86, 0x3f, 0xc1, 0xef,
+	0x5f, 0x0d, 0x00, 0x96, 0x10, 0x4d, 0x19, 0x9e, 0x07, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -263,6 +520,187 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxExecutionsAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxExecutionsAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxExecutionsAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CooldownAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CooldownAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CooldownAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExecution != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastExecution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastExecution):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cooldown):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContentFilteredAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentFilteredAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentFilteredAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -283,13 +721,35 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Delegatable {
+		i--
+		if m.Delegatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Expiration != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -328,13 +788,35 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Delegatable {
+		i--
+		if m.Delegatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintAuthz(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -423,7 +905,7 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *MaxExecutionsAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -433,10 +915,96 @@ func (m *Grant) Size() (n int) {
 		l = m.Authorization.Size()
 		n += 1 + l + sovAuthz(uint64(l))
 	}
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingExecutions))
+	}
+	return n
+}
+
+func (m *CooldownAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cooldown)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.LastExecution != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastExecution)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *ContentFilteredAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovAuthz(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Delegatable {
+		n += 2
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovAuthz(uint64(m.Depth))
+	}
 	return n
 }
 
@@ -462,6 +1030,16 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.Delegatable {
+		n += 2
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovAuthz(uint64(m.Depth))
+	}
 	return n
 }
 
@@ -477,16 +1055,474 @@ func (m *GrantQueueItem) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenericAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxExecutionsAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxExecutionsAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxExecutionsAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CooldownAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CooldownAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CooldownAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExecution == nil {
+				m.LastExecution = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastExecution, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentFilteredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentFilteredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentFilteredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, FieldConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -509,15 +1545,15 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenericAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -545,7 +1581,58 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= ConstraintOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -669,6 +1756,77 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delegatable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -855,6 +2013,77 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delegatable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
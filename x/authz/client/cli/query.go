@@ -98,15 +98,16 @@ $ %s query %s grants cosmos1skjw.. cosmos1skjwj.. %s
 // GetQueryGranterGrants returns cmd to query for all grants for a granter.
 func GetQueryGranterGrants() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grants-by-granter [granter-addr]",
-		Args:  cobra.ExactArgs(1),
+		Use:   "grants-by-granter [granter-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "query authorization grants granted by granter",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query authorization grants granted by granter.
 Examples:
 $ %s q %s grants-by-granter cosmos1skj..
+$ %s q %s grants-by-granter cosmos1skj.. %s
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -119,6 +120,11 @@ $ %s q %s grants-by-granter cosmos1skj..
 				return err
 			}
 
+			msgAuthorized := ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -129,6 +135,7 @@ $ %s q %s grants-by-granter cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
 					Granter:    granter.String(),
+					MsgTypeUrl: msgAuthorized,
 					Pagination: pageReq,
 				},
 			)
@@ -147,15 +154,16 @@ $ %s q %s grants-by-granter cosmos1skj..
 // GetQueryGranteeGrants returns cmd to query for all grants for a grantee.
 func GetQueryGranteeGrants() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grants-by-grantee [grantee-addr]",
-		Args:  cobra.ExactArgs(1),
+		Use:   "grants-by-grantee [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "query authorization grants granted to a grantee",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query authorization grants granted to a grantee.
 Examples:
 $ %s q %s grants-by-grantee cosmos1skj..
+$ %s q %s grants-by-grantee cosmos1skj.. %s
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -168,6 +176,11 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				return err
 			}
 
+			msgAuthorized := ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -178,6 +191,7 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranteeGrantsRequest{
 					Grantee:    grantee.String(),
+					MsgTypeUrl: msgAuthorized,
 					Pagination: pageReq,
 				},
 			)
@@ -26,6 +26,12 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagPeriod            = "period"
+	FlagPeriodSpendLimit  = "period-spend-limit"
+	FlagMaxExecutions     = "max-executions"
+	FlagCooldown          = "cooldown"
+	FlagConstraint        = "constraint"
+	FlagDelegatable       = "delegatable"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -45,6 +51,9 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
+		NewCmdRegrantAuthorization(),
+		NewCmdRevokeRegrantAuthorization(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -54,15 +63,18 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"content-filtered\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
- $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. send --period=720h --period-spend-limit=100stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --max-executions=3 --cooldown=24h --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. content-filtered --msg-type=/cosmos.gov.v1.MsgVote --constraint=proposal_id:gte:10 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --delegatable --from=cosmos1skl..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,94 +88,9 @@ Examples:
 				return err
 			}
 
-			var authorization authz.Authorization
-			switch args[1] {
-			case "send":
-				limit, err := cmd.Flags().GetString(FlagSpendLimit)
-				if err != nil {
-					return err
-				}
-
-				spendLimit, err := sdk.ParseCoinsNormalized(limit)
-				if err != nil {
-					return err
-				}
-
-				if !spendLimit.IsAllPositive() {
-					return fmt.Errorf("spend-limit should be greater than zero")
-				}
-
-				authorization = bank.NewSendAuthorization(spendLimit)
-			case "generic":
-				msgType, err := cmd.Flags().GetString(FlagMsgType)
-				if err != nil {
-					return err
-				}
-
-				authorization = authz.NewGenericAuthorization(msgType)
-			case delegate, unbond, redelegate:
-				limit, err := cmd.Flags().GetString(FlagSpendLimit)
-				if err != nil {
-					return err
-				}
-
-				allowValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
-				if err != nil {
-					return err
-				}
-
-				denyValidators, err := cmd.Flags().GetStringSlice(FlagDenyValidators)
-				if err != nil {
-					return err
-				}
-
-				var delegateLimit *sdk.Coin
-				if limit != "" {
-					spendLimit, err := sdk.ParseCoinNormalized(limit)
-					if err != nil {
-						return err
-					}
-					queryClient := staking.NewQueryClient(clientCtx)
-
-					res, err := queryClient.Params(cmd.Context(), &staking.QueryParamsRequest{})
-					if err != nil {
-						return err
-					}
-
-					if spendLimit.Denom != res.Params.BondDenom {
-						return fmt.Errorf("invalid denom %s; coin denom should match the current bond denom %s", spendLimit.Denom, res.Params.BondDenom)
-					}
-
-					if !spendLimit.IsPositive() {
-						return fmt.Errorf("spend-limit should be greater than zero")
-					}
-					delegateLimit = &spendLimit
-				}
-
-				allowed, err := bech32toValidatorAddresses(allowValidators)
-				if err != nil {
-					return err
-				}
-
-				denied, err := bech32toValidatorAddresses(denyValidators)
-				if err != nil {
-					return err
-				}
-
-				switch args[1] {
-				case delegate:
-					authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, delegateLimit)
-				case unbond:
-					authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, delegateLimit)
-				default:
-					authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, delegateLimit)
-				}
-				if err != nil {
-					return err
-				}
-
-			default:
-				return fmt.Errorf("invalid authorization type, %s", args[1])
+			authorization, err := newAuthorization(cmd, clientCtx, args[1])
+			if err != nil {
+				return err
 			}
 
 			expire, err := getExpireTime(cmd)
@@ -176,16 +103,201 @@ Examples:
 				return err
 			}
 
+			msg.Grant.Delegatable, err = cmd.Flags().GetBool(FlagDelegatable)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	addAuthorizationFlags(cmd)
+	return cmd
+}
+
+// addAuthorizationFlags adds the flags used to create an authorization to the command.
+func addAuthorizationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or a ContentFilteredAuthorization")
+	cmd.Flags().StringArray(FlagConstraint, []string{}, "Constraint of a ContentFilteredAuthorization on the message content as <path>:<in|not-in|gte|lte>:<values separated by ,>")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
-	return cmd
+	cmd.Flags().Duration(FlagPeriod, 0, "Period after which the period spend limit of a Send Authorization is reset. Set zero (0) for no period.")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "Coins allowed to be spent within each period of a Send Authorization")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of times the authorization can be executed. Set zero (0) for no limit.")
+	cmd.Flags().Duration(FlagCooldown, 0, "Minimum time between two executions of the authorization. Set zero (0) for no cooldown.")
+	cmd.Flags().Bool(FlagDelegatable, false, "Allow the grantee to regrant the authorization, or a narrower one, to other accounts")
+}
+
+// newAuthorization creates an authorization of the provided type from the command flags.
+func newAuthorization(cmd *cobra.Command, clientCtx client.Context, authType string) (authz.Authorization, error) {
+	var authorization authz.Authorization
+	switch authType {
+	case "send":
+		limit, err := cmd.Flags().GetString(FlagSpendLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		spendLimit, err := sdk.ParseCoinsNormalized(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		period, err := cmd.Flags().GetDuration(FlagPeriod)
+		if err != nil {
+			return nil, err
+		}
+
+		if period == 0 {
+			if !spendLimit.IsAllPositive() {
+				return nil, fmt.Errorf("spend-limit should be greater than zero")
+			}
+
+			authorization = bank.NewSendAuthorization(spendLimit)
+			break
+		}
+
+		periodLimit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		periodSpendLimit, err := sdk.ParseCoinsNormalized(periodLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		if !periodSpendLimit.IsAllPositive() {
+			return nil, fmt.Errorf("period-spend-limit should be greater than zero")
+		}
+
+		authorization = bank.NewPeriodicSendAuthorization(spendLimit, period, periodSpendLimit)
+	case "generic":
+		msgType, err := cmd.Flags().GetString(FlagMsgType)
+		if err != nil {
+			return nil, err
+		}
+
+		authorization = authz.NewGenericAuthorization(msgType)
+	case "content-filtered":
+		msgType, err := cmd.Flags().GetString(FlagMsgType)
+		if err != nil {
+			return nil, err
+		}
+
+		constraintStrs, err := cmd.Flags().GetStringArray(FlagConstraint)
+		if err != nil {
+			return nil, err
+		}
+
+		constraints := make([]authz.FieldConstraint, len(constraintStrs))
+		for i, c := range constraintStrs {
+			constraints[i], err = authz.ParseFieldConstraint(c)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		authorization = authz.NewContentFilteredAuthorization(msgType, constraints)
+	case delegate, unbond, redelegate:
+		limit, err := cmd.Flags().GetString(FlagSpendLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		allowValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		denyValidators, err := cmd.Flags().GetStringSlice(FlagDenyValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		var delegateLimit *sdk.Coin
+		if limit != "" {
+			spendLimit, err := sdk.ParseCoinNormalized(limit)
+			if err != nil {
+				return nil, err
+			}
+			queryClient := staking.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &staking.QueryParamsRequest{})
+			if err != nil {
+				return nil, err
+			}
+
+			if spendLimit.Denom != res.Params.BondDenom {
+				return nil, fmt.Errorf("invalid denom %s; coin denom should match the current bond denom %s", spendLimit.Denom, res.Params.BondDenom)
+			}
+
+			if !spendLimit.IsPositive() {
+				return nil, fmt.Errorf("spend-limit should be greater than zero")
+			}
+			delegateLimit = &spendLimit
+		}
+
+		allowed, err := bech32toValidatorAddresses(allowValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		denied, err := bech32toValidatorAddresses(denyValidators)
+		if err != nil {
+			return nil, err
+		}
+
+		switch authType {
+		case delegate:
+			authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, delegateLimit)
+		case unbond:
+			authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, delegateLimit)
+		default:
+			authorization, err = staking.NewStakeAuthorization(allowed, denied, staking.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE, delegateLimit)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid authorization type, %s", authType)
+	}
+
+	return wrapAuthorization(cmd, authorization)
+}
+
+// wrapAuthorization wraps the authorization with a MaxExecutionsAuthorization
+// and a CooldownAuthorization if the corresponding flags are set.
+func wrapAuthorization(cmd *cobra.Command, authorization authz.Authorization) (authz.Authorization, error) {
+	cooldown, err := cmd.Flags().GetDuration(FlagCooldown)
+	if err != nil {
+		return nil, err
+	}
+
+	if cooldown != 0 {
+		authorization, err = authz.NewCooldownAuthorization(authorization, cooldown)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxExecutions != 0 {
+		authorization, err = authz.NewMaxExecutionsAuthorization(authorization, maxExecutions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return authorization, nil
 }
 
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
@@ -234,6 +346,134 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all [msg-type-url]? --from=[granter]",
+		Short: "revoke all authorizations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all authorizations granted by a granter. If msg-type-url is set,
+only the authorizations for that msg type are revoked:
+Examples:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+ $ %s tx %s revoke-all %s --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgAuthorized := ""
+			if len(args) == 1 {
+				msgAuthorized = args[0]
+			}
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress(), msgAuthorized)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRegrantAuthorization returns a CLI command handler for creating a MsgRegrant transaction.
+func NewCmdRegrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "regrant <granter> <grantee> <authorization_type=\"send\"|\"generic\"|\"content-filtered\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <delegator>",
+		Short: "Regrant a delegatable authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`grant, on behalf of the granter, an authorization you were granted with a delegatable grant to another address.
+The executions of the new grant are also subject to your grant, and it can't expire after it:
+
+Examples:
+ $ %s tx %s regrant cosmos1skl.. cosmos1skjw.. send --spend-limit=100stake --expiration=1700000000 --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := newAuthorization(cmd, clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			expire, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegatable, err := cmd.Flags().GetBool(FlagDelegatable)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgRegrant(granter, clientCtx.GetFromAddress(), grantee, authorization, expire, delegatable)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addAuthorizationFlags(cmd)
+	return cmd
+}
+
+// NewCmdRevokeRegrantAuthorization returns a CLI command handler for creating a MsgRevokeRegrant transaction.
+func NewCmdRevokeRegrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-regrant [granter] [grantee] [msg-type-url] --from=[delegator]",
+		Short: "revoke a regranted authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke an authorization regranted to a grantee on behalf of a granter:
+Example:
+ $ %s tx %s revoke-regrant cosmos1skl.. cosmos1skj.. %s --from=cosmos1sk..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeRegrant(granter, clientCtx.GetFromAddress(), grantee, args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -14,10 +14,16 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
+	legacy.RegisterAminoMsg(cdc, &MsgRegrant{}, "cosmos-sdk/MsgRegrant")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeRegrant{}, "cosmos-sdk/MsgRevokeRegrant")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&MaxExecutionsAuthorization{}, "cosmos-sdk/MaxExecutionsAuthorization", nil)
+	cdc.RegisterConcrete(&CooldownAuthorization{}, "cosmos-sdk/CooldownAuthorization", nil)
+	cdc.RegisterConcrete(&ContentFilteredAuthorization{}, "cosmos-sdk/ContentFilteredAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -25,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrant{},
 		&MsgRevoke{},
+		&MsgRevokeAll{},
+		&MsgRegrant{},
+		&MsgRevokeRegrant{},
 		&MsgExec{},
 	)
 
@@ -32,6 +41,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&MaxExecutionsAuthorization{},
+		&CooldownAuthorization{},
+		&ContentFilteredAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
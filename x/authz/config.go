@@ -0,0 +1,15 @@
+package authz
+
+// Config is a config struct used for intialising the authz module to avoid using globals.
+type Config struct {
+	// MaxDelegationDepth defines the maximum number of times a grant can be regranted.
+	// Regranting is disabled if it is 0.
+	MaxDelegationDepth uint32
+}
+
+// DefaultConfig returns the default config for authz.
+func DefaultConfig() Config {
+	return Config{
+		MaxDelegationDepth: 3,
+	}
+}
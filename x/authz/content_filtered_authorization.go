@@ -0,0 +1,210 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &ContentFilteredAuthorization{}
+
+// NewContentFilteredAuthorization creates a new ContentFilteredAuthorization object.
+func NewContentFilteredAuthorization(msgTypeURL string, constraints []FieldConstraint) *ContentFilteredAuthorization {
+	return &ContentFilteredAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContentFilteredAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The message is accepted if its proto
+// JSON encoding satisfies all of the constraints.
+func (a ContentFilteredAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var content interface{}
+	if err := dec.Decode(&content); err != nil {
+		return AcceptResponse{}, err
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.check(content, strings.Split(c.Path, ".")); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContentFilteredAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidType.Wrap("msg type URL cannot be empty")
+	}
+
+	if len(a.Constraints) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one constraint is required")
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the constraint.
+func (c FieldConstraint) ValidateBasic() error {
+	for _, p := range strings.Split(c.Path, ".") {
+		if p == "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid constraint path %q", c.Path)
+		}
+	}
+
+	switch c.Operator {
+	case ConstraintOperator_CONSTRAINT_OPERATOR_IN, ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN:
+		if len(c.Values) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("constraint on %s requires at least one value", c.Path)
+		}
+	case ConstraintOperator_CONSTRAINT_OPERATOR_GTE, ConstraintOperator_CONSTRAINT_OPERATOR_LTE:
+		if len(c.Values) != 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("constraint on %s requires exactly one value", c.Path)
+		}
+		if _, err := sdk.NewDecFromStr(c.Values[0]); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("constraint on %s requires a numeric value: %s", c.Path, err)
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown constraint operator %s", c.Operator)
+	}
+
+	return nil
+}
+
+// check walks the remaining path of the constraint in the decoded proto JSON
+// and returns an error if a value it leads to doesn't satisfy the constraint.
+func (c FieldConstraint) check(value interface{}, path []string) error {
+	if items, ok := value.([]interface{}); ok {
+		if len(path) > 0 {
+			if i, err := strconv.Atoi(path[0]); err == nil {
+				if i < 0 || i >= len(items) {
+					return sdkerrors.ErrUnauthorized.Wrapf("field %s not found in message", c.Path)
+				}
+				return c.check(items[i], path[1:])
+			}
+		}
+
+		for _, item := range items {
+			if err := c.check(item, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(path) > 0 {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return sdkerrors.ErrUnauthorized.Wrapf("field %s not found in message", c.Path)
+		}
+		field, ok := fields[path[0]]
+		if !ok {
+			return sdkerrors.ErrUnauthorized.Wrapf("field %s not found in message", c.Path)
+		}
+		return c.check(field, path[1:])
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case bool:
+		s = strconv.FormatBool(v)
+	default:
+		return sdkerrors.ErrUnauthorized.Wrapf("field %s is not a scalar value", c.Path)
+	}
+
+	if !c.satisfiedBy(s) {
+		return sdkerrors.ErrUnauthorized.Wrapf("field %s with value %s does not satisfy the %s constraint", c.Path, s, c.Operator)
+	}
+
+	return nil
+}
+
+func (c FieldConstraint) satisfiedBy(value string) bool {
+	switch c.Operator {
+	case ConstraintOperator_CONSTRAINT_OPERATOR_IN:
+		return containsString(c.Values, value)
+	case ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN:
+		return !containsString(c.Values, value)
+	case ConstraintOperator_CONSTRAINT_OPERATOR_GTE, ConstraintOperator_CONSTRAINT_OPERATOR_LTE:
+		v, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return false
+		}
+		bound, err := sdk.NewDecFromStr(c.Values[0])
+		if err != nil {
+			return false
+		}
+		if c.Operator == ConstraintOperator_CONSTRAINT_OPERATOR_GTE {
+			return v.GTE(bound)
+		}
+		return v.LTE(bound)
+	default:
+		return false
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFieldConstraint parses a constraint in the `<path>:<operator>:<values>`
+// format, where the operator is one of `in`, `not-in`, `gte` or `lte` and the
+// values are separated by commas, e.g. `to_address:in:cosmos1..,cosmos1..`.
+func ParseFieldConstraint(s string) (FieldConstraint, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return FieldConstraint{}, fmt.Errorf("invalid constraint %q, expected <path>:<operator>:<values>", s)
+	}
+
+	var op ConstraintOperator
+	switch parts[1] {
+	case "in":
+		op = ConstraintOperator_CONSTRAINT_OPERATOR_IN
+	case "not-in":
+		op = ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN
+	case "gte":
+		op = ConstraintOperator_CONSTRAINT_OPERATOR_GTE
+	case "lte":
+		op = ConstraintOperator_CONSTRAINT_OPERATOR_LTE
+	default:
+		return FieldConstraint{}, fmt.Errorf("invalid constraint operator %q, expected in, not-in, gte or lte", parts[1])
+	}
+
+	c := FieldConstraint{Path: parts[0], Operator: op, Values: strings.Split(parts[2], ",")}
+	return c, c.ValidateBasic()
+}
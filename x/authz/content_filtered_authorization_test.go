@@ -0,0 +1,123 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestContentFilteredAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(0))
+	valAddr := sdk.ValAddress(addrs[2])
+
+	testCases := []struct {
+		msg         string
+		constraints []authz.FieldConstraint
+		sdkMsg      sdk.Msg
+		accept      bool
+	}{
+		{
+			"vote on a proposal above the minimum id",
+			[]authz.FieldConstraint{{Path: "proposal_id", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_GTE, Values: []string{"10"}}},
+			govv1.NewMsgVote(addrs[0], 10, govv1.OptionYes, ""),
+			true,
+		},
+		{
+			"vote on a proposal below the minimum id",
+			[]authz.FieldConstraint{{Path: "proposal_id", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_GTE, Values: []string{"10"}}},
+			govv1.NewMsgVote(addrs[0], 9, govv1.OptionYes, ""),
+			false,
+		},
+		{
+			"delegate to an allowed validator",
+			[]authz.FieldConstraint{{Path: "validator_address", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{valAddr.String()}}},
+			stakingtypes.NewMsgDelegate(addrs[0], valAddr, sdk.NewInt64Coin("stake", 10)),
+			true,
+		},
+		{
+			"delegate to another validator",
+			[]authz.FieldConstraint{{Path: "validator_address", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{valAddr.String()}}},
+			stakingtypes.NewMsgDelegate(addrs[0], sdk.ValAddress(addrs[1]), sdk.NewInt64Coin("stake", 10)),
+			false,
+		},
+		{
+			"send to a denied recipient",
+			[]authz.FieldConstraint{{Path: "to_address", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN, Values: []string{addrs[1].String()}}},
+			banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			false,
+		},
+		{
+			"every coin sent satisfies the constraints",
+			[]authz.FieldConstraint{
+				{Path: "amount.denom", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{"atom", "stake"}},
+				{Path: "amount.amount", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_LTE, Values: []string{"100"}},
+			},
+			banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 10))),
+			true,
+		},
+		{
+			"one of the coins sent exceeds the limit",
+			[]authz.FieldConstraint{{Path: "amount.amount", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_LTE, Values: []string{"100"}}},
+			banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("stake", 10))),
+			false,
+		},
+		{
+			"indexed repeated field",
+			[]authz.FieldConstraint{{Path: "amount.0.denom", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{"atom"}}},
+			banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 10))),
+			true,
+		},
+		{
+			"unknown field",
+			[]authz.FieldConstraint{{Path: "recipient", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{addrs[1].String()}}},
+			banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			authorization := authz.NewContentFilteredAuthorization(sdk.MsgTypeURL(tc.sdkMsg), tc.constraints)
+			require.NoError(t, authorization.ValidateBasic())
+			require.Equal(t, sdk.MsgTypeURL(tc.sdkMsg), authorization.MsgTypeURL())
+
+			resp, err := authorization.Accept(ctx, tc.sdkMsg)
+			if tc.accept {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+			}
+		})
+	}
+}
+
+func TestParseFieldConstraint(t *testing.T) {
+	c, err := authz.ParseFieldConstraint("to_address:in:cosmos1a,cosmos1b")
+	require.NoError(t, err)
+	require.Equal(t, authz.FieldConstraint{Path: "to_address", Operator: authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, Values: []string{"cosmos1a", "cosmos1b"}}, c)
+
+	c, err = authz.ParseFieldConstraint("proposal_id:gte:10")
+	require.NoError(t, err)
+	require.Equal(t, authz.ConstraintOperator_CONSTRAINT_OPERATOR_GTE, c.Operator)
+
+	for _, s := range []string{"proposal_id", "proposal_id:eq:10", "proposal_id:gte:ten", "proposal_id:lte:1,2", "amount..denom:in:atom"} {
+		_, err = authz.ParseFieldConstraint(s)
+		require.Error(t, err, s)
+	}
+
+	require.Error(t, authz.NewContentFilteredAuthorization("/cosmos.bank.v1beta1.MsgSend", nil).ValidateBasic())
+}
@@ -0,0 +1,91 @@
+package authz
+
+import (
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                    = &CooldownAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &CooldownAuthorization{}
+)
+
+// NewCooldownAuthorization creates a new CooldownAuthorization object
+// wrapping an authorization which can be executed at most once per cooldown.
+func NewCooldownAuthorization(authorization Authorization, cooldown time.Duration) (*CooldownAuthorization, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CooldownAuthorization{
+		Authorization: any,
+		Cooldown:      cooldown,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a CooldownAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a CooldownAuthorization) GetAuthorization() (Authorization, error) {
+	return unpackAuthorization(a.Authorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CooldownAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. It rejects the executions before the
+// end of the cooldown following the last one.
+func (a CooldownAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	now := ctx.BlockTime()
+	if a.LastExecution != nil {
+		if next := a.LastExecution.Add(a.Cooldown); now.Before(next) {
+			return AcceptResponse{}, sdkerrors.Wrapf(ErrAuthorizationCooldown, "next execution allowed at %s", next.Format(time.RFC3339))
+		}
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept || resp.Delete {
+		return AcceptResponse{Accept: resp.Accept, Delete: resp.Delete}, err
+	}
+
+	if resp.Updated != nil {
+		if a.Authorization, err = packAuthorization(resp.Updated); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+	a.LastExecution = &now
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CooldownAuthorization) ValidateBasic() error {
+	if a.Cooldown <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("cooldown must be positive")
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	return authorization.ValidateBasic()
+}
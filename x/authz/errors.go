@@ -22,4 +22,10 @@ var (
 	ErrAuthorizationNumOfSigners = sdkerrors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = sdkerrors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrAuthorizationCooldown error if an authorization is executed before the end of its cooldown
+	ErrAuthorizationCooldown = sdkerrors.Register(ModuleName, 13, "authorization cooldown has not elapsed")
+	// ErrGrantNotDelegatable error if a grant which is not delegatable is regranted
+	ErrGrantNotDelegatable = sdkerrors.Register(ModuleName, 14, "grant is not delegatable")
+	// ErrMaxDelegationDepth error if a regrant exceeds the maximum delegation depth
+	ErrMaxDelegationDepth = sdkerrors.Register(ModuleName, 15, "maximum delegation depth exceeded")
 )
@@ -22,7 +22,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err := k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		grant, err := authz.NewGrant(now, a, entry.Expiration)
+		if err != nil {
+			panic(err)
+		}
+		grant.Delegatable = entry.Delegatable
+		grant.Delegator = entry.Delegator
+		grant.Depth = entry.Depth
+
+		err = k.saveGrant(ctx, grantee, granter, grant)
 		if err != nil {
 			panic(err)
 		}
@@ -38,6 +46,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			Delegatable:   grant.Delegatable,
+			Delegator:     grant.Delegator,
+			Depth:         grant.Depth,
 		})
 		return false
 	})
@@ -53,6 +53,9 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 			Grants: []*authz.Grant{{
 				Authorization: authorizationAny,
 				Expiration:    grant.Expiration,
+				Delegatable:   grant.Delegatable,
+				Delegator:     grant.Delegator,
+				Depth:         grant.Depth,
 			}},
 		}, nil
 	}
@@ -74,6 +77,9 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 		return &authz.Grant{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			Delegatable:   auth.Delegatable,
+			Delegator:     auth.Delegator,
+			Depth:         auth.Depth,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -89,6 +95,7 @@ func (k Keeper) Grants(c context.Context, req *authz.QueryGrantsRequest) (*authz
 }
 
 // GranterGrants implements the Query/GranterGrants gRPC method.
+// If msg type URL is set, it returns grants only for that msg type.
 func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -104,6 +111,11 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	authzStore := prefix.NewStore(store, grantStoreKey(nil, granter, ""))
 
 	grants, pageRes, err := query.GenericFilteredPaginate(k.cdc, authzStore, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
+		grantee := firstAddressFromGrantStoreKey(key)
+		if req.MsgTypeUrl != "" && string(key[1+len(grantee):]) != req.MsgTypeUrl {
+			return nil, nil
+		}
+
 		auth1, err := auth.GetAuthorization()
 		if err != nil {
 			return nil, err
@@ -114,12 +126,14 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 
-		grantee := firstAddressFromGrantStoreKey(key)
 		return &authz.GrantAuthorization{
 			Granter:       granter.String(),
 			Grantee:       grantee.String(),
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			Delegatable:   auth.Delegatable,
+			Delegator:     auth.Delegator,
+			Depth:         auth.Depth,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -135,6 +149,7 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 }
 
 // GranteeGrants implements the Query/GranteeGrants gRPC method.
+// If msg type URL is set, it returns grants only for that msg type.
 func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -146,32 +161,44 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantKey)
-
-	authorizations, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
-		auth1, err := auth.GetAuthorization()
-		if err != nil {
-			return nil, err
+	indexPrefix := granteeGrantIndexPrefix(grantee)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	var authorizations []*authz.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		_, granter, msgType := parseGranteeGrantIndexKey(append(indexPrefix, key...))
+		if req.MsgTypeUrl != "" && msgType != req.MsgTypeUrl {
+			return false, nil
 		}
 
-		granter, g, _ := parseGrantStoreKey(append(GrantKey, key...))
-		if !g.Equals(grantee) {
-			return nil, nil
-		}
+		if accumulate {
+			grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+			if !found {
+				return false, status.Errorf(codes.Internal, "grant of %s to %s for %s not found", granter, grantee, msgType)
+			}
 
-		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, err.Error())
+			auth1, err := grant.GetAuthorization()
+			if err != nil {
+				return false, err
+			}
+
+			authorizationAny, err := codectypes.NewAnyWithValue(auth1)
+			if err != nil {
+				return false, status.Errorf(codes.Internal, err.Error())
+			}
+
+			authorizations = append(authorizations, &authz.GrantAuthorization{
+				Authorization: authorizationAny,
+				Expiration:    grant.Expiration,
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Delegatable:   grant.Delegatable,
+				Delegator:     grant.Delegator,
+				Depth:         grant.Depth,
+			})
 		}
 
-		return &authz.GrantAuthorization{
-			Authorization: authorizationAny,
-			Expiration:    auth.Expiration,
-			Granter:       granter.String(),
-			Grantee:       grantee.String(),
-		}, nil
-	}, func() *authz.Grant {
-		return &authz.Grant{}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -209,6 +209,28 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type",
+			func() {
+				suite.createGenericAuthorization(addrs[1], addrs[0], genericAuthMsgType)
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, filter by another msg type",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: genericAuthMsgType,
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -286,6 +308,39 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, filter by msg type",
+			func() {
+				suite.createGenericAuthorization(addrs[0], addrs[1], genericAuthMsgType)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, filter by another msg type",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: genericAuthMsgType,
+			},
+			1,
+		},
+		{
+			"valid case, revoked authorization",
+			func() {
+				suite.Require().NoError(suite.authzKeeper.DeleteGrant(suite.ctx, addrs[0], addrs[1], genericAuthMsgType))
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			2,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -310,3 +365,10 @@ func (suite *TestSuite) createSendAuthorization(a1, a2 sdk.AccAddress) authz.Aut
 	suite.Require().NoError(err)
 	return authorization
 }
+
+func (suite *TestSuite) createGenericAuthorization(grantee, granter sdk.AccAddress, msgType string) authz.Authorization {
+	authorization := authz.NewGenericAuthorization(msgType)
+	err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, nil)
+	suite.Require().NoError(err)
+	return authorization
+}
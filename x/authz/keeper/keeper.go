@@ -28,15 +28,17 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	router     *baseapp.MsgServiceRouter
 	authKeeper authz.AccountKeeper
+	config     authz.Config
 }
 
 // NewKeeper constructs a message authorization Keeper
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, router *baseapp.MsgServiceRouter, ak authz.AccountKeeper) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, router *baseapp.MsgServiceRouter, ak authz.AccountKeeper, config authz.Config) Keeper {
 	return Keeper{
 		storeKey:   storeKey,
 		cdc:        cdc,
 		router:     router,
 		authKeeper: ak,
+		config:     config,
 	}
 }
 
@@ -84,7 +86,6 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
-	now := ctx.BlockTime()
 
 	for i, msg := range msgs {
 		signers := msg.GetSigners()
@@ -104,31 +105,29 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 				return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "failed to update grant with key %s", string(skey))
 			}
 
-			if grant.Expiration != nil && grant.Expiration.Before(now) {
-				return nil, authz.ErrAuthorizationExpired
-			}
-
-			authorization, err := grant.GetAuthorization()
-			if err != nil {
-				return nil, err
-			}
-
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
+			if err := k.acceptGrant(ctx, grantee, granter, grant, msg); err != nil {
 				return nil, err
 			}
 
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
-
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
+			// a regranted grant is also subject to all of its parent grants
+			for grant.Delegator != "" {
+				delegator, err := sdk.AccAddressFromBech32(grant.Delegator)
+				if err != nil {
+					return nil, err
+				}
+
+				grant, found = k.getGrant(ctx, grantStoreKey(delegator, granter, sdk.MsgTypeURL(msg)))
+				if !found {
+					return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "parent grant of %s not found", delegator)
+				}
+
+				if !grant.Delegatable {
+					return nil, sdkerrors.Wrapf(authz.ErrGrantNotDelegatable, "parent grant of %s", delegator)
+				}
+
+				if err := k.acceptGrant(ctx, delegator, granter, grant, msg); err != nil {
+					return nil, err
+				}
 			}
 		}
 
@@ -160,21 +159,117 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 	return results, nil
 }
 
+// acceptGrant checks that the grant accepts the msg and updates or deletes it
+// according to the authorization response.
+func (k Keeper) acceptGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant, msg sdk.Msg) error {
+	if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Delete {
+		err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	} else if resp.Updated != nil {
+		err = k.update(ctx, grantee, granter, resp.Updated)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	return nil
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
-	store := ctx.KVStore(k.storeKey)
+	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	return k.saveGrant(ctx, grantee, granter, grant)
+}
+
+// SaveDelegatedGrant grants the authorization of the provided grant to the grantee on
+// behalf of the granter, the delegator being the grantee of a delegatable grant for the
+// same `sdk.Msg` type from the granter. The grant can only narrow the parent one: it
+// can't outlive it, and its executions are also subject to the parent grants.
+func (k Keeper) SaveDelegatedGrant(ctx sdk.Context, grantee, delegator, granter sdk.AccAddress, grant authz.Grant) error {
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
 	msgType := authorization.MsgTypeURL()
-	skey := grantStoreKey(grantee, granter, msgType)
+	parent, found := k.getGrant(ctx, grantStoreKey(delegator, granter, msgType))
+	if !found {
+		return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no grant from %s to %s for %s", granter, delegator, msgType)
+	}
 
-	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, expiration)
+	if parent.Expiration != nil && parent.Expiration.Before(ctx.BlockTime()) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	if !parent.Delegatable {
+		return authz.ErrGrantNotDelegatable
+	}
+
+	if k.config.MaxDelegationDepth == 0 {
+		return sdkerrors.Wrap(authz.ErrMaxDelegationDepth, "regranting is disabled")
+	}
+
+	depth := parent.Depth + 1
+	if depth > k.config.MaxDelegationDepth {
+		return sdkerrors.Wrapf(authz.ErrMaxDelegationDepth, "depth %d, max %d", depth, k.config.MaxDelegationDepth)
+	}
+
+	if parent.Expiration != nil && (grant.Expiration == nil || grant.Expiration.After(*parent.Expiration)) {
+		return sdkerrors.Wrapf(authz.ErrInvalidExpirationTime, "expiration must not be after the expiration of the parent grant (%v)", parent.Expiration.Format(time.RFC3339))
+	}
+
+	if existing, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType)); found && existing.Delegator != delegator.String() {
+		return sdkerrors.ErrUnauthorized.Wrapf("a grant from %s to %s for %s was not issued by %s", granter, grantee, msgType, delegator)
+	}
+
+	newGrant, err := authz.NewGrant(ctx.BlockTime(), authorization, grant.Expiration)
 	if err != nil {
 		return err
 	}
+	newGrant.Delegatable = grant.Delegatable
+	newGrant.Delegator = delegator.String()
+	newGrant.Depth = depth
+
+	return k.saveGrant(ctx, grantee, granter, newGrant)
+}
+
+// saveGrant stores the grant and inserts its key into the grants queue.
+func (k Keeper) saveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant) error {
+	store := ctx.KVStore(k.storeKey)
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	msgType := authorization.MsgTypeURL()
+	skey := grantStoreKey(grantee, granter, msgType)
+	expiration := grant.Expiration
 
 	var oldExp *time.Time
-	if oldGrant, found := k.getGrant(ctx, skey); found {
+	oldGrant, found := k.getGrant(ctx, skey)
+	if found {
 		oldExp = oldGrant.Expiration
 	}
 	if oldExp != nil && (expiration == nil || !oldExp.Equal(*expiration)) {
@@ -191,9 +286,17 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 
 	bz := k.cdc.MustMarshal(&grant)
 	store.Set(skey, bz)
+	store.Set(granteeGrantIndexKey(grantee, granter, msgType), []byte{})
+
+	// the grantee can't regrant anymore, so revoke the grants it issued
+	if found && oldGrant.Delegatable && !grant.Delegatable {
+		if err := k.deleteDelegatedGrants(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
-		MsgTypeUrl: authorization.MsgTypeURL(),
+		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 	})
@@ -217,6 +320,13 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	}
 
 	store.Delete(skey)
+	store.Delete(granteeGrantIndexKey(grantee, granter, msgType))
+
+	if grant.Delegatable {
+		if err := k.deleteDelegatedGrants(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
@@ -225,6 +335,80 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// deleteDelegatedGrants revokes the grants for the provided message type issued by the
+// delegator on behalf of the granter, along with the grants issued from them.
+func (k Keeper) deleteDelegatedGrants(ctx sdk.Context, delegator, granter sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(nil, granter, ""))
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "revoke delegated grants")
+
+		_, grantee, t := parseGrantStoreKey(iter.Key())
+		if t != msgType {
+			continue
+		}
+
+		var grant authz.Grant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		if grant.Delegator == delegator.String() {
+			grantees = append(grantees, grantee)
+		}
+	}
+	iter.Close()
+
+	for _, grantee := range grantees {
+		if err := k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteDelegatedGrant revokes the grant for the provided message type issued by the
+// delegator to the grantee on behalf of the granter.
+func (k Keeper) DeleteDelegatedGrant(ctx sdk.Context, grantee, delegator, granter sdk.AccAddress, msgType string) error {
+	grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+	if !found || grant.Delegator != delegator.String() {
+		return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no grant from %s to %s for %s issued by %s", granter, grantee, msgType, delegator)
+	}
+
+	return k.DeleteGrant(ctx, grantee, granter, msgType)
+}
+
+// DeleteAllGrants revokes all the authorizations granted by the granter, or only the
+// ones for the provided message type if it is not empty. It returns the number of
+// revoked grants.
+func (k Keeper) DeleteAllGrants(ctx sdk.Context, granter sdk.AccAddress, msgType string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(nil, granter, ""))
+
+	type grantKey struct {
+		grantee sdk.AccAddress
+		msgType string
+	}
+	var keys []grantKey
+	for ; iter.Valid(); iter.Next() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "revoke all grants")
+
+		_, grantee, t := parseGrantStoreKey(iter.Key())
+		if msgType == "" || t == msgType {
+			keys = append(keys, grantKey{grantee: grantee, msgType: t})
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		if err := k.DeleteGrant(ctx, key.grantee, granter, key.msgType); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(keys)), nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -358,23 +542,44 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 	store := ctx.KVStore(k.storeKey)
 
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
-	defer iterator.Close()
 
+	type grantKey struct {
+		granter, grantee sdk.AccAddress
+		msgType          string
+	}
+	var delegatable []grantKey
 	for ; iterator.Valid(); iterator.Next() {
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+			iterator.Close()
 			return err
 		}
 
 		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
 		if err != nil {
+			iterator.Close()
 			return err
 		}
 
 		store.Delete(iterator.Key())
 
 		for _, typeURL := range queueItem.MsgTypeUrls {
-			store.Delete(grantStoreKey(grantee, granter, typeURL))
+			skey := grantStoreKey(grantee, granter, typeURL)
+			if grant, found := k.getGrant(ctx, skey); found && grant.Delegatable {
+				delegatable = append(delegatable, grantKey{granter: granter, grantee: grantee, msgType: typeURL})
+			}
+
+			store.Delete(skey)
+			store.Delete(granteeGrantIndexKey(grantee, granter, typeURL))
+		}
+	}
+	iterator.Close()
+
+	// the grants regranted from expired grants can't outlive them, but their parents
+	// may have been granted again with an earlier expiration
+	for _, key := range delegatable {
+		if err := k.deleteDelegatedGrants(ctx, key.grantee, key.granter, key.msgType); err != nil {
+			return err
 		}
 	}
 
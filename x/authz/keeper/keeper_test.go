@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/authz/testutil"
@@ -23,6 +25,7 @@ import (
 
 var (
 	bankSendAuthMsgType = banktypes.SendAuthorization{}.MsgTypeURL()
+	genericAuthMsgType  = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
 	coins10             = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
 	coins100            = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	coins1000           = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
@@ -136,6 +139,48 @@ func (s *TestSuite) TestKeeperIter() {
 	})
 }
 
+func (s *TestSuite) TestDeleteAllGrants() {
+	ctx, addrs := s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr := addrs[0]
+	e := ctx.BlockTime().AddDate(1, 0, 0)
+
+	require.NoError(s.authzKeeper.SaveGrant(ctx, addrs[1], granterAddr, banktypes.NewSendAuthorization(coins100), &e))
+	require.NoError(s.authzKeeper.SaveGrant(ctx, addrs[1], granterAddr, authz.NewGenericAuthorization(genericAuthMsgType), nil))
+	require.NoError(s.authzKeeper.SaveGrant(ctx, addrs[2], granterAddr, banktypes.NewSendAuthorization(coins100), &e))
+	require.NoError(s.authzKeeper.SaveGrant(ctx, addrs[2], addrs[1], banktypes.NewSendAuthorization(coins100), &e))
+
+	s.T().Log("verify revoking all grants of a msg type")
+	revoked, err := s.authzKeeper.DeleteAllGrants(ctx, granterAddr, bankSendAuthMsgType)
+	require.NoError(err)
+	require.Equal(uint64(2), revoked)
+
+	authorizations, err := s.authzKeeper.GetAuthorizations(ctx, addrs[1], granterAddr)
+	require.NoError(err)
+	require.Len(authorizations, 1)
+	require.Equal(genericAuthMsgType, authorizations[0].MsgTypeURL())
+
+	res, err := s.queryClient.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{Grantee: addrs[2].String()})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.Equal(addrs[1].String(), res.Grants[0].Granter)
+
+	s.T().Log("verify revoking all grants")
+	revoked, err = s.authzKeeper.DeleteAllGrants(ctx, granterAddr, "")
+	require.NoError(err)
+	require.Equal(uint64(1), revoked)
+
+	granterRes, err := s.queryClient.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(granterRes.Grants, 0)
+
+	s.T().Log("verify the grants of other granters are left")
+	authorizations, err = s.authzKeeper.GetAuthorizations(ctx, addrs[2], addrs[1])
+	require.NoError(err)
+	require.Len(authorizations, 1)
+}
+
 func (s *TestSuite) TestDispatchAction() {
 	addrs := s.addrs
 	require := s.Require()
@@ -284,6 +329,120 @@ func (s *TestSuite) TestDispatchAction() {
 
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
+func (s *TestSuite) TestRegrant() {
+	ctx, addrs := s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr := addrs[0]
+	delegatorAddr := addrs[1]
+	granteeAddr := addrs[2]
+	e := ctx.BlockTime().AddDate(1, 0, 0)
+	require.NoError(banktestutil.FundAccount(s.bankKeeper, ctx, granterAddr, coins1000))
+
+	regrant := func(grantee, delegator sdk.AccAddress, a authz.Authorization, exp *time.Time, delegatable bool) error {
+		msg, err := authz.NewMsgRegrant(granterAddr, delegator, grantee, a, exp, delegatable)
+		require.NoError(err)
+		_, err = s.authzKeeper.Regrant(ctx, msg)
+		return err
+	}
+	getGrant := func(grantee sdk.AccAddress) *authz.Grant {
+		res, err := s.queryClient.Grants(ctx, &authz.QueryGrantsRequest{
+			Granter:    granterAddr.String(),
+			Grantee:    grantee.String(),
+			MsgTypeUrl: bankSendAuthMsgType,
+		})
+		if err != nil {
+			return nil
+		}
+		return res.Grants[0]
+	}
+	send := func(ctx sdk.Context, grantee sdk.AccAddress, amount sdk.Coins) error {
+		_, err := s.authzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granterAddr, grantee, amount),
+		})
+		return err
+	}
+
+	s.T().Log("verify a grant can't be regranted if it is not delegatable")
+	require.NoError(s.authzKeeper.SaveGrant(ctx, delegatorAddr, granterAddr, banktypes.NewSendAuthorization(coins100), &e))
+	err := regrant(granteeAddr, delegatorAddr, banktypes.NewSendAuthorization(coins10), &e, false)
+	require.ErrorIs(err, authz.ErrGrantNotDelegatable)
+
+	msg, err := authz.NewMsgGrant(granterAddr, delegatorAddr, banktypes.NewSendAuthorization(coins100), &e)
+	require.NoError(err)
+	msg.Grant.Delegatable = true
+	_, err = s.authzKeeper.Grant(ctx, msg)
+	require.NoError(err)
+
+	s.T().Log("verify a regrant can't outlive its parent grant")
+	later := e.Add(time.Hour)
+	require.ErrorIs(regrant(granteeAddr, delegatorAddr, banktypes.NewSendAuthorization(coins10), &later, false), authz.ErrInvalidExpirationTime)
+	require.ErrorIs(regrant(granteeAddr, delegatorAddr, banktypes.NewSendAuthorization(coins10), nil, false), authz.ErrInvalidExpirationTime)
+
+	s.T().Log("verify regrant")
+	require.NoError(regrant(granteeAddr, delegatorAddr, banktypes.NewSendAuthorization(coins1000), &e, true))
+	grant := getGrant(granteeAddr)
+	require.NotNil(grant)
+	require.Equal(delegatorAddr.String(), grant.Delegator)
+	require.Equal(uint32(1), grant.Depth)
+	require.True(grant.Delegatable)
+
+	s.T().Log("verify the executions of a regrant are subject to its parent grant")
+	require.NoError(send(ctx, granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	var auth authz.Authorization
+	require.NoError(s.interfaceRegistry.UnpackAny(getGrant(delegatorAddr).Authorization, &auth))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), auth.(*banktypes.SendAuthorization).SpendLimit)
+	require.NoError(s.interfaceRegistry.UnpackAny(getGrant(granteeAddr).Authorization, &auth))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 950)), auth.(*banktypes.SendAuthorization).SpendLimit)
+
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(send(cacheCtx, granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+
+	s.T().Log("verify the maximum delegation depth")
+	chain := []sdk.AccAddress{granteeAddr}
+	for i := 0; i < 3; i++ {
+		next := sdk.AccAddress(fmt.Sprintf("regrantee%d___________", i))
+		err = regrant(next, chain[len(chain)-1], banktypes.NewSendAuthorization(coins10), &e, true)
+		if i < 2 {
+			require.NoError(err)
+		} else {
+			require.ErrorIs(err, authz.ErrMaxDelegationDepth)
+		}
+		chain = append(chain, next)
+	}
+	require.Equal(uint32(3), getGrant(chain[2]).Depth)
+
+	s.T().Log("verify a grant issued by another account can't be overwritten")
+	require.ErrorIs(regrant(chain[1], delegatorAddr, banktypes.NewSendAuthorization(coins10), &e, false), sdkerrors.ErrUnauthorized)
+
+	s.T().Log("verify the delegator can revoke its regrants")
+	_, err = s.authzKeeper.RevokeRegrant(ctx, &authz.MsgRevokeRegrant{
+		Granter:    granterAddr.String(),
+		Delegator:  delegatorAddr.String(),
+		Grantee:    chain[1].String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.Error(err)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = s.authzKeeper.RevokeRegrant(cacheCtx, &authz.MsgRevokeRegrant{
+		Granter:    granterAddr.String(),
+		Delegator:  granteeAddr.String(),
+		Grantee:    chain[1].String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+
+	s.T().Log("verify revocation cascades from the granter")
+	require.NoError(s.authzKeeper.DeleteGrant(ctx, delegatorAddr, granterAddr, bankSendAuthMsgType))
+	for _, grantee := range chain[:3] {
+		require.Nil(getGrant(grantee))
+	}
+	res, err := s.queryClient.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(res.Grants, 0)
+}
+
 func (s *TestSuite) TestDispatchedEvents() {
 	require := s.Require()
 	addrs := s.addrs
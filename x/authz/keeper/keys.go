@@ -15,10 +15,14 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grantee_Bytes><granter_Bytes><msgType_Bytes>: []byte{}
 //
+// Grants are stored by granter, the grantee index allows to look up the grants
+// of a grantee without iterating over all of them.
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey                = []byte{0x01} // prefix for each key
+	GrantQueuePrefix        = []byte{0x02}
+	GranteeGrantIndexPrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -54,6 +58,38 @@ func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, ms
 	return granterAddr, granteeAddr, conv.UnsafeBytesToStr(key[(granteeAddrEndIndex + 1):])
 }
 
+// granteeGrantIndexKey - return the grantee index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func granteeGrantIndexKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	return sdk.AppendLengthPrefixedBytes(GranteeGrantIndexPrefix, grantee, granter, m)
+}
+
+// granteeGrantIndexPrefix - return the grantee index prefix of all the grants of a grantee
+func granteeGrantIndexPrefix(grantee sdk.AccAddress) []byte {
+	return sdk.AppendLengthPrefixedBytes(GranteeGrantIndexPrefix, address.MustLengthPrefix(grantee))
+}
+
+// parseGranteeGrantIndexKey - split grantee, granter address and msg type from the grantee index key
+func parseGranteeGrantIndexKey(key []byte) (granteeAddr, granterAddr sdk.AccAddress, msgType string) {
+	// key is of format:
+	// 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>
+
+	granteeAddrLen, granteeAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1) // ignore key[0] since it is a prefix key
+	granteeAddr, granteeAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, granteeAddrLenEndIndex+1, int(granteeAddrLen[0]))
+
+	granterAddrLen, granterAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, granteeAddrEndIndex+1, 1)
+	granterAddr, granterAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, granterAddrLenEndIndex+1, int(granterAddrLen[0]))
+
+	kv.AssertKeyAtLeastLength(key, granterAddrEndIndex+1)
+	return granteeAddr, granterAddr, conv.UnsafeBytesToStr(key[(granterAddrEndIndex + 1):])
+}
+
 // parseGrantQueueKey split expiration time, granter and grantee from the grant queue key
 func parseGrantQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
 	// key is of format:
@@ -40,7 +40,13 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, msg.Grant.Expiration)
+	if err != nil {
+		return nil, err
+	}
+	grant.Delegatable = msg.Grant.Delegatable
+
+	err = k.saveGrant(ctx, grantee, granter, grant)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +54,63 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 	return &authz.MsgGrantResponse{}, nil
 }
 
+// Regrant implements the MsgServer.Regrant method to regrant a delegatable grant.
+func (k Keeper) Regrant(goCtx context.Context, msg *authz.MsgRegrant) (*authz.MsgRegrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SaveDelegatedGrant(ctx, grantee, delegator, granter, msg.Grant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRegrantResponse{}, nil
+}
+
+// RevokeRegrant implements the MsgServer.RevokeRegrant method.
+func (k Keeper) RevokeRegrant(goCtx context.Context, msg *authz.MsgRevokeRegrant) (*authz.MsgRevokeRegrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DeleteDelegatedGrant(ctx, grantee, delegator, granter, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeRegrantResponse{}, nil
+}
+
 // RevokeAuthorization implements the MsgServer.Revoke method.
 func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgRevokeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -68,6 +131,22 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(goCtx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := k.DeleteAllGrants(ctx, granter, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeAllResponse{Revoked: revoked}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -0,0 +1,111 @@
+package authz
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization                    = &MaxExecutionsAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &MaxExecutionsAuthorization{}
+)
+
+// NewMaxExecutionsAuthorization creates a new MaxExecutionsAuthorization
+// object wrapping an authorization which can be executed maxExecutions times.
+func NewMaxExecutionsAuthorization(authorization Authorization, maxExecutions uint64) (*MaxExecutionsAuthorization, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxExecutionsAuthorization{
+		Authorization:       any,
+		RemainingExecutions: maxExecutions,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a MaxExecutionsAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a MaxExecutionsAuthorization) GetAuthorization() (Authorization, error) {
+	return unpackAuthorization(a.Authorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MaxExecutionsAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The authorization is deleted after
+// its last execution, or once the wrapped authorization is deleted.
+func (a MaxExecutionsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept {
+		return AcceptResponse{Delete: resp.Delete}, err
+	}
+
+	if resp.Delete || a.RemainingExecutions <= 1 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	if resp.Updated != nil {
+		if a.Authorization, err = packAuthorization(resp.Updated); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+	a.RemainingExecutions--
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MaxExecutionsAuthorization) ValidateBasic() error {
+	if a.RemainingExecutions == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("remaining executions must be positive")
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	return authorization.ValidateBasic()
+}
+
+// packAuthorization packs an authorization wrapped by another one.
+func packAuthorization(authorization Authorization) (*cdctypes.Any, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", authorization)
+	}
+	return cdctypes.NewAnyWithValue(msg)
+}
+
+// unpackAuthorization returns the cached value of an authorization wrapped by
+// another one.
+func unpackAuthorization(any *cdctypes.Any) (Authorization, error) {
+	if any == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	av := any.GetCachedValue()
+	a, ok := av.(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
+	}
+	return a, nil
+}
@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+)
+
+// GranteeGrantIndexPrefix is the prefix of the grantee index of the grants.
+var GranteeGrantIndexPrefix = []byte{0x03}
+
+// GranteeGrantIndexKey - return the grantee index key of a grant
+// Key format is
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func GranteeGrantIndexKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	return sdk.AppendLengthPrefixedBytes(GranteeGrantIndexPrefix, grantee, granter, m)
+}
+
+// MigrateStore performs in-place store migrations from version 2 to 3. The
+// migration creates the grantee index of the grants.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, v046.GrantPrefix)
+	defer iter.Close()
+
+	var indexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		granter, grantee, msgType := v046.ParseGrantKey(iter.Key()[len(v046.GrantPrefix):])
+		indexKeys = append(indexKeys, GranteeGrantIndexKey(grantee, granter, msgType))
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
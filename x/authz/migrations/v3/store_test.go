@@ -0,0 +1,54 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	v3 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v3"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func TestMigration(t *testing.T) {
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	genericMsgType := sdk.MsgTypeURL(&govtypes.MsgVote{})
+
+	grants := []struct {
+		grantee sdk.AccAddress
+		msgType string
+	}{
+		{grantee1, sendMsgType},
+		{grantee1, genericMsgType},
+		{grantee2, sendMsgType},
+	}
+	for _, g := range grants {
+		store.Set(v046.GrantStoreKey(g.grantee, granter, g.msgType), []byte{0x01})
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, authzKey))
+
+	for _, g := range grants {
+		require.True(t, store.Has(v3.GranteeGrantIndexKey(g.grantee, granter, g.msgType)))
+	}
+	require.False(t, store.Has(v3.GranteeGrantIndexKey(grantee2, granter, genericMsgType)))
+
+	iter := sdk.KVStorePrefixIterator(store, v3.GranteeGrantIndexPrefix)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	require.Equal(t, len(grants), count)
+}
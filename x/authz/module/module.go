@@ -55,6 +55,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = cfg.RegisterMigration(authz.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterLegacyAminoCodec registers the authz module's types for the given codec.
@@ -162,7 +167,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock returns the begin blocker for the authz module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
@@ -186,6 +191,7 @@ func provideModuleBasic() runtime.AppModuleBasicWrapper {
 type authzInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Key              *store.KVStoreKey
 	Cdc              codec.Codec
 	AccountKeeper    authz.AccountKeeper
@@ -202,7 +208,8 @@ type authzOutputs struct {
 }
 
 func provideModule(in authzInputs) authzOutputs {
-	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
+	config := authz.Config{MaxDelegationDepth: in.Config.MaxDelegationDepth}
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper, config)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return authzOutputs{AuthzKeeper: k, Module: runtime.WrapAppModule(m)}
 }
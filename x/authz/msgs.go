@@ -16,14 +16,21 @@ import (
 var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgRevokeAll{}
+	_ sdk.Msg = &MsgRegrant{}
+	_ sdk.Msg = &MsgRevokeRegrant{}
 	_ sdk.Msg = &MsgExec{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
+	_ legacytx.LegacyMsg = &MsgRevokeAll{}
+	_ legacytx.LegacyMsg = &MsgRegrant{}
+	_ legacytx.LegacyMsg = &MsgRevokeRegrant{}
 	_ legacytx.LegacyMsg = &MsgExec{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgRegrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
 )
 
@@ -62,6 +69,10 @@ func (msg MsgGrant) ValidateBasic() error {
 	if granter.Equals(grantee) {
 		return ErrGranteeIsGranter
 	}
+
+	if msg.Grant.Delegator != "" || msg.Grant.Depth != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator and depth can only be set by regranting")
+	}
 	return msg.Grant.ValidateBasic()
 }
 
@@ -170,6 +181,171 @@ func (msg MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll
+//nolint:interfacer
+func NewMsgRevokeAll(granter sdk.AccAddress, msgTypeURL string) MsgRevokeAll {
+	return MsgRevokeAll{
+		Granter:    granter.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAll) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAll) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRegrant creates a new MsgRegrant
+//nolint:interfacer
+func NewMsgRegrant(granter, delegator, grantee sdk.AccAddress, a Authorization, expiration *time.Time, delegatable bool) (*MsgRegrant, error) {
+	m := &MsgRegrant{
+		Granter:   granter.String(),
+		Delegator: delegator.String(),
+		Grantee:   grantee.String(),
+		Grant:     Grant{Expiration: expiration, Delegatable: delegatable},
+	}
+	any, err := cdctypes.NewAnyWithValue(a)
+	if err != nil {
+		return nil, err
+	}
+	m.Grant.Authorization = any
+	return m, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgRegrant) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRegrant) ValidateBasic() error {
+	if err := validateRegrantAddresses(msg.Granter, msg.Delegator, msg.Grantee); err != nil {
+		return err
+	}
+
+	if msg.Grant.Delegator != "" || msg.Grant.Depth != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator and depth of the grant are set by the module")
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRegrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRegrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRegrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevokeRegrant creates a new MsgRevokeRegrant
+//nolint:interfacer
+func NewMsgRevokeRegrant(granter, delegator, grantee sdk.AccAddress, msgTypeURL string) MsgRevokeRegrant {
+	return MsgRevokeRegrant{
+		Granter:    granter.String(),
+		Delegator:  delegator.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeRegrant) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeRegrant) ValidateBasic() error {
+	if err := validateRegrantAddresses(msg.Granter, msg.Delegator, msg.Grantee); err != nil {
+		return err
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("missing method name")
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeRegrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeRegrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeRegrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// validateRegrantAddresses checks that the granter, delegator and grantee
+// addresses are valid and distinct.
+func validateRegrantAddresses(granterAddr, delegatorAddr, granteeAddr string) error {
+	granter, err := sdk.AccAddressFromBech32(granterAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(granteeAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if granter.Equals(grantee) || delegator.Equals(grantee) {
+		return ErrGranteeIsGranter
+	}
+	if granter.Equals(delegator) {
+		return sdkerrors.ErrInvalidRequest.Wrap("delegator and granter should be different")
+	}
+
+	return nil
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 //nolint:interfacer
 func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
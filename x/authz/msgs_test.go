@@ -77,6 +77,58 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeAllAuthorizations(t *testing.T) {
+	tests := []struct {
+		title      string
+		granter    sdk.AccAddress
+		msgType    string
+		expectPass bool
+	}{
+		{"nil Granter address", nil, "hello", false},
+		{"valid test case", granter, "hello", true},
+		{"valid test case without msg type", granter, "", true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRevokeAll(tc.granter, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRegrantAuthorization(t *testing.T) {
+	delegator := sdk.AccAddress("_______delegator___")
+	tests := []struct {
+		title                       string
+		granter, delegator, grantee sdk.AccAddress
+		expectPass                  bool
+	}{
+		{"nil Granter address", nil, delegator, grantee, false},
+		{"nil Delegator address", granter, nil, grantee, false},
+		{"nil Grantee address", granter, delegator, nil, false},
+		{"Delegator is Granter", granter, granter, grantee, false},
+		{"Grantee is Delegator", granter, delegator, delegator, false},
+		{"Grantee is Granter", granter, delegator, granter, false},
+		{"valid test case", granter, delegator, grantee, true},
+	}
+	for i, tc := range tests {
+		msg, err := authz.NewMsgRegrant(tc.granter, tc.delegator, tc.grantee, authz.NewGenericAuthorization("hello"), nil, false)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg, err := authz.NewMsgRegrant(granter, delegator, grantee, authz.NewGenericAuthorization("hello"), nil, false)
+	require.NoError(t, err)
+	msg.Grant.Depth = 1
+	require.Error(t, msg.ValidateBasic())
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
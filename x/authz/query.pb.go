@@ -161,6 +161,8 @@ type QueryGranterGrantsRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional, msg_type_url, when set, will query only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *QueryGranterGrantsRequest) Reset()         { *m = QueryGranterGrantsRequest{} }
@@ -210,6 +212,13 @@ func (m *QueryGranterGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranterGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // QueryGranterGrantsResponse is the response type for the Query/GranterGrants RPC method.
 type QueryGranterGrantsResponse struct {
 	// grants is a list of grants granted by the granter.
@@ -270,6 +279,8 @@ type QueryGranteeGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional, msg_type_url, when set, will query only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 }
 
 func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
@@ -319,6 +330,13 @@ func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranteeGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 // QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants RPC method.
 type QueryGranteeGrantsResponse struct {
 	// grants is a list of grants granted to the grantee.
@@ -386,41 +404,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xb1, 0x6e, 0x13, 0x31,
+	0x18, 0xc7, 0xe3, 0x04, 0x82, 0x70, 0x61, 0x31, 0x0c, 0xd7, 0x50, 0x9d, 0x4e, 0x51, 0x05, 0x01,
+	0xa9, 0x76, 0x9b, 0x4a, 0x8c, 0x88, 0x76, 0x68, 0x57, 0x38, 0x60, 0x61, 0x89, 0x2e, 0xcd, 0x27,
+	0xe7, 0x44, 0x72, 0xbe, 0xda, 0x3e, 0x44, 0x8a, 0xba, 0xc0, 0x0b, 0x20, 0xf5, 0x21, 0x90, 0x98,
+	0x59, 0x78, 0x03, 0xc6, 0x0a, 0x16, 0x46, 0x94, 0x20, 0x78, 0x0d, 0x14, 0xdb, 0x21, 0x4d, 0xb9,
+	0x36, 0x07, 0x15, 0xa2, 0x53, 0xe2, 0xe4, 0xff, 0xff, 0xbe, 0xdf, 0xf7, 0xf7, 0xd9, 0x87, 0x83,
+	0x1d, 0xa1, 0xfa, 0x42, 0xb1, 0x28, 0xd3, 0xdd, 0x3d, 0xf6, 0x7c, 0xad, 0x0d, 0x3a, 0x5a, 0x63,
+	0xbb, 0x19, 0xc8, 0x01, 0x4d, 0xa5, 0xd0, 0x82, 0x5c, 0xb7, 0x0a, 0x6a, 0x14, 0xd4, 0x29, 0x6a,
+	0x4b, 0x5c, 0x08, 0xde, 0x03, 0x16, 0xa5, 0x31, 0x8b, 0x92, 0x44, 0xe8, 0x48, 0xc7, 0x22, 0x51,
+	0xd6, 0x53, 0xbb, 0xe3, 0xaa, 0xb6, 0x23, 0x05, 0xb6, 0xd8, 0xaf, 0xd2, 0x69, 0xc4, 0xe3, 0xc4,
+	0x88, 0x9d, 0x36, 0x9f, 0xc0, 0x76, 0xb3, 0x8a, 0x45, 0xab, 0x68, 0x99, 0x15, 0xb3, 0x0b, 0xfb,
+	0x57, 0xfd, 0x3b, 0xc2, 0xe4, 0xe1, 0xb8, 0xfe, 0xb6, 0x8c, 0x12, 0xad, 0x42, 0xd8, 0xcd, 0x40,
+	0x69, 0xd2, 0xc4, 0x97, 0xf8, 0xf8, 0x07, 0x90, 0x1e, 0x0a, 0x50, 0xe3, 0xf2, 0xa6, 0xf7, 0xe9,
+	0xfd, 0xca, 0x64, 0x90, 0x8d, 0x4e, 0x47, 0x82, 0x52, 0x8f, 0xb4, 0x8c, 0x13, 0x1e, 0x4e, 0x84,
+	0x53, 0x0f, 0x78, 0xe5, 0x62, 0x1e, 0x20, 0x01, 0xbe, 0xd2, 0x57, 0xbc, 0xa5, 0x07, 0x29, 0xb4,
+	0x32, 0xd9, 0xf3, 0x2a, 0x63, 0x63, 0x88, 0xfb, 0x8a, 0x3f, 0x1e, 0xa4, 0xf0, 0x44, 0xf6, 0xc8,
+	0x16, 0xc6, 0xd3, 0x89, 0xbd, 0x0b, 0x01, 0x6a, 0x2c, 0x34, 0x6f, 0x52, 0x57, 0x75, 0x1c, 0x0f,
+	0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x88, 0x83, 0x9b, 0x22, 0x3c, 0xe2, 0xac, 0x1f, 0x20, 0x7c,
+	0x6d, 0x66, 0x50, 0x95, 0x8a, 0x44, 0x01, 0x59, 0xc7, 0x55, 0x03, 0xa3, 0x3c, 0x14, 0x54, 0x1a,
+	0x0b, 0xcd, 0x1b, 0x34, 0x6f, 0xbb, 0xa8, 0x71, 0x85, 0x4e, 0x4a, 0xb6, 0x67, 0xa0, 0xca, 0x06,
+	0xea, 0xd6, 0x5c, 0x28, 0xdb, 0x71, 0x86, 0xea, 0x03, 0xc2, 0x8b, 0x53, 0x2a, 0x90, 0x67, 0xdf,
+	0x85, 0xad, 0x1c, 0xb4, 0xbf, 0xc8, 0x6b, 0xfe, 0xce, 0xd4, 0xdf, 0x22, 0x5c, 0xcb, 0x63, 0x77,
+	0xc1, 0xde, 0x3f, 0x16, 0x6c, 0xe3, 0x94, 0x60, 0x37, 0x32, 0xdd, 0x15, 0x32, 0xde, 0x33, 0xad,
+	0xff, 0x79, 0xca, 0x70, 0x42, 0xca, 0x50, 0x34, 0x65, 0xf8, 0x7f, 0x29, 0xc3, 0xb9, 0x4d, 0xb9,
+	0xf9, 0xa3, 0x82, 0x2f, 0x1a, 0x52, 0xf2, 0x1a, 0xe1, 0xaa, 0xe5, 0x24, 0x27, 0xf0, 0xfc, 0x7e,
+	0xe5, 0xd4, 0x6e, 0x17, 0x50, 0xda, 0xae, 0xf5, 0xe5, 0x57, 0x9f, 0xbf, 0x1d, 0x94, 0x7d, 0xb2,
+	0xc4, 0x72, 0xaf, 0x3e, 0x37, 0xd8, 0x3b, 0x84, 0xaf, 0xce, 0x3c, 0x9a, 0x84, 0xcd, 0x6b, 0x71,
+	0xec, 0x00, 0xd6, 0x56, 0x8b, 0x1b, 0x1c, 0xda, 0x5d, 0x83, 0xb6, 0x4a, 0xe8, 0x69, 0x68, 0xcc,
+	0x1d, 0x56, 0xf6, 0xd2, 0x7d, 0xd9, 0x3f, 0x02, 0x0b, 0x85, 0x61, 0xe1, 0x4f, 0x61, 0xe1, 0x0c,
+	0xb0, 0x30, 0x81, 0x85, 0xfd, 0xcd, 0x7b, 0x1f, 0x87, 0x3e, 0x3a, 0x1c, 0xfa, 0xe8, 0xeb, 0xd0,
+	0x47, 0x6f, 0x46, 0x7e, 0xe9, 0x70, 0xe4, 0x97, 0xbe, 0x8c, 0xfc, 0xd2, 0xd3, 0x65, 0x1e, 0xeb,
+	0x6e, 0xd6, 0xa6, 0x3b, 0xa2, 0x3f, 0xa9, 0x69, 0x3f, 0x56, 0x54, 0xe7, 0x19, 0x7b, 0x61, 0x1b,
+	0xb4, 0xab, 0xe6, 0xdd, 0xb3, 0xfe, 0x73, 0x00, 0x83, 0x89, 0xbc, 0x24, 0x3c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -714,6 +731,13 @@ func (m *QueryGranterGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -805,6 +829,13 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -945,6 +976,10 @@ func (m *QueryGranterGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -981,6 +1016,10 @@ func (m *QueryGranteeGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1408,6 +1447,38 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1646,6 +1717,38 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
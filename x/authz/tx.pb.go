@@ -269,6 +269,245 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRevokeAll revokes all the authorizations on the granter's account, or only
+// the ones with the provided sdk.Msg type if it is set.
+type MsgRevokeAll struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Optional, msg_type_url, when set, will revoke only grants matching given msg type.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRevokeAll) Reset()         { *m = MsgRevokeAll{} }
+func (m *MsgRevokeAll) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAll) ProtoMessage()    {}
+func (*MsgRevokeAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgRevokeAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAll.Merge(m, src)
+}
+func (m *MsgRevokeAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAll proto.InternalMessageInfo
+
+// MsgRevokeAllResponse defines the Msg/MsgRevokeAllResponse response type.
+type MsgRevokeAllResponse struct {
+	// revoked is the number of revoked grants.
+	Revoked uint64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *MsgRevokeAllResponse) Reset()         { *m = MsgRevokeAllResponse{} }
+func (m *MsgRevokeAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllResponse) ProtoMessage()    {}
+func (*MsgRevokeAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRevokeAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllResponse proto.InternalMessageInfo
+
+// MsgRegrant is a request type for the Regrant method. It grants the provided
+// authorization to the grantee on behalf of the granter, the delegator being the
+// grantee of a delegatable grant for the same sdk.Msg type from the granter.
+type MsgRegrant struct {
+	Granter   string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Grantee   string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Grant     Grant  `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant"`
+}
+
+func (m *MsgRegrant) Reset()         { *m = MsgRegrant{} }
+func (m *MsgRegrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRegrant) ProtoMessage()    {}
+func (*MsgRegrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRegrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegrant.Merge(m, src)
+}
+func (m *MsgRegrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegrant proto.InternalMessageInfo
+
+// MsgRegrantResponse defines the Msg/MsgRegrant response type.
+type MsgRegrantResponse struct {
+}
+
+func (m *MsgRegrantResponse) Reset()         { *m = MsgRegrantResponse{} }
+func (m *MsgRegrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegrantResponse) ProtoMessage()    {}
+func (*MsgRegrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{9}
+}
+func (m *MsgRegrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegrantResponse.Merge(m, src)
+}
+func (m *MsgRegrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegrantResponse proto.InternalMessageInfo
+
+// MsgRevokeRegrant revokes the grant with the provided sdk.Msg type issued by
+// the delegator to the grantee on behalf of the granter.
+type MsgRevokeRegrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Delegator  string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Grantee    string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRevokeRegrant) Reset()         { *m = MsgRevokeRegrant{} }
+func (m *MsgRevokeRegrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRegrant) ProtoMessage()    {}
+func (*MsgRevokeRegrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{10}
+}
+func (m *MsgRevokeRegrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRegrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRegrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRegrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRegrant.Merge(m, src)
+}
+func (m *MsgRevokeRegrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRegrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRegrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRegrant proto.InternalMessageInfo
+
+// MsgRevokeRegrantResponse defines the Msg/MsgRevokeRegrantResponse response type.
+type MsgRevokeRegrantResponse struct {
+}
+
+func (m *MsgRevokeRegrantResponse) Reset()         { *m = MsgRevokeRegrantResponse{} }
+func (m *MsgRevokeRegrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRegrantResponse) ProtoMessage()    {}
+func (*MsgRevokeRegrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{11}
+}
+func (m *MsgRevokeRegrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRegrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRegrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRegrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRegrantResponse.Merge(m, src)
+}
+func (m *MsgRevokeRegrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRegrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRegrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRegrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
@@ -276,45 +515,60 @@ func init() {
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRevokeAll)(nil), "cosmos.authz.v1beta1.MsgRevokeAll")
+	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
+	proto.RegisterType((*MsgRegrant)(nil), "cosmos.authz.v1beta1.MsgRegrant")
+	proto.RegisterType((*MsgRegrantResponse)(nil), "cosmos.authz.v1beta1.MsgRegrantResponse")
+	proto.RegisterType((*MsgRevokeRegrant)(nil), "cosmos.authz.v1beta1.MsgRevokeRegrant")
+	proto.RegisterType((*MsgRevokeRegrantResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeRegrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcd, 0x8e, 0x12, 0x41,
-	0x10, 0xa6, 0x17, 0x76, 0x91, 0x5e, 0x12, 0x75, 0x24, 0x71, 0x16, 0xdd, 0xd9, 0xc9, 0xc4, 0x1f,
-	0xa2, 0xd2, 0x13, 0xf0, 0x60, 0xe2, 0x8d, 0x49, 0x8c, 0x89, 0x71, 0x62, 0x32, 0xea, 0xc5, 0x0b,
-	0x19, 0xa0, 0x6d, 0x08, 0xcc, 0x34, 0x99, 0xea, 0x21, 0xb0, 0x47, 0x9f, 0xc0, 0x9b, 0x0f, 0xe0,
-	0x0b, 0x78, 0xd8, 0x8b, 0x6f, 0x40, 0x3c, 0x6d, 0x3c, 0x79, 0x32, 0x0a, 0x07, 0x5f, 0xc1, 0xa3,
-	0xa1, 0x7b, 0x1a, 0x7f, 0xc2, 0xae, 0x7b, 0xda, 0x53, 0x77, 0xd5, 0xf7, 0x55, 0xf5, 0x57, 0xfd,
-	0x75, 0xe3, 0xfd, 0x2e, 0x87, 0x88, 0x83, 0x1b, 0xa6, 0xa2, 0x7f, 0xe8, 0x4e, 0x1a, 0x1d, 0x2a,
-	0xc2, 0x86, 0x2b, 0xa6, 0x64, 0x9c, 0x70, 0xc1, 0x8d, 0x8a, 0x82, 0x89, 0x84, 0x49, 0x06, 0x57,
-	0xf7, 0x54, 0xb6, 0x2d, 0x39, 0x6e, 0x46, 0x91, 0x41, 0xb5, 0xc2, 0x38, 0xe3, 0x2a, 0xbf, 0xda,
-	0x65, 0xd9, 0x3d, 0xc6, 0x39, 0x1b, 0x51, 0x57, 0x46, 0x9d, 0xf4, 0xb5, 0x1b, 0xc6, 0xb3, 0x0c,
-	0xb2, 0x37, 0x0a, 0x50, 0xe7, 0x29, 0xc6, 0xd5, 0x8c, 0x11, 0x01, 0x73, 0x27, 0x8d, 0xd5, 0xa2,
-	0x00, 0xe7, 0x23, 0xc2, 0x17, 0x7c, 0x60, 0x8f, 0x93, 0x30, 0x16, 0x46, 0x13, 0x17, 0xd9, 0x6a,
-	0x43, 0x13, 0x13, 0xd9, 0xa8, 0x56, 0xf2, 0xcc, 0xcf, 0x47, 0x75, 0x2d, 0xbf, 0xd5, 0xeb, 0x25,
-	0x14, 0xe0, 0xb9, 0x48, 0x06, 0x31, 0x0b, 0x34, 0xf1, 0x77, 0x0d, 0x35, 0xb7, 0xce, 0x56, 0x43,
-	0x8d, 0x07, 0x78, 0x5b, 0x6e, 0xcd, 0xbc, 0x8d, 0x6a, 0xbb, 0xcd, 0x6b, 0x64, 0xd3, 0x0d, 0x11,
-	0xa9, 0xc9, 0x2b, 0xcc, 0xbf, 0x1e, 0xe4, 0x02, 0xc5, 0x7f, 0x58, 0x7e, 0xf3, 0xe3, 0xc3, 0x1d,
-	0x7d, 0xb4, 0x73, 0x17, 0x5f, 0xf4, 0x81, 0x3d, 0x9a, 0xd2, 0x6e, 0x40, 0x61, 0xcc, 0x63, 0xa0,
-	0x86, 0x89, 0x8b, 0x09, 0x85, 0x74, 0x24, 0xc0, 0x44, 0x76, 0xbe, 0x56, 0x0e, 0x74, 0xe8, 0xbc,
-	0x43, 0xb8, 0x98, 0xb1, 0xff, 0xd4, 0x8c, 0xce, 0xaa, 0xf9, 0x09, 0x2e, 0x44, 0xc0, 0xc0, 0xdc,
-	0xb2, 0xf3, 0xb5, 0xdd, 0x66, 0x85, 0x28, 0x37, 0x88, 0x76, 0x83, 0xb4, 0xe2, 0x99, 0x67, 0x7f,
-	0x3a, 0xaa, 0x5f, 0x87, 0xde, 0x90, 0xf8, 0xc0, 0xee, 0xd9, 0x6a, 0x9a, 0x56, 0x2a, 0xfa, 0x3c,
-	0x19, 0x1c, 0x86, 0x62, 0xc0, 0xe3, 0x40, 0xf6, 0xf8, 0x6b, 0x0c, 0xea, 0x18, 0xf8, 0x92, 0x76,
-	0x40, 0xcf, 0xe1, 0xbc, 0x47, 0xb8, 0xe4, 0x03, 0x0b, 0xe8, 0x84, 0x0f, 0xe9, 0xb9, 0xf9, 0x62,
-	0xe3, 0x72, 0x04, 0xac, 0x2d, 0x66, 0x63, 0xda, 0x4e, 0x93, 0x91, 0xb4, 0xa7, 0x14, 0xe0, 0x08,
-	0xd8, 0x8b, 0xd9, 0x98, 0xbe, 0x4c, 0x46, 0xff, 0x18, 0x70, 0x05, 0x5f, 0x5e, 0x8b, 0xd4, 0xd2,
-	0x9b, 0x3f, 0x11, 0xce, 0xfb, 0xc0, 0x8c, 0x67, 0x78, 0x5b, 0xbd, 0x2a, 0x6b, 0xb3, 0xbd, 0x7a,
-	0xe6, 0xea, 0xad, 0xd3, 0xf1, 0xb5, 0xb7, 0x4f, 0x71, 0x41, 0xba, 0xb7, 0x7f, 0x22, 0x7f, 0x05,
-	0x57, 0x6f, 0x9e, 0x0a, 0xaf, 0xbb, 0x05, 0x78, 0x27, 0xbb, 0xdd, 0x83, 0x13, 0x0b, 0x14, 0xa1,
-	0x7a, 0xfb, 0x3f, 0x04, 0xdd, 0xd3, 0xf3, 0xe6, 0xdf, 0xad, 0xdc, 0x7c, 0x61, 0xa1, 0xe3, 0x85,
-	0x85, 0xbe, 0x2d, 0x2c, 0xf4, 0x76, 0x69, 0xe5, 0x8e, 0x97, 0x56, 0xee, 0xcb, 0xd2, 0xca, 0xbd,
-	0xba, 0xc1, 0x06, 0xa2, 0x9f, 0x76, 0x48, 0x97, 0x47, 0xd9, 0x7f, 0xcf, 0x96, 0x3a, 0xf4, 0x86,
-	0xee, 0x54, 0xfd, 0xd7, 0xce, 0x8e, 0x7c, 0x51, 0xf7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x66, 0xa5, 0x3e, 0x55, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0xcf, 0x36, 0x6e, 0xf3, 0x65, 0x9a, 0x0f, 0x8a, 0x89, 0x84, 0x6b, 0xa8, 0x6b, 0x59, 0x50,
+	0xa2, 0x42, 0x6d, 0x1a, 0x24, 0x90, 0xb8, 0x25, 0x12, 0x42, 0x42, 0x44, 0x48, 0x86, 0x5e, 0xe0,
+	0x50, 0x25, 0xcd, 0xb2, 0x8d, 0xea, 0x64, 0x23, 0xef, 0xba, 0x6a, 0x7a, 0xe4, 0x09, 0xb8, 0x20,
+	0x1e, 0x80, 0x17, 0xe0, 0xd0, 0x0b, 0x6f, 0x50, 0x71, 0xaa, 0x38, 0x71, 0x42, 0xd0, 0x22, 0xf1,
+	0x04, 0xdc, 0x91, 0x77, 0xbd, 0x4e, 0x1b, 0xda, 0x3a, 0xed, 0x01, 0x89, 0xd3, 0xee, 0xce, 0xfc,
+	0x66, 0xe6, 0x37, 0x7f, 0x76, 0x17, 0xe6, 0xd6, 0x28, 0xeb, 0x52, 0xe6, 0x35, 0x23, 0xbe, 0xbe,
+	0xed, 0x6d, 0x2e, 0xb7, 0x30, 0x6f, 0x2e, 0x7b, 0x7c, 0xcb, 0xed, 0x87, 0x94, 0x53, 0xbd, 0x2c,
+	0xd5, 0xae, 0x50, 0xbb, 0x89, 0xda, 0x9c, 0x95, 0xd2, 0x55, 0x81, 0xf1, 0x12, 0x88, 0x38, 0x98,
+	0x65, 0x42, 0x09, 0x95, 0xf2, 0x78, 0x97, 0x48, 0x67, 0x09, 0xa5, 0x24, 0xc0, 0x9e, 0x38, 0xb5,
+	0xa2, 0x57, 0x5e, 0xb3, 0x37, 0x48, 0x54, 0xf6, 0xb1, 0x04, 0x64, 0x3c, 0x89, 0xb8, 0x92, 0x20,
+	0xba, 0x8c, 0x78, 0x9b, 0xcb, 0xf1, 0x22, 0x15, 0xce, 0x47, 0x04, 0xff, 0x35, 0x18, 0x79, 0x14,
+	0x36, 0x7b, 0x5c, 0xaf, 0x42, 0x81, 0xc4, 0x1b, 0x1c, 0x1a, 0xc8, 0x46, 0x95, 0x62, 0xdd, 0xf8,
+	0xbc, 0xb3, 0xa4, 0xe8, 0xd7, 0xda, 0xed, 0x10, 0x33, 0xf6, 0x8c, 0x87, 0x9d, 0x1e, 0xf1, 0x15,
+	0x70, 0x68, 0x83, 0x8d, 0x89, 0xf1, 0x6c, 0xb0, 0x7e, 0x1f, 0x26, 0xc5, 0xd6, 0xc8, 0xdb, 0xa8,
+	0x32, 0x5d, 0xbd, 0xea, 0x1e, 0x57, 0x21, 0x57, 0x70, 0xaa, 0x6b, 0xbb, 0x5f, 0xe7, 0x73, 0xbe,
+	0xc4, 0x3f, 0x28, 0xbd, 0xfe, 0xf9, 0x61, 0x51, 0x85, 0x76, 0x6e, 0xc1, 0xc5, 0x06, 0x23, 0x0f,
+	0xb7, 0xf0, 0x9a, 0x8f, 0x59, 0x9f, 0xf6, 0x18, 0xd6, 0x0d, 0x28, 0x84, 0x98, 0x45, 0x01, 0x67,
+	0x06, 0xb2, 0xf3, 0x95, 0x92, 0xaf, 0x8e, 0xce, 0x3b, 0x04, 0x85, 0x04, 0x7d, 0x98, 0x33, 0x1a,
+	0x97, 0xf3, 0x63, 0xd0, 0xba, 0x8c, 0x30, 0x63, 0xc2, 0xce, 0x57, 0xa6, 0xab, 0x65, 0x57, 0x76,
+	0xc3, 0x55, 0xdd, 0x70, 0x6b, 0xbd, 0x41, 0xdd, 0xfe, 0xb4, 0xb3, 0x74, 0x8d, 0xb5, 0x37, 0xdc,
+	0x06, 0x23, 0xb7, 0x6d, 0x99, 0x4d, 0x2d, 0xe2, 0xeb, 0x34, 0xec, 0x6c, 0x37, 0x79, 0x87, 0xf6,
+	0x7c, 0xe1, 0xe3, 0x48, 0x1a, 0xd8, 0xd1, 0x61, 0x46, 0x75, 0x40, 0xe5, 0xe1, 0xbc, 0x47, 0x50,
+	0x6c, 0x30, 0xe2, 0xe3, 0x4d, 0xba, 0x81, 0xff, 0x5a, 0x5f, 0x6c, 0x28, 0x75, 0x19, 0x59, 0xe5,
+	0x83, 0x3e, 0x5e, 0x8d, 0xc2, 0x40, 0xb4, 0xa7, 0xe8, 0x43, 0x97, 0x91, 0xe7, 0x83, 0x3e, 0x5e,
+	0x09, 0x83, 0x91, 0x06, 0x5c, 0x86, 0x4b, 0x29, 0xc9, 0x94, 0x7a, 0x08, 0xa5, 0x54, 0x58, 0x0b,
+	0x82, 0x73, 0x91, 0x1f, 0x25, 0x32, 0x91, 0x41, 0xe4, 0x0e, 0x94, 0x0f, 0xc7, 0x3c, 0x3a, 0x0e,
+	0xb1, 0xb0, 0x2d, 0x62, 0x6b, 0xbe, 0x3a, 0x3a, 0xbf, 0x10, 0x80, 0x30, 0x21, 0xe7, 0x9e, 0xfc,
+	0x7b, 0x50, 0x6c, 0xe3, 0x00, 0x93, 0x26, 0xa7, 0x61, 0x66, 0x8d, 0x87, 0xd0, 0xc3, 0x9d, 0xc9,
+	0x9f, 0xf9, 0xc6, 0x68, 0x67, 0xbc, 0x31, 0x17, 0xe2, 0x3a, 0x0d, 0x83, 0x3b, 0x65, 0xd0, 0x87,
+	0x69, 0xa7, 0x3d, 0xfb, 0x81, 0x60, 0x26, 0x2d, 0xe0, 0xbf, 0x52, 0x93, 0xd1, 0x21, 0xd1, 0xfe,
+	0x18, 0x92, 0xd1, 0xe4, 0x4d, 0x30, 0x46, 0xb3, 0x54, 0x25, 0xa8, 0xbe, 0xd5, 0x20, 0xdf, 0x60,
+	0x44, 0x7f, 0x0a, 0x93, 0xf2, 0x31, 0xb4, 0x8e, 0xaf, 0xb1, 0xba, 0xaa, 0xe6, 0xc2, 0xe9, 0xfa,
+	0x74, 0x06, 0x9f, 0x80, 0x26, 0x1e, 0x9d, 0xb9, 0x13, 0xf1, 0xb1, 0xda, 0xbc, 0x71, 0xaa, 0x3a,
+	0xf5, 0xe6, 0xc3, 0x54, 0xf2, 0x28, 0xcc, 0x9f, 0x68, 0x20, 0x01, 0xe6, 0xcd, 0x0c, 0x40, 0xea,
+	0xf3, 0x25, 0x14, 0x87, 0xd7, 0xd5, 0xc9, 0xb0, 0xaa, 0x05, 0x81, 0xb9, 0x98, 0x8d, 0x49, 0x9d,
+	0xaf, 0x40, 0x41, 0x0d, 0x94, 0x7d, 0x8a, 0x99, 0x40, 0x98, 0x95, 0x2c, 0x44, 0xea, 0x96, 0xc0,
+	0xff, 0x47, 0xa7, 0x75, 0x21, 0x33, 0x5b, 0x19, 0xc2, 0x1d, 0x0f, 0xa7, 0x02, 0xd5, 0xeb, 0xbb,
+	0xdf, 0xad, 0xdc, 0xee, 0xbe, 0x85, 0xf6, 0xf6, 0x2d, 0xf4, 0x6d, 0xdf, 0x42, 0x6f, 0x0e, 0xac,
+	0xdc, 0xde, 0x81, 0x95, 0xfb, 0x72, 0x60, 0xe5, 0x5e, 0x5c, 0x27, 0x1d, 0xbe, 0x1e, 0xb5, 0xdc,
+	0x35, 0xda, 0x4d, 0xfe, 0xf0, 0x64, 0x59, 0x62, 0xed, 0x0d, 0x6f, 0x4b, 0xfe, 0xc1, 0xad, 0x29,
+	0xf1, 0x4b, 0xdc, 0xfd, 0x3d, 0x00, 0x5c, 0xdd, 0xe1, 0x76, 0x29, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -341,6 +595,15 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter, or only
+	// the ones for the provided method name if it is set.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
+	// Regrant grants, on behalf of the granter, an authorization the delegator
+	// was granted with a delegatable grant to another grantee. The executions of
+	// the new grant are also subject to the parent grants.
+	Regrant(ctx context.Context, in *MsgRegrant, opts ...grpc.CallOption) (*MsgRegrantResponse, error)
+	// RevokeRegrant revokes a grant issued by the delegator with a MsgRegrant.
+	RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error)
 }
 
 type msgClient struct {
@@ -378,6 +641,33 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Regrant(ctx context.Context, in *MsgRegrant, opts ...grpc.CallOption) (*MsgRegrantResponse, error) {
+	out := new(MsgRegrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/Regrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error) {
+	out := new(MsgRevokeRegrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeRegrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -392,6 +682,15 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter, or only
+	// the ones for the provided method name if it is set.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
+	// Regrant grants, on behalf of the granter, an authorization the delegator
+	// was granted with a delegatable grant to another grantee. The executions of
+	// the new grant are also subject to the parent grants.
+	Regrant(context.Context, *MsgRegrant) (*MsgRegrantResponse, error)
+	// RevokeRegrant revokes a grant issued by the delegator with a MsgRegrant.
+	RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -407,6 +706,15 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
+func (*UnimplementedMsgServer) Regrant(ctx context.Context, req *MsgRegrant) (*MsgRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Regrant not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRegrant(ctx context.Context, req *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRegrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -466,6 +774,60 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Regrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Regrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/Regrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Regrant(ctx, req.(*MsgRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRegrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRegrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeRegrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRegrant(ctx, req.(*MsgRevokeRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -482,6 +844,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
+		{
+			MethodName: "Regrant",
+			Handler:    _Msg_Regrant_Handler,
+		},
+		{
+			MethodName: "RevokeRegrant",
+			Handler:    _Msg_RevokeRegrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -700,52 +1074,268 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRevokeAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Grant.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgRevokeAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, b := range m.Results {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExec) Size() (n int) {
+func (m *MsgRevokeAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRegrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRegrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRegrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRegrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRegrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRegrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -791,25 +1381,711 @@ func (m *MsgRevoke) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRevokeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	return n
+}
+
+func (m *MsgRegrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRegrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRegrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAll) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -832,10 +2108,10 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAll: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAll: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -872,7 +2148,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -900,40 +2176,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -956,7 +2199,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -979,17 +2222,17 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
 			}
-			var byteLen int
+			m.Revoked = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -999,24 +2242,11 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Revoked |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
-			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1038,7 +2268,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgRegrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,13 +2291,77 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -1099,9 +2393,9 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,8 +2422,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &types.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1154,7 +2447,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,10 +2470,10 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1204,7 +2497,7 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRegrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1227,10 +2520,10 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRegrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRegrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1266,6 +2559,38 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
@@ -1297,7 +2622,7 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
@@ -1350,7 +2675,7 @@ func (m *MsgRevoke) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRegrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +2698,10 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRegrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRegrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,85 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMaxExecutionsAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	send := banktypes.NewMsgSend(sdk.AccAddress("_____from _____"), sdk.AccAddress("_______to________"), coins)
+
+	var authorization authz.Authorization
+	authorization, err := authz.NewMaxExecutionsAuthorization(banktypes.NewSendAuthorization(coins.MulInt(sdk.NewInt(10))), 2)
+	require.NoError(t, err)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(send), authorization.MsgTypeURL())
+
+	t.Log("the wrapped authorization is updated along with the remaining executions")
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*authz.MaxExecutionsAuthorization)
+	require.Equal(t, uint64(1), updated.RemainingExecutions)
+	wrapped, err := updated.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, coins.MulInt(sdk.NewInt(9)), wrapped.(*banktypes.SendAuthorization).SpendLimit)
+
+	t.Log("the authorization is deleted after its last execution")
+	resp, err = updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify invalid authorizations")
+	require.Error(t, authz.MaxExecutionsAuthorization{}.ValidateBasic())
+	invalid, err := authz.NewMaxExecutionsAuthorization(banktypes.NewSendAuthorization(coins), 0)
+	require.NoError(t, err)
+	require.Error(t, invalid.ValidateBasic())
+}
+
+func TestCooldownAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	send := banktypes.NewMsgSend(sdk.AccAddress("_____from _____"), sdk.AccAddress("_______to________"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	var authorization authz.Authorization
+	authorization, err := authz.NewCooldownAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(send), authorization.MsgTypeURL())
+
+	t.Log("the first execution is accepted and recorded")
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	authorization = resp.Updated
+	require.Equal(t, now, *authorization.(*authz.CooldownAuthorization).LastExecution)
+
+	t.Log("executions are rejected during the cooldown")
+	_, err = authorization.Accept(ctx.WithBlockTime(now.Add(time.Hour-time.Second)), send)
+	require.ErrorIs(t, err, authz.ErrAuthorizationCooldown)
+
+	t.Log("and accepted after it")
+	resp, err = authorization.Accept(ctx.WithBlockTime(now.Add(time.Hour)), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, now.Add(time.Hour), *resp.Updated.(*authz.CooldownAuthorization).LastExecution)
+
+	t.Log("verify invalid authorizations")
+	invalid, err := authz.NewCooldownAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), 0)
+	require.NoError(t, err)
+	require.Error(t, invalid.ValidateBasic())
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PeriodicSendAuthorization{}
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period starts at the first send.
+func NewPeriodicSendAuthorization(spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The authorization is deleted once
+// its spend limit is used up.
+func (a PeriodicSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	// deduct from both the current period and the spend limit
+	var isNegative bool
+	a.PeriodCanSpend, isNegative = a.PeriodCanSpend.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit, isNegative = a.SpendLimit.SafeSub(mSend.Amount...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+		if a.SpendLimit.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// tryResetPeriod tops up the amount which can be spent in the period to the
+// lesser of the period spend limit and the spend limit, per denom, once the
+// period reset time is reached. The next period ends one period after the previous one, or
+// one period after the block time if more than one period has elapsed.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	if a.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit.Min(a.SpendLimit)
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", a.SpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+
+	// ensure the period spend limit can be subtracted from the spend limit
+	if !a.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different denoms than spend limit")
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	coins300 := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(300)))
+	coins200 := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200)))
+	month := 30 * 24 * time.Hour
+
+	// 500 per month, without any spend limit over all periods
+	var authorization authz.Authorization = types.NewPeriodicSendAuthorization(nil, month, coins500)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("the first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	authorization = resp.Updated
+	periodic := authorization.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins200, periodic.PeriodCanSpend)
+	require.Equal(t, now.Add(month), periodic.PeriodReset)
+
+	t.Log("the period spend limit can't be exceeded")
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	t.Log("the period spend limit is reset with the next period")
+	ctx = ctx.WithBlockTime(now.Add(month))
+	resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	periodic = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.True(t, periodic.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(2*month), periodic.PeriodReset)
+
+	t.Log("the authorization is deleted once its spend limit is used up")
+	authorization = types.NewPeriodicSendAuthorization(coins1000, month, coins500)
+	require.NoError(t, authorization.ValidateBasic())
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithBlockTime(now.Add(time.Duration(i+2) * month))
+		resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		authorization = resp.Updated
+	}
+	require.True(t, resp.Delete)
+
+	t.Log("the period is topped up per denom to the lesser of both limits")
+	spendLimit := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000)), sdk.NewCoin("stake", sdk.NewInt(200)))
+	periodLimit := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(300)), sdk.NewCoin("stake", sdk.NewInt(500)))
+	authorization = types.NewPeriodicSendAuthorization(spendLimit, month, periodLimit)
+	require.NoError(t, authorization.ValidateBasic())
+	send := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)))
+	resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, send))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	periodic = resp.Updated.(*types.PeriodicSendAuthorization)
+	expected := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200)), sdk.NewCoin("stake", sdk.NewInt(200)))
+	require.Equal(t, expected, periodic.PeriodCanSpend)
+
+	t.Log("verify invalid authorizations")
+	require.Error(t, types.NewPeriodicSendAuthorization(nil, 0, coins500).ValidateBasic())
+	require.Error(t, types.NewPeriodicSendAuthorization(nil, month, nil).ValidateBasic())
+	otherCoins := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000)))
+	require.Error(t, types.NewPeriodicSendAuthorization(otherCoins, month, coins500).ValidateBasic())
+}
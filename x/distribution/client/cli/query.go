@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
 	)
 
 	return distQueryCmd
@@ -319,3 +321,84 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryContinuousFund implements the query continuous fund command.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a continuous fund of the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a stream of funds from the community pool to a recipient by its id.
+
+Example:
+$ %s query distribution continuous-fund 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid continuous fund id", args[0])
+			}
+
+			res, err := queryClient.ContinuousFund(cmd.Context(), &types.QueryContinuousFundRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.ContinuousFund)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds implements the query continuous funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query the active continuous funds of the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the active streams of funds from the community pool to recipients.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &types.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HasAutoCompound returns whether the auto-compounding of a delegation is enabled.
+func (k Keeper) HasAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// SetAutoCompound enables the auto-compounding of a delegation.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundKey(delAddr, valAddr), []byte{0x01})
+}
+
+// DeleteAutoCompound disables the auto-compounding of a delegation.
+func (k Keeper) DeleteAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// IterateAutoCompounds iterates over the delegations with auto-compounding enabled.
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// nextAutoCompoundKey returns the first auto-compounding key at or after start,
+// or nil if there is none.
+func (k Keeper) nextAutoCompoundKey(ctx sdk.Context, start []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundPrefix))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	return iter.Key()
+}
+
+// ProcessAutoCompounds compounds the rewards of the delegations with
+// auto-compounding enabled, every AutoCompoundInterval blocks. A batch spends
+// at most AutoCompoundGasLimit gas and the next batch resumes where it stopped.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundInterval == 0 || uint64(ctx.BlockHeight())%params.AutoCompoundInterval != 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.AutoCompoundCursorKey)
+	if start == nil {
+		start = types.AutoCompoundPrefix
+	}
+
+	// the iterator is reopened for each entry as compounding writes to the store
+	var gasUsed uint64
+	for key := k.nextAutoCompoundKey(ctx, start); key != nil; key = k.nextAutoCompoundKey(ctx, start) {
+		if gasUsed >= params.AutoCompoundGasLimit {
+			store.Set(types.AutoCompoundCursorKey, key)
+			return
+		}
+
+		delAddr, valAddr := types.GetAutoCompoundAddresses(key)
+		gas, outOfGas := k.autoCompound(ctx, delAddr, valAddr, params.AutoCompoundGasLimit-gasUsed)
+
+		if outOfGas {
+			// an entry running out of gas is retried in the next batch, unless
+			// it ran out of the whole budget of a batch, in which case it is
+			// skipped until the budget is raised
+			if gasUsed > 0 {
+				store.Set(types.AutoCompoundCursorKey, key)
+				return
+			}
+			k.autoCompoundFailed(
+				ctx, delAddr, valAddr, false,
+				sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "auto-compounding gas limit %d exceeded", params.AutoCompoundGasLimit),
+			)
+		}
+
+		gasUsed += gas
+		start = append(append([]byte{}, key...), 0x00)
+	}
+
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// autoCompound compounds the rewards of a delegation within a gas limit,
+// discarding its state changes on failure. It returns the gas consumed and
+// whether the gas limit was exceeded.
+func (k Keeper) autoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, gasLimit uint64) (gasUsed uint64, outOfGas bool) {
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	amount, err := k.safeCompoundDelegationRewards(cacheCtx, delAddr, valAddr)
+	gasUsed = gasMeter.GasConsumedToLimit()
+	switch {
+	case sdkerrors.ErrOutOfGas.Is(err):
+		return gasUsed, true
+
+	case err != nil:
+		// a delegation which panics or whose rewards are withdrawn to another
+		// address would fail again, so its auto-compounding is disabled
+		disable := sdkerrors.ErrPanic.Is(err) || types.ErrAutoCompoundWithdrawAddr.Is(err)
+		k.autoCompoundFailed(ctx, delAddr, valAddr, disable, err)
+		return gasUsed, false
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	if amount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+
+	return gasUsed, false
+}
+
+// autoCompoundFailed logs and emits the failure to compound the rewards of a
+// delegation, and disables its auto-compounding if disable is set.
+func (k Keeper) autoCompoundFailed(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, disable bool, err error) {
+	k.Logger(ctx).Error(
+		"failed to auto-compound delegation rewards",
+		"delegator", delAddr.String(), "validator", valAddr.String(), "disabled", disable, "err", err,
+	)
+
+	if disable {
+		k.DeleteAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompoundFailed,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(!disable)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// safeCompoundDelegationRewards calls compoundDelegationRewards, turning an
+// out of gas panic into an ErrOutOfGas error and any other panic into an
+// ErrPanic error.
+func (k Keeper) safeCompoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (amount sdk.Coin, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "%v", r)
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	return k.compoundDelegationRewards(ctx, delAddr, valAddr)
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates those in the staking denom to the same validator, through the
+// staking keeper which enforces the validator bond factor. The rewards of
+// delegators with a withdraw address other than their own are not compounded,
+// and the auto-compounding of a delegation which no longer exists is disabled.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	zero := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		k.DeleteAutoCompound(ctx, delAddr, valAddr)
+		return zero, nil
+	}
+
+	if withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr); !withdrawAddr.Equals(delAddr) {
+		return zero, sdkerrors.Wrapf(types.ErrAutoCompoundWithdrawAddr, "withdraw address %s", withdrawAddr)
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return zero, err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return zero, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return zero, err
+	}
+
+	return sdk.NewCoin(bondDenom, amount), nil
+}
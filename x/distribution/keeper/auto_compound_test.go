@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	balanceTokens := stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 0% commission and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	// auto-compounding can only be enabled for existing delegations
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[2], valAddrs[0], true))
+	require.ErrorIs(t, err, types.ErrEmptyDelegationDistInfo)
+
+	for _, delAddr := range addr[:2] {
+		_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(delAddr, valAddrs[0], true))
+		require.NoError(t, err)
+		require.True(t, distrKeeper.HasAutoCompound(ctx, delAddr, valAddrs[0]))
+	}
+
+	var delAddrs []sdk.AccAddress
+	distrKeeper.IterateAutoCompounds(ctx, func(del sdk.AccAddress, _ sdk.ValAddress) bool {
+		delAddrs = append(delAddrs, del)
+		return false
+	})
+	require.Len(t, delAddrs, 2)
+
+	allocate := func() {
+		val := stakingKeeper.Validator(ctx, valAddrs[0])
+		rewards := stakingKeeper.TokensFromConsensusPower(ctx, 20)
+		distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+	}
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		return stakingKeeper.Delegation(ctx, delAddr, valAddrs[0]).GetShares()
+	}
+	failedEvents := func() (enabled []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeAutoCompoundFailed {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyEnabled {
+					enabled = append(enabled, string(attr.Value))
+				}
+			}
+		}
+		return enabled
+	}
+
+	// nothing is compounded outside of the auto-compounding interval
+	ctx = ctx.WithBlockHeight(9)
+	allocate()
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.Equal(t, sdk.NewDecFromInt(valTokens), shares(delAddrs[0]))
+
+	// a batch is limited by the gas budget: the first delegation exhausts it,
+	// which is reported, and the second one is left to the next batch
+	params.AutoCompoundGasLimit = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.Equal(t, sdk.NewDecFromInt(valTokens), shares(delAddrs[0]))
+	require.Equal(t, sdk.NewDecFromInt(valTokens), shares(delAddrs[1]))
+	require.Equal(t, []string{"true"}, failedEvents())
+	require.True(t, distrKeeper.HasAutoCompound(ctx, delAddrs[0], valAddrs[0]))
+
+	// the next batch resumes from the second delegation
+	params.AutoCompoundGasLimit = types.DefaultAutoCompoundGasLimit
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(20)
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.Equal(t, sdk.NewDecFromInt(valTokens), shares(delAddrs[0]))
+	compounded := valTokens.Add(stakingKeeper.TokensFromConsensusPower(ctx, 10))
+	require.Equal(t, sdk.NewDecFromInt(compounded), shares(delAddrs[1]))
+
+	// and the following one starts over
+	ctx = ctx.WithBlockHeight(30)
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.True(t, shares(delAddrs[0]).GT(sdk.NewDecFromInt(valTokens)))
+	require.Equal(t, sdk.NewDecFromInt(compounded), shares(delAddrs[1]))
+
+	// removing a delegation disables its auto-compounding
+	tstaking.Undelegate(delAddrs[1], valAddrs[0], compounded, true)
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delAddrs[1], valAddrs[0]))
+
+	// as does the delegator
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(delAddrs[0], valAddrs[0], false))
+	require.NoError(t, err)
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delAddrs[0], valAddrs[0]))
+
+	// the rewards withdrawn to another address can't be compounded
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(delAddrs[0], valAddrs[0], true))
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, delAddrs[0], addr[2]))
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(delAddrs[0], valAddrs[0], true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+
+	// and the auto-compounding enabled before changing the withdraw address is
+	// disabled by the next batch
+	ctx = ctx.WithBlockHeight(40).WithEventManager(sdk.NewEventManager())
+	allocate()
+	before := shares(delAddrs[0])
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.Equal(t, before, shares(delAddrs[0]))
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delAddrs[0], valAddrs[0]))
+	require.Equal(t, []string{"false"}, failedEvents())
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns a continuous fund by its id.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(id))
+	if b == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshal(b, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets a continuous fund.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&fund)
+	store.Set(types.GetContinuousFundKey(fund.Id), b)
+}
+
+// DeleteContinuousFund deletes a continuous fund.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(id))
+}
+
+// IterateContinuousFunds iterates over the continuous funds in the order of
+// their ids.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// GetNextContinuousFundID returns the id of the next continuous fund.
+func (k Keeper) GetNextContinuousFundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.NextContinuousFundIDKey)
+	if b == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetNextContinuousFundID sets the id of the next continuous fund.
+func (k Keeper) SetNextContinuousFundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextContinuousFundIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateContinuousFund creates a continuous fund streaming amount from the
+// community pool to recipient over the next blocks blocks, and returns its id.
+// The community pool is not required to hold the whole amount up front, each
+// payout being made from the community pool of its block.
+func (k Keeper) CreateContinuousFund(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, blocks uint64) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	id := k.GetNextContinuousFundID(ctx)
+	fund := types.NewContinuousFund(id, recipient, amount, ctx.BlockHeight(), ctx.BlockHeight()+int64(blocks))
+	if err := fund.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidContinuousFund, err.Error())
+	}
+
+	k.SetContinuousFund(ctx, fund)
+	k.SetNextContinuousFundID(ctx, id+1)
+
+	return id, nil
+}
+
+// CancelContinuousFund cancels a continuous fund, the part of its amount not
+// paid out yet remaining in the community pool.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetContinuousFund(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrNoContinuousFundExists, "continuous fund %d", id)
+	}
+
+	k.DeleteContinuousFund(ctx, id)
+	return nil
+}
+
+// ProcessContinuousFunds pays out the amounts due by the continuous funds and
+// deletes the continuous funds which were paid out entirely. A payout the
+// community pool can't cover is retried in the following blocks.
+func (k Keeper) ProcessContinuousFunds(ctx sdk.Context) {
+	var funds []types.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) bool {
+		funds = append(funds, fund)
+		return false
+	})
+
+	for _, fund := range funds {
+		due, hasNeg := fund.VestedAmount(ctx.BlockHeight()).SafeSub(fund.Paid...)
+		if hasNeg {
+			panic("continuous fund paid more than its vested amount")
+		}
+
+		if due.IsZero() {
+			continue
+		}
+
+		if err := k.payContinuousFund(ctx, fund, due); err != nil {
+			k.Logger(ctx).Error(
+				"failed to pay out continuous fund",
+				"id", fund.Id, "recipient", fund.Recipient, "amount", due.String(), "err", err,
+			)
+			continue
+		}
+
+		fund.Paid = fund.Paid.Add(due...)
+		if fund.Paid.IsAllGTE(fund.Amount) {
+			k.DeleteContinuousFund(ctx, fund.Id)
+		} else {
+			k.SetContinuousFund(ctx, fund)
+		}
+	}
+}
+
+// payContinuousFund sends an amount due by a continuous fund from the
+// community pool to its recipient.
+func (k Keeper) payContinuousFund(ctx sdk.Context, fund types.ContinuousFund, amount sdk.Coins) error {
+	recipient, err := sdk.AccAddressFromBech32(fund.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFundPayout,
+			sdk.NewAttribute(types.AttributeKeyContinuousFund, strconv.FormatUint(fund.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
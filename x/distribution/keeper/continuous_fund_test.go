@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func TestContinuousFund(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000))
+	depositor, recipient := addr[0], addr[1]
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	balance := func() sdk.Coins {
+		return bankKeeper.GetAllBalances(ctx, recipient).Sub(coins(1000)...)
+	}
+
+	// only the authority can create continuous funds
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(
+		depositor.String(), recipient, coins(300), 4,
+	))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// stream 300 over 4 blocks from a community pool holding 150
+	pool, _ := distrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+	require.NoError(t, distrKeeper.DistributeFromFeePool(ctx, pool, depositor))
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, coins(150), depositor))
+	res, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(
+		distrKeeper.GetAuthority(), recipient, coins(300), 4,
+	))
+	require.NoError(t, err)
+
+	fundRes, err := distrKeeper.ContinuousFund(sdk.WrapSDKContext(ctx), &types.QueryContinuousFundRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.NewContinuousFund(res.Id, recipient, coins(300), 10, 14), fundRes.ContinuousFund)
+
+	// nothing is paid out in the block of the creation
+	distrKeeper.ProcessContinuousFunds(ctx)
+	require.True(t, balance().IsZero())
+
+	// a quarter of the amount is paid out every block
+	for i, paid := range []int64{75, 150} {
+		ctx = ctx.WithBlockHeight(int64(11 + i))
+		distrKeeper.ProcessContinuousFunds(ctx)
+		require.Equal(t, coins(paid), balance())
+	}
+
+	// a payout the community pool can't cover is retried in the following blocks
+	ctx = ctx.WithBlockHeight(13)
+	distrKeeper.ProcessContinuousFunds(ctx)
+	require.Equal(t, coins(150), balance())
+
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, coins(500), depositor))
+	ctx = ctx.WithBlockHeight(14)
+	distrKeeper.ProcessContinuousFunds(ctx)
+	require.Equal(t, coins(300), balance())
+
+	// a continuous fund paid out entirely is deleted
+	_, found := distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.False(t, found)
+
+	// a cancelled continuous fund isn't paid out anymore
+	res, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(
+		distrKeeper.GetAuthority(), recipient, coins(100), 10,
+	))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(15)
+	distrKeeper.ProcessContinuousFunds(ctx)
+	require.Equal(t, coins(310), balance())
+
+	fundsRes, err := distrKeeper.ContinuousFunds(sdk.WrapSDKContext(ctx), &types.QueryContinuousFundsRequest{})
+	require.NoError(t, err)
+	require.Len(t, fundsRes.ContinuousFunds, 1)
+	require.Equal(t, coins(10), fundsRes.ContinuousFunds[0].Paid)
+
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(distrKeeper.GetAuthority(), res.Id))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(16)
+	distrKeeper.ProcessContinuousFunds(ctx)
+	require.Equal(t, coins(310), balance())
+
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(distrKeeper.GetAuthority(), res.Id))
+	require.ErrorIs(t, err, types.ErrNoContinuousFundExists)
+}
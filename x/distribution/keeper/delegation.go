@@ -137,10 +137,62 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 	return rewards
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+// delegationRewards returns the rewards of a delegation along with the rewards
+// it accrued before. The delegation is nil if it was removed.
+func (k Keeper) delegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+	rewards := k.GetDelegatorAccruedRewards(ctx, delAddr, valAddr).Rewards
+	if del != nil {
+		endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+		rewards = rewards.Add(k.CalculateDelegationRewards(ctx, val, del, endingPeriod)...)
+	}
+
+	return rewards
+}
+
+// delegatorTotalRewards returns the rewards of the delegations of a delegator,
+// including the removed delegations whose accrued rewards were not withdrawn.
+func (k Keeper) delegatorTotalRewards(ctx sdk.Context, delAddr sdk.AccAddress) ([]types.DelegationDelegatorReward, sdk.DecCoins) {
+	total := sdk.DecCoins{}
+	var delRewards []types.DelegationDelegatorReward
+
+	k.stakingKeeper.IterateDelegations(
+		ctx, delAddr,
+		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			valAddr := del.GetValidatorAddr()
+			val := k.stakingKeeper.Validator(ctx, valAddr)
+			delReward := k.delegationRewards(ctx, val, del, delAddr, valAddr)
+
+			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
+			total = total.Add(delReward...)
+			return false
+		},
+	)
+
+	k.IterateDelegatorAccruedRewardsByDelegator(
+		ctx, delAddr,
+		func(valAddr sdk.ValAddress, accrued types.DelegatorAccruedRewards) (stop bool) {
+			if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) != nil {
+				return false
+			}
+
+			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, accrued.Rewards))
+			total = total.Add(accrued.Rewards...)
+			return false
+		},
+	)
+
+	return delRewards, total
+}
+
+// accrueDelegationRewards ends the current period of a delegation and moves
+// its rewards from the outstanding rewards of the validator to the rewards
+// accrued by the delegation, which remain claimable until withdrawn. The
+// starting info of the delegation is removed, so the delegation must be
+// reinitialized unless it is being removed.
+func (k Keeper) accrueDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) error {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
-		return nil, types.ErrEmptyDelegationDistInfo
+		return types.ErrEmptyDelegationDistInfo
 	}
 
 	// end current period and calculate rewards
@@ -162,32 +214,49 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 		)
 	}
 
+	// move the rewards from the outstanding rewards to the accrued rewards
+	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
+	if !rewards.IsZero() {
+		accrued := k.GetDelegatorAccruedRewards(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
+		accrued.Rewards = accrued.Rewards.Add(rewards...)
+		k.SetDelegatorAccruedRewards(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr(), accrued)
+	}
+
+	// decrement reference count of starting period
+	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	startingPeriod := startingInfo.PreviousPeriod
+	k.decrementReferenceCount(ctx, del.GetValidatorAddr(), startingPeriod)
+
+	// remove delegator starting info
+	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+
+	return nil
+}
+
+// withdrawAccruedRewards sends the rewards accrued by a delegation to the
+// withdraw address of the delegator, the remainder of the truncation going to
+// the community pool.
+func (k Keeper) withdrawAccruedRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	accrued := k.GetDelegatorAccruedRewards(ctx, delAddr, valAddr)
+
 	// truncate coins, return remainder to community pool
-	coins, remainder := rewards.TruncateDecimal()
+	coins, remainder := accrued.Rewards.TruncateDecimal()
 
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// update the outstanding rewards and the community pool only if the
+	// update the accrued rewards and the community pool only if the
 	// transaction was successful
-	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
+	k.DeleteDelegatorAccruedRewards(ctx, delAddr, valAddr)
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 	k.SetFeePool(ctx, feePool)
 
-	// decrement reference count of starting period
-	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
-	startingPeriod := startingInfo.PreviousPeriod
-	k.decrementReferenceCount(ctx, del.GetValidatorAddr(), startingPeriod)
-
-	// remove delegator starting info
-	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
-
 	return coins, nil
 }
@@ -13,6 +13,7 @@ import (
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -821,3 +822,200 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	ownerAddr := addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// tokenize half of the self delegation
+	msgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(
+		addr[0], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)), ownerAddr,
+	))
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	initial := stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// withdraw the rewards of the tokenized delegation to the record owner
+	ownerBalance := bankKeeper.GetAllBalances(ctx, ownerAddr)
+	rewards, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, ownerBalance.Add(rewards...), bankKeeper.GetAllBalances(ctx, ownerAddr))
+
+	// nothing left to withdraw
+	rewards, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	// the rewards not withdrawn by the owner are sent to the owner on the
+	// redemption of share tokens, and the redeemer receives none of them
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	record, err := stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	shareTokens := bankKeeper.GetBalance(ctx, addr[0], record.GetShareTokenDenom())
+	holderBalance := bankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom)
+	ownerBalance = bankKeeper.GetAllBalances(ctx, ownerAddr)
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgRedeemTokensForShares(
+		addr[0], sdk.NewCoin(shareTokens.Denom, shareTokens.Amount.QuoRaw(2)),
+	))
+	require.NoError(t, err)
+	require.Equal(t, holderBalance, bankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom))
+	require.Equal(t, ownerBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), bankKeeper.GetAllBalances(ctx, ownerAddr))
+	require.True(t, bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the rewards accrued until the last redemption are sent to the owner too
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	ownerBalance = bankKeeper.GetAllBalances(ctx, ownerAddr)
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgRedeemTokensForShares(
+		addr[0], bankKeeper.GetBalance(ctx, addr[0], record.GetShareTokenDenom()),
+	))
+	require.NoError(t, err)
+	require.Equal(t, holderBalance, bankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom))
+	require.Equal(t, ownerBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))), bankKeeper.GetAllBalances(ctx, ownerAddr))
+	require.True(t, bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
+
+func TestAccrueDelegationRewards(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	delAddr := addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 0% commission and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(delAddr, valAddrs[0], valTokens)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tstaking.Ctx = ctx
+
+	// allocate some rewards
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	initial := stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	delRewards := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+
+	// modifying the delegation accrues its rewards without withdrawing them
+	delBalance := bankKeeper.GetAllBalances(ctx, delAddr)
+	tstaking.Undelegate(delAddr, valAddrs[0], valTokens.QuoRaw(2), true)
+	require.Equal(t, delBalance, bankKeeper.GetAllBalances(ctx, delAddr))
+	require.Equal(t, delRewards, distrKeeper.GetDelegatorAccruedRewards(ctx, delAddr, valAddrs[0]).Rewards)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tstaking.Ctx = ctx
+
+	// allocate some more rewards, of which the delegation gets a third
+	val = stakingKeeper.Validator(ctx, valAddrs[0])
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.MulRaw(3))})
+	delRewards = delRewards.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, initial))
+
+	queryRewards := func() (sdk.DecCoins, error) {
+		res, err := distrKeeper.DelegationRewards(sdk.WrapSDKContext(ctx), &types.QueryDelegationRewardsRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddrs[0].String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.Rewards, nil
+	}
+
+	// the accrued rewards are included in the queried rewards
+	rewards, err := queryRewards()
+	require.NoError(t, err)
+	require.Equal(t, delRewards, rewards)
+
+	// and remain claimable after the delegation is removed
+	tstaking.Undelegate(delAddr, valAddrs[0], valTokens.QuoRaw(2), true)
+	require.Nil(t, stakingKeeper.Delegation(ctx, delAddr, valAddrs[0]))
+	rewards, err = queryRewards()
+	require.NoError(t, err)
+	require.Equal(t, delRewards, rewards)
+
+	totalRes, err := distrKeeper.DelegationTotalRewards(sdk.WrapSDKContext(ctx), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.DelegationDelegatorReward{types.NewDelegationDelegatorReward(valAddrs[0], delRewards)}, totalRes.Rewards)
+	require.Equal(t, delRewards, totalRes.Total)
+
+	withdrawn, err := distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+	expWithdrawn, _ := delRewards.TruncateDecimal()
+	require.Equal(t, expWithdrawn, withdrawn)
+	require.Equal(t, delBalance.Add(withdrawn...), bankKeeper.GetAllBalances(ctx, delAddr))
+	require.False(t, distrKeeper.HasDelegatorAccruedRewards(ctx, delAddr, valAddrs[0]))
+
+	// nothing left to withdraw
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.ErrorIs(t, err, types.ErrEmptyDelegationDistInfo)
+	_, err = queryRewards()
+	require.Error(t, err)
+}
@@ -79,6 +79,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, ac := range data.AutoCompounds {
+		delegatorAddress := sdk.MustAccAddressFromBech32(ac.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(ac.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoCompound(ctx, delegatorAddress, valAddr)
+	}
+	for _, acc := range data.DelegatorAccruedRewards {
+		delegatorAddress := sdk.MustAccAddressFromBech32(acc.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(acc.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorAccruedRewards(ctx, delegatorAddress, valAddr, acc.AccruedRewards)
+		moduleHoldings = moduleHoldings.Add(acc.AccruedRewards.Rewards...)
+	}
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+	if data.NextContinuousFundId != 0 {
+		k.SetNextContinuousFundID(ctx, data.NextContinuousFundId)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -184,5 +207,39 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.AutoCompoundRecord, 0)
+	k.IterateAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.AutoCompoundRecord{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+			})
+			return false
+		},
+	)
+
+	accrued := make([]types.DelegatorAccruedRewardsRecord, 0)
+	k.IterateDelegatorAccruedRewards(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorAccruedRewards) (stop bool) {
+			accrued = append(accrued, types.DelegatorAccruedRewardsRecord{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+				AccruedRewards:   rewards,
+			})
+			return false
+		},
+	)
+
+	funds := make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx,
+		func(fund types.ContinuousFund) (stop bool) {
+			funds = append(funds, fund)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds, accrued, funds,
+		k.GetNextContinuousFundID(ctx),
+	)
 }
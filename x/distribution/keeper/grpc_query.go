@@ -130,22 +130,24 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 		return nil, err
 	}
 
-	val := k.stakingKeeper.Validator(ctx, valAdr)
-	if val == nil {
-		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
-	}
-
 	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
+
+	// the rewards accrued by a removed delegation remain claimable
+	hasAccrued := k.HasDelegatorAccruedRewards(ctx, delAdr, valAdr)
+	val := k.stakingKeeper.Validator(ctx, valAdr)
+	if val == nil && !hasAccrued {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
 	del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
-	if del == nil {
+	if del == nil && !hasAccrued {
 		return nil, types.ErrNoDelegationExists
 	}
 
-	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards := k.delegationRewards(ctx, val, del, delAdr, valAdr)
 
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
@@ -162,27 +164,12 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	total := sdk.DecCoins{}
-	var delRewards []types.DelegationDelegatorReward
-
 	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	k.stakingKeeper.IterateDelegations(
-		ctx, delAdr,
-		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
-			valAddr := del.GetValidatorAddr()
-			val := k.stakingKeeper.Validator(ctx, valAddr)
-			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
-			total = total.Add(delReward...)
-			return false
-		},
-	)
+	delRewards, total := k.delegatorTotalRewards(ctx, delAdr)
 
 	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
@@ -242,3 +229,45 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFund queries a continuous fund by its id
+func (k Keeper) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fund, found := k.GetContinuousFund(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund %d doesn't exist", req.Id)
+	}
+
+	return &types.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// ContinuousFunds queries the active continuous funds
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	fundsStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	var funds []types.ContinuousFund
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(key []byte, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -85,16 +86,12 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
-// withdraw delegation rewards (which also increments period)
+// accrue delegation rewards (which also increments period), without withdrawing them
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	del := h.k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
 
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
-		return err
-	}
-
-	return nil
+	return h.k.accrueDelegationRewards(ctx, val, del)
 }
 
 // create new delegation period record
@@ -118,6 +115,57 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// BeforeDelegationRemoved disables the auto-compounding of a delegation being removed
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteAutoCompound(ctx, delAddr, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterConsensusPubKeyUpdate(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
 	return nil
 }
+
+// withdraw the rewards of the delegation of a tokenize share record to its
+// module account, before its balance is sent to the record owner on the
+// redemption of share tokens
+func (h Hooks) BeforeTokenizeSharesRedeemed(ctx sdk.Context, recordID uint64) error {
+	record, err := h.k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	if h.k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) == nil && !h.k.HasDelegatorAccruedRewards(ctx, moduleAddr, valAddr) {
+		return nil
+	}
+
+	_, err = h.k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr)
+	return err
+}
+
+// withdraw the rewards accrued by the delegation of a tokenize share record to
+// its module account, before its balance is sent to the record owner
+func (h Hooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	record, err := h.k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	if !h.k.HasDelegatorAccruedRewards(ctx, moduleAddr, valAddr) {
+		return nil
+	}
+
+	_, err = h.k.withdrawAccruedRewards(ctx, moduleAddr, valAddr)
+	return err
+}
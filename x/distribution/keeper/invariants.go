@@ -132,7 +132,8 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// is consistent with the sum of validator outstanding rewards and delegator
+// accrued rewards
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var expectedCoins sdk.DecCoins
@@ -140,6 +141,10 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			expectedCoins = expectedCoins.Add(rewards.Rewards...)
 			return false
 		})
+		k.IterateDelegatorAccruedRewards(ctx, func(_ sdk.AccAddress, _ sdk.ValAddress, rewards types.DelegatorAccruedRewards) (stop bool) {
+			expectedCoins = expectedCoins.Add(rewards.Rewards...)
+			return false
+		})
 
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
 		expectedInt, _ := expectedCoins.Add(communityPool...).TruncateDecimal()
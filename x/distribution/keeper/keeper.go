@@ -79,20 +79,29 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
-// withdraw rewards from a delegation
+// withdraw rewards from a delegation, along with the rewards it accrued when
+// it was modified. The rewards accrued by a removed delegation remain
+// claimable.
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
-	if val == nil {
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	hasAccrued := k.HasDelegatorAccruedRewards(ctx, delAddr, valAddr)
+	if val == nil && !hasAccrued {
 		return nil, types.ErrNoValidatorDistInfo
 	}
-
-	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
-	if del == nil {
+	if del == nil && !hasAccrued {
 		return nil, types.ErrEmptyDelegationDistInfo
 	}
 
+	// accrue the rewards of the current period
+	if del != nil {
+		if err := k.accrueDelegationRewards(ctx, val, del); err != nil {
+			return nil, err
+		}
+	}
+
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, err := k.withdrawAccruedRewards(ctx, delAddr, valAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +123,9 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	)
 
 	// reinitialize the delegation
-	k.initializeDelegation(ctx, valAddr, delAddr)
+	if del != nil {
+		k.initializeDelegation(ctx, valAddr, delAddr)
+	}
 	return rewards, nil
 }
 
@@ -152,6 +163,48 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized
+// delegations of the tokenize share records owned by ownerAddr. The rewards
+// are withdrawn to the module accounts of the records, along with the rewards
+// accrued by previous modifications of the delegations, and then sent to
+// ownerAddr.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.Coins{}
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleAddr := record.GetModuleAddress()
+		if k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil || k.HasDelegatorAccruedRewards(ctx, moduleAddr, valAddr) {
+			if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyOwner, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
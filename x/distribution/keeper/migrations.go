@@ -30,3 +30,13 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates the x/distribution module state from the consensus
+// version 3 to version 4, in which the rewards of a delegation are accrued
+// when it is modified or removed instead of being withdrawn. No state needs to
+// be migrated: the starting info of each existing delegation records the
+// period of its last withdrawal, from which its rewards keep being calculated,
+// and no delegation has accrued rewards yet.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	delAddr := addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 0% commission and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(delAddr, valAddrs[0], valTokens)
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tstaking.Ctx = ctx
+
+	// allocate some rewards, not withdrawn before the upgrade: the v3 state
+	// only holds the starting info of the delegation
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	initial := stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	require.True(t, distrKeeper.HasDelegatorStartingInfo(ctx, valAddrs[0], delAddr))
+	require.False(t, distrKeeper.HasDelegatorAccruedRewards(ctx, delAddr, valAddrs[0]))
+
+	require.NoError(t, keeper.NewMigrator(distrKeeper, nil).Migrate3to4(ctx))
+
+	// the rewards earned before the upgrade are accrued when the delegation is
+	// removed, and can be withdrawn afterwards
+	delRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	tstaking.Undelegate(delAddr, valAddrs[0], valTokens, true)
+	require.Nil(t, stakingKeeper.Delegation(ctx, delAddr, valAddrs[0]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(delRewards...), distrKeeper.GetDelegatorAccruedRewards(ctx, delAddr, valAddrs[0]).Rewards)
+
+	withdrawn, err := distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, delRewards, withdrawn)
+	require.False(t, distrKeeper.HasDelegatorAccruedRewards(ctx, delAddr, valAddrs[0]))
+}
@@ -132,18 +132,19 @@ func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery,
 	// branch the context to isolate state changes
 	ctx, _ = ctx.CacheContext()
 
+	// the rewards accrued by a removed delegation remain claimable
+	hasAccrued := k.HasDelegatorAccruedRewards(ctx, params.DelegatorAddress, params.ValidatorAddress)
 	val := k.stakingKeeper.Validator(ctx, params.ValidatorAddress)
-	if val == nil {
+	if val == nil && !hasAccrued {
 		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, params.ValidatorAddress.String())
 	}
 
 	del := k.stakingKeeper.Delegation(ctx, params.DelegatorAddress, params.ValidatorAddress)
-	if del == nil {
+	if del == nil && !hasAccrued {
 		return nil, types.ErrNoDelegationExists
 	}
 
-	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards := k.delegationRewards(ctx, val, del, params.DelegatorAddress, params.ValidatorAddress)
 	if rewards == nil {
 		rewards = sdk.DecCoins{}
 	}
@@ -166,23 +167,7 @@ func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQue
 	// branch the context to isolate state changes
 	ctx, _ = ctx.CacheContext()
 
-	total := sdk.DecCoins{}
-
-	var delRewards []types.DelegationDelegatorReward
-
-	k.stakingKeeper.IterateDelegations(
-		ctx, params.DelegatorAddress,
-		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
-			valAddr := del.GetValidatorAddr()
-			val := k.stakingKeeper.Validator(ctx, valAddr)
-			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
-			total = total.Add(delReward...)
-			return false
-		},
-	)
+	delRewards, total := k.delegatorTotalRewards(ctx, params.DelegatorAddress)
 
 	totalRewards := types.NewQueryDelegatorTotalRewardsResponse(delRewards, total)
 
@@ -124,6 +124,66 @@ func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context, handler func(val
 	}
 }
 
+// get the rewards accrued by a delegation
+func (k Keeper) GetDelegatorAccruedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) (rewards types.DelegatorAccruedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorAccruedRewardsKey(del, val))
+	if b == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(b, &rewards)
+	return
+}
+
+// set the rewards accrued by a delegation
+func (k Keeper) SetDelegatorAccruedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorAccruedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&rewards)
+	store.Set(types.GetDelegatorAccruedRewardsKey(del, val), b)
+}
+
+// check existence of the rewards accrued by a delegation
+func (k Keeper) HasDelegatorAccruedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAccruedRewardsKey(del, val))
+}
+
+// delete the rewards accrued by a delegation
+func (k Keeper) DeleteDelegatorAccruedRewards(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAccruedRewardsKey(del, val))
+}
+
+// iterate over the rewards accrued by the delegations of a delegator
+func (k Keeper) IterateDelegatorAccruedRewardsByDelegator(ctx sdk.Context, del sdk.AccAddress, handler func(val sdk.ValAddress, rewards types.DelegatorAccruedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAccruedRewardsPrefix(del))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.DelegatorAccruedRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		_, val := types.GetDelegatorAccruedRewardsAddresses(iter.Key())
+		if handler(val, rewards) {
+			break
+		}
+	}
+}
+
+// iterate over delegator accrued rewards
+func (k Keeper) IterateDelegatorAccruedRewards(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress, rewards types.DelegatorAccruedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAccruedRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.DelegatorAccruedRewards
+		k.cdc.MustUnmarshal(iter.Value(), &rewards)
+		del, val := types.GetDelegatorAccruedRewardsAddresses(iter.Key())
+		if handler(del, val, rewards) {
+			break
+		}
+	}
+}
+
 // get historical rewards for a particular period
 func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (rewards types.ValidatorHistoricalRewards) {
 	store := ctx.KVStore(k.storeKey)
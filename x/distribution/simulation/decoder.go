@@ -63,6 +63,28 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorAccruedRewardsPrefix):
+			var rewardsA, rewardsB types.DelegatorAccruedRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundPrefix):
+			delAddr, valAddr := types.GetAutoCompoundAddresses(kvA.Key)
+			return fmt.Sprintf("%v\n%v", delAddr, valAddr)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextContinuousFundIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
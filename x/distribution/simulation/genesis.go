@@ -69,10 +69,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:         communityTax,
+			BaseProposerReward:   baseProposerReward,
+			BonusProposerReward:  bonusProposerReward,
+			WithdrawAddrEnabled:  withdrawEnabled,
+			AutoCompoundInterval: types.DefaultAutoCompoundInterval,
+			AutoCompoundGasLimit: types.DefaultAutoCompoundGasLimit,
 		},
 	}
 
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewContinuousFund creates a new ContinuousFund streaming amount to recipient
+// between startHeight and endHeight.
+func NewContinuousFund(id uint64, recipient sdk.AccAddress, amount sdk.Coins, startHeight, endHeight int64) ContinuousFund {
+	return ContinuousFund{
+		Id:          id,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// VestedAmount returns the amount of the continuous fund due to the recipient
+// at a height, which increases linearly from zero at the start height to the
+// whole amount at the end height.
+func (f ContinuousFund) VestedAmount(height int64) sdk.Coins {
+	if height <= f.StartHeight {
+		return sdk.Coins{}
+	}
+	if height >= f.EndHeight {
+		return f.Amount
+	}
+
+	elapsed := sdk.NewInt(height - f.StartHeight)
+	duration := sdk.NewInt(f.EndHeight - f.StartHeight)
+	vested := sdk.Coins{}
+	for _, coin := range f.Amount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+
+	return vested
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (f ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return fmt.Errorf("invalid continuous fund %d recipient: %w", f.Id, err)
+	}
+	if !f.Amount.IsValid() || f.Amount.IsZero() {
+		return fmt.Errorf("invalid continuous fund %d amount: %s", f.Id, f.Amount)
+	}
+	if !f.Paid.IsValid() || !f.Amount.IsAllGTE(f.Paid) {
+		return fmt.Errorf("invalid continuous fund %d paid amount: %s", f.Id, f.Paid)
+	}
+	if f.EndHeight <= f.StartHeight {
+		return fmt.Errorf("continuous fund %d end height %d must be after its start height %d", f.Id, f.EndHeight, f.StartHeight)
+	}
+
+	return nil
+}
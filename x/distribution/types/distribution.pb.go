@@ -32,6 +32,11 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two batches of
+	// auto-compounding. Zero disables auto-compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_gas_limit is the gas budget of a batch of auto-compounding.
+	AutoCompoundGasLimit uint64 `protobuf:"varint,6,opt,name=auto_compound_gas_limit,json=autoCompoundGasLimit,proto3" json:"auto_compound_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +78,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundGasLimit() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasLimit
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +99,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -523,6 +543,53 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
+// DelegatorAccruedRewards represents the rewards accrued by a delegation when
+// it was modified or removed, which remain claimable by the delegator until
+// withdrawn.
+type DelegatorAccruedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *DelegatorAccruedRewards) Reset()         { *m = DelegatorAccruedRewards{} }
+func (m *DelegatorAccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAccruedRewards) ProtoMessage()    {}
+func (*DelegatorAccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *DelegatorAccruedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorAccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorAccruedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorAccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAccruedRewards.Merge(m, src)
+}
+func (m *DelegatorAccruedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorAccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAccruedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorAccruedRewards proto.InternalMessageInfo
+
+func (m *DelegatorAccruedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // DelegationDelegatorReward represents the properties
 // of a delegator's delegation reward.
 type DelegationDelegatorReward struct {
@@ -534,7 +601,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +644,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,6 +673,95 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// ContinuousFund defines a stream of funds from the community pool to a
+// recipient, paid out linearly every block between start_height and
+// end_height.
+type ContinuousFund struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount of the stream.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// paid is the amount already paid out to the recipient.
+	Paid        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	StartHeight int64                                    `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64                                    `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -617,8 +773,10 @@ func init() {
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegatorAccruedRewards)(nil), "cosmos.distribution.v1beta1.DelegatorAccruedRewards")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 }
 
 func init() {
@@ -626,66 +784,76 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 941 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x34, 0x8e, 0x93, 0x4e, 0xbf, 0x4d, 0xbe, 0x4c, 0x9c, 0xd4, 0x71, 0x2b, 0x3b, 0x5a,
-	0x89, 0x12, 0xa8, 0xe2, 0x34, 0xed, 0x2d, 0xe2, 0x12, 0x3b, 0x41, 0x70, 0x6a, 0xb4, 0x41, 0x80,
-	0xb8, 0xac, 0xc6, 0xbb, 0x2f, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x7b, 0xe0,
-	0xc7, 0x09, 0x89, 0x0b, 0xe2, 0x80, 0x72, 0x44, 0x9c, 0xf3, 0x0f, 0x70, 0xab, 0x38, 0x95, 0x5e,
-	0x40, 0x1c, 0x02, 0x4a, 0x2e, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xed, 0x40, 0x88, 0x7a, 0x88,
-	0xc5, 0xc9, 0x9e, 0xf7, 0x66, 0x3f, 0x3f, 0xde, 0xbc, 0x7d, 0xb3, 0xb8, 0x15, 0x0a, 0x95, 0x08,
-	0xb5, 0x1e, 0x31, 0xa5, 0x25, 0xeb, 0x66, 0x9a, 0x09, 0xbe, 0x3e, 0xd8, 0xe8, 0x82, 0xa6, 0x1b,
-	0x17, 0x82, 0xad, 0x54, 0x0a, 0x2d, 0xc8, 0x5d, 0xbb, 0xbf, 0x75, 0x21, 0xe5, 0xf6, 0xd7, 0xab,
-	0x3d, 0xd1, 0x13, 0xf9, 0xbe, 0x75, 0xf3, 0xcf, 0x3e, 0x52, 0x6f, 0x38, 0x8a, 0x2e, 0x55, 0x50,
-	0x40, 0x87, 0x82, 0x39, 0xc8, 0xfa, 0xb2, 0xcd, 0x07, 0xf6, 0x41, 0x87, 0x9f, 0x2f, 0xbc, 0x4f,
-	0xa7, 0x70, 0x65, 0x97, 0x4a, 0x9a, 0x28, 0x42, 0xf1, 0xed, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x1a, 0x68, 0x7a, 0x54, 0x43, 0x2b, 0x68, 0xf5, 0x66, 0xfb, 0xed, 0xe7, 0xa7, 0xcd, 0xd2, 0xaf,
-	0xa7, 0xcd, 0xfb, 0x3d, 0xa6, 0xfb, 0x59, 0xb7, 0x15, 0x8a, 0xc4, 0x41, 0xb8, 0x9f, 0x35, 0x15,
-	0x1d, 0xac, 0xeb, 0xa7, 0x29, 0xa8, 0xd6, 0x36, 0x84, 0x2f, 0x4f, 0xd6, 0xb0, 0x63, 0xd8, 0x86,
-	0xd0, 0xff, 0x5f, 0x01, 0xf9, 0x3e, 0x3d, 0x22, 0x1c, 0x57, 0x8d, 0x46, 0x23, 0x24, 0x15, 0x0a,
-	0x64, 0x20, 0xe1, 0x90, 0xca, 0xa8, 0x76, 0xe3, 0x1a, 0x98, 0x88, 0x41, 0xde, 0x75, 0xc0, 0x7e,
-	0x8e, 0x4b, 0x52, 0xbc, 0xd8, 0x15, 0x3c, 0x53, 0xff, 0x20, 0x9c, 0xba, 0x06, 0xc2, 0x85, 0x1c,
-	0xfa, 0x6f, 0x8c, 0x8f, 0xf0, 0xe2, 0x21, 0xd3, 0xfd, 0x48, 0xd2, 0xc3, 0x80, 0x46, 0x91, 0x0c,
-	0x80, 0xd3, 0x6e, 0x0c, 0x51, 0xad, 0xbc, 0x82, 0x56, 0x67, 0xfd, 0x85, 0x61, 0x72, 0x2b, 0x8a,
-	0xe4, 0x8e, 0x4d, 0x6d, 0x96, 0xbf, 0x3e, 0x6e, 0x96, 0xbc, 0x9f, 0x10, 0xae, 0x7f, 0x40, 0x63,
-	0x16, 0x51, 0x2d, 0xe4, 0xbb, 0x4c, 0x69, 0x21, 0x59, 0x48, 0x63, 0x8b, 0xab, 0xc8, 0xe7, 0x08,
-	0xdf, 0x09, 0xb3, 0x24, 0x8b, 0xa9, 0x66, 0x03, 0x70, 0x3e, 0x02, 0x49, 0x35, 0x13, 0x35, 0xb4,
-	0x32, 0xb5, 0x7a, 0xeb, 0xd1, 0x3d, 0xd7, 0x69, 0x2d, 0x53, 0x88, 0x61, 0xc7, 0x18, 0xa5, 0x1d,
-	0xc1, 0x78, 0xfb, 0xb1, 0xf1, 0xfa, 0xfd, 0x6f, 0xcd, 0x07, 0xaf, 0xe6, 0xd5, 0x3c, 0xa3, 0xfc,
-	0xc5, 0x11, 0xa3, 0xd5, 0xe1, 0x1b, 0x3e, 0xf2, 0x06, 0x9e, 0x97, 0xb0, 0x0f, 0x12, 0x78, 0x08,
-	0x41, 0x28, 0x32, 0xae, 0xf3, 0x13, 0xbc, 0xed, 0xcf, 0x15, 0xe1, 0x8e, 0x89, 0x7a, 0xdf, 0x22,
-	0x7c, 0xa7, 0xf0, 0xd4, 0xc9, 0xa4, 0x04, 0xae, 0x87, 0x86, 0x0e, 0xf0, 0x8c, 0x35, 0xa1, 0x26,
-	0xa7, 0x7f, 0xc8, 0x40, 0x96, 0x70, 0x25, 0x05, 0xc9, 0x84, 0x6d, 0xb5, 0xb2, 0xef, 0x56, 0xde,
-	0x57, 0x08, 0x37, 0x0a, 0x81, 0x5b, 0xa1, 0xb3, 0x0b, 0x51, 0x47, 0x24, 0x09, 0x53, 0x8a, 0x09,
-	0x4e, 0x3e, 0xc1, 0x38, 0x2c, 0x56, 0x93, 0x93, 0x3a, 0x46, 0xe2, 0x7d, 0x81, 0xf0, 0xdd, 0x42,
-	0xd5, 0x93, 0x4c, 0x2b, 0x4d, 0x79, 0xc4, 0x78, 0xef, 0xbf, 0x28, 0x9d, 0xf7, 0x0d, 0xc2, 0x0b,
-	0x85, 0x98, 0xbd, 0x98, 0xaa, 0xfe, 0xce, 0x00, 0xb8, 0x26, 0x6f, 0xe2, 0xff, 0x0f, 0x86, 0xe1,
-	0xc0, 0x15, 0x17, 0xe5, 0xc5, 0x9d, 0x2f, 0xe2, 0xbb, 0x79, 0x98, 0x7c, 0x84, 0x67, 0xf7, 0x25,
-	0x0d, 0xcd, 0x24, 0xbb, 0x96, 0x57, 0xbd, 0x40, 0x33, 0x95, 0xaa, 0x5e, 0x22, 0x4e, 0x91, 0x18,
-	0x2f, 0x8d, 0xd4, 0x29, 0x93, 0x08, 0x20, 0xcf, 0xb8, 0x8a, 0x3d, 0x6c, 0x5d, 0x31, 0x66, 0x5b,
-	0x97, 0x40, 0xb6, 0xcb, 0x46, 0xb2, 0x5f, 0x1d, 0x5c, 0xc2, 0xe6, 0xde, 0xe0, 0x67, 0x08, 0xcf,
-	0xbc, 0x03, 0xb0, 0x2b, 0x44, 0x4c, 0x8e, 0xf0, 0xdc, 0x68, 0x98, 0xa6, 0x42, 0xc4, 0x93, 0x3b,
-	0xa9, 0xd1, 0xd4, 0x36, 0xcc, 0xde, 0xb3, 0x1b, 0xb8, 0xde, 0x19, 0x8f, 0xec, 0xa5, 0xc0, 0x23,
-	0x3b, 0xa6, 0x68, 0x4c, 0xaa, 0x78, 0x5a, 0x33, 0x1d, 0x83, 0x9d, 0xee, 0xbe, 0x5d, 0x90, 0x15,
-	0x7c, 0x2b, 0x02, 0x15, 0x4a, 0x96, 0x8e, 0x0e, 0xc9, 0x1f, 0x0f, 0x91, 0x7b, 0xf8, 0xa6, 0x84,
-	0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0xe3, 0xd3, 0x1f, 0x05, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0x07, 0x41,
-	0x39, 0xb7, 0xb9, 0x7c, 0xa9, 0xcd, 0xdc, 0xe3, 0x43, 0xe7, 0x71, 0xf5, 0x15, 0x3c, 0x5a, 0x83,
-	0x0e, 0x7a, 0xf3, 0xad, 0xcf, 0x8e, 0x9b, 0x25, 0x53, 0xe9, 0x3f, 0x8e, 0x9b, 0xa5, 0x1f, 0x4f,
-	0xd6, 0xea, 0x8e, 0xa3, 0x27, 0x06, 0x63, 0x14, 0x5c, 0x03, 0xd7, 0xde, 0x0f, 0x08, 0x2f, 0x6e,
-	0x43, 0x0c, 0xbd, 0xfc, 0xa8, 0x34, 0x95, 0x9a, 0xf1, 0xde, 0x7b, 0x7c, 0x3f, 0x1f, 0x5e, 0xa9,
-	0x84, 0x01, 0x13, 0xe6, 0x5a, 0x18, 0x6f, 0xdb, 0xb9, 0x61, 0xd8, 0x75, 0xad, 0x8f, 0xa7, 0x95,
-	0xa6, 0x07, 0x70, 0x2d, 0x2d, 0x6b, 0xa1, 0xc8, 0x03, 0x5c, 0xe9, 0x03, 0xeb, 0xf5, 0x6d, 0x09,
-	0xcb, 0xed, 0x85, 0x3f, 0x4f, 0x9b, 0xf3, 0xa1, 0x04, 0x33, 0x56, 0x79, 0x60, 0x53, 0xbe, 0xdb,
-	0xe2, 0xfd, 0x8c, 0xf0, 0xb2, 0xf3, 0xc0, 0x04, 0x2f, 0xdc, 0xb8, 0x9b, 0x66, 0x07, 0xbf, 0x36,
-	0xea, 0x70, 0x73, 0xd5, 0x80, 0x52, 0xee, 0xca, 0xae, 0xbd, 0x3c, 0x59, 0xab, 0x3a, 0xf2, 0x2d,
-	0x9b, 0xd9, 0xd3, 0xd2, 0x0c, 0x90, 0xd1, 0x2b, 0xeb, 0xe2, 0x84, 0xe1, 0x4a, 0x71, 0x09, 0x4f,
-	0xa8, 0x41, 0x1d, 0xc1, 0xe6, 0xac, 0x3b, 0x3f, 0x64, 0x9c, 0xbd, 0xfe, 0xef, 0x3d, 0xfa, 0x21,
-	0xd3, 0xfd, 0x6d, 0x48, 0x85, 0x62, 0x7a, 0x42, 0xed, 0xba, 0x34, 0xd6, 0xae, 0x26, 0xe5, 0x56,
-	0xa4, 0x86, 0x67, 0x22, 0x4b, 0x5c, 0x9b, 0xce, 0x13, 0xc3, 0xe5, 0xe6, 0xfd, 0xa1, 0xf6, 0xab,
-	0xfb, 0xae, 0xfd, 0xe4, 0xbb, 0xb3, 0x06, 0x7a, 0x7e, 0xd6, 0x40, 0x2f, 0xce, 0x1a, 0xe8, 0xf7,
-	0xb3, 0x06, 0xfa, 0xf2, 0xbc, 0x51, 0x7a, 0x71, 0xde, 0x28, 0xfd, 0x72, 0xde, 0x28, 0x7d, 0xbc,
-	0x71, 0x65, 0xd9, 0x8e, 0x2e, 0x7e, 0x23, 0xe6, 0x55, 0xec, 0x56, 0xf2, 0xef, 0xb4, 0xc7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x8d, 0xa6, 0x48, 0x47, 0x0a, 0x00, 0x00,
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x8e, 0x93, 0xbc, 0xb4, 0x09, 0x4c, 0x9c, 0xc4, 0x71, 0x8b, 0x1d, 0x2c, 0x51,
+	0x02, 0x55, 0x9c, 0xa6, 0x05, 0x0e, 0x11, 0x97, 0xc4, 0x49, 0x69, 0x25, 0xa4, 0x46, 0x1b, 0x04,
+	0x88, 0xcb, 0x6a, 0xbc, 0x33, 0xb1, 0x47, 0xd9, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xf4, 0x5c, 0x09,
+	0x01, 0x27, 0x24, 0x2e, 0x88, 0x03, 0xca, 0x11, 0x71, 0xce, 0x1f, 0xe0, 0x56, 0x71, 0x2a, 0xbd,
+	0x80, 0x38, 0x84, 0x2a, 0xb9, 0x20, 0xce, 0xfc, 0x00, 0x34, 0xbb, 0xe3, 0xb5, 0x43, 0x43, 0xdb,
+	0x43, 0xac, 0x9e, 0xec, 0x79, 0x6f, 0xe6, 0x7d, 0xdf, 0x7b, 0xfb, 0xde, 0x37, 0xbb, 0xd0, 0xf0,
+	0x85, 0x0a, 0x85, 0x5a, 0x21, 0x4c, 0x69, 0xc9, 0x5a, 0xb1, 0x66, 0x82, 0xaf, 0x74, 0x57, 0x5b,
+	0x54, 0xe3, 0xd5, 0x33, 0xc6, 0x46, 0x24, 0x85, 0x16, 0xe8, 0x4a, 0xba, 0xbf, 0x71, 0xc6, 0x65,
+	0xf7, 0x57, 0x4a, 0x6d, 0xd1, 0x16, 0xc9, 0xbe, 0x15, 0xf3, 0x2f, 0x3d, 0x52, 0xa9, 0x5a, 0x88,
+	0x16, 0x56, 0x34, 0x0b, 0xed, 0x0b, 0x66, 0x43, 0x56, 0x16, 0x52, 0xbf, 0x97, 0x1e, 0xb4, 0xf1,
+	0x93, 0x45, 0xfd, 0x9f, 0x11, 0x28, 0x6e, 0x63, 0x89, 0x43, 0x85, 0x30, 0x5c, 0xf6, 0x45, 0x18,
+	0xc6, 0x9c, 0xe9, 0xfb, 0x9e, 0xc6, 0x07, 0x65, 0x67, 0xd1, 0x59, 0x9a, 0xd8, 0x78, 0xff, 0xe1,
+	0x71, 0x2d, 0xf7, 0xc7, 0x71, 0xed, 0x5a, 0x9b, 0xe9, 0x4e, 0xdc, 0x6a, 0xf8, 0x22, 0xb4, 0x21,
+	0xec, 0xcf, 0xb2, 0x22, 0x7b, 0x2b, 0xfa, 0x7e, 0x44, 0x55, 0x63, 0x93, 0xfa, 0x8f, 0x8f, 0x96,
+	0xc1, 0x22, 0x6c, 0x52, 0xdf, 0xbd, 0x94, 0x85, 0xfc, 0x08, 0x1f, 0x20, 0x0e, 0x25, 0xc3, 0xd1,
+	0x10, 0x89, 0x84, 0xa2, 0xd2, 0x93, 0x74, 0x1f, 0x4b, 0x52, 0xce, 0x5f, 0x00, 0x12, 0x32, 0x91,
+	0xb7, 0x6d, 0x60, 0x37, 0x89, 0x8b, 0x22, 0x98, 0x6d, 0x09, 0x1e, 0xab, 0xa7, 0x00, 0x47, 0x2e,
+	0x00, 0x70, 0x26, 0x09, 0xfd, 0x1f, 0xc4, 0x9b, 0x30, 0xbb, 0xcf, 0x74, 0x87, 0x48, 0xbc, 0xef,
+	0x61, 0x42, 0xa4, 0x47, 0x39, 0x6e, 0x05, 0x94, 0x94, 0x0b, 0x8b, 0xce, 0xd2, 0xb8, 0x3b, 0xd3,
+	0x73, 0xae, 0x13, 0x22, 0xb7, 0x52, 0x17, 0x7a, 0x07, 0xe6, 0x70, 0xac, 0x85, 0xe7, 0x8b, 0x30,
+	0x12, 0x31, 0x27, 0x1e, 0xe3, 0x9a, 0xca, 0x2e, 0x0e, 0xca, 0xa3, 0x8b, 0xce, 0x52, 0xc1, 0x2d,
+	0x19, 0x6f, 0xd3, 0x3a, 0xef, 0x5a, 0x1f, 0x7a, 0x17, 0xe6, 0xcf, 0x9e, 0x6a, 0x63, 0xe5, 0x05,
+	0x2c, 0x64, 0xba, 0x5c, 0x7c, 0xfa, 0xd8, 0x07, 0x58, 0x7d, 0x68, 0x7c, 0x6b, 0x85, 0xef, 0x0e,
+	0x6b, 0xb9, 0xfa, 0xaf, 0x0e, 0x54, 0x3e, 0xc6, 0x01, 0x23, 0x58, 0x0b, 0x79, 0x87, 0x29, 0x2d,
+	0x24, 0xf3, 0x71, 0x90, 0x26, 0xa1, 0xd0, 0x57, 0x0e, 0xcc, 0xfb, 0x71, 0x18, 0x07, 0x58, 0xb3,
+	0x2e, 0xb5, 0x45, 0xf3, 0x24, 0xd6, 0x4c, 0x94, 0x9d, 0xc5, 0x91, 0xa5, 0xc9, 0x9b, 0x57, 0x6d,
+	0x5b, 0x37, 0x4c, 0xd5, 0x7b, 0xed, 0x69, 0xca, 0xd2, 0x14, 0x8c, 0x6f, 0xdc, 0x32, 0x85, 0xfd,
+	0xe9, 0xcf, 0xda, 0xf5, 0x17, 0x2b, 0xac, 0x39, 0xa3, 0xdc, 0xd9, 0x3e, 0x62, 0xca, 0xc3, 0x35,
+	0x78, 0xe8, 0x4d, 0x98, 0x96, 0x74, 0x97, 0x4a, 0xca, 0x7d, 0xea, 0xf9, 0x22, 0xe6, 0x3a, 0x69,
+	0x97, 0xcb, 0xee, 0x54, 0x66, 0x6e, 0x1a, 0x6b, 0xfd, 0x07, 0x07, 0xe6, 0xb3, 0x9c, 0x9a, 0xb1,
+	0x94, 0x94, 0xeb, 0x5e, 0x42, 0x7b, 0x30, 0x96, 0x26, 0xa1, 0x86, 0xc7, 0xbf, 0x87, 0x80, 0xe6,
+	0xa0, 0x18, 0x51, 0xc9, 0x44, 0xda, 0xd7, 0x05, 0xd7, 0xae, 0xea, 0xdf, 0x3a, 0x50, 0xcd, 0x08,
+	0xae, 0xfb, 0x36, 0x5d, 0x4a, 0x9a, 0x22, 0x0c, 0x99, 0x52, 0x4c, 0x70, 0xf4, 0x39, 0x80, 0x9f,
+	0xad, 0x86, 0x47, 0x75, 0x00, 0xa4, 0xfe, 0xb5, 0x03, 0x57, 0x32, 0x56, 0xf7, 0x62, 0xad, 0x34,
+	0xe6, 0x84, 0xf1, 0xf6, 0xcb, 0x28, 0x5d, 0xfd, 0x7b, 0x07, 0x66, 0x32, 0x32, 0x3b, 0x01, 0x56,
+	0x9d, 0xad, 0x2e, 0xe5, 0x1a, 0xbd, 0x05, 0xaf, 0x74, 0x7b, 0x66, 0xcf, 0x16, 0xd7, 0x49, 0x8a,
+	0x3b, 0x9d, 0xd9, 0xb7, 0x13, 0x33, 0xfa, 0x14, 0xc6, 0x77, 0x25, 0xf6, 0x8d, 0x6c, 0x5e, 0x88,
+	0xae, 0x64, 0xd1, 0x4c, 0xa5, 0x4a, 0xe7, 0x90, 0x53, 0x28, 0x80, 0xb9, 0x3e, 0x3b, 0x65, 0x1c,
+	0x1e, 0x4d, 0x3c, 0xb6, 0x62, 0x37, 0x1a, 0xcf, 0xd0, 0xf4, 0xc6, 0x39, 0x21, 0x37, 0x0a, 0x86,
+	0xb2, 0x5b, 0xea, 0x9e, 0x83, 0x66, 0x27, 0xf8, 0x81, 0x03, 0x63, 0xb7, 0x29, 0xdd, 0x16, 0x22,
+	0x40, 0x07, 0x30, 0xd5, 0x57, 0xee, 0x48, 0x88, 0x60, 0x78, 0x4f, 0xaa, 0x7f, 0x45, 0x18, 0xe4,
+	0xfa, 0x83, 0x3c, 0x54, 0x9a, 0x83, 0x96, 0x9d, 0x88, 0x72, 0x92, 0x6a, 0x22, 0x0e, 0x50, 0x09,
+	0x46, 0x35, 0xd3, 0x01, 0x4d, 0xaf, 0x12, 0x37, 0x5d, 0xa0, 0x45, 0x98, 0x24, 0x54, 0xf9, 0x92,
+	0x45, 0xfd, 0x87, 0xe4, 0x0e, 0x9a, 0xd0, 0x55, 0x98, 0x90, 0xd4, 0x67, 0x11, 0xa3, 0x5c, 0xa7,
+	0x5a, 0xed, 0xf6, 0x0d, 0xc8, 0x87, 0x22, 0x0e, 0x13, 0x21, 0x28, 0x24, 0x69, 0x2e, 0x9c, 0x9b,
+	0x66, 0x92, 0xe3, 0x0d, 0x9b, 0xe3, 0xd2, 0x0b, 0xe4, 0x98, 0x26, 0x68, 0x43, 0xaf, 0xbd, 0xfd,
+	0xe5, 0x61, 0x2d, 0x67, 0x2a, 0xfd, 0xd7, 0x61, 0x2d, 0xf7, 0xcb, 0xd1, 0x72, 0xc5, 0x62, 0xb4,
+	0x45, 0x77, 0x00, 0x82, 0x6b, 0xca, 0x75, 0xfd, 0x67, 0x07, 0x66, 0x37, 0x69, 0x40, 0xdb, 0xc9,
+	0xa3, 0xd2, 0x58, 0x6a, 0xc6, 0xdb, 0x77, 0xf9, 0x6e, 0x22, 0x5e, 0x91, 0xa4, 0x5d, 0x26, 0xcc,
+	0x1d, 0x34, 0xd8, 0xb6, 0x53, 0x3d, 0xb3, 0xed, 0x5a, 0x17, 0x46, 0x95, 0xc6, 0x7b, 0xf4, 0x42,
+	0x5a, 0x36, 0x0d, 0x85, 0xae, 0x43, 0xb1, 0x43, 0x59, 0xbb, 0x93, 0x96, 0xb0, 0xb0, 0x31, 0xf3,
+	0xf7, 0x71, 0x6d, 0xda, 0x97, 0xd4, 0xc8, 0x2a, 0xf7, 0x52, 0x97, 0x6b, 0xb7, 0xd4, 0xbf, 0x70,
+	0x60, 0x3e, 0xcb, 0x61, 0xdd, 0xf7, 0x65, 0x4c, 0xc9, 0x4b, 0x91, 0x80, 0xdf, 0x1c, 0x58, 0xb0,
+	0x44, 0x98, 0xe0, 0x19, 0x25, 0x7b, 0xbf, 0x6e, 0xc1, 0xab, 0xfd, 0x51, 0x33, 0x17, 0x2c, 0x55,
+	0xca, 0xbe, 0xa8, 0x94, 0x1f, 0x1f, 0x2d, 0x97, 0x2c, 0xaf, 0xf5, 0xd4, 0xb3, 0xa3, 0xa5, 0x51,
+	0xb2, 0xbe, 0x76, 0x58, 0x3b, 0x62, 0x50, 0xcc, 0x5e, 0x3d, 0x86, 0x94, 0x90, 0x05, 0x58, 0x1b,
+	0xb7, 0x8d, 0xe4, 0x98, 0xcc, 0xde, 0xf8, 0xff, 0x61, 0xf9, 0x84, 0xe9, 0xce, 0x26, 0x8d, 0x84,
+	0x62, 0x7a, 0x48, 0x73, 0x33, 0x37, 0x30, 0x37, 0xc6, 0x65, 0x57, 0xa8, 0x0c, 0x63, 0x24, 0x05,
+	0x4e, 0x5e, 0x38, 0x26, 0xdc, 0xde, 0x72, 0xed, 0x5a, 0x8f, 0xfb, 0x73, 0x06, 0xe0, 0x49, 0x1e,
+	0xa6, 0xcc, 0x7f, 0xc6, 0x63, 0x11, 0xab, 0xdb, 0x31, 0x27, 0x68, 0x0a, 0xf2, 0xac, 0xd7, 0xec,
+	0x79, 0x46, 0xd0, 0x7b, 0x83, 0xd4, 0xf2, 0xcf, 0x79, 0x60, 0xe7, 0x0e, 0xfb, 0xc8, 0xd0, 0x86,
+	0x1d, 0x79, 0x50, 0x88, 0x30, 0x23, 0xc3, 0xd0, 0x93, 0x24, 0x30, 0x7a, 0x1d, 0x2e, 0x29, 0xa3,
+	0x0b, 0x76, 0xea, 0x92, 0x3a, 0x8f, 0xb8, 0x93, 0x89, 0xed, 0x4e, 0x62, 0x42, 0xaf, 0x01, 0x50,
+	0x4e, 0x7a, 0x1b, 0x8a, 0xc9, 0x86, 0x09, 0xca, 0x49, 0xea, 0xde, 0xb8, 0xf7, 0xe3, 0x49, 0xd5,
+	0x79, 0x78, 0x52, 0x75, 0x1e, 0x9d, 0x54, 0x9d, 0x27, 0x27, 0x55, 0xe7, 0x9b, 0xd3, 0x6a, 0xee,
+	0xd1, 0x69, 0x35, 0xf7, 0xfb, 0x69, 0x35, 0xf7, 0xd9, 0xea, 0x33, 0xf9, 0x1c, 0x9c, 0xfd, 0xf8,
+	0x48, 0xe8, 0xb5, 0x8a, 0xc9, 0x07, 0xc0, 0xad, 0x7f, 0x07, 0x00, 0xca, 0xdf, 0xe5, 0x8f, 0xa0,
+	0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -719,6 +887,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundGasLimit != that1.AutoCompoundGasLimit {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -958,6 +1132,35 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegatorAccruedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorAccruedRewards)
+	if !ok {
+		that2, ok := that.(DelegatorAccruedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1026,6 +1229,55 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1046,6 +1298,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasLimit != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1460,6 +1722,43 @@ func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorAccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1562,6 +1861,79 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1588,6 +1960,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundGasLimit != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasLimit))
+	}
 	return n
 }
 
@@ -1745,13 +2123,28 @@ func (m *DelegatorStartingInfo) Size() (n int) {
 	return n
 }
 
-func (m *DelegationDelegatorReward) Size() (n int) {
+func (m *DelegatorAccruedRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
@@ -1793,6 +2186,40 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.EndHeight))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1950,6 +2377,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasLimit", wireType)
+			}
+			m.AutoCompoundGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2918,6 +3383,90 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorAccruedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorAccruedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorAccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3244,6 +3793,213 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,8 +9,22 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+	EventTypeAutoCompoundFailed          = "auto_compound_failed"
+	EventTypeCreateContinuousFund        = "create_continuous_fund"
+	EventTypeCancelContinuousFund        = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout        = "continuous_fund_payout"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyContinuousFund  = "continuous_fund"
+	AttributeKeyError           = "error"
 
 	AttributeValueCategory = ModuleName
 )
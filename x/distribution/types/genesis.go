@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,8 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []AutoCompoundRecord, accrued []DelegatorAccruedRewardsRecord, funds []ContinuousFund,
+	nextFundID uint64,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +25,10 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompounds:                   autoCompounds,
+		DelegatorAccruedRewards:         accrued,
+		ContinuousFunds:                 funds,
+		NextContinuousFundId:            nextFundID,
 	}
 }
 
@@ -37,6 +45,10 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompounds:                   []AutoCompoundRecord{},
+		DelegatorAccruedRewards:         []DelegatorAccruedRewardsRecord{},
+		ContinuousFunds:                 []ContinuousFund{},
+		NextContinuousFundId:            1,
 	}
 }
 
@@ -45,5 +57,13 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if fund.Id >= gs.NextContinuousFundId {
+			return fmt.Errorf("continuous fund id %d must be lower than the next continuous fund id %d", fund.Id, gs.NextContinuousFundId)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
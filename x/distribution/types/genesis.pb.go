@@ -280,6 +280,49 @@ func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
 
+// DelegatorAccruedRewardsRecord is used for import / export via genesis json.
+type DelegatorAccruedRewardsRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// accrued_rewards defines the rewards accrued by the delegation.
+	AccruedRewards DelegatorAccruedRewards `protobuf:"bytes,3,opt,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+}
+
+func (m *DelegatorAccruedRewardsRecord) Reset()         { *m = DelegatorAccruedRewardsRecord{} }
+func (m *DelegatorAccruedRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAccruedRewardsRecord) ProtoMessage()    {}
+func (*DelegatorAccruedRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{6}
+}
+func (m *DelegatorAccruedRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorAccruedRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorAccruedRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorAccruedRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAccruedRewardsRecord.Merge(m, src)
+}
+func (m *DelegatorAccruedRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorAccruedRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAccruedRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorAccruedRewardsRecord proto.InternalMessageInfo
+
 // ValidatorSlashEventRecord is used for import / export via genesis json.
 type ValidatorSlashEventRecord struct {
 	// validator_address is the address of the validator.
@@ -296,7 +339,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,6 +368,47 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundRecord is used for import / export via genesis json.
+type AutoCompoundRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundRecord) Reset()         { *m = AutoCompoundRecord{} }
+func (m *AutoCompoundRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRecord) ProtoMessage()    {}
+func (*AutoCompoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{8}
+}
+func (m *AutoCompoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRecord.Merge(m, src)
+}
+func (m *AutoCompoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -347,13 +431,24 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compounds defines the delegations with auto-compounding enabled at
+	// genesis.
+	AutoCompounds []AutoCompoundRecord `protobuf:"bytes,11,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+	// delegator_accrued_rewards defines the rewards accrued by delegations at
+	// genesis.
+	DelegatorAccruedRewards []DelegatorAccruedRewardsRecord `protobuf:"bytes,12,rep,name=delegator_accrued_rewards,json=delegatorAccruedRewards,proto3" json:"delegator_accrued_rewards"`
+	// continuous_funds defines the active continuous funds at genesis.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,13,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// next_continuous_fund_id defines the id of the next continuous fund at
+	// genesis.
+	NextContinuousFundId uint64 `protobuf:"varint,14,opt,name=next_continuous_fund_id,json=nextContinuousFundId,proto3" json:"next_continuous_fund_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +484,9 @@ func init() {
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
+	proto.RegisterType((*DelegatorAccruedRewardsRecord)(nil), "cosmos.distribution.v1beta1.DelegatorAccruedRewardsRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundRecord)(nil), "cosmos.distribution.v1beta1.AutoCompoundRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -398,63 +495,73 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x3a, 0x21, 0x6d, 0xc7, 0xf9, 0x62, 0x9a, 0xa6, 0x9b, 0xb4, 0xb5, 0xd3, 0xd2, 0x43,
+	0x51, 0xd5, 0x35, 0x49, 0xcb, 0x87, 0x8a, 0x40, 0x72, 0xdc, 0x14, 0x7a, 0x6a, 0xe4, 0x20, 0x2a,
+	0xa1, 0xa2, 0xd5, 0x78, 0x67, 0x6c, 0x0f, 0xd8, 0x3b, 0xd6, 0xcc, 0xac, 0x13, 0x24, 0x4e, 0x48,
+	0x48, 0x3d, 0x22, 0xc1, 0x91, 0x43, 0x8f, 0x08, 0xc1, 0x8d, 0xdf, 0x80, 0x7a, 0xa3, 0xe2, 0xc4,
+	0x01, 0x01, 0x4a, 0x38, 0xf0, 0x17, 0xb8, 0xa1, 0x9d, 0x9d, 0xdd, 0x9d, 0xad, 0x37, 0x5b, 0xa7,
+	0xa4, 0x52, 0x4f, 0xf6, 0xee, 0xbc, 0x1f, 0xcf, 0xf3, 0xbc, 0x33, 0xef, 0x3b, 0x0b, 0x5e, 0xf5,
+	0x98, 0x18, 0x30, 0x51, 0xc7, 0x54, 0x48, 0x4e, 0xdb, 0x81, 0xa4, 0xcc, 0xaf, 0x8f, 0xd6, 0xdb,
+	0x44, 0xa2, 0xf5, 0x7a, 0x97, 0xf8, 0x44, 0x50, 0xe1, 0x0c, 0x39, 0x93, 0x0c, 0x9e, 0x8b, 0x4c,
+	0x1d, 0xd3, 0xd4, 0xd1, 0xa6, 0xab, 0x4b, 0x5d, 0xd6, 0x65, 0xca, 0xae, 0x1e, 0xfe, 0x8b, 0x5c,
+	0x56, 0xab, 0x3a, 0x7a, 0x1b, 0x09, 0x92, 0x44, 0xf5, 0x18, 0xf5, 0xf5, 0xba, 0x53, 0x94, 0x3d,
+	0x93, 0x27, 0xb2, 0x5f, 0x89, 0xec, 0xdd, 0x28, 0x91, 0xc6, 0xa3, 0x1e, 0x2e, 0xfd, 0x68, 0x81,
+	0x33, 0xb7, 0x48, 0x9f, 0x74, 0x91, 0x64, 0xfc, 0x1e, 0x95, 0x3d, 0xcc, 0xd1, 0xee, 0x1d, 0xbf,
+	0xc3, 0xe0, 0x16, 0x78, 0x19, 0xc7, 0x0b, 0x2e, 0xc2, 0x98, 0x13, 0x21, 0x6c, 0x6b, 0xcd, 0xba,
+	0x72, 0x6a, 0xd3, 0xfe, 0xf5, 0xa7, 0x6b, 0x4b, 0x3a, 0x4c, 0x23, 0x5a, 0xd9, 0x91, 0x9c, 0xfa,
+	0xdd, 0xd6, 0x62, 0xe2, 0xa2, 0xdf, 0xc3, 0x26, 0x58, 0xdc, 0xd5, 0x61, 0x93, 0x28, 0xe5, 0xa7,
+	0x44, 0x59, 0x88, 0x3d, 0xf4, 0xeb, 0x9b, 0x27, 0x1f, 0x3c, 0xac, 0x95, 0xfe, 0x79, 0x58, 0x2b,
+	0x5d, 0xfa, 0xd7, 0x02, 0x17, 0x3f, 0x44, 0x7d, 0x8a, 0xc3, 0x1c, 0x77, 0x03, 0x29, 0x24, 0xf2,
+	0x71, 0xe8, 0x43, 0x76, 0x11, 0xc7, 0xa2, 0x45, 0x3c, 0xc6, 0x71, 0x88, 0x7d, 0x14, 0x1b, 0x4d,
+	0x8e, 0x3d, 0x71, 0x89, 0xb1, 0x7f, 0x61, 0x81, 0xd3, 0x2c, 0xcd, 0xe1, 0xf2, 0x28, 0x89, 0x5d,
+	0x5e, 0x9b, 0xba, 0x52, 0xd9, 0x38, 0xaf, 0xcb, 0xe0, 0x84, 0x65, 0x8a, 0x2b, 0xea, 0xdc, 0x22,
+	0x5e, 0x93, 0x51, 0x7f, 0xf3, 0xfa, 0xa3, 0x3f, 0x6a, 0xa5, 0xef, 0xff, 0xac, 0x5d, 0xed, 0x52,
+	0xd9, 0x0b, 0xda, 0x8e, 0xc7, 0x06, 0x5a, 0x79, 0xfd, 0x73, 0x4d, 0xe0, 0x4f, 0xeb, 0xf2, 0xb3,
+	0x21, 0x11, 0xb1, 0x8f, 0x68, 0x41, 0x36, 0xc6, 0xc8, 0xe0, 0xfe, 0xbb, 0x05, 0x2e, 0x27, 0xdc,
+	0x1b, 0x9e, 0x17, 0x0c, 0x82, 0x3e, 0x92, 0x04, 0x37, 0xd9, 0x60, 0x40, 0x85, 0xa0, 0xcc, 0x3f,
+	0x5e, 0xfa, 0x1e, 0xa8, 0xa0, 0x34, 0x8b, 0xaa, 0x5a, 0x65, 0xe3, 0x6d, 0xa7, 0x60, 0x3f, 0x3b,
+	0xc5, 0xf0, 0x36, 0xa7, 0x43, 0x51, 0x5a, 0x66, 0x54, 0x83, 0xde, 0xdf, 0x16, 0x58, 0x4b, 0xfc,
+	0xdf, 0xa7, 0x42, 0x32, 0x4e, 0x3d, 0xd4, 0x7f, 0x2e, 0x95, 0x5d, 0x06, 0x33, 0x43, 0xc2, 0x29,
+	0x8b, 0x58, 0x4d, 0xb7, 0xf4, 0x13, 0xbc, 0x07, 0x4e, 0xc4, 0x45, 0x9e, 0x52, 0x74, 0xdf, 0x9c,
+	0x8c, 0xee, 0x18, 0x5c, 0x4d, 0x35, 0x8e, 0x66, 0xd0, 0xfc, 0xd9, 0x02, 0x17, 0x12, 0xbf, 0x66,
+	0xc0, 0x39, 0xf1, 0xe5, 0x73, 0xe1, 0xf8, 0x41, 0xca, 0x25, 0x2a, 0xdd, 0x8d, 0xc9, 0xb8, 0x64,
+	0x31, 0x1d, 0x4e, 0xe4, 0x9b, 0x32, 0x38, 0x97, 0xb4, 0x8e, 0x1d, 0x89, 0xb8, 0xa4, 0x7e, 0x37,
+	0x6c, 0x1d, 0x29, 0x8d, 0xe3, 0x68, 0x20, 0xb9, 0x6a, 0x94, 0x8f, 0xac, 0xc6, 0xc7, 0x60, 0x4e,
+	0x68, 0x8c, 0x2e, 0xf5, 0x3b, 0x4c, 0xd7, 0x77, 0xa3, 0x50, 0x93, 0x5c, 0x7a, 0x5a, 0x91, 0x59,
+	0x61, 0xbc, 0x33, 0x64, 0xf9, 0xb6, 0x0c, 0x2e, 0x24, 0x7e, 0x0d, 0xcf, 0xe3, 0x01, 0xc1, 0x63,
+	0xf5, 0x7d, 0x81, 0x84, 0xf1, 0xc0, 0x02, 0x8a, 0x50, 0xba, 0xd9, 0xad, 0x7f, 0x63, 0x32, 0x69,
+	0xb2, 0x14, 0xb5, 0x38, 0xf3, 0x28, 0xf3, 0xd6, 0x90, 0xe7, 0x41, 0x19, 0xac, 0x24, 0x5b, 0x6d,
+	0xa7, 0x8f, 0x44, 0x6f, 0x6b, 0xa4, 0x76, 0xdb, 0x31, 0x1f, 0xef, 0x1e, 0xa1, 0xdd, 0x9e, 0x8c,
+	0x8f, 0x77, 0xf4, 0x64, 0x1c, 0xfb, 0xa9, 0xcc, 0xb1, 0xff, 0x04, 0x9c, 0x49, 0xd3, 0x8a, 0x10,
+	0x94, 0x4b, 0x42, 0x54, 0xf6, 0xb4, 0x52, 0xe2, 0xb5, 0xc9, 0x0e, 0x4e, 0xca, 0x46, 0xab, 0x70,
+	0x7a, 0x34, 0xbe, 0x64, 0x48, 0xf1, 0x83, 0x05, 0x60, 0x23, 0x90, 0xac, 0xc9, 0x06, 0x43, 0x16,
+	0xf8, 0xf8, 0x45, 0xdc, 0x1e, 0x06, 0xdc, 0x5f, 0x2a, 0x60, 0xf6, 0xbd, 0xe8, 0x6a, 0xb3, 0x23,
+	0x91, 0x24, 0xb0, 0x01, 0x66, 0x86, 0x88, 0xa3, 0x41, 0x84, 0xae, 0xb2, 0xf1, 0x4a, 0xa1, 0x4c,
+	0xdb, 0xca, 0x54, 0x2b, 0xa3, 0x1d, 0xe1, 0x16, 0x38, 0xd9, 0x21, 0xc4, 0x1d, 0x32, 0xd6, 0xd7,
+	0x4d, 0xea, 0x72, 0x61, 0x90, 0xdb, 0x84, 0x6c, 0x33, 0xd6, 0x8f, 0x9b, 0x52, 0x27, 0x7a, 0x84,
+	0x1c, 0xd8, 0xa9, 0x64, 0xc9, 0x75, 0x23, 0x3c, 0xe6, 0xe1, 0x66, 0x9e, 0x9a, 0xfc, 0x9c, 0x9b,
+	0x37, 0x20, 0x9d, 0x64, 0x19, 0xe7, 0x2d, 0x2a, 0x7d, 0x87, 0x9c, 0x8c, 0x28, 0x0b, 0xd4, 0xc5,
+	0x6a, 0xc8, 0x04, 0xe1, 0xf6, 0xf4, 0xd3, 0xf4, 0x8d, 0x5d, 0xb6, 0xb5, 0x07, 0x0c, 0xf2, 0xaf,
+	0x18, 0x2f, 0x29, 0xd4, 0xef, 0x4e, 0xb6, 0xf1, 0x0e, 0xbb, 0x07, 0x69, 0x06, 0x39, 0xb7, 0x0a,
+	0xf8, 0xb5, 0x05, 0x2e, 0x1a, 0xdb, 0x23, 0x1d, 0xc8, 0xae, 0x97, 0x8c, 0x6b, 0x61, 0xcf, 0x28,
+	0x14, 0x8d, 0xff, 0x31, 0xf2, 0x33, 0x40, 0x6a, 0xa3, 0x42, 0x5b, 0x01, 0xbf, 0xb4, 0xc0, 0xf9,
+	0x14, 0x55, 0x2f, 0x19, 0xaa, 0x89, 0x2c, 0x27, 0x14, 0xa0, 0x77, 0x9e, 0x71, 0x28, 0x67, 0xc0,
+	0xac, 0x8e, 0x0e, 0xb5, 0x83, 0x9f, 0x83, 0x95, 0x14, 0x86, 0x17, 0xcd, 0xc3, 0x04, 0xc3, 0x49,
+	0x85, 0xe1, 0xe6, 0xb3, 0x0c, 0xd3, 0x0c, 0x80, 0xb3, 0xa3, 0x7c, 0x23, 0xb8, 0x67, 0xee, 0xe6,
+	0xcc, 0xd0, 0x12, 0xf6, 0x29, 0x95, 0xfc, 0xad, 0xa3, 0x4f, 0xad, 0x4c, 0xea, 0x65, 0x9c, 0x67,
+	0x22, 0x20, 0x07, 0xcb, 0xb9, 0x7d, 0x50, 0xd8, 0x40, 0xe5, 0x7d, 0xe3, 0xa8, 0x8d, 0x30, 0x93,
+	0x75, 0x29, 0xa7, 0x1d, 0x0a, 0x78, 0x1f, 0xcc, 0xa3, 0x40, 0x32, 0xd7, 0xd3, 0x5d, 0x50, 0xd8,
+	0x15, 0x95, 0xab, 0x5e, 0x98, 0x6b, 0xbc, 0x6f, 0xea, 0x24, 0x73, 0xc8, 0x58, 0x51, 0x95, 0x34,
+	0x9a, 0xe9, 0x13, 0x73, 0x6e, 0x76, 0x82, 0x4a, 0x16, 0x8e, 0xf2, 0xb8, 0x92, 0x38, 0xdf, 0x08,
+	0xde, 0x07, 0x8b, 0x1e, 0xf3, 0x25, 0xf5, 0x83, 0xb0, 0x4b, 0x74, 0x14, 0xbb, 0x39, 0x95, 0xf4,
+	0x6a, 0x61, 0xd2, 0x66, 0xe2, 0x74, 0x3b, 0xf0, 0xe3, 0x2c, 0x0b, 0x5e, 0xe6, 0xad, 0x80, 0xaf,
+	0x83, 0xb3, 0x3e, 0xd9, 0x93, 0xee, 0x13, 0x29, 0x5c, 0x8a, 0xed, 0x79, 0x35, 0xde, 0x96, 0xc2,
+	0xe5, 0x6c, 0xac, 0x3b, 0xc6, 0x8d, 0x7b, 0xf3, 0xee, 0x77, 0xfb, 0x55, 0xeb, 0xd1, 0x7e, 0xd5,
+	0x7a, 0xbc, 0x5f, 0xb5, 0xfe, 0xda, 0xaf, 0x5a, 0x5f, 0x1d, 0x54, 0x4b, 0x8f, 0x0f, 0xaa, 0xa5,
+	0xdf, 0x0e, 0xaa, 0xa5, 0x8f, 0xd6, 0x0b, 0xbf, 0x5c, 0xf6, 0xb2, 0x5f, 0x9f, 0xea, 0x43, 0xa6,
+	0x3d, 0xa3, 0x3e, 0x2a, 0xaf, 0xff, 0x37, 0x00, 0x0e, 0xa1, 0x2e, 0x74, 0x1f, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -710,6 +817,53 @@ func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorAccruedRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAccruedRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAccruedRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AccruedRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSlashEventRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -760,6 +914,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +971,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextContinuousFundId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextContinuousFundId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DelegatorAccruedRewards) > 0 {
+		for iNdEx := len(m.DelegatorAccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorAccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1022,6 +1260,25 @@ func (m *DelegatorStartingInfoRecord) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorAccruedRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AccruedRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *ValidatorSlashEventRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1043,6 +1300,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1373,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorAccruedRewards) > 0 {
+		for _, e := range m.DelegatorAccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextContinuousFundId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextContinuousFundId))
+	}
 	return n
 }
 
@@ -1849,7 +2144,7 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
+func (m *DelegatorAccruedRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1872,15 +2167,15 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorAccruedRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorAccruedRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1908,13 +2203,13 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1924,33 +2219,27 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1977,7 +2266,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccruedRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2002,6 +2291,273 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2367,6 +2923,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompoundRecord{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAccruedRewards = append(m.DelegatorAccruedRewards, DelegatorAccruedRewardsRecord{})
+			if err := m.DelegatorAccruedRewards[len(m.DelegatorAccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextContinuousFundId", wireType)
+			}
+			m.NextContinuousFundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextContinuousFundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
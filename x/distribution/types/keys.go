@@ -44,6 +44,16 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{0x01}
+//
+// - 0x0B: AutoCompoundCursor
+//
+// - 0x0C<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: DelegatorAccruedRewards
+//
+// - 0x0D<id_Bytes>: ContinuousFund
+//
+// - 0x0E: NextContinuousFundID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -57,6 +67,14 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundPrefix    = []byte{0x0A} // key for the delegations with auto-compounding enabled
+	AutoCompoundCursorKey = []byte{0x0B} // key for the next auto-compounding entry to process
+
+	DelegatorAccruedRewardsPrefix = []byte{0x0C} // key for delegator accrued rewards
+
+	ContinuousFundPrefix    = []byte{0x0D} // key for continuous funds
+	NextContinuousFundIDKey = []byte{0x0E} // key for the id of the next continuous fund
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -156,6 +174,38 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetAutoCompoundAddresses creates the addresses from an auto-compounding key.
+func GetAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetDelegatorAccruedRewardsAddresses creates the addresses from a delegator accrued rewards key.
+func GetDelegatorAccruedRewardsAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0C<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -217,3 +267,23 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundKey creates the key marking the auto-compounding of a delegation.
+func GetAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(append(AutoCompoundPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetDelegatorAccruedRewardsPrefix creates the prefix key for the accrued rewards of a delegator.
+func GetDelegatorAccruedRewardsPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAccruedRewardsPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetDelegatorAccruedRewardsKey creates the key for the accrued rewards of a delegation.
+func GetDelegatorAccruedRewardsKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAccruedRewardsPrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetContinuousFundKey creates the key for a continuous fund.
+func GetContinuousFundKey(id uint64) []byte {
+	return append(ContinuousFundPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -7,15 +7,19 @@ import (
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
-	TypeMsgUpdateParams                = "update_params"
+	TypeMsgSetWithdrawAddress                = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward           = "withdraw_delegator_reward"
+	TypeMsgWithdrawValidatorCommission       = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool                 = "fund_community_pool"
+	TypeMsgUpdateParams                      = "update_params"
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgSetAutoCompound                   = "set_auto_compound"
+	TypeMsgCreateContinuousFund              = "create_continuous_fund"
+	TypeMsgCancelContinuousFund              = "cancel_continuous_fund"
 )
 
 // Verify interface at compile time
-var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgUpdateParams{}
+var _, _, _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgUpdateParams{}, &MsgWithdrawTokenizeShareRecordReward{}, &MsgSetAutoCompound{}, &MsgCreateContinuousFund{}, &MsgCancelContinuousFund{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -153,6 +157,37 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
 // Route returns the MsgUpdateParams message route.
 func (msg MsgUpdateParams) Route() string { return ModuleName }
 
@@ -177,3 +212,124 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 func (msg MsgUpdateParams) ValidateBasic() error {
 	return msg.Params.ValidateBasic()
 }
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+func (msg MsgSetAutoCompound) Type() string  { return TypeMsgSetAutoCompound }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgCreateContinuousFund returns a new MsgCreateContinuousFund streaming
+// amount to recipient over a number of blocks.
+func NewMsgCreateContinuousFund(authority string, recipient sdk.AccAddress, amount sdk.Coins, blocks uint64) *MsgCreateContinuousFund {
+	return &MsgCreateContinuousFund{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Blocks:    blocks,
+	}
+}
+
+// Route returns the MsgCreateContinuousFund message route.
+func (msg MsgCreateContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCreateContinuousFund message type.
+func (msg MsgCreateContinuousFund) Type() string { return TypeMsgCreateContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateContinuousFund message
+// that the expected signer needs to sign.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCreateContinuousFund message validation.
+func (msg MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.Blocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidContinuousFund, "blocks must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgCancelContinuousFund returns a new MsgCancelContinuousFund.
+func NewMsgCancelContinuousFund(authority string, id uint64) *MsgCancelContinuousFund {
+	return &MsgCancelContinuousFund{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// Route returns the MsgCancelContinuousFund message route.
+func (msg MsgCancelContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCancelContinuousFund message type.
+func (msg MsgCancelContinuousFund) Type() string { return TypeMsgCancelContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelContinuousFund message
+// that the expected signer needs to sign.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCancelContinuousFund message validation.
+func (msg MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
@@ -54,6 +54,25 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawTokenizeShareRecordReward
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawTokenizeShareRecordReward(tc.ownerAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -93,3 +112,29 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCreateContinuousFund
+func TestMsgCreateContinuousFund(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		blocks     uint64
+		expectPass bool
+	}{
+		{delAddr1.String(), delAddr2, amount, 100, true},
+		{"", delAddr2, amount, 100, false},
+		{delAddr1.String(), emptyDelAddr, amount, 100, false},
+		{delAddr1.String(), delAddr2, sdk.Coins{}, 100, false},
+		{delAddr1.String(), delAddr2, amount, 0, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCreateContinuousFund(tc.authority, tc.recipient, tc.amount, tc.blocks)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -8,13 +8,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default auto-compounding parameters
+const (
+	DefaultAutoCompoundInterval uint64 = 100
+	DefaultAutoCompoundGasLimit uint64 = 10_000_000
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:  sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:  true,
+		AutoCompoundInterval: DefaultAutoCompoundInterval,
+		AutoCompoundGasLimit: DefaultAutoCompoundGasLimit,
 	}
 }
 
@@ -45,6 +53,9 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if p.AutoCompoundInterval > 0 && p.AutoCompoundGasLimit == 0 {
+		return fmt.Errorf("auto-compound gas limit must be positive when auto-compounding is enabled")
+	}
 
 	return nil
 }
@@ -13,29 +13,35 @@ func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
 
 	type fields struct {
-		CommunityTax        sdk.Dec
-		BaseProposerReward  sdk.Dec
-		BonusProposerReward sdk.Dec
-		WithdrawAddrEnabled bool
+		CommunityTax         sdk.Dec
+		BaseProposerReward   sdk.Dec
+		BonusProposerReward  sdk.Dec
+		WithdrawAddrEnabled  bool
+		AutoCompoundInterval uint64
+		AutoCompoundGasLimit uint64
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false}, true},
-		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false}, true},
-		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false}, true},
-		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 0, 0}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0.5"), toDec("0.4"), false, 0, 0}, true},
+		{"negative base proposer reward", fields{toDec("0.1"), toDec("-0.5"), toDec("0.4"), false, 0, 0}, true},
+		{"negative bonus proposer reward", fields{toDec("0.1"), toDec("0.5"), toDec("-0.4"), false, 0, 0}, true},
+		{"total sum greater than 1", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, 0, 0}, true},
+		{"auto-compounding", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 1000}, false},
+		{"auto-compounding without gas limit", fields{toDec("0.1"), toDec("0.5"), toDec("0.4"), false, 10, 0}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				BaseProposerReward:  tt.fields.BaseProposerReward,
-				BonusProposerReward: tt.fields.BonusProposerReward,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:         tt.fields.CommunityTax,
+				BaseProposerReward:   tt.fields.BaseProposerReward,
+				BonusProposerReward:  tt.fields.BonusProposerReward,
+				WithdrawAddrEnabled:  tt.fields.WithdrawAddrEnabled,
+				AutoCompoundInterval: tt.fields.AutoCompoundInterval,
+				AutoCompoundGasLimit: tt.fields.AutoCompoundGasLimit,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -839,6 +839,201 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryContinuousFundRequest is the request type for the Query/ContinuousFund
+// RPC method.
+type QueryContinuousFundRequest struct {
+	// id defines the id of the continuous fund to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryContinuousFundRequest) Reset()         { *m = QueryContinuousFundRequest{} }
+func (m *QueryContinuousFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundRequest) ProtoMessage()    {}
+func (*QueryContinuousFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryContinuousFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundRequest.Merge(m, src)
+}
+func (m *QueryContinuousFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundRequest proto.InternalMessageInfo
+
+func (m *QueryContinuousFundRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryContinuousFundResponse is the response type for the
+// Query/ContinuousFund RPC method.
+type QueryContinuousFundResponse struct {
+	ContinuousFund ContinuousFund `protobuf:"bytes,1,opt,name=continuous_fund,json=continuousFund,proto3" json:"continuous_fund"`
+}
+
+func (m *QueryContinuousFundResponse) Reset()         { *m = QueryContinuousFundResponse{} }
+func (m *QueryContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundResponse) ProtoMessage()    {}
+func (*QueryContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundResponse.Merge(m, src)
+}
+func (m *QueryContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundResponse proto.InternalMessageInfo
+
+func (m *QueryContinuousFundResponse) GetContinuousFund() ContinuousFund {
+	if m != nil {
+		return m.ContinuousFund
+	}
+	return ContinuousFund{}
+}
+
+// QueryContinuousFundsRequest is the request type for the
+// Query/ContinuousFunds RPC method.
+type QueryContinuousFundsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContinuousFundsRequest) Reset()         { *m = QueryContinuousFundsRequest{} }
+func (m *QueryContinuousFundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundsRequest) ProtoMessage()    {}
+func (*QueryContinuousFundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryContinuousFundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundsRequest.Merge(m, src)
+}
+func (m *QueryContinuousFundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundsRequest proto.InternalMessageInfo
+
+func (m *QueryContinuousFundsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContinuousFundsResponse is the response type for the
+// Query/ContinuousFunds RPC method.
+type QueryContinuousFundsResponse struct {
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,1,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContinuousFundsResponse) Reset()         { *m = QueryContinuousFundsResponse{} }
+func (m *QueryContinuousFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundsResponse) ProtoMessage()    {}
+func (*QueryContinuousFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryContinuousFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundsResponse.Merge(m, src)
+}
+func (m *QueryContinuousFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundsResponse proto.InternalMessageInfo
+
+func (m *QueryContinuousFundsResponse) GetContinuousFunds() []ContinuousFund {
+	if m != nil {
+		return m.ContinuousFunds
+	}
+	return nil
+}
+
+func (m *QueryContinuousFundsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -858,6 +1053,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryContinuousFundRequest)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundRequest")
+	proto.RegisterType((*QueryContinuousFundResponse)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundResponse")
+	proto.RegisterType((*QueryContinuousFundsRequest)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundsRequest")
+	proto.RegisterType((*QueryContinuousFundsResponse)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundsResponse")
 }
 
 func init() {
@@ -865,78 +1064,87 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x84, 0xdc, 0x4d, 0xb0, 0xa3, 0x0d,
-	0x25, 0x11, 0x51, 0xbc, 0x4d, 0x22, 0x15, 0x68, 0x41, 0x90, 0x5f, 0xa5, 0x52, 0xab, 0x36, 0x75,
-	0xab, 0xa6, 0x70, 0xb1, 0xd6, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0xc7, 0x44, 0x55,
-	0x2f, 0x94, 0x4a, 0x5c, 0x90, 0x90, 0xb8, 0xf4, 0x98, 0x33, 0x67, 0x10, 0x12, 0x7f, 0x41, 0x8f,
-	0x15, 0x48, 0xa8, 0x27, 0x40, 0x09, 0x42, 0xbd, 0x70, 0xe6, 0x5a, 0x79, 0x66, 0xd6, 0xde, 0xb5,
-	0xd7, 0x6b, 0x3b, 0xae, 0x4f, 0x75, 0x67, 0xe7, 0x7d, 0xdf, 0xfb, 0xbc, 0x99, 0x37, 0xef, 0x29,
-	0x30, 0x5f, 0xa4, 0xac, 0x42, 0x99, 0x61, 0x39, 0x8c, 0x7b, 0x4e, 0xa1, 0xc6, 0x1d, 0xea, 0x1a,
-	0xbb, 0xcb, 0x05, 0xc2, 0xcd, 0x65, 0xe3, 0x41, 0x8d, 0x78, 0x7b, 0xd9, 0xaa, 0x47, 0x39, 0xc5,
-	0xd3, 0x72, 0x63, 0x36, 0xb8, 0x31, 0xab, 0x36, 0x6a, 0xef, 0x2b, 0x95, 0x82, 0xc9, 0x88, 0xb4,
-	0x6a, 0x6a, 0x54, 0x4d, 0xdb, 0x71, 0x4d, 0xb1, 0x5b, 0x08, 0x69, 0x53, 0x36, 0xb5, 0xa9, 0xf8,
-	0x69, 0x34, 0x7e, 0xa9, 0xd5, 0x19, 0x9b, 0x52, 0xbb, 0x4c, 0x0c, 0xb3, 0xea, 0x18, 0xa6, 0xeb,
-	0x52, 0x2e, 0x4c, 0x98, 0xfa, 0x9a, 0x0e, 0xea, 0xfb, 0xca, 0x45, 0xea, 0xf8, 0x9a, 0xd9, 0x38,
-	0x8a, 0x50, 0xc4, 0x72, 0xff, 0x39, 0xb9, 0x3f, 0x2f, 0xc3, 0x50, 0x64, 0xe2, 0x3f, 0xfa, 0x14,
-	0xe0, 0x5b, 0x0d, 0x80, 0x6d, 0xd3, 0x33, 0x2b, 0x2c, 0x47, 0x1e, 0xd4, 0x08, 0xe3, 0xfa, 0x3d,
-	0x38, 0x1b, 0x5a, 0x65, 0x55, 0xea, 0x32, 0x82, 0xd7, 0x60, 0xbc, 0x2a, 0x56, 0x52, 0x68, 0x16,
-	0x2d, 0x9c, 0x5a, 0x99, 0xcb, 0xc6, 0x64, 0x29, 0x2b, 0x8d, 0xd7, 0xc7, 0x9e, 0xfd, 0x99, 0x49,
-	0xe4, 0x94, 0xa1, 0x5e, 0x85, 0x79, 0xa1, 0x7c, 0xd7, 0x2c, 0x3b, 0x96, 0xc9, 0xa9, 0x77, 0xb3,
-	0xc6, 0x19, 0x37, 0x5d, 0xcb, 0x71, 0xed, 0x1c, 0xa9, 0x9b, 0x9e, 0xe5, 0x07, 0x81, 0xb7, 0xe0,
-	0xcc, 0xae, 0xbf, 0x2b, 0x6f, 0x5a, 0x96, 0x47, 0x98, 0x74, 0x7c, 0x72, 0x3d, 0xf5, 0xdb, 0x4f,
-	0x4b, 0x53, 0xca, 0xf7, 0x9a, 0xfc, 0x72, 0x9b, 0x7b, 0x0d, 0x89, 0xc9, 0xa6, 0x89, 0x5a, 0xd7,
-	0xbf, 0x41, 0xb0, 0xd0, 0xdb, 0xa5, 0x22, 0xbc, 0x07, 0x27, 0x3c, 0xb9, 0xa4, 0x10, 0x3f, 0x8c,
-	0x45, 0x8c, 0x91, 0x54, 0xdc, 0xbe, 0x9c, 0x5e, 0x82, 0x4c, 0x38, 0x8a, 0x0d, 0x5a, 0xa9, 0x38,
-	0x8c, 0x39, 0xd4, 0x7d, 0xcd, 0xc0, 0x4f, 0x10, 0xcc, 0x76, 0x77, 0xa5, 0x40, 0x4d, 0x80, 0x62,
-	0x73, 0x55, 0xb1, 0x5e, 0xee, 0x8f, 0x75, 0xad, 0x58, 0xac, 0x55, 0x6a, 0x65, 0x93, 0x13, 0xab,
-	0x25, 0xac, 0x70, 0x03, 0xa2, 0xfa, 0x93, 0x24, 0xcc, 0x84, 0xe3, 0xb8, 0x5d, 0x36, 0x59, 0x89,
-	0xbc, 0xe6, 0x03, 0xc6, 0xf3, 0x30, 0xc1, 0xb8, 0xe9, 0x71, 0xc7, 0xb5, 0xf3, 0x25, 0xe2, 0xd8,
-	0x25, 0x9e, 0x4a, 0xce, 0xa2, 0x85, 0xb1, 0xdc, 0x5b, 0xfe, 0xf2, 0x55, 0xb1, 0x8a, 0xe7, 0xe0,
-	0x34, 0x11, 0x47, 0xe4, 0x6f, 0x3b, 0x26, 0xb6, 0xbd, 0x29, 0x17, 0xd5, 0xa6, 0x2b, 0x00, 0xad,
-	0x1a, 0x4e, 0x8d, 0x89, 0xc4, 0xbc, 0xe7, 0x27, 0xa6, 0x51, 0x90, 0x59, 0xf9, 0x4c, 0xb4, 0x6e,
-	0xb9, 0x4d, 0x14, 0x50, 0x2e, 0x60, 0x79, 0xe9, 0x8d, 0x6f, 0xf7, 0x33, 0x89, 0xa7, 0xfb, 0x19,
-	0xa4, 0xff, 0x8a, 0xe0, 0x9d, 0x2e, 0x79, 0x50, 0x87, 0xb1, 0x0d, 0x27, 0x98, 0x5c, 0x4a, 0xa1,
-	0xd9, 0x63, 0x0b, 0xa7, 0x56, 0x2e, 0xf4, 0x77, 0x12, 0x42, 0x67, 0x6b, 0x97, 0xb8, 0xdc, 0xbf,
-	0x6d, 0x4a, 0x06, 0x7f, 0x1e, 0xa2, 0x48, 0x0a, 0x8a, 0xf9, 0x9e, 0x14, 0x32, 0x9c, 0x20, 0x86,
-	0xfe, 0x8b, 0x1f, 0xfc, 0x26, 0x29, 0x13, 0x5b, 0xac, 0x75, 0x96, 0xa9, 0x25, 0xbf, 0x0d, 0x72,
-	0x8a, 0x4d, 0x13, 0xff, 0x14, 0x23, 0x2f, 0x43, 0x72, 0xd0, 0xcb, 0x20, 0xd3, 0xfe, 0x72, 0x3f,
-	0x93, 0xd0, 0xbf, 0x43, 0x90, 0xee, 0x16, 0xb9, 0xca, 0xfb, 0xfd, 0x60, 0xb5, 0x37, 0xf2, 0x3e,
-	0x13, 0x4a, 0x91, 0x9f, 0x9c, 0x4d, 0x52, 0xdc, 0xa0, 0x8e, 0xbb, 0xbe, 0xda, 0xc8, 0xf1, 0x8f,
-	0x7f, 0x65, 0x16, 0x6d, 0x87, 0x97, 0x6a, 0x85, 0x6c, 0x91, 0x56, 0xd4, 0x63, 0xaa, 0xfe, 0x59,
-	0x62, 0xd6, 0x7d, 0x83, 0xef, 0x55, 0x09, 0xf3, 0x6d, 0x58, 0xeb, 0x01, 0xa8, 0x81, 0xde, 0x16,
-	0xce, 0x1d, 0xca, 0xcd, 0xf2, 0x48, 0xb2, 0x19, 0x48, 0xc3, 0xbf, 0x08, 0xe6, 0x62, 0xfd, 0xaa,
-	0x5c, 0xdc, 0x6d, 0xcf, 0xc5, 0xc5, 0xd8, 0x3b, 0xd8, 0x52, 0xdb, 0xf4, 0x7d, 0x4b, 0xc5, 0xb6,
-	0x77, 0x0f, 0xdb, 0x70, 0x9c, 0x37, 0xfc, 0xa5, 0x92, 0xa3, 0xca, 0xb0, 0xd4, 0xd7, 0x3d, 0xf5,
-	0xc0, 0x36, 0xe3, 0x69, 0x96, 0xc9, 0xe8, 0x92, 0x7b, 0x5d, 0xbd, 0xb4, 0x91, 0x3e, 0x55, 0x62,
-	0xd3, 0x00, 0xcd, 0x5b, 0x2a, 0x73, 0x7b, 0x32, 0x17, 0x58, 0x09, 0xa8, 0xd5, 0xe1, 0xdd, 0xb0,
-	0xda, 0x8e, 0xc3, 0x4b, 0x96, 0x67, 0xd6, 0x95, 0xe3, 0x91, 0x61, 0xec, 0xc2, 0xf9, 0x1e, 0x8e,
-	0x15, 0xcb, 0x06, 0x4c, 0xd6, 0xd5, 0xa7, 0xbe, 0x1d, 0x4f, 0xd4, 0xc3, 0x62, 0x01, 0xbf, 0xd3,
-	0x70, 0x4e, 0xf8, 0x6d, 0xb4, 0x91, 0x9a, 0xeb, 0xf0, 0xbd, 0x6d, 0x4a, 0xcb, 0xfe, 0x0c, 0xf2,
-	0x18, 0x81, 0x16, 0xf5, 0x55, 0x85, 0x42, 0x60, 0xac, 0x4a, 0x69, 0x79, 0x74, 0x85, 0x2b, 0xe4,
-	0x57, 0x5e, 0x4c, 0xc0, 0x71, 0x11, 0x05, 0x7e, 0x8a, 0x60, 0x5c, 0x8e, 0x34, 0xd8, 0x88, 0x2d,
-	0x8d, 0xce, 0x79, 0x4a, 0xbb, 0xd0, 0xbf, 0x81, 0xc4, 0xd3, 0x17, 0xbf, 0xfe, 0xfd, 0x9f, 0x1f,
-	0x92, 0xe7, 0xf1, 0x9c, 0x11, 0x37, 0xeb, 0xc9, 0xa1, 0x0a, 0x3f, 0x4e, 0xc2, 0x74, 0xcc, 0x28,
-	0x82, 0x37, 0x7b, 0xbb, 0xef, 0x3d, 0x8f, 0x69, 0x5b, 0x43, 0xaa, 0x28, 0xb2, 0x1d, 0x41, 0x76,
-	0x0b, 0xdf, 0x8c, 0x25, 0x6b, 0x15, 0x88, 0xf1, 0xb0, 0xa3, 0x2f, 0x3c, 0x32, 0x68, 0x4b, 0x3f,
-	0xef, 0xbf, 0x34, 0x07, 0x08, 0xce, 0x46, 0x8c, 0x3c, 0xf8, 0xe3, 0x01, 0xe2, 0xee, 0x18, 0xca,
-	0xb4, 0x4f, 0x8e, 0x68, 0xad, 0x68, 0x6f, 0x08, 0xda, 0xab, 0xf8, 0xca, 0x30, 0xb4, 0xad, 0xa1,
-	0x0a, 0xff, 0x81, 0x60, 0xb2, 0x7d, 0x8e, 0xc0, 0x1f, 0x0d, 0x10, 0x63, 0x78, 0x06, 0xd3, 0x2e,
-	0x1d, 0xc5, 0x54, 0xb1, 0x5d, 0x13, 0x6c, 0x5b, 0x78, 0x63, 0x18, 0x36, 0x7f, 0x62, 0xf9, 0x0f,
-	0xc1, 0x99, 0x8e, 0x4e, 0x8d, 0xfb, 0x08, 0xaf, 0xdb, 0x60, 0xa2, 0x5d, 0x3e, 0x92, 0xad, 0x62,
-	0xcb, 0x0b, 0xb6, 0x2f, 0xf0, 0x4e, 0x2c, 0x5b, 0xf3, 0x4d, 0x65, 0xc6, 0xc3, 0x8e, 0x27, 0xf9,
-	0x91, 0xa1, 0x6e, 0x66, 0x14, 0x37, 0x7e, 0x89, 0xe0, 0xed, 0xe8, 0x96, 0x8c, 0x3f, 0x1d, 0x24,
-	0xf0, 0x88, 0x21, 0x42, 0xfb, 0xec, 0xe8, 0x02, 0x03, 0x1d, 0x6d, 0x7f, 0xf8, 0xa2, 0x30, 0x23,
-	0x3a, 0x64, 0x3f, 0x85, 0xd9, 0xbd, 0x99, 0xf7, 0x53, 0x98, 0x31, 0x6d, 0xb9, 0xcf, 0xc2, 0xec,
-	0x41, 0xd8, 0xba, 0xdb, 0xf8, 0x7f, 0x04, 0xa9, 0x6e, 0xfd, 0x13, 0xaf, 0x0d, 0x10, 0x6b, 0x74,
-	0xd3, 0xd7, 0xd6, 0x87, 0x91, 0x50, 0xcc, 0x77, 0x04, 0xf3, 0x0d, 0x7c, 0x7d, 0x18, 0xe6, 0xf6,
-	0x01, 0x00, 0xff, 0x8c, 0xe0, 0x74, 0xa8, 0x47, 0xe3, 0x8b, 0xbd, 0x63, 0x8d, 0x6a, 0xf9, 0xda,
-	0x07, 0x03, 0xdb, 0x29, 0xb0, 0x55, 0x01, 0xb6, 0x84, 0x17, 0x63, 0xc1, 0x8a, 0xbe, 0x6d, 0xbe,
-	0xd1, 0xda, 0xd7, 0xaf, 0x3d, 0x3b, 0x48, 0xa3, 0xe7, 0x07, 0x69, 0xf4, 0xf7, 0x41, 0x1a, 0x7d,
-	0x7f, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x5e, 0x1c, 0xa6, 0x13, 0x5f, 0x2e, 0xc7, 0xce, 0x09,
-	0x5f, 0x85, 0xd5, 0xc5, 0xd8, 0x50, 0x18, 0x17, 0x7f, 0x4e, 0x59, 0x7d, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0xc9, 0xca, 0xd1, 0x61, 0x12, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0x69, 0x4b, 0x5f, 0x69, 0x92, 0x4e, 0x2b, 0xe4, 0x6e, 0x82, 0x1d, 0x6d, 0x28,
+	0x89, 0x08, 0xf1, 0x36, 0x09, 0x94, 0x36, 0xa5, 0x82, 0xfc, 0x52, 0xa9, 0x55, 0x9b, 0xba, 0x55,
+	0x53, 0x2a, 0x24, 0x6b, 0xe3, 0x5d, 0xd6, 0xab, 0xda, 0x3b, 0xee, 0xee, 0x6c, 0x42, 0x14, 0xe5,
+	0x42, 0xa9, 0xc4, 0x05, 0x09, 0x89, 0x4b, 0x8f, 0x39, 0x73, 0x06, 0x21, 0x21, 0x21, 0x4e, 0x48,
+	0x3d, 0x56, 0x20, 0x21, 0xc4, 0x01, 0x50, 0x82, 0x50, 0x2f, 0x9c, 0xb9, 0x22, 0xcf, 0xcc, 0xae,
+	0x77, 0xed, 0xf5, 0xda, 0xeb, 0x24, 0xa7, 0xba, 0x6f, 0xe7, 0x7d, 0xef, 0xfb, 0xde, 0xcc, 0x9b,
+	0xf9, 0x14, 0x18, 0x2b, 0x11, 0xa7, 0x4a, 0x1c, 0x45, 0x33, 0x1d, 0x6a, 0x9b, 0x6b, 0x2e, 0x35,
+	0x89, 0xa5, 0xac, 0x4f, 0xad, 0xe9, 0x54, 0x9d, 0x52, 0x1e, 0xb9, 0xba, 0xbd, 0x99, 0xaf, 0xd9,
+	0x84, 0x12, 0x3c, 0xc4, 0x17, 0xe6, 0x83, 0x0b, 0xf3, 0x62, 0xa1, 0xf4, 0x86, 0x40, 0x59, 0x53,
+	0x1d, 0x9d, 0x67, 0xf9, 0x18, 0x35, 0xd5, 0x30, 0x2d, 0x95, 0xad, 0x66, 0x40, 0xd2, 0x59, 0x83,
+	0x18, 0x84, 0xfd, 0x54, 0xea, 0xbf, 0x44, 0x74, 0xd8, 0x20, 0xc4, 0xa8, 0xe8, 0x8a, 0x5a, 0x33,
+	0x15, 0xd5, 0xb2, 0x08, 0x65, 0x29, 0x8e, 0xf8, 0x9a, 0x0d, 0xe2, 0x7b, 0xc8, 0x25, 0x62, 0x7a,
+	0x98, 0xf9, 0x38, 0x15, 0x21, 0xc6, 0x7c, 0xfd, 0x39, 0xbe, 0xbe, 0xc8, 0x69, 0x08, 0x65, 0xec,
+	0x3f, 0xf2, 0x59, 0xc0, 0xb7, 0xeb, 0x02, 0x56, 0x54, 0x5b, 0xad, 0x3a, 0x05, 0xfd, 0x91, 0xab,
+	0x3b, 0x54, 0xbe, 0x0f, 0x67, 0x42, 0x51, 0xa7, 0x46, 0x2c, 0x47, 0xc7, 0x73, 0x70, 0xac, 0xc6,
+	0x22, 0x19, 0x34, 0x82, 0xc6, 0x4f, 0x4e, 0x8f, 0xe6, 0x63, 0xba, 0x94, 0xe7, 0xc9, 0xf3, 0x7d,
+	0xcf, 0xfe, 0xc8, 0xa5, 0x0a, 0x22, 0x51, 0xae, 0xc1, 0x18, 0x43, 0xbe, 0xa7, 0x56, 0x4c, 0x4d,
+	0xa5, 0xc4, 0xbe, 0xe5, 0x52, 0x87, 0xaa, 0x96, 0x66, 0x5a, 0x46, 0x41, 0xdf, 0x50, 0x6d, 0xcd,
+	0x23, 0x81, 0x97, 0xe0, 0xf4, 0xba, 0xb7, 0xaa, 0xa8, 0x6a, 0x9a, 0xad, 0x3b, 0xbc, 0xf0, 0x89,
+	0xf9, 0xcc, 0xcf, 0xdf, 0x4c, 0x9e, 0x15, 0xb5, 0xe7, 0xf8, 0x97, 0x3b, 0xd4, 0xae, 0x43, 0x0c,
+	0xfa, 0x29, 0x22, 0x2e, 0x7f, 0x86, 0x60, 0xbc, 0x73, 0x49, 0xa1, 0xf0, 0x3e, 0x1c, 0xb7, 0x79,
+	0x48, 0x48, 0xbc, 0x14, 0x2b, 0x31, 0x06, 0x52, 0xe8, 0xf6, 0xe0, 0xe4, 0x32, 0xe4, 0xc2, 0x2c,
+	0x16, 0x48, 0xb5, 0x6a, 0x3a, 0x8e, 0x49, 0xac, 0x03, 0x16, 0xfc, 0x04, 0xc1, 0x48, 0xfb, 0x52,
+	0x42, 0xa8, 0x0a, 0x50, 0xf2, 0xa3, 0x42, 0xeb, 0x95, 0xee, 0xb4, 0xce, 0x95, 0x4a, 0x6e, 0xd5,
+	0xad, 0xa8, 0x54, 0xd7, 0x1a, 0xc0, 0x42, 0x6e, 0x00, 0x54, 0x7e, 0x92, 0x86, 0xe1, 0x30, 0x8f,
+	0x3b, 0x15, 0xd5, 0x29, 0xeb, 0x07, 0xbc, 0xc1, 0x78, 0x0c, 0x06, 0x1c, 0xaa, 0xda, 0xd4, 0xb4,
+	0x8c, 0x62, 0x59, 0x37, 0x8d, 0x32, 0xcd, 0xa4, 0x47, 0xd0, 0x78, 0x5f, 0xa1, 0xdf, 0x0b, 0x5f,
+	0x63, 0x51, 0x3c, 0x0a, 0xa7, 0x74, 0x4b, 0x0b, 0x2c, 0x3b, 0xc2, 0x96, 0xbd, 0xcc, 0x83, 0x62,
+	0xd1, 0x32, 0x40, 0x63, 0x86, 0x33, 0x7d, 0xac, 0x31, 0xaf, 0x7b, 0x8d, 0xa9, 0x0f, 0x64, 0x9e,
+	0x5f, 0x13, 0x8d, 0x53, 0x6e, 0xe8, 0x42, 0x50, 0x21, 0x90, 0x39, 0xfb, 0xd2, 0xe7, 0x3b, 0xb9,
+	0xd4, 0xd3, 0x9d, 0x1c, 0x92, 0xbf, 0x47, 0xf0, 0x6a, 0x9b, 0x3e, 0x88, 0xcd, 0x58, 0x81, 0xe3,
+	0x0e, 0x0f, 0x65, 0xd0, 0xc8, 0x91, 0xf1, 0x93, 0xd3, 0x17, 0xba, 0xdb, 0x09, 0x86, 0xb3, 0xb4,
+	0xae, 0x5b, 0xd4, 0x3b, 0x6d, 0x02, 0x06, 0x7f, 0x10, 0x52, 0x91, 0x66, 0x2a, 0xc6, 0x3a, 0xaa,
+	0xe0, 0x74, 0x82, 0x32, 0xe4, 0xef, 0x3c, 0xf2, 0x8b, 0x7a, 0x45, 0x37, 0x58, 0xac, 0x75, 0x4c,
+	0x35, 0xfe, 0x2d, 0xc9, 0x2e, 0xfa, 0x29, 0xde, 0x2e, 0x46, 0x1e, 0x86, 0x74, 0xd2, 0xc3, 0xc0,
+	0xdb, 0xfe, 0x62, 0x27, 0x97, 0x92, 0xbf, 0x40, 0x90, 0x6d, 0xc7, 0x5c, 0xf4, 0xfd, 0x61, 0x70,
+	0xda, 0xeb, 0x7d, 0x1f, 0x0e, 0xb5, 0xc8, 0x6b, 0xce, 0xa2, 0x5e, 0x5a, 0x20, 0xa6, 0x35, 0x3f,
+	0x53, 0xef, 0xf1, 0xd7, 0x7f, 0xe6, 0x26, 0x0c, 0x93, 0x96, 0xdd, 0xb5, 0x7c, 0x89, 0x54, 0xc5,
+	0x65, 0x2a, 0xfe, 0x99, 0x74, 0xb4, 0x87, 0x0a, 0xdd, 0xac, 0xe9, 0x8e, 0x97, 0xe3, 0x34, 0x2e,
+	0x00, 0x17, 0xe4, 0x26, 0x3a, 0x77, 0x09, 0x55, 0x2b, 0x87, 0xd2, 0xcd, 0x40, 0x1b, 0xfe, 0x41,
+	0x30, 0x1a, 0x5b, 0x57, 0xf4, 0xe2, 0x5e, 0x73, 0x2f, 0x2e, 0xc6, 0x9e, 0xc1, 0x06, 0xda, 0xa2,
+	0x57, 0x9b, 0x23, 0x36, 0xdd, 0x7b, 0xd8, 0x80, 0xa3, 0xb4, 0x5e, 0x2f, 0x93, 0x3e, 0xac, 0x0e,
+	0x73, 0x7c, 0xd9, 0x16, 0x17, 0xac, 0xcf, 0xc7, 0x1f, 0x93, 0xc3, 0x6b, 0xee, 0x0d, 0x18, 0x69,
+	0x5f, 0x53, 0x34, 0x36, 0x0b, 0xe0, 0x9f, 0x52, 0xde, 0xdb, 0x13, 0x85, 0x40, 0x24, 0x80, 0xb6,
+	0x01, 0xaf, 0x85, 0xd1, 0x56, 0x4d, 0x5a, 0xd6, 0x6c, 0x75, 0x43, 0x14, 0x3e, 0x34, 0x19, 0xeb,
+	0x70, 0xbe, 0x43, 0x61, 0xa1, 0x65, 0x01, 0x06, 0x37, 0xc4, 0xa7, 0xae, 0x0b, 0x0f, 0x6c, 0x84,
+	0xc1, 0x02, 0x75, 0x87, 0xe0, 0x1c, 0xab, 0x5b, 0x7f, 0x46, 0x5c, 0xcb, 0xa4, 0x9b, 0x2b, 0x84,
+	0x54, 0x3c, 0x0f, 0xf2, 0x18, 0x81, 0x14, 0xf5, 0x55, 0x50, 0xd1, 0xa1, 0xaf, 0x46, 0x48, 0xe5,
+	0xf0, 0x06, 0x97, 0xc1, 0xcb, 0x6f, 0xfa, 0x24, 0x2c, 0x6a, 0x5a, 0x2e, 0x71, 0x9d, 0x65, 0xd7,
+	0xd2, 0xbc, 0x9d, 0xe8, 0x87, 0xb4, 0xa9, 0xb1, 0x0e, 0xf4, 0x15, 0xd2, 0xa6, 0x26, 0x6f, 0xc2,
+	0x50, 0xe4, 0x6a, 0xc1, 0xf9, 0x01, 0x0c, 0x94, 0xfc, 0x2f, 0xc5, 0x8f, 0x5d, 0x4b, 0x13, 0x2f,
+	0xef, 0x44, 0xec, 0xac, 0x85, 0xd1, 0xc4, 0x80, 0xf5, 0x97, 0x42, 0x51, 0x59, 0x8f, 0x2c, 0xed,
+	0x9f, 0x99, 0xf0, 0xb3, 0x86, 0x7a, 0x7d, 0xd6, 0xe4, 0x9f, 0x10, 0x0c, 0x47, 0xd7, 0x11, 0x1a,
+	0x3f, 0x82, 0xc1, 0x26, 0x8d, 0xde, 0x85, 0xd2, 0x83, 0xc8, 0x81, 0xb0, 0xc8, 0x83, 0x7b, 0xd7,
+	0xa6, 0x7f, 0xc7, 0x70, 0x94, 0xe9, 0xc0, 0x4f, 0x11, 0x1c, 0xe3, 0x56, 0x15, 0x2b, 0xb1, 0x0c,
+	0x5b, 0x7d, 0xb2, 0x74, 0xa1, 0xfb, 0x04, 0xce, 0x41, 0x9e, 0xf8, 0xf4, 0x97, 0xbf, 0xbf, 0x4a,
+	0x9f, 0xc7, 0xa3, 0x4a, 0x9c, 0x87, 0xe7, 0x66, 0x19, 0x3f, 0x4e, 0xc3, 0x50, 0x8c, 0xc5, 0xc4,
+	0x8b, 0x9d, 0xcb, 0x77, 0xf6, 0xd9, 0xd2, 0xd2, 0x3e, 0x51, 0x84, 0xb2, 0x55, 0xa6, 0xec, 0x36,
+	0xbe, 0x15, 0xab, 0xac, 0x71, 0xf1, 0x29, 0x5b, 0x2d, 0xef, 0xfd, 0xb6, 0x42, 0x1a, 0xf8, 0x45,
+	0xef, 0x05, 0xd9, 0x45, 0x70, 0x26, 0xc2, 0xca, 0xe2, 0x77, 0x13, 0xf0, 0x6e, 0x31, 0xdb, 0xd2,
+	0xd5, 0x1e, 0xb3, 0x85, 0xda, 0x9b, 0x4c, 0xed, 0x35, 0xbc, 0xbc, 0x1f, 0xb5, 0x0d, 0xb3, 0x8c,
+	0x7f, 0x45, 0x30, 0xd8, 0xec, 0x0f, 0xf1, 0xe5, 0x04, 0x1c, 0xc3, 0xde, 0x5a, 0x9a, 0xed, 0x25,
+	0x55, 0x68, 0xbb, 0xce, 0xb4, 0x2d, 0xe1, 0x85, 0xfd, 0x68, 0xf3, 0x9c, 0xe8, 0xbf, 0x08, 0x4e,
+	0xb7, 0x38, 0x30, 0xdc, 0x05, 0xbd, 0x76, 0x86, 0x53, 0xba, 0xd2, 0x53, 0xae, 0xd0, 0x56, 0x64,
+	0xda, 0x3e, 0xc4, 0xab, 0xb1, 0xda, 0xfc, 0xb7, 0xd2, 0x51, 0xb6, 0x5a, 0x9e, 0xda, 0x6d, 0x45,
+	0x9c, 0xcc, 0x28, 0xdd, 0xf8, 0x05, 0x82, 0x57, 0xa2, 0xad, 0x16, 0x7e, 0x2f, 0x09, 0xf1, 0x08,
+	0x73, 0x28, 0xbd, 0xdf, 0x3b, 0x40, 0xa2, 0xad, 0xed, 0x4e, 0x3e, 0x1b, 0xcc, 0x08, 0xe7, 0xd3,
+	0xcd, 0x60, 0xb6, 0x37, 0x69, 0xd2, 0xd5, 0x1e, 0xb3, 0x13, 0x0d, 0x66, 0x07, 0x85, 0x8d, 0xb3,
+	0x8d, 0xff, 0x43, 0x90, 0x69, 0xe7, 0x8b, 0xf0, 0x5c, 0x02, 0xae, 0xd1, 0x66, 0x4e, 0x9a, 0xdf,
+	0x0f, 0x84, 0xd0, 0x7c, 0x97, 0x69, 0xbe, 0x89, 0x6f, 0xec, 0x47, 0x73, 0xb3, 0xb1, 0xc3, 0xdf,
+	0x22, 0x38, 0x15, 0xf2, 0x5e, 0xf8, 0x62, 0x67, 0xae, 0x51, 0x56, 0x4e, 0x7a, 0x27, 0x71, 0x9e,
+	0x10, 0x36, 0xc3, 0x84, 0x4d, 0xe2, 0x89, 0x58, 0x61, 0x25, 0x2f, 0xb7, 0x58, 0xb7, 0x6c, 0xf8,
+	0x47, 0x04, 0xfd, 0x61, 0x37, 0x81, 0xbb, 0x22, 0x10, 0x61, 0xf0, 0xa4, 0x4b, 0xc9, 0x13, 0x05,
+	0xf5, 0x59, 0x46, 0xfd, 0x2d, 0x3c, 0xdd, 0x81, 0x7a, 0xd8, 0x2a, 0x29, 0x5b, 0xa6, 0xb6, 0x8d,
+	0x7f, 0x40, 0x30, 0xb0, 0xd0, 0xe4, 0x7c, 0x12, 0x33, 0xf1, 0x4f, 0xd8, 0xe5, 0x1e, 0x32, 0x85,
+	0x88, 0xb7, 0x99, 0x08, 0x05, 0x4f, 0x26, 0x12, 0x31, 0x7f, 0xfd, 0xd9, 0x6e, 0x16, 0x3d, 0xdf,
+	0xcd, 0xa2, 0xbf, 0x76, 0xb3, 0xe8, 0xcb, 0xbd, 0x6c, 0xea, 0xf9, 0x5e, 0x36, 0xf5, 0xdb, 0x5e,
+	0x36, 0xf5, 0x60, 0x2a, 0xd6, 0x81, 0x7f, 0x12, 0xc6, 0x67, 0x86, 0x7c, 0xed, 0x18, 0xfb, 0x43,
+	0xe5, 0xcc, 0xff, 0x03, 0x00, 0xb9, 0xb2, 0x81, 0x6d, 0xbb, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1178,10 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries the active continuous funds.
+	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
 }
 
 type queryClient struct {
@@ -1061,6 +1273,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error) {
+	out := new(QueryContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error) {
+	out := new(QueryContinuousFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ContinuousFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1082,6 +1312,10 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries the active continuous funds.
+	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1115,6 +1349,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) ContinuousFund(ctx context.Context, req *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFund not implemented")
+}
+func (*UnimplementedQueryServer) ContinuousFunds(ctx context.Context, req *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1282,6 +1522,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFund(ctx, req.(*QueryContinuousFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ContinuousFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFunds(ctx, req.(*QueryContinuousFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1598,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "ContinuousFund",
+			Handler:    _Query_ContinuousFund_Handler,
+		},
+		{
+			MethodName: "ContinuousFunds",
+			Handler:    _Query_ContinuousFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1947,87 +2231,232 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContinuousFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryContinuousFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContinuousFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ContinuousFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContinuousFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryContinuousFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContinuousFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContinuousFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2212,6 +2641,61 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContinuousFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContinuousFund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContinuousFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContinuousFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3817,6 +4301,364 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContinuousFundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContinuousFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
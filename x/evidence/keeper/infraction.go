@@ -26,6 +26,12 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
 
+	// Evidence against the old consensus key of a validator which rotated its
+	// key is handled against its new key until the rotation matures.
+	if newConsAddr, found := k.stakingKeeper.GetRotatedConsAddr(ctx, consAddr); found {
+		consAddr = newConsAddr
+	}
+
 	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 		// Ignore evidence that cannot be handled.
 		//
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -75,6 +76,42 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.Len(evidences, 1)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_RotatedKey() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, oldPk := valAddresses[0], pubkeys[0]
+	newPk := ed25519.GenPrivKey().PubKey()
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.stakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, oldPk, power, true)
+	staking.EndBlocker(ctx, suite.stakingKeeper)
+
+	// handle a signature to set signing info
+	suite.slashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus key of the validator
+	validator, _ := suite.stakingKeeper.GetValidator(ctx, operatorAddr)
+	suite.Require().NoError(suite.stakingKeeper.RotateConsPubKey(ctx, validator, newPk))
+	staking.EndBlocker(ctx, suite.stakingKeeper)
+
+	// double sign with the old key before the rotation matures
+	oldTokens := suite.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(oldPk.Address()).String(),
+	}
+	suite.evidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// the validator should be slashed, jailed and tombstoned under its new key
+	suite.True(suite.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPk.Address())))
+	suite.True(suite.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
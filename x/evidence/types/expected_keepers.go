@@ -13,6 +13,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		GetRotatedConsAddr(sdk.Context, sdk.ConsAddress) (sdk.ConsAddress, bool)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
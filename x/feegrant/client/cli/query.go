@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryAllowancePool(),
+		GetCmdQueryAllowancePoolUsage(),
 	)
 
 	return feegrantQueryCmd
@@ -175,3 +177,93 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryAllowancePool returns cmd to query for the allowance pool of a granter.
+func GetCmdQueryAllowancePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the allowance pool of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the allowance pool funded by a granter address, along with the
+remaining balance of the pool.
+
+Example:
+$ %s query feegrant pool [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowancePool(
+				cmd.Context(),
+				&feegrant.QueryAllowancePoolRequest{
+					Granter: granterAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllowancePoolUsage returns cmd to query for the usage of the allowance pool of a granter by a grantee.
+func GetCmdQueryAllowancePoolUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-usage [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the usage of an allowance pool by a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fees paid by the allowance pool of a granter for a grantee, along
+with the remaining allowance of the grantee.
+
+Example:
+$ %s query feegrant pool-usage [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowancePoolUsage(
+				cmd.Context(),
+				&feegrant.QueryAllowancePoolUsageRequest{
+					Granter: granterAddr.String(),
+					Grantee: granteeAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Usage)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
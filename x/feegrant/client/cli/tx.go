@@ -22,6 +22,13 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagMaxGasPerTx      = "max-gas-per-tx"
+	FlagMaxFeePerTx      = "max-fee-per-tx"
+	FlagAllowedAddresses = "allowed-addresses"
+	FlagMaxTxsPerPeriod  = "max-txs-per-period"
+	FlagTxPeriod         = "tx-period"
+	FlagTotalSpendLimit  = "total-spend-limit"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +45,8 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdSetAllowancePool(),
+		NewCmdRemoveAllowancePool(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +66,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas-per-tx 200000 --max-fee-per-tx 1stake
+	--allowed-addresses cosmos1skjw... --max-txs-per-period 10
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -75,92 +87,106 @@ Examples:
 			}
 
 			granter := clientCtx.GetFromAddress()
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			grant, err := newFeeAllowance(cmd)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addFeeAllowanceFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter] [grantee]",
+		Short: "revoke fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
+			ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
 			}
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
-			}
+			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSetAllowancePool returns a CLI command handler for creating a MsgSetAllowancePool transaction.
+func NewCmdSetAllowancePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool [granter_key_or_address]",
+		Short: "Create or update the allowance pool of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Create or update an allowance pool paying fees from your address. Any account can
+use the pool by setting your address as its fee granter, and gets its own copy of the
+allowance described by the flags. The fees paid by the pool over all the accounts are
+capped by the required --total-spend-limit flag. Updating the pool resets the usage of
+all the accounts.
+Note, the'--from' flag is ignored as it is implied from [granter].
+
+Examples:
+%s tx %s set-pool cosmos1skjw... --total-spend-limit 10000stake --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
+%s tx %s set-pool cosmos1skjw... --total-spend-limit 10000stake --period 86400 --period-limit 10stake
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			allowance, err := newFeeAllowance(cmd)
 			if err != nil {
 				return err
 			}
 
-			// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
-
-				if periodClock <= 0 {
-					return fmt.Errorf("period clock was not set")
-				}
-
-				if periodLimit == nil {
-					return fmt.Errorf("period limit was not set")
-				}
-
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
-
-				periodic := feegrant.PeriodicAllowance{
-					Basic:            basic,
-					Period:           getPeriod(periodClock),
-					PeriodReset:      getPeriodReset(periodClock),
-					PeriodSpendLimit: periodLimit,
-					PeriodCanSpend:   periodLimit,
-				}
-
-				grant = &periodic
-			}
-
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			totalLimitStr, err := cmd.Flags().GetString(FlagTotalSpendLimit)
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
-					return err
-				}
+			totalSpendLimit, err := sdk.ParseCoinsNormalized(totalLimitStr)
+			if err != nil {
+				return err
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+			msg, err := feegrant.NewMsgSetAllowancePool(allowance, totalSpendLimit, clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
@@ -170,29 +196,27 @@ Examples:
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
-	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
-	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
-	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
-	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	addFeeAllowanceFlags(cmd)
+	cmd.Flags().String(FlagTotalSpendLimit, "", "The maximum amount of fees paid by the pool over all the accounts using it")
+	cmd.MarkFlagRequired(FlagTotalSpendLimit)
 
 	return cmd
 }
 
-// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
-func NewCmdRevokeFeegrant() *cobra.Command {
+// NewCmdRemoveAllowancePool returns a CLI command handler for creating a MsgRemoveAllowancePool transaction.
+func NewCmdRemoveAllowancePool() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "revoke [granter] [grantee]",
-		Short: "revoke fee-grant",
+		Use:   "remove-pool [granter]",
+		Short: "remove the allowance pool of an address",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
+			fmt.Sprintf(`remove the allowance pool paying fees from the granter. Note, the'--from' flag is
 			ignored as it is implied from [granter].
 
 Example:
- $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+ $ %s tx %s remove-pool cosmos1skj..
 			`, version.AppName, feegrant.ModuleName),
 		),
-		Args: cobra.ExactArgs(2),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -200,12 +224,7 @@ Example:
 				return err
 			}
 
-			grantee, err := sdk.AccAddressFromBech32(args[1])
-			if err != nil {
-				return err
-			}
-
-			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			msg := feegrant.NewMsgRemoveAllowancePool(clientCtx.GetFromAddress())
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
@@ -215,6 +234,149 @@ Example:
 	return cmd
 }
 
+// newFeeAllowance builds the fee allowance described by the allowance flags of the command.
+func newFeeAllowance(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
+
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock <= 0 {
+			return nil, fmt.Errorf("period clock was not set")
+		}
+
+		if periodLimit == nil {
+			return nil, fmt.Errorf("period limit was not set")
+		}
+
+		periodReset := getPeriodReset(periodClock)
+		if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+			return nil, fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+		}
+
+		periodic := feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           getPeriod(periodClock),
+			PeriodReset:      getPeriodReset(periodClock),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+
+		grant = &periodic
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxGas, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFeeVal, err := cmd.Flags().GetString(FlagMaxFeePerTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagMaxFeePerTx` isn't set, max fee will be nil
+	maxFee, err := sdk.ParseCoinsNormalized(maxFeeVal)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedAddrs, err := cmd.Flags().GetStringSlice(FlagAllowedAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTxs, err := cmd.Flags().GetUint64(FlagMaxTxsPerPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	txPeriod, err := cmd.Flags().GetInt64(FlagTxPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check any of the restriction flags set, If set consider it as restricted fee allowance.
+	if maxGas > 0 || maxFee != nil || len(allowedAddrs) > 0 || maxTxs > 0 {
+		grant, err = feegrant.NewRestrictedAllowance(grant, maxGas, maxFee, allowedAddrs, maxTxs, getPeriod(txPeriod), time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
+}
+
+// addFeeAllowanceFlags adds the flags describing a fee allowance to the command.
+func addFeeAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
+	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "The maximum gas limit of a transaction using the allowance, if not mentioned there is no limit")
+	cmd.Flags().String(FlagMaxFeePerTx, "", "The maximum fee of a transaction using the allowance, if not mentioned there is no limit")
+	cmd.Flags().StringSlice(FlagAllowedAddresses, []string{}, "Set of addresses the messages of a transaction using the allowance may reference besides their signers")
+	cmd.Flags().Uint64(FlagMaxTxsPerPeriod, 0, "The maximum number of transactions using the allowance in a tx period, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagTxPeriod, 24*60*60, "tx period specifies the time duration(in seconds) after which the number of transactions is reset")
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -583,6 +583,36 @@ func (s *IntegrationTestSuite) TestNewCmdFeeGrant() {
 			),
 			true, 0, nil,
 		},
+		{
+			"valid restricted fee grant",
+			append(
+				[]string{
+					granter.String(),
+					sdk.AccAddress("restricted_grantee__").String(),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+					fmt.Sprintf("--%s=%s", cli.FlagMaxFeePerTx, "10stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedAddresses, granter),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxTxsPerPeriod, 10),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"invalid allowed address",
+			append(
+				[]string{
+					granter.String(),
+					sdk.AccAddress("restricted_grantee2_").String(),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedAddresses, "invalid"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -712,6 +742,76 @@ func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdAllowancePool() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	queryPool := func() (*feegrant.QueryAllowancePoolResponse, error) {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryAllowancePool(), []string{
+			granter.String(),
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var res feegrant.QueryAllowancePoolResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+		return &res, nil
+	}
+
+	_, err := queryPool()
+	s.Require().Error(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdSetAllowancePool(), append(
+		[]string{
+			granter.String(),
+			fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+			fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+			fmt.Sprintf("--%s=%s", cli.FlagTotalSpendLimit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))),
+		},
+		commonFlags...,
+	))
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	pool, err := queryPool()
+	s.Require().NoError(err)
+	s.Require().Equal(granter.String(), pool.Pool.Granter)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))), pool.Pool.TotalSpendLimit)
+	s.Require().False(pool.Balance.IsZero())
+
+	grantee := s.addedGrantee
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryAllowancePoolUsage(), []string{
+		granter.String(),
+		grantee.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var usage feegrant.AllowancePoolUsage
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &usage), out.String())
+	s.Require().Equal(grantee.String(), usage.Grantee)
+	s.Require().Equal(pool.Pool.Sequence, usage.Sequence)
+	s.Require().True(usage.Spent.IsZero())
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdRemoveAllowancePool(), append([]string{granter.String()}, commonFlags...))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	_, err = queryPool()
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 	s.T().Skip() // TODO to re-enable in #12274
 
@@ -7,6 +7,9 @@ const (
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
 
+	EventTypeSetFeeGrantPool    = "set_feegrant_pool"
+	EventTypeRemoveFeeGrantPool = "remove_feegrant_pool"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 
@@ -218,6 +218,72 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// RestrictedAllowance restricts an allowance to transactions within a gas
+// limit, a fee limit, targeting allowed addresses only, and to a maximum
+// number of transactions per period.
+//
+// Since: cosmos-sdk 0.47
+type RestrictedAllowance struct {
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_per_tx is the maximum gas limit of a transaction using the
+	// allowance. If it is 0, the gas limit is not restricted.
+	MaxGasPerTx uint64 `protobuf:"varint,2,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// max_fee_per_tx is the maximum fee of a transaction using the allowance.
+	// If it is empty, the fee of a single transaction is not restricted.
+	MaxFeePerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_fee_per_tx,json=maxFeePerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_tx"`
+	// allowed_addresses are the addresses, such as recipients, validators or
+	// contracts, that the messages of a transaction using the allowance may
+	// reference besides their signers. If it is empty, the addresses are not
+	// restricted.
+	AllowedAddresses []string `protobuf:"bytes,4,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+	// max_txs_per_period is the maximum number of transactions using the
+	// allowance in a period. If it is 0, the number of transactions is not
+	// restricted.
+	MaxTxsPerPeriod uint64 `protobuf:"varint,5,opt,name=max_txs_per_period,json=maxTxsPerPeriod,proto3" json:"max_txs_per_period,omitempty"`
+	// period is the time duration after which the number of transactions is
+	// reset.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	// period_tx_count is the number of transactions using the allowance in the
+	// current period.
+	PeriodTxCount uint64 `protobuf:"varint,7,opt,name=period_tx_count,json=periodTxCount,proto3" json:"period_tx_count,omitempty"`
+	// period_reset is the time at which the current period ends.
+	PeriodReset time.Time `protobuf:"bytes,8,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *RestrictedAllowance) Reset()         { *m = RestrictedAllowance{} }
+func (m *RestrictedAllowance) String() string { return proto.CompactTextString(m) }
+func (*RestrictedAllowance) ProtoMessage()    {}
+func (*RestrictedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *RestrictedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestrictedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestrictedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestrictedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestrictedAllowance.Merge(m, src)
+}
+func (m *RestrictedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestrictedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestrictedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestrictedAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -232,7 +298,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,11 +348,202 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// AllowancePool is a fee allowance funded by a shared pool account that any
+// account can draw from by setting the pool account as its fee granter. Each
+// grantee gets its own copy of the pool allowance, so the allowance acts as a
+// per-grantee cap on the pool, while the total spend limit caps the fees paid
+// by the pool over all of its grantees.
+//
+// Since: cosmos-sdk 0.47
+type AllowancePool struct {
+	// granter is the address of the pool account paying the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// allowance is the allowance given to every grantee of the pool, it can be
+	// any of basic, periodic, allowed fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// sequence identifies the current version of the pool allowance. It changes
+	// every time the pool is set, discarding the usage recorded by grantees
+	// under the previous allowance.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// total_spend_limit is the maximum amount of fees paid by the pool over all
+	// of its grantees under the current pool allowance.
+	TotalSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_spend_limit,json=totalSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spend_limit"`
+	// total_spent is the amount of fees paid by the pool over all of its
+	// grantees under the current pool allowance.
+	TotalSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spent"`
+}
+
+func (m *AllowancePool) Reset()         { *m = AllowancePool{} }
+func (m *AllowancePool) String() string { return proto.CompactTextString(m) }
+func (*AllowancePool) ProtoMessage()    {}
+func (*AllowancePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *AllowancePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowancePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowancePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowancePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowancePool.Merge(m, src)
+}
+func (m *AllowancePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowancePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowancePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowancePool proto.InternalMessageInfo
+
+func (m *AllowancePool) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *AllowancePool) GetAllowance() *types1.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *AllowancePool) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AllowancePool) GetTotalSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpendLimit
+	}
+	return nil
+}
+
+func (m *AllowancePool) GetTotalSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpent
+	}
+	return nil
+}
+
+// AllowancePoolUsage records the use of an allowance pool by a single grantee.
+//
+// Since: cosmos-sdk 0.47
+type AllowancePoolUsage struct {
+	// granter is the address of the pool account.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the account drawing from the pool.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance is the grantee's copy of the pool allowance, updated as fees are
+	// paid by the pool.
+	Allowance *types1.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// spent is the total amount of fees paid by the pool for the grantee.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// exhausted is set once the grantee has used up its allowance.
+	Exhausted bool `protobuf:"varint,5,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// sequence is the sequence of the pool allowance the usage was recorded
+	// under.
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *AllowancePoolUsage) Reset()         { *m = AllowancePoolUsage{} }
+func (m *AllowancePoolUsage) String() string { return proto.CompactTextString(m) }
+func (*AllowancePoolUsage) ProtoMessage()    {}
+func (*AllowancePoolUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{6}
+}
+func (m *AllowancePoolUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowancePoolUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowancePoolUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowancePoolUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowancePoolUsage.Merge(m, src)
+}
+func (m *AllowancePoolUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowancePoolUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowancePoolUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowancePoolUsage proto.InternalMessageInfo
+
+func (m *AllowancePoolUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *AllowancePoolUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *AllowancePoolUsage) GetAllowance() *types1.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *AllowancePoolUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *AllowancePoolUsage) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+func (m *AllowancePoolUsage) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*RestrictedAllowance)(nil), "cosmos.feegrant.v1beta1.RestrictedAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*AllowancePool)(nil), "cosmos.feegrant.v1beta1.AllowancePool")
+	proto.RegisterType((*AllowancePoolUsage)(nil), "cosmos.feegrant.v1beta1.AllowancePoolUsage")
 }
 
 func init() {
@@ -294,44 +551,59 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xf3, 0x67, 0x9b, 0x4c, 0xd8, 0x4d, 0x33, 0x5d, 0x84, 0x37, 0x42, 0xc9, 0x2a, 0x48,
+	0x6d, 0x50, 0xb5, 0x0e, 0x5d, 0x6e, 0xe5, 0x42, 0x1c, 0xe8, 0x0a, 0x89, 0x4a, 0x2b, 0x37, 0x5c,
+	0xb8, 0x58, 0x13, 0xfb, 0xad, 0x6b, 0x61, 0x7b, 0x8c, 0x67, 0x42, 0x9d, 0x6f, 0xc0, 0x05, 0xa9,
+	0xe2, 0xc4, 0x09, 0x71, 0xe6, 0x5c, 0xf1, 0x19, 0x2a, 0x4e, 0x15, 0x5c, 0x38, 0xd1, 0x6a, 0xf3,
+	0x45, 0x90, 0x67, 0xc6, 0x4e, 0xb2, 0xa1, 0xfc, 0x89, 0xb2, 0x87, 0x9e, 0xd6, 0xf3, 0xe6, 0xbd,
+	0xf7, 0xfb, 0xbd, 0xf7, 0x7e, 0xf3, 0x36, 0xe8, 0xb6, 0x43, 0x59, 0x48, 0xd9, 0xf0, 0x02, 0xc0,
+	0x4b, 0x48, 0xc4, 0x87, 0xdf, 0xdc, 0x9b, 0x02, 0x27, 0xf7, 0x0a, 0x83, 0x11, 0x27, 0x94, 0x53,
+	0xfc, 0x8e, 0xf4, 0x33, 0x0a, 0xb3, 0xf2, 0xeb, 0x1c, 0x7a, 0xd4, 0xa3, 0xc2, 0x67, 0x98, 0x7d,
+	0x49, 0xf7, 0xce, 0x91, 0x47, 0xa9, 0x17, 0xc0, 0x50, 0x9c, 0xa6, 0xb3, 0x8b, 0x21, 0x89, 0xe6,
+	0xf9, 0x95, 0xcc, 0x64, 0xcb, 0x18, 0x95, 0x56, 0x5e, 0x75, 0x15, 0x99, 0x29, 0x61, 0x50, 0x10,
+	0x71, 0xa8, 0x1f, 0xa9, 0xfb, 0xde, 0xd5, 0xac, 0xdc, 0x0f, 0x81, 0x71, 0x12, 0xc6, 0x79, 0x82,
+	0xab, 0x0e, 0xee, 0x2c, 0x21, 0xdc, 0xa7, 0x2a, 0x41, 0xff, 0x77, 0x0d, 0x1d, 0x98, 0x84, 0xf9,
+	0xce, 0x28, 0x08, 0xe8, 0x13, 0x12, 0x39, 0x80, 0x03, 0xd4, 0x64, 0x31, 0x44, 0xae, 0x1d, 0xf8,
+	0xa1, 0xcf, 0x75, 0xed, 0xb8, 0x32, 0x68, 0x9e, 0x1e, 0x19, 0x8a, 0x57, 0xc6, 0x24, 0x2f, 0xd5,
+	0x18, 0x53, 0x3f, 0x32, 0x3f, 0x78, 0xfe, 0x67, 0xaf, 0xf4, 0xf3, 0xcb, 0xde, 0xc0, 0xf3, 0xf9,
+	0xe3, 0xd9, 0xd4, 0x70, 0x68, 0xa8, 0x8a, 0x50, 0x7f, 0x4e, 0x98, 0xfb, 0xd5, 0x90, 0xcf, 0x63,
+	0x60, 0x22, 0x80, 0x59, 0x48, 0xe4, 0xff, 0x3c, 0x4b, 0x8f, 0x3f, 0x46, 0x08, 0xd2, 0xd8, 0x97,
+	0xa4, 0xf4, 0xf2, 0xb1, 0x36, 0x68, 0x9e, 0x76, 0x0c, 0xc9, 0xda, 0xc8, 0x59, 0x1b, 0x93, 0xbc,
+	0x2c, 0xb3, 0xfa, 0xf4, 0x65, 0x4f, 0xb3, 0x56, 0x62, 0xee, 0xb7, 0x7f, 0x7d, 0x76, 0xb2, 0xff,
+	0x00, 0xa0, 0xa8, 0xe0, 0xb3, 0xfe, 0xa2, 0x82, 0xda, 0xe7, 0x90, 0xf8, 0xd4, 0x5d, 0x2d, 0x6c,
+	0x8c, 0x6a, 0xd3, 0xac, 0x54, 0x5d, 0x13, 0x28, 0x77, 0x8c, 0xd7, 0x4c, 0xd0, 0x58, 0x6f, 0x88,
+	0x59, 0xcd, 0x0a, 0xb4, 0x64, 0x2c, 0xfe, 0x08, 0xed, 0xc5, 0x22, 0xb3, 0xe2, 0x7a, 0xb4, 0xc1,
+	0xf5, 0x13, 0xd5, 0x61, 0xb3, 0x9e, 0xc5, 0xfd, 0x90, 0xd1, 0x55, 0x21, 0x78, 0x8e, 0xb0, 0xfc,
+	0xb2, 0x57, 0x3b, 0x5c, 0xd9, 0x7d, 0x87, 0x6f, 0x4a, 0x98, 0x47, 0xcb, 0x3e, 0xcf, 0x90, 0xb2,
+	0xd9, 0x0e, 0x89, 0x24, 0xbc, 0x5e, 0xdd, 0x3d, 0xf0, 0x81, 0x04, 0x19, 0x93, 0x48, 0x60, 0xe3,
+	0x33, 0xf4, 0x96, 0x82, 0x4d, 0x80, 0x01, 0xd7, 0x6b, 0xff, 0x3a, 0x60, 0xd1, 0x35, 0x31, 0xe4,
+	0xa6, 0x8c, 0xb4, 0xb2, 0xc0, 0xbf, 0x9b, 0xf2, 0x8f, 0x1a, 0xba, 0x25, 0x8e, 0xe0, 0x3e, 0x64,
+	0xde, 0x72, 0xce, 0x9f, 0xa2, 0x06, 0xc9, 0x0f, 0x6a, 0xd6, 0x87, 0x1b, 0x80, 0xa3, 0x68, 0x6e,
+	0x6e, 0xe6, 0xb4, 0x96, 0x91, 0xf8, 0x7d, 0x74, 0x93, 0xc8, 0xec, 0x76, 0x08, 0x8c, 0x11, 0x0f,
+	0x98, 0x5e, 0x3e, 0xae, 0x0c, 0x1a, 0x56, 0x4b, 0xd9, 0x1f, 0x2a, 0xf3, 0xfd, 0xb7, 0xbf, 0xfd,
+	0xa9, 0x57, 0xda, 0x24, 0xf8, 0x7d, 0x15, 0xdd, 0xb2, 0x80, 0xf1, 0xc4, 0x77, 0x38, 0xb8, 0x3b,
+	0x27, 0xf8, 0x1e, 0x3a, 0x08, 0x49, 0x6a, 0x7b, 0x84, 0xd9, 0x31, 0x24, 0x36, 0x4f, 0x85, 0x24,
+	0xab, 0x56, 0x33, 0x24, 0xe9, 0x19, 0x61, 0xe7, 0x90, 0x4c, 0x52, 0x1c, 0x4b, 0xa7, 0x0b, 0x80,
+	0xdc, 0xe9, 0x1a, 0xe4, 0x96, 0x21, 0x3e, 0x00, 0x90, 0x88, 0x77, 0x51, 0x3b, 0xef, 0x1b, 0x71,
+	0xdd, 0x04, 0x18, 0x03, 0x26, 0xa4, 0xd6, 0xb0, 0xf2, 0x86, 0x8e, 0x72, 0x3b, 0xbe, 0x8b, 0x70,
+	0x46, 0x8f, 0xa7, 0xb2, 0x06, 0xf5, 0xb4, 0x6a, 0xa2, 0x8e, 0x56, 0x48, 0xd2, 0x49, 0x9a, 0xd5,
+	0x21, 0xdf, 0xf2, 0xca, 0xdb, 0xdb, 0xfb, 0xff, 0x6f, 0xef, 0x36, 0x6a, 0x29, 0x25, 0xf2, 0xd4,
+	0x76, 0xe8, 0x2c, 0xe2, 0xfa, 0x0d, 0x01, 0xb3, 0x2f, 0xcd, 0x93, 0x74, 0x9c, 0x19, 0x37, 0x14,
+	0x5b, 0xdf, 0x56, 0xb1, 0xaf, 0x11, 0xc5, 0x2f, 0x1a, 0xaa, 0x9d, 0x65, 0xeb, 0x06, 0x9f, 0xa2,
+	0x1b, 0x62, 0xef, 0x40, 0x22, 0x44, 0xd0, 0x30, 0xf5, 0xdf, 0x9e, 0x9d, 0x1c, 0xaa, 0xb1, 0xa8,
+	0x16, 0x3d, 0xe2, 0x89, 0x1f, 0x79, 0x56, 0xee, 0xb8, 0x8c, 0x01, 0xbd, 0xfc, 0xdf, 0x62, 0xae,
+	0xc8, 0xad, 0xb2, 0xad, 0xdc, 0xfa, 0xdf, 0x55, 0xd0, 0x7e, 0x71, 0x73, 0x4e, 0x69, 0xb0, 0x55,
+	0x01, 0x6b, 0x64, 0xca, 0x5b, 0x6b, 0xbf, 0x83, 0xea, 0x0c, 0xbe, 0x9e, 0x41, 0x5e, 0x52, 0xd5,
+	0x2a, 0xce, 0xf8, 0x09, 0x6a, 0x73, 0xca, 0x49, 0xb0, 0xb6, 0x64, 0xaf, 0x61, 0xd7, 0xb5, 0x04,
+	0xca, 0xca, 0x8e, 0x0d, 0x50, 0x73, 0x09, 0x9c, 0xed, 0xba, 0xdd, 0xff, 0xe7, 0x2c, 0x20, 0x79,
+	0xff, 0x55, 0x19, 0xe1, 0xb5, 0x79, 0x7c, 0x91, 0x2d, 0xa3, 0x37, 0x4c, 0x55, 0x98, 0xa0, 0x9a,
+	0xec, 0xd6, 0x35, 0x0c, 0x48, 0x66, 0xc6, 0xef, 0xa2, 0x06, 0xa4, 0x8f, 0xc9, 0x8c, 0x71, 0x90,
+	0xab, 0xa5, 0x6e, 0x2d, 0x0d, 0x6b, 0x4a, 0xda, 0x5b, 0x57, 0x92, 0x39, 0x7a, 0x7e, 0xd9, 0xd5,
+	0x5e, 0x5c, 0x76, 0xb5, 0x57, 0x97, 0x5d, 0xed, 0xe9, 0xa2, 0x5b, 0x7a, 0xb1, 0xe8, 0x96, 0xfe,
+	0x58, 0x74, 0x4b, 0x5f, 0xde, 0xf9, 0x47, 0x12, 0x69, 0xf1, 0x63, 0x71, 0xba, 0x27, 0x7a, 0xf1,
+	0xe1, 0x5f, 0x03, 0x00, 0x34, 0xf7, 0x88, 0xa2, 0x57, 0x0a, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -502,6 +774,95 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestrictedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestrictedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestrictedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.PeriodTxCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodTxCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxTxsPerPeriod != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxTxsPerPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for iNdEx := len(m.MaxFeePerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -551,64 +912,217 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeegrant(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllowancePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BasicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SpendLimit) > 0 {
-		for _, e := range m.SpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
-		}
-	}
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	return n
+
+func (m *AllowancePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PeriodicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllowancePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Basic.Size()
-	n += 1 + l + sovFeegrant(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovFeegrant(uint64(l))
-	if len(m.PeriodSpendLimit) > 0 {
-		for _, e := range m.PeriodSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
-		}
-	}
-	if len(m.PeriodCanSpend) > 0 {
-		for _, e := range m.PeriodCanSpend {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.TotalSpent) > 0 {
+		for iNdEx := len(m.TotalSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
-	n += 1 + l + sovFeegrant(uint64(l))
-	return n
-}
-
-func (m *AllowedMsgAllowance) Size() (n int) {
+	if len(m.TotalSpendLimit) > 0 {
+		for iNdEx := len(m.TotalSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowancePoolUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowancePoolUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowancePoolUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Exhausted {
+		i--
+		if m.Exhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BasicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *AllowedMsgAllowance) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -627,6 +1141,44 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *RestrictedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasPerTx))
+	}
+	if len(m.MaxFeePerTx) > 0 {
+		for _, e := range m.MaxFeePerTx {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxTxsPerPeriod != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxTxsPerPeriod))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.PeriodTxCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodTxCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -648,13 +1200,808 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *AllowancePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFeegrant(uint64(m.Sequence))
+	}
+	if len(m.TotalSpendLimit) > 0 {
+		for _, e := range m.TotalSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.TotalSpent) > 0 {
+		for _, e := range m.TotalSpent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowancePoolUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Exhausted {
+		n += 2
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFeegrant(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozFeegrant(x uint64) (n int) {
 	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestrictedAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestrictedAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestrictedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeePerTx = append(m.MaxFeePerTx, types.Coin{})
+			if err := m.MaxFeePerTx[len(m.MaxFeePerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerPeriod", wireType)
+			}
+			m.MaxTxsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTxCount", wireType)
+			}
+			m.PeriodTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -677,17 +2024,17 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -697,29 +2044,59 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendLimit = append(m.SpendLimit, types.Coin{})
-			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -746,10 +2123,10 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -774,7 +2151,7 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+func (m *AllowancePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -797,17 +2174,17 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowancePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowancePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -817,28 +2194,27 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -865,15 +2241,18 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -883,29 +2262,14 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
-			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -932,14 +2296,14 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
-			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalSpendLimit = append(m.TotalSpendLimit, types.Coin{})
+			if err := m.TotalSpendLimit[len(m.TotalSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -966,7 +2330,8 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalSpent = append(m.TotalSpent, types.Coin{})
+			if err := m.TotalSpent[len(m.TotalSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -991,7 +2356,7 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+func (m *AllowancePoolUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,17 +2379,17 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowancePoolUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowancePoolUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1034,31 +2399,27 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &types1.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1086,63 +2447,13 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFeegrant(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Grant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeegrant
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1152,29 +2463,33 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1184,29 +2499,31 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhausted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1216,28 +2533,31 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeegrant
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Allowance == nil {
-				m.Allowance = &types1.Any{}
+			m.Exhausted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
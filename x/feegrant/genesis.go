@@ -2,6 +2,7 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +26,24 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	pools := make(map[string]bool, len(data.Pools))
+	for _, p := range data.Pools {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if pools[p.Granter] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowance pool for granter %s", p.Granter)
+		}
+		pools[p.Granter] = true
+	}
+
+	for _, u := range data.PoolUsages {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -42,5 +61,17 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 		}
 	}
 
+	for _, p := range data.Pools {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.PoolUsages {
+		if err := u.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -26,6 +26,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState contains a set of fee allowances, persisted from the store
 type GenesisState struct {
 	Allowances []Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// pools defines the allowance pools.
+	//
+	// Since: cosmos-sdk 0.47
+	Pools []AllowancePool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	// pool_usages defines the usage of the allowance pools by their grantees.
+	//
+	// Since: cosmos-sdk 0.47
+	PoolUsages []AllowancePoolUsage `protobuf:"bytes,3,rep,name=pool_usages,json=poolUsages,proto3" json:"pool_usages"`
+	// pool_sequence is the last sequence assigned to an allowance pool.
+	//
+	// Since: cosmos-sdk 0.47
+	PoolSequence uint64 `protobuf:"varint,4,opt,name=pool_sequence,json=poolSequence,proto3" json:"pool_sequence,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +80,27 @@ func (m *GenesisState) GetAllowances() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetPools() []AllowancePool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolUsages() []AllowancePoolUsage {
+	if m != nil {
+		return m.PoolUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolSequence() uint64 {
+	if m != nil {
+		return m.PoolSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.feegrant.v1beta1.GenesisState")
 }
@@ -77,20 +110,25 @@ func init() {
 }
 
 var fileDescriptor_ac719d2d0954d1bf = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
+	// 285 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x99, 0x0a, 0xd7, 0x0f, 0x56,
-	0xa7, 0x14, 0xc2, 0xc5, 0xe3, 0x0e, 0xb1, 0x27, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x85, 0x8b,
-	0x2b, 0x31, 0x27, 0x27, 0xbf, 0x3c, 0x31, 0x2f, 0x39, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83,
-	0xdb, 0x48, 0x4e, 0x0f, 0x87, 0xdd, 0x7a, 0xee, 0x20, 0x9e, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c,
-	0x41, 0x48, 0xfa, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x44, 0x08, 0xa5,
-	0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x01, 0x77, 0x5e, 0x12, 0x1b, 0xd8, 0x7d, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0xdc, 0xb9, 0x91, 0x1f, 0x01, 0x00, 0x00,
+	0xa7, 0x34, 0x91, 0x89, 0x8b, 0xc7, 0x1d, 0x62, 0x51, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x0b,
+	0x17, 0x57, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x62, 0x5e, 0x72, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
+	0x06, 0xb7, 0x91, 0x9c, 0x1e, 0x0e, 0xcb, 0xf5, 0xdc, 0x41, 0x3c, 0x27, 0x96, 0x13, 0xf7, 0xe4,
+	0x19, 0x82, 0x90, 0xf4, 0x09, 0x39, 0x71, 0xb1, 0x16, 0xe4, 0xe7, 0xe7, 0x14, 0x4b, 0x30, 0x81,
+	0x0d, 0x50, 0xc3, 0x69, 0x80, 0x23, 0x4c, 0x4f, 0x40, 0x7e, 0x7e, 0x0e, 0xd4, 0x20, 0x88, 0x56,
+	0xa1, 0x20, 0x2e, 0x6e, 0x10, 0x23, 0xbe, 0xb4, 0x38, 0x31, 0x3d, 0xb5, 0x58, 0x82, 0x19, 0x6c,
+	0x92, 0x36, 0x71, 0x26, 0x85, 0x82, 0xf4, 0xc0, 0xdc, 0x55, 0x00, 0x13, 0x28, 0x16, 0x52, 0xe6,
+	0xe2, 0x05, 0x9b, 0x59, 0x9c, 0x5a, 0x58, 0x9a, 0x9a, 0x97, 0x9c, 0x2a, 0xc1, 0xa2, 0xc0, 0xa8,
+	0xc1, 0x12, 0xc4, 0x03, 0x12, 0x0c, 0x86, 0x8a, 0x39, 0x39, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
+	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
+	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
+	0x3e, 0x34, 0x80, 0x21, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x3c, 0x70, 0x93, 0xd8, 0xc0,
+	0xa1, 0x6b, 0x0c, 0x18, 0x00, 0x5c, 0x8b, 0xdf, 0x58, 0xdd, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +151,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolUsages) > 0 {
+		for iNdEx := len(m.PoolUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +224,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolUsages) > 0 {
+		for _, e := range m.PoolUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PoolSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolSequence))
+	}
 	return n
 }
 
@@ -225,6 +311,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, AllowancePool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolUsages = append(m.PoolUsages, AllowancePoolUsage{})
+			if err := m.PoolUsages[len(m.PoolUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSequence", wireType)
+			}
+			m.PoolSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
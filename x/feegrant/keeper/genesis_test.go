@@ -34,7 +34,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	accountKeeper := feegranttestutil.NewMockAccountKeeper(ctrl)
 	accountKeeper.EXPECT().GetAccount(gomock.Any(), granteeAddr).Return(authtypes.NewBaseAccountWithAddress(granteeAddr)).AnyTimes()
 
-	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper)
+	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper, feegranttestutil.NewMockBankKeeper(ctrl))
 
 	suite.ctx = testCtx.Ctx
 }
@@ -72,6 +72,59 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	suite.Require().Equal(genesis, newGenesis)
 }
 
+func (suite *GenesisTestSuite) TestImportExportGenesisPools() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	totalLimit := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100_000)))
+	fee := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)))
+
+	err := suite.feegrantKeeper.SetAllowancePool(suite.ctx, granterAddr, &feegrant.BasicAllowance{SpendLimit: coins}, totalLimit)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granterAddr, granteeAddr, fee, nil)
+	suite.Require().NoError(err)
+
+	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genesis.Pools, 1)
+	suite.Require().Len(genesis.PoolUsages, 1)
+	suite.Require().Equal(fee, genesis.Pools[0].TotalSpent)
+	suite.Require().Equal(genesis.Pools[0].Sequence, genesis.PoolSequence)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+
+	suite.Require().NoError(suite.feegrantKeeper.RemoveAllowancePool(suite.ctx, granterAddr))
+	emptyGenesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(emptyGenesis.PoolUsages)
+
+	err = suite.feegrantKeeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().NoError(err)
+
+	newGenesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis, newGenesis)
+
+	usage, err := suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, granterAddr, granteeAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, usage.Spent)
+
+	// pools created after the import never reuse the imported sequences
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, granterAddr, &feegrant.BasicAllowance{SpendLimit: coins}, totalLimit)
+	suite.Require().NoError(err)
+	pool, err := suite.feegrantKeeper.GetAllowancePool(suite.ctx, granterAddr)
+	suite.Require().NoError(err)
+	suite.Require().Greater(pool.Sequence, genesis.Pools[0].Sequence)
+
+	// nor the sequences of the imported usages, even without an exported pool sequence
+	usageGenesis := &feegrant.GenesisState{PoolUsages: genesis.PoolUsages}
+	usageGenesis.PoolUsages[0].Sequence = pool.Sequence + 10
+	err = suite.feegrantKeeper.InitGenesis(suite.ctx, usageGenesis)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, granterAddr, &feegrant.BasicAllowance{SpendLimit: coins}, totalLimit)
+	suite.Require().NoError(err)
+	pool, err = suite.feegrantKeeper.GetAllowancePool(suite.ctx, granterAddr)
+	suite.Require().NoError(err)
+	suite.Require().Greater(pool.Sequence, usageGenesis.PoolUsages[0].Sequence)
+}
+
 func (suite *GenesisTestSuite) TestInitGenesis() {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	suite.Require().NoError(err)
@@ -125,3 +125,53 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancePool returns the allowance pool funded by the granter and its remaining balance.
+func (q Keeper) AllowancePool(c context.Context, req *feegrant.QueryAllowancePoolRequest) (*feegrant.QueryAllowancePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, err := q.GetAllowancePool(ctx, granterAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &feegrant.QueryAllowancePoolResponse{
+		Pool:    pool,
+		Balance: q.bankKeeper.SpendableCoins(ctx, granterAddr),
+	}, nil
+}
+
+// AllowancePoolUsage returns the usage of the allowance pool funded by the granter by the grantee.
+func (q Keeper) AllowancePoolUsage(c context.Context, req *feegrant.QueryAllowancePoolUsageRequest) (*feegrant.QueryAllowancePoolUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usage, err := q.GetAllowancePoolUsage(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &feegrant.QueryAllowancePoolUsageResponse{Usage: usage}, nil
+}
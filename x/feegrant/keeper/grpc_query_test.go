@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/golang/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -225,3 +227,34 @@ func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress)
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestAllowancePoolQueries() {
+	pool, grantee := suite.addrs[0], suite.addrs[1]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	_, err := suite.feegrantKeeper.AllowancePool(suite.ctx, &feegrant.QueryAllowancePoolRequest{Granter: pool.String()})
+	suite.Require().Error(err)
+	_, err = suite.feegrantKeeper.AllowancePoolUsage(suite.ctx, &feegrant.QueryAllowancePoolUsageRequest{Granter: pool.String(), Grantee: grantee.String()})
+	suite.Require().Error(err)
+	_, err = suite.feegrantKeeper.AllowancePool(suite.ctx, &feegrant.QueryAllowancePoolRequest{Granter: "invalid_granter"})
+	suite.Require().Error(err)
+	_, err = suite.feegrantKeeper.AllowancePool(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom}, suite.atom)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee, fee, []sdk.Msg{}))
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), pool).Return(balance)
+	poolRes, err := suite.feegrantKeeper.AllowancePool(suite.ctx, &feegrant.QueryAllowancePoolRequest{Granter: pool.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.String(), poolRes.Pool.Granter)
+	suite.Require().Equal(balance, poolRes.Balance)
+
+	usageRes, err := suite.feegrantKeeper.AllowancePoolUsage(suite.ctx, &feegrant.QueryAllowancePoolUsageRequest{Granter: pool.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(grantee.String(), usageRes.Usage.Grantee)
+	suite.Require().Equal(fee, usageRes.Usage.Spent)
+	suite.Require().Equal(poolRes.Pool.Sequence, usageRes.Usage.Sequence)
+}
@@ -21,16 +21,18 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	authKeeper feegrant.AccountKeeper
+	bankKeeper feegrant.BankKeeper
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper, bk feegrant.BankKeeper) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		authKeeper: ak,
+		bankKeeper: bk,
 	}
 }
 
@@ -227,11 +229,25 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the granter has not granted an allowance to the grantee, the fee is paid from the granter's
+// allowance pool, if any. A pool is open to any grantee, including new accounts, so the pool
+// allowance only caps the fees paid for each account, while the total spend limit of the pool
+// caps the fees paid for all of them.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
-		return err
+		if !sdkerrors.IsOf(err, sdkerrors.ErrNotFound) {
+			return err
+		}
+
+		pool, poolErr := k.GetAllowancePool(ctx, granter)
+		if poolErr != nil {
+			// the granter has neither a grant nor a pool, report the missing grant.
+			return err
+		}
+
+		return k.useAllowancePool(ctx, pool, granter, grantee, fee, msgs)
 	}
 
 	grant, err := f.GetGrant()
@@ -295,6 +311,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 	}
+
+	// the sequence can't go backwards, even if the exported one is missing or behind the
+	// sequence of the pools and usages.
+	lastSequence := data.PoolSequence
+	for _, pool := range data.Pools {
+		if err := k.setAllowancePool(ctx, pool); err != nil {
+			return err
+		}
+		if pool.Sequence > lastSequence {
+			lastSequence = pool.Sequence
+		}
+	}
+
+	for _, usage := range data.PoolUsages {
+		if err := k.setAllowancePoolUsage(ctx, usage); err != nil {
+			return err
+		}
+		if usage.Sequence > lastSequence {
+			lastSequence = usage.Sequence
+		}
+	}
+	k.setAllowancePoolSequence(ctx, lastSequence)
+
 	return nil
 }
 
@@ -306,9 +345,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.AllowancePool
+	err = k.IterateAllowancePools(ctx, func(pool feegrant.AllowancePool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.AllowancePoolUsage
+	err = k.IterateAllowancePoolUsages(ctx, func(usage feegrant.AllowancePoolUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:   grants,
+		Pools:        pools,
+		PoolUsages:   usages,
+		PoolSequence: k.getAllowancePoolSequence(ctx),
 	}, err
 }
 
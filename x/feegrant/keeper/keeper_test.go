@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
@@ -26,6 +29,7 @@ type KeeperTestSuite struct {
 	atom           sdk.Coins
 	feegrantKeeper keeper.Keeper
 	accountKeeper  *feegranttestutil.MockAccountKeeper
+	bankKeeper     *feegranttestutil.MockBankKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -46,7 +50,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), suite.addrs[2]).Return(authtypes.NewBaseAccountWithAddress(suite.addrs[2])).AnyTimes()
 	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), suite.addrs[3]).Return(authtypes.NewBaseAccountWithAddress(suite.addrs[3])).AnyTimes()
 
-	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, suite.accountKeeper)
+	suite.bankKeeper = feegranttestutil.NewMockBankKeeper(ctrl)
+
+	suite.feegrantKeeper = keeper.NewKeeper(encCfg.Codec, key, suite.accountKeeper, suite.bankKeeper)
 	suite.ctx = testCtx.Ctx
 	suite.msgSrvr = keeper.NewMsgServerImpl(suite.feegrantKeeper)
 	suite.atom = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(555)))
@@ -461,3 +467,160 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAllowancePool() {
+	pool, grantee1, grantee2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	oneDay := suite.ctx.BlockTime().AddDate(0, 0, 1)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 300))
+	totalLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10_000))
+
+	// the granter has neither a grant nor a pool
+	err := suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneDay,
+	}, totalLimit)
+	suite.Require().NoError(err)
+
+	// every grantee draws from its own copy of the pool allowance
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee2, fee, []sdk.Msg{}))
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	usage, err := suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, pool, grantee1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, usage.Spent)
+	suite.Require().False(usage.Exhausted)
+	allowance, err := usage.GetFeeAllowanceI()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 255)), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// using up the allowance exhausts the pool for the grantee only
+	remaining := sdk.NewCoins(sdk.NewInt64Coin("atom", 255))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, remaining, []sdk.Msg{}))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	usage, err = suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, pool, grantee1)
+	suite.Require().NoError(err)
+	suite.Require().True(usage.Exhausted)
+	suite.Require().Equal(suite.atom, usage.Spent)
+
+	// a grantee that has not used the pool yet has the full pool allowance
+	usage, err = suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, pool, suite.addrs[3])
+	suite.Require().NoError(err)
+	suite.Require().True(usage.Spent.IsZero())
+	allowance, err = usage.GetFeeAllowanceI()
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom, allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// a direct grant takes precedence over the pool
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, pool, grantee1, &feegrant.BasicAllowance{SpendLimit: fee})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{}))
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, pool, grantee1)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// updating the pool resets the usage of all the grantees
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom}, totalLimit)
+	suite.Require().NoError(err)
+	usage, err = suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, pool, grantee1)
+	suite.Require().NoError(err)
+	suite.Require().False(usage.Exhausted)
+	suite.Require().True(usage.Spent.IsZero())
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{}))
+
+	// a removed and re-created pool doesn't reuse the previous usage, which is pruned
+	suite.Require().NoError(suite.feegrantKeeper.RemoveAllowancePool(suite.ctx, pool))
+	suite.Require().NoError(suite.feegrantKeeper.IterateAllowancePoolUsages(suite.ctx, func(usage feegrant.AllowancePoolUsage) bool {
+		suite.Require().NotEqual(pool.String(), usage.Granter)
+		return false
+	}))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	suite.Require().ErrorIs(suite.feegrantKeeper.RemoveAllowancePool(suite.ctx, pool), sdkerrors.ErrNotFound)
+
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom}, totalLimit)
+	suite.Require().NoError(err)
+	usage, err = suite.feegrantKeeper.GetAllowancePoolUsage(suite.ctx, pool, grantee1)
+	suite.Require().NoError(err)
+	suite.Require().True(usage.Spent.IsZero())
+
+	// the total spend limit caps the fees paid by the pool over all the grantees
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom}, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee1, fee, []sdk.Msg{}))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee2, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee2, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)), []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	remaining = sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee2, remaining, []sdk.Msg{}))
+	poolRes, err := suite.feegrantKeeper.GetAllowancePool(suite.ctx, pool)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee.Add(remaining...), poolRes.TotalSpent)
+
+	// the total spend limit is required
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: suite.atom}, nil)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// the pool allowance can't be expired
+	yesterday := suite.ctx.BlockTime().AddDate(0, 0, -1)
+	err = suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{Expiration: &yesterday}, totalLimit)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *KeeperTestSuite) TestAllowancePoolOpenToNewAccounts() {
+	pool := suite.addrs[0]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 300))
+	totalLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 1_000))
+
+	err := suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.BasicAllowance{SpendLimit: fee}, totalLimit)
+	suite.Require().NoError(err)
+
+	// new accounts can draw from the pool up to the pool allowance each, so
+	// the pool allowance doesn't bound the fees paid for a single user owning
+	// many accounts, the total spend limit does
+	for i := 0; i < 3; i++ {
+		newAddr := sdk.AccAddress(fmt.Sprintf("new-account-%d", i))
+		suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, newAddr, fee, []sdk.Msg{}))
+		err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, newAddr, fee, []sdk.Msg{})
+		suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	}
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, sdk.AccAddress("new-account-3"), fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	poolRes, err := suite.feegrantKeeper.GetAllowancePool(suite.ctx, pool)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 900)), poolRes.TotalSpent)
+}
+
+func (suite *KeeperTestSuite) TestPeriodicAllowancePool() {
+	pool, grantee := suite.addrs[0], suite.addrs[1]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	err := suite.feegrantKeeper.SetAllowancePool(suite.ctx, pool, &feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: fee,
+		PeriodCanSpend:   fee,
+		PeriodReset:      suite.ctx.BlockTime(),
+	}, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee, fee, []sdk.Msg{}))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, pool, grantee, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// the grantee can spend again in the next period
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, pool, grantee, fee, []sdk.Msg{}))
+
+	usage, err := suite.feegrantKeeper.GetAllowancePoolUsage(ctx, pool, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee.Add(fee...), usage.Spent)
+}
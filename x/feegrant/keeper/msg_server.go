@@ -76,3 +76,42 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// SetAllowancePool creates or updates an allowance pool funded by the granter.
+func (k msgServer) SetAllowancePool(goCtx context.Context, msg *feegrant.MsgSetAllowancePool) (*feegrant.MsgSetAllowancePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.SetAllowancePool(ctx, granter, allowance, msg.TotalSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgSetAllowancePoolResponse{}, nil
+}
+
+// RemoveAllowancePool removes the allowance pool funded by the granter.
+func (k msgServer) RemoveAllowancePool(goCtx context.Context, msg *feegrant.MsgRemoveAllowancePool) (*feegrant.MsgRemoveAllowancePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RemoveAllowancePool(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRemoveAllowancePoolResponse{}, nil
+}
@@ -219,3 +219,92 @@ func (suite *KeeperTestSuite) TestRevokeAllowance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetAllowancePool() {
+	oneYear := suite.ctx.BlockTime().AddDate(1, 0, 0)
+
+	testCases := []struct {
+		name      string
+		req       func() *feegrant.MsgSetAllowancePool
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid granter address",
+			func() *feegrant.MsgSetAllowancePool {
+				any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+				return &feegrant.MsgSetAllowancePool{
+					Granter:   "invalid-granter",
+					Allowance: any,
+				}
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"valid: create pool",
+			func() *feegrant.MsgSetAllowancePool {
+				msg, err := feegrant.NewMsgSetAllowancePool(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+					Expiration: &oneYear,
+				}, suite.atom, suite.addrs[0])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+		{
+			"invalid: no total spend limit",
+			func() *feegrant.MsgSetAllowancePool {
+				msg, err := feegrant.NewMsgSetAllowancePool(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+				}, nil, suite.addrs[0])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"total spend limit must be positive",
+		},
+		{
+			"valid: update pool",
+			func() *feegrant.MsgSetAllowancePool {
+				msg, err := feegrant.NewMsgSetAllowancePool(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+				}, suite.atom, suite.addrs[0])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.SetAllowancePool(suite.ctx, tc.req())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveAllowancePool() {
+	msg, err := feegrant.NewMsgSetAllowancePool(&feegrant.BasicAllowance{SpendLimit: suite.atom}, suite.atom, suite.addrs[0])
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.SetAllowancePool(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	removeMsg := feegrant.NewMsgRemoveAllowancePool(suite.addrs[0])
+	_, err = suite.msgSrvr.RemoveAllowancePool(suite.ctx, &removeMsg)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RemoveAllowancePool(suite.ctx, &removeMsg)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "allowance pool not found")
+}
@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// SetAllowancePool creates or updates the allowance pool funded by the granter, paying at
+// most totalSpendLimit of fees over all of its grantees. Any usage recorded by the grantees
+// under the previous pool allowance is discarded.
+func (k Keeper) SetAllowancePool(ctx sdk.Context, granter sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, totalSpendLimit sdk.Coins) error {
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if exp != nil && exp.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	if !totalSpendLimit.IsValid() || !totalSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("total spend limit must be positive: %s", totalSpendLimit)
+	}
+
+	pool, err := feegrant.NewAllowancePool(granter, feeAllowance, totalSpendLimit, k.nextAllowancePoolSequence(ctx))
+	if err != nil {
+		return err
+	}
+
+	k.removeAllowancePoolUsages(ctx, granter)
+	if err := k.setAllowancePool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeSetFeeGrantPool,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+		),
+	)
+
+	return nil
+}
+
+// RemoveAllowancePool removes the allowance pool funded by the granter, along with the
+// usage recorded by its grantees.
+func (k Keeper) RemoveAllowancePool(ctx sdk.Context, granter sdk.AccAddress) error {
+	_, err := k.GetAllowancePool(ctx, granter)
+	if err != nil {
+		return err
+	}
+
+	k.removeAllowancePoolUsages(ctx, granter)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(feegrant.AllowancePoolKey(granter))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeRemoveFeeGrantPool,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAllowancePool returns the allowance pool funded by the granter.
+func (k Keeper) GetAllowancePool(ctx sdk.Context, granter sdk.AccAddress) (*feegrant.AllowancePool, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.AllowancePoolKey(granter))
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrap("allowance pool not found")
+	}
+
+	var pool feegrant.AllowancePool
+	if err := k.cdc.Unmarshal(bz, &pool); err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// GetAllowancePoolUsage returns the usage of the allowance pool funded by the granter by the grantee.
+// If the grantee has not used the current pool allowance yet, the returned usage holds the full
+// pool allowance.
+func (k Keeper) GetAllowancePoolUsage(ctx sdk.Context, granter, grantee sdk.AccAddress) (*feegrant.AllowancePoolUsage, error) {
+	pool, err := k.GetAllowancePool(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.getAllowancePoolUsage(ctx, pool, granter, grantee)
+}
+
+func (k Keeper) getAllowancePoolUsage(ctx sdk.Context, pool *feegrant.AllowancePool, granter, grantee sdk.AccAddress) (*feegrant.AllowancePoolUsage, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.AllowancePoolUsageKey(granter, grantee))
+	if len(bz) != 0 {
+		var usage feegrant.AllowancePoolUsage
+		if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+			return nil, err
+		}
+
+		// usage recorded under a previous pool allowance is stale.
+		if usage.Sequence == pool.Sequence {
+			return &usage, nil
+		}
+	}
+
+	return &feegrant.AllowancePoolUsage{
+		Granter:   pool.Granter,
+		Grantee:   grantee.String(),
+		Allowance: pool.Allowance,
+		Sequence:  pool.Sequence,
+	}, nil
+}
+
+// useAllowancePool pays the fee from the allowance pool funded by the granter, within the
+// limits of the grantee's copy of the pool allowance and of the pool total spend limit.
+func (k Keeper) useAllowancePool(ctx sdk.Context, pool *feegrant.AllowancePool, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	totalSpent := pool.TotalSpent.Add(fee...)
+	if !totalSpent.IsAllLTE(pool.TotalSpendLimit) {
+		return sdkerrors.Wrap(feegrant.ErrFeeLimitExceeded, "allowance pool total spend limit exceeded")
+	}
+
+	usage, err := k.getAllowancePoolUsage(ctx, pool, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	if usage.Exhausted {
+		return sdkerrors.Wrap(feegrant.ErrFeeLimitExceeded, "allowance pool exhausted")
+	}
+
+	allowance, err := usage.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil {
+		return err
+	}
+
+	updated, err := feegrant.NewAllowancePoolUsage(granter, grantee, allowance, usage.Spent.Add(fee...), usage.Sequence)
+	if err != nil {
+		return err
+	}
+
+	// the grantee can't draw from the pool again until the pool allowance is updated.
+	updated.Exhausted = remove
+
+	if err := k.setAllowancePoolUsage(ctx, updated); err != nil {
+		return err
+	}
+
+	pool.TotalSpent = totalSpent
+	if err := k.setAllowancePool(ctx, *pool); err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+	return nil
+}
+
+// IterateAllowancePools iterates over all the allowance pools in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateAllowancePools(ctx sdk.Context, cb func(pool feegrant.AllowancePool) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowancePoolKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pool feegrant.AllowancePool
+		if err := k.cdc.Unmarshal(iter.Value(), &pool); err != nil {
+			return err
+		}
+		if cb(pool) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// IterateAllowancePoolUsages iterates over the usage of all the allowance pools in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllowancePoolUsages(ctx sdk.Context, cb func(usage feegrant.AllowancePoolUsage) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowancePoolUsageKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var usage feegrant.AllowancePoolUsage
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return err
+		}
+		if cb(usage) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setAllowancePool(ctx sdk.Context, pool feegrant.AllowancePool) error {
+	granter, err := sdk.AccAddressFromBech32(pool.Granter)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&pool)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.AllowancePoolKey(granter), bz)
+
+	return nil
+}
+
+func (k Keeper) setAllowancePoolUsage(ctx sdk.Context, usage feegrant.AllowancePoolUsage) error {
+	granter, err := sdk.AccAddressFromBech32(usage.Granter)
+	if err != nil {
+		return err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(usage.Grantee)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.AllowancePoolUsageKey(granter, grantee), bz)
+
+	return nil
+}
+
+// removeAllowancePoolUsages deletes the usage recorded by all the grantees of the allowance
+// pool funded by the granter.
+func (k Keeper) removeAllowancePoolUsages(ctx sdk.Context, granter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.AllowancePoolUsagePrefixByGranter(granter))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// nextAllowancePoolSequence increments and returns the allowance pool sequence. The sequence is
+// shared by all the pools, so that the usage recorded under a previous pool allowance is never
+// mistaken for the usage of the current one.
+func (k Keeper) nextAllowancePoolSequence(ctx sdk.Context) uint64 {
+	seq := k.getAllowancePoolSequence(ctx) + 1
+	k.setAllowancePoolSequence(ctx, seq)
+
+	return seq
+}
+
+func (k Keeper) getAllowancePoolSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.AllowancePoolSequenceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setAllowancePoolSequence(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.AllowancePoolSequenceKey, sdk.Uint64ToBigEndian(seq))
+}
@@ -29,6 +29,18 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// AllowancePoolKeyPrefix is the set of the kvstore for allowance pool data
+	// - 0x02<granter_address_bytes>: allowance pool
+	AllowancePoolKeyPrefix = []byte{0x02}
+
+	// AllowancePoolUsageKeyPrefix is the set of the kvstore for allowance pool usage data
+	// - 0x03<granter_address_bytes><grantee_address_bytes>: allowance pool usage
+	AllowancePoolUsageKeyPrefix = []byte{0x03}
+
+	// AllowancePoolSequenceKey is the key of the last allowance pool sequence
+	// - 0x04: sequence
+	AllowancePoolSequenceKey = []byte{0x04}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -93,3 +105,29 @@ func ParseAddressesFromFeeAllowanceQueueKey(key []byte) (granter, grantee sdk.Ac
 
 	return granter, grantee
 }
+
+// AllowancePoolKey is the canonical key to store the allowance pool of a granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes>
+func AllowancePoolKey(granter sdk.AccAddress) []byte {
+	return append(AllowancePoolKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// AllowancePoolUsageKey is the canonical key to store the usage of the allowance pool
+// of the granter by the grantee.
+//
+// Key format:
+// - <0x03><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes>
+func AllowancePoolUsageKey(granter, grantee sdk.AccAddress) []byte {
+	return append(AllowancePoolUsagePrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// AllowancePoolUsagePrefixByGranter returns a prefix to scan for the usage of the allowance
+// pool of the given granter.
+//
+// Key format:
+// - <0x03><len(granter_address_bytes)><granter_address_bytes>
+func AllowancePoolUsagePrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(AllowancePoolUsageKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
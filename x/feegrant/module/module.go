@@ -212,7 +212,7 @@ type feegrantInputs struct {
 }
 
 func provideModule(in feegrantInputs) (keeper.Keeper, runtime.AppModuleWrapper) {
-	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper)
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper, in.BankKeeper)
 	m := NewAppModule(in.Cdc, in.AccountKeeper, in.BankKeeper, k, in.Registry)
 	return k, runtime.WrapAppModule(m)
 }
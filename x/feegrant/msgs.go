@@ -10,10 +10,11 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _, _, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgSetAllowancePool{}, &MsgRemoveAllowancePool{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgSetAllowancePool{}, &MsgRemoveAllowancePool{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_ types.UnpackInterfacesMessage = &MsgSetAllowancePool{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -134,3 +135,107 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgSetAllowancePool creates a new MsgSetAllowancePool.
+//nolint:interfacer
+func NewMsgSetAllowancePool(feeAllowance FeeAllowanceI, totalSpendLimit sdk.Coins, granter sdk.AccAddress) (*MsgSetAllowancePool, error) {
+	any, err := packFeeAllowance(feeAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSetAllowancePool{
+		Granter:         granter.String(),
+		Allowance:       any,
+		TotalSpendLimit: totalSpendLimit,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetAllowancePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if err := validateTotalSpendLimit(msg.TotalSpendLimit); err != nil {
+		return err
+	}
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSigners gets the granter account funding the allowance pool
+func (msg MsgSetAllowancePool) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetAllowancePool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetAllowancePool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSetAllowancePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgSetAllowancePool) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSetAllowancePool) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRemoveAllowancePool returns a message to remove the allowance pool of the granter
+//nolint:interfacer
+func NewMsgRemoveAllowancePool(granter sdk.AccAddress) MsgRemoveAllowancePool {
+	return MsgRemoveAllowancePool{Granter: granter.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveAllowancePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners gets the granter account funding the allowance pool
+func (msg MsgRemoveAllowancePool) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRemoveAllowancePool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRemoveAllowancePool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRemoveAllowancePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -134,6 +134,65 @@ func TestMsgRevokeAllowance(t *testing.T) {
 	}
 }
 
+func TestMsgSetAllowancePool(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	cases := map[string]struct {
+		granter    sdk.AccAddress
+		grant      feegrant.FeeAllowanceI
+		totalLimit sdk.Coins
+		valid      bool
+	}{
+		"valid": {
+			granter:    addr,
+			grant:      &feegrant.BasicAllowance{SpendLimit: atom},
+			totalLimit: atom,
+			valid:      true,
+		},
+		"no granter": {
+			granter:    sdk.AccAddress{},
+			grant:      &feegrant.BasicAllowance{SpendLimit: atom},
+			totalLimit: atom,
+			valid:      false,
+		},
+		"invalid allowance": {
+			granter:    addr,
+			grant:      &feegrant.PeriodicAllowance{},
+			totalLimit: atom,
+			valid:      false,
+		},
+		"no total spend limit": {
+			granter: addr,
+			grant:   &feegrant.BasicAllowance{SpendLimit: atom},
+			valid:   false,
+		},
+		"zero total spend limit": {
+			granter:    addr,
+			grant:      &feegrant.BasicAllowance{SpendLimit: atom},
+			totalLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)},
+			valid:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg, err := feegrant.NewMsgSetAllowancePool(tc.grant, tc.totalLimit, tc.granter)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			require.True(t, tc.granter.Equals(msg.GetSigners()[0]))
+		} else {
+			require.Error(t, err)
+		}
+	}
+
+	removeMsg := feegrant.NewMsgRemoveAllowancePool(addr)
+	require.NoError(t, removeMsg.ValidateBasic())
+	removeMsg = feegrant.NewMsgRemoveAllowancePool(sdk.AccAddress{})
+	require.Error(t, removeMsg.ValidateBasic())
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var msg legacytx.LegacyMsg
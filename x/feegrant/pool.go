@@ -0,0 +1,140 @@
+package feegrant
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &AllowancePool{}
+	_ types.UnpackInterfacesMessage = &AllowancePoolUsage{}
+)
+
+// NewAllowancePool creates a new AllowancePool.
+//nolint:interfacer
+func NewAllowancePool(granter sdk.AccAddress, feeAllowance FeeAllowanceI, totalSpendLimit sdk.Coins, sequence uint64) (AllowancePool, error) {
+	any, err := packFeeAllowance(feeAllowance)
+	if err != nil {
+		return AllowancePool{}, err
+	}
+
+	return AllowancePool{
+		Granter:         granter.String(),
+		Allowance:       any,
+		Sequence:        sequence,
+		TotalSpendLimit: totalSpendLimit,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on AllowancePool
+func (p AllowancePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if err := validateTotalSpendLimit(p.TotalSpendLimit); err != nil {
+		return err
+	}
+
+	if !p.TotalSpent.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("total spent is invalid: %s", p.TotalSpent)
+	}
+
+	f, err := p.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return f.ValidateBasic()
+}
+
+// GetFeeAllowanceI unpacks the pool allowance
+func (p AllowancePool) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := p.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p AllowancePool) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(p.Allowance, &allowance)
+}
+
+// NewAllowancePoolUsage creates a new AllowancePoolUsage.
+//nolint:interfacer
+func NewAllowancePoolUsage(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI, spent sdk.Coins, sequence uint64) (AllowancePoolUsage, error) {
+	any, err := packFeeAllowance(feeAllowance)
+	if err != nil {
+		return AllowancePoolUsage{}, err
+	}
+
+	return AllowancePoolUsage{
+		Granter:   granter.String(),
+		Grantee:   grantee.String(),
+		Allowance: any,
+		Spent:     spent,
+		Sequence:  sequence,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on AllowancePoolUsage
+func (u AllowancePoolUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if !u.Spent.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, u.Spent.String())
+	}
+
+	f, err := u.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return f.ValidateBasic()
+}
+
+// GetFeeAllowanceI unpacks the grantee's allowance
+func (u AllowancePoolUsage) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := u.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (u AllowancePoolUsage) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(u.Allowance, &allowance)
+}
+
+func packFeeAllowance(feeAllowance FeeAllowanceI) (*types.Any, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", feeAllowance)
+	}
+
+	return types.NewAnyWithValue(msg)
+}
+
+// validateTotalSpendLimit checks that the total spend limit of an allowance pool is set
+// and positive, so that the fees paid by the pool are always capped.
+func validateTotalSpendLimit(totalSpendLimit sdk.Coins) error {
+	if !totalSpendLimit.IsValid() || !totalSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("total spend limit must be positive: %s", totalSpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package feegrant
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*RestrictedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*RestrictedAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *RestrictedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewRestrictedAllowance creates a new restricted fee allowance. The number of transactions
+// is counted from the given period reset, which should be the current block time.
+func NewRestrictedAllowance(allowance FeeAllowanceI, maxGasPerTx uint64, maxFeePerTx sdk.Coins,
+	allowedAddresses []string, maxTxsPerPeriod uint64, period time.Duration, periodReset time.Time,
+) (*RestrictedAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestrictedAllowance{
+		Allowance:        any,
+		MaxGasPerTx:      maxGasPerTx,
+		MaxFeePerTx:      maxFeePerTx,
+		AllowedAddresses: allowedAddresses,
+		MaxTxsPerPeriod:  maxTxsPerPeriod,
+		Period:           period,
+		PeriodReset:      periodReset,
+	}, nil
+}
+
+// GetAllowance returns the restricted fee allowance.
+func (a *RestrictedAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the restricted fee allowance.
+func (a *RestrictedAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks the transaction against the restrictions before passing the fee to the
+// restricted allowance.
+//
+// The gas limit of the transaction is read from the gas meter of the context. Transactions
+// executed without a gas limit, such as simulations, are not restricted by MaxGasPerTx.
+func (a *RestrictedAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.MaxGasPerTx > 0 {
+		if limit := ctx.GasMeter().Limit(); limit != math.MaxUint64 && limit > a.MaxGasPerTx {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "gas limit %d exceeds the maximum of %d per tx", limit, a.MaxGasPerTx)
+		}
+	}
+
+	if !a.MaxFeePerTx.Empty() && !fee.IsAllLTE(a.MaxFeePerTx) {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "fee %s exceeds the maximum of %s per tx", fee, a.MaxFeePerTx)
+	}
+
+	if len(a.AllowedAddresses) > 0 {
+		if err := a.checkAddresses(ctx, msgs); err != nil {
+			return false, err
+		}
+	}
+
+	if a.MaxTxsPerPeriod > 0 {
+		a.tryResetPeriod(ctx.BlockTime())
+		if a.PeriodTxCount >= a.MaxTxsPerPeriod {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "maximum of %d txs per period reached", a.MaxTxsPerPeriod)
+		}
+		a.PeriodTxCount++
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// tryResetPeriod resets the transaction count once the period has ended. The next period
+// ends one Period after the last one, or one Period from the block time if more than one
+// period has passed.
+func (a *RestrictedAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodTxCount = 0
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// checkAddresses checks that every bech32 address found in the messages is either one of
+// their signers or an allowed address.
+func (a *RestrictedAllowance) checkAddresses(ctx sdk.Context, msgs []sdk.Msg) error {
+	allowed := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+		allowed[addr] = true
+	}
+
+	for _, msg := range msgs {
+		signers := make(map[string]bool)
+		for _, signer := range msg.GetSigners() {
+			signers[signer.String()] = true
+		}
+
+		bz, err := codec.ProtoMarshalJSON(msg, nil)
+		if err != nil {
+			return err
+		}
+
+		var content interface{}
+		if err := json.Unmarshal(bz, &content); err != nil {
+			return err
+		}
+
+		for _, addr := range addressesOf(content) {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+			if !signers[addr] && !allowed[addr] {
+				return sdkerrors.Wrapf(ErrMessageNotAllowed, "address %s is not allowed", addr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addressesOf returns the bech32 strings found in the decoded JSON value, in a deterministic order.
+func addressesOf(v interface{}) []string {
+	var addrs []string
+	switch v := v.(type) {
+	case string:
+		if _, _, err := bech32.DecodeAndConvert(v); err == nil {
+			addrs = append(addrs, v)
+		}
+	case []interface{}:
+		for _, item := range v {
+			addrs = append(addrs, addressesOf(item)...)
+		}
+	case map[string]interface{}:
+		// iterate in key order, so the gas consumed is deterministic.
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			addrs = append(addrs, addressesOf(v[k])...)
+		}
+	}
+
+	return addrs
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *RestrictedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if !a.MaxFeePerTx.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max fee per tx is invalid: %s", a.MaxFeePerTx)
+	}
+
+	for _, addr := range a.AllowedAddresses {
+		if _, _, err := bech32.DecodeAndConvert(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed address %s: %s", addr, err)
+		}
+	}
+
+	if a.Period < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+	if a.MaxTxsPerPeriod > 0 && a.Period == 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period is required to limit the number of txs")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *RestrictedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
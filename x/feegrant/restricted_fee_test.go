@@ -0,0 +1,210 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRestrictedAllowance(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	now := time.Now()
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: now})
+
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+
+	send := func(to sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(sender, to, smallAtom)
+	}
+
+	cases := map[string]struct {
+		allowance *feegrant.RestrictedAllowance
+		gasLimit  uint64
+		fee       sdk.Coins
+		msgs      []sdk.Msg
+		accept    bool
+	}{
+		"no restrictions": {
+			allowance: &feegrant.RestrictedAllowance{},
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{send(other)},
+			accept:    true,
+		},
+		"gas limit within max": {
+			allowance: &feegrant.RestrictedAllowance{MaxGasPerTx: 200000},
+			gasLimit:  200000,
+			fee:       smallAtom,
+			accept:    true,
+		},
+		"gas limit above max": {
+			allowance: &feegrant.RestrictedAllowance{MaxGasPerTx: 200000},
+			gasLimit:  200001,
+			fee:       smallAtom,
+			accept:    false,
+		},
+		"fee within max": {
+			allowance: &feegrant.RestrictedAllowance{MaxFeePerTx: smallAtom},
+			fee:       smallAtom,
+			accept:    true,
+		},
+		"fee above max": {
+			allowance: &feegrant.RestrictedAllowance{MaxFeePerTx: smallAtom},
+			fee:       atom,
+			accept:    false,
+		},
+		"fee denom not in max": {
+			allowance: &feegrant.RestrictedAllowance{MaxFeePerTx: smallAtom},
+			fee:       eth,
+			accept:    false,
+		},
+		"allowed address": {
+			allowance: &feegrant.RestrictedAllowance{AllowedAddresses: []string{recipient.String()}},
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{send(recipient)},
+			accept:    true,
+		},
+		"not allowed address": {
+			allowance: &feegrant.RestrictedAllowance{AllowedAddresses: []string{recipient.String()}},
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{send(recipient), send(other)},
+			accept:    false,
+		},
+		"not allowed validator address": {
+			allowance: &feegrant.RestrictedAllowance{AllowedAddresses: []string{recipient.String()}},
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{stakingtypes.NewMsgDelegate(sender, sdk.ValAddress(other), sdk.NewInt64Coin("atom", 1))},
+			accept:    false,
+		},
+		"allowed validator address": {
+			allowance: &feegrant.RestrictedAllowance{AllowedAddresses: []string{sdk.ValAddress(other).String()}},
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{stakingtypes.NewMsgDelegate(sender, sdk.ValAddress(other), sdk.NewInt64Coin("atom", 1))},
+			accept:    true,
+		},
+		"inner allowance exceeded": {
+			allowance: &feegrant.RestrictedAllowance{MaxFeePerTx: atom},
+			fee:       atom,
+			accept:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.SetAllowance(&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))})
+			require.NoError(t, err)
+			require.NoError(t, tc.allowance.ValidateBasic())
+
+			ctx := ctx
+			if tc.gasLimit > 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			_, err = tc.allowance.Accept(ctx, tc.fee, tc.msgs)
+			if tc.accept {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRestrictedAllowanceTxsPerPeriod(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	now := time.Now()
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: now})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+
+	allowance, err := feegrant.NewRestrictedAllowance(&feegrant.BasicAllowance{}, 0, nil, nil, 2, 24*time.Hour, now)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the period starts with the first tx
+	_, err = allowance.Accept(ctx, fee, nil)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(24*time.Hour), allowance.PeriodReset)
+
+	_, err = allowance.Accept(ctx.WithBlockTime(now.Add(time.Hour)), fee, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), allowance.PeriodTxCount)
+
+	_, err = allowance.Accept(ctx.WithBlockTime(now.Add(2*time.Hour)), fee, nil)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	// the count is reset in the next period
+	_, err = allowance.Accept(ctx.WithBlockTime(now.Add(25*time.Hour)), fee, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), allowance.PeriodTxCount)
+	require.Equal(t, now.Add(48*time.Hour), allowance.PeriodReset)
+
+	// the inner allowance is removed once used up
+	allowance, err = feegrant.NewRestrictedAllowance(&feegrant.BasicAllowance{SpendLimit: fee}, 0, nil, nil, 0, 0, now)
+	require.NoError(t, err)
+	remove, err := allowance.Accept(ctx, fee, nil)
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestRestrictedAllowanceValidateBasic(t *testing.T) {
+	basic := &feegrant.BasicAllowance{}
+
+	cases := map[string]struct {
+		allowedAddresses []string
+		maxTxsPerPeriod  uint64
+		period           time.Duration
+		valid            bool
+	}{
+		"valid": {
+			allowedAddresses: []string{"cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr"},
+			maxTxsPerPeriod:  10,
+			period:           time.Hour,
+			valid:            true,
+		},
+		"invalid allowed address": {
+			allowedAddresses: []string{"cosmos1invalid"},
+			valid:            false,
+		},
+		"max txs without period": {
+			maxTxsPerPeriod: 10,
+			valid:           false,
+		},
+		"negative period": {
+			period: -time.Hour,
+			valid:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewRestrictedAllowance(basic, 0, nil, tc.allowedAddresses, tc.maxTxsPerPeriod, tc.period, time.Now())
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, (&feegrant.RestrictedAllowance{}).ValidateBasic())
+}
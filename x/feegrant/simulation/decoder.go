@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -19,6 +20,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.AllowancePoolKeyPrefix):
+			var poolA, poolB feegrant.AllowancePool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], feegrant.AllowancePoolUsageKeyPrefix):
+			var usageA, usageB feegrant.AllowancePoolUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+		case bytes.Equal(kvA.Key[:1], feegrant.AllowancePoolSequenceKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
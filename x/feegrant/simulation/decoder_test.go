@@ -36,9 +36,19 @@ func TestDecodeStore(t *testing.T) {
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
 
+	pool, err := feegrant.NewAllowancePool(granterAddr, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10))),
+	}, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))), 1)
+	require.NoError(t, err)
+
+	poolBz, err := cdc.Marshal(&pool)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(feegrant.FeeAllowanceKeyPrefix), Value: grantBz},
+			{Key: feegrant.AllowancePoolKey(granterAddr), Value: poolBz},
+			{Key: feegrant.AllowancePoolSequenceKey, Value: sdk.Uint64ToBigEndian(1)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -48,6 +58,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"AllowancePool", fmt.Sprintf("%v\n%v", pool, pool)},
+		{"AllowancePoolSequence", "1\n1"},
 		{"other", ""},
 	}
 
@@ -8,7 +8,10 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -224,41 +227,252 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgSetAllowancePool creates or updates the allowance pool of Granter. Each
+// account drawing from the pool can spend up to Allowance of fees from the
+// account of Granter, and all of them together up to TotalSpendLimit.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetAllowancePool struct {
+	// granter is the address of the pool account paying the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// allowance is the allowance given to every grantee of the pool, it can be
+	// any of basic, periodic, allowed fee allowance.
+	Allowance *types.Any `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// total_spend_limit is the maximum amount of fees paid by the pool over all
+	// of its grantees.
+	TotalSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_spend_limit,json=totalSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spend_limit"`
+}
+
+func (m *MsgSetAllowancePool) Reset()         { *m = MsgSetAllowancePool{} }
+func (m *MsgSetAllowancePool) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowancePool) ProtoMessage()    {}
+func (*MsgSetAllowancePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgSetAllowancePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowancePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowancePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowancePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowancePool.Merge(m, src)
+}
+func (m *MsgSetAllowancePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowancePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowancePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowancePool proto.InternalMessageInfo
+
+func (m *MsgSetAllowancePool) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgSetAllowancePool) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *MsgSetAllowancePool) GetTotalSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpendLimit
+	}
+	return nil
+}
+
+// MsgSetAllowancePoolResponse defines the Msg/SetAllowancePool response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetAllowancePoolResponse struct {
+}
+
+func (m *MsgSetAllowancePoolResponse) Reset()         { *m = MsgSetAllowancePoolResponse{} }
+func (m *MsgSetAllowancePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowancePoolResponse) ProtoMessage()    {}
+func (*MsgSetAllowancePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgSetAllowancePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowancePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowancePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowancePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowancePoolResponse.Merge(m, src)
+}
+func (m *MsgSetAllowancePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowancePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowancePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowancePoolResponse proto.InternalMessageInfo
+
+// MsgRemoveAllowancePool removes the allowance pool of Granter.
+//
+// Since: cosmos-sdk 0.47
+type MsgRemoveAllowancePool struct {
+	// granter is the address of the pool account paying the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRemoveAllowancePool) Reset()         { *m = MsgRemoveAllowancePool{} }
+func (m *MsgRemoveAllowancePool) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowancePool) ProtoMessage()    {}
+func (*MsgRemoveAllowancePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgRemoveAllowancePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowancePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowancePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowancePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowancePool.Merge(m, src)
+}
+func (m *MsgRemoveAllowancePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowancePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowancePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowancePool proto.InternalMessageInfo
+
+func (m *MsgRemoveAllowancePool) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// MsgRemoveAllowancePoolResponse defines the Msg/RemoveAllowancePool response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgRemoveAllowancePoolResponse struct {
+}
+
+func (m *MsgRemoveAllowancePoolResponse) Reset()         { *m = MsgRemoveAllowancePoolResponse{} }
+func (m *MsgRemoveAllowancePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowancePoolResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowancePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgRemoveAllowancePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowancePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowancePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowancePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowancePoolResponse.Merge(m, src)
+}
+func (m *MsgRemoveAllowancePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowancePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowancePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowancePoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgSetAllowancePool)(nil), "cosmos.feegrant.v1beta1.MsgSetAllowancePool")
+	proto.RegisterType((*MsgSetAllowancePoolResponse)(nil), "cosmos.feegrant.v1beta1.MsgSetAllowancePoolResponse")
+	proto.RegisterType((*MsgRemoveAllowancePool)(nil), "cosmos.feegrant.v1beta1.MsgRemoveAllowancePool")
+	proto.RegisterType((*MsgRemoveAllowancePoolResponse)(nil), "cosmos.feegrant.v1beta1.MsgRemoveAllowancePoolResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0x88, 0x84, 0xd2, 0x05, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x1d, 0x8e, 0x39,
-	0x39, 0xf9, 0xe5, 0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x5b, 0x53, 0x8b, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x9a, 0xe8, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93,
-	0x2a, 0xc1, 0x44, 0x9c, 0x9e, 0x54, 0x21, 0x57, 0x2e, 0xce, 0x44, 0x98, 0xa5, 0x12, 0xcc, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x22, 0x7a, 0x10, 0x0f, 0xea, 0xc1, 0x3c, 0xa8, 0xe7, 0x98, 0x57, 0xe9,
-	0x24, 0x78, 0x6a, 0x8b, 0x2e, 0xaf, 0x5b, 0x6a, 0x2a, 0xdc, 0x89, 0x9e, 0x41, 0x08, 0x9d, 0x56,
-	0x3c, 0x4d, 0xcf, 0x37, 0x68, 0xc1, 0x1c, 0xa2, 0x24, 0xcd, 0x25, 0x89, 0xe1, 0xa3, 0xa0, 0xd4,
-	0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa5, 0x2e, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4,
-	0xb2, 0xfc, 0xec, 0x54, 0xba, 0x7b, 0x18, 0xcd, 0xa5, 0x32, 0x5c, 0x52, 0x98, 0x6e, 0x81, 0x39,
-	0xd5, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x01, 0x17, 0x1f, 0x5a, 0xf4, 0x68,
-	0xe9, 0xe1, 0x48, 0x1d, 0x7a, 0x18, 0x1e, 0x97, 0x32, 0x22, 0x5e, 0x2d, 0xcc, 0x66, 0xa1, 0x62,
-	0x2e, 0x7e, 0xf4, 0x00, 0xd2, 0xc6, 0x67, 0x0c, 0x9a, 0x62, 0x29, 0x63, 0x12, 0x14, 0xc3, 0x2c,
-	0x75, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x68, 0xaa, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9,
-	0xfa, 0x15, 0xf0, 0x5c, 0x94, 0xc4, 0x06, 0x4e, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xb7, 0x32, 0x99, 0x5f, 0x03, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x13, 0xe9, 0xfb, 0xd4, 0x29, 0x50, 0xe2, 0x46, 0x90, 0xb8, 0xe0, 0x46, 0xd9, 0x10,
+	0x15, 0x32, 0x43, 0x52, 0x24, 0x24, 0x76, 0x09, 0x02, 0x84, 0x44, 0x24, 0x94, 0xec, 0xba, 0x89,
+	0x9c, 0xe4, 0x76, 0xb0, 0x6a, 0x7b, 0x2c, 0xcf, 0xd4, 0x6d, 0x56, 0x48, 0x2c, 0x59, 0xb1, 0xe3,
+	0x1d, 0x58, 0xb1, 0xe8, 0x43, 0x54, 0xac, 0x22, 0x56, 0xac, 0x00, 0x25, 0x42, 0xbc, 0x06, 0xf2,
+	0xcf, 0x38, 0xc2, 0x0e, 0x51, 0x53, 0x24, 0x56, 0x93, 0xe8, 0x9e, 0x7b, 0xcf, 0xb9, 0xc7, 0x67,
+	0x6c, 0x54, 0x1d, 0x31, 0x6e, 0x33, 0x4e, 0x0e, 0x01, 0xa8, 0x67, 0x38, 0x82, 0xf8, 0xcd, 0x21,
+	0x08, 0xa3, 0x49, 0xc4, 0x29, 0x76, 0x3d, 0x26, 0x98, 0x7a, 0x33, 0x42, 0x60, 0x89, 0xc0, 0x31,
+	0x42, 0x2b, 0x51, 0x46, 0x59, 0x88, 0x21, 0xc1, 0xaf, 0x08, 0xae, 0x55, 0x28, 0x63, 0xd4, 0x02,
+	0x12, 0xfe, 0x1b, 0x1e, 0x1f, 0x12, 0xc3, 0x99, 0xc8, 0x52, 0x34, 0x69, 0x10, 0xf5, 0xc4, 0x63,
+	0xa3, 0x92, 0x1e, 0xcb, 0x18, 0x1a, 0x1c, 0x12, 0x09, 0x23, 0x66, 0x3a, 0x71, 0x3d, 0x16, 0x41,
+	0x6c, 0x4e, 0x89, 0xdf, 0x0c, 0x8e, 0xa8, 0x50, 0x9b, 0x2a, 0xa8, 0xd8, 0xe5, 0xf4, 0x59, 0xa0,
+	0xac, 0x6d, 0x59, 0xec, 0xc4, 0x70, 0x46, 0xa0, 0xb6, 0xd0, 0xff, 0xa1, 0x56, 0xf0, 0xca, 0x4a,
+	0x55, 0xa9, 0x6f, 0x74, 0xca, 0x9f, 0xcf, 0x1a, 0xa5, 0x98, 0xb1, 0x3d, 0x1e, 0x7b, 0xc0, 0x79,
+	0x5f, 0x78, 0xa6, 0x43, 0x7b, 0x12, 0xb8, 0xe8, 0x81, 0x72, 0xfe, 0x62, 0x3d, 0xa0, 0x3e, 0x41,
+	0x1b, 0x86, 0x24, 0x2d, 0x17, 0xaa, 0x4a, 0x7d, 0xb3, 0x55, 0xc2, 0x91, 0x01, 0x58, 0x1a, 0x80,
+	0xdb, 0xce, 0xa4, 0x53, 0xfc, 0x74, 0xd6, 0xb8, 0xfa, 0x14, 0x20, 0x91, 0xf8, 0xbc, 0xb7, 0xe8,
+	0x7c, 0x74, 0xe5, 0xcd, 0xcf, 0x8f, 0x7b, 0x52, 0x48, 0x6d, 0x07, 0x55, 0x32, 0x1b, 0xf5, 0x80,
+	0xbb, 0xcc, 0xe1, 0x50, 0x7b, 0xab, 0x20, 0xb5, 0xcb, 0x69, 0x0f, 0x7c, 0x76, 0x04, 0xff, 0x7c,
+	0xe1, 0x94, 0xd2, 0x5b, 0x48, 0xcb, 0x6a, 0x49, 0xa4, 0xbe, 0xcf, 0xa3, 0xed, 0x2e, 0xa7, 0x7d,
+	0x58, 0xac, 0xf1, 0x92, 0x31, 0xeb, 0x52, 0x5a, 0x7f, 0x33, 0x3a, 0x7f, 0x59, 0xa3, 0xd5, 0x13,
+	0x54, 0x14, 0x4c, 0x18, 0xd6, 0x80, 0xbb, 0xe0, 0x8c, 0x07, 0x96, 0x69, 0x9b, 0xa2, 0x5c, 0xa8,
+	0x16, 0xea, 0x9b, 0xad, 0x0a, 0x8e, 0x15, 0x04, 0x11, 0x94, 0x19, 0xc7, 0x8f, 0x99, 0xe9, 0x74,
+	0xee, 0x9f, 0x7f, 0xdd, 0xcd, 0x7d, 0xf8, 0xb6, 0x5b, 0xa7, 0xa6, 0x78, 0x75, 0x3c, 0xc4, 0x23,
+	0x66, 0xc7, 0xe9, 0x8d, 0x8f, 0x06, 0x1f, 0x1f, 0x11, 0x31, 0x71, 0x81, 0x87, 0x0d, 0xbc, 0xb7,
+	0x15, 0xb2, 0xf4, 0x03, 0x92, 0x17, 0x01, 0x47, 0xca, 0xb7, 0xdb, 0x68, 0x67, 0x89, 0x31, 0x89,
+	0x71, 0x07, 0xe8, 0x46, 0x68, 0xab, 0xcd, 0x7c, 0xf8, 0x6b, 0xeb, 0x52, 0xd4, 0x55, 0xa4, 0x2f,
+	0x9f, 0x2d, 0xd9, 0x5b, 0x3f, 0x0a, 0xa8, 0xd0, 0xe5, 0x54, 0x75, 0xd1, 0xb5, 0xd4, 0xad, 0xda,
+	0xc3, 0x7f, 0x78, 0x15, 0xe0, 0x4c, 0x5e, 0xb5, 0xd6, 0xc5, 0xb1, 0x92, 0x59, 0xe5, 0x68, 0x2b,
+	0x9d, 0xeb, 0xbb, 0xab, 0xc6, 0xa4, 0xc0, 0xda, 0xfe, 0x1a, 0xe0, 0x84, 0xd4, 0x47, 0xd7, 0x33,
+	0x09, 0xbd, 0xb7, 0x6a, 0x50, 0x1a, 0xad, 0x3d, 0x58, 0x07, 0x9d, 0xf0, 0xbe, 0x46, 0xdb, 0xcb,
+	0x9e, 0x30, 0x59, 0xbd, 0x43, 0xa6, 0x41, 0x7b, 0xb8, 0x66, 0x83, 0x14, 0xd0, 0x69, 0x9f, 0xcf,
+	0x74, 0x65, 0x3a, 0xd3, 0x95, 0xef, 0x33, 0x5d, 0x79, 0x37, 0xd7, 0x73, 0xd3, 0xb9, 0x9e, 0xfb,
+	0x32, 0xd7, 0x73, 0x07, 0x77, 0x56, 0xe6, 0xfc, 0x34, 0xf9, 0x56, 0x0c, 0xff, 0x0b, 0xaf, 0xde,
+	0xfe, 0xaf, 0x01, 0x00, 0x8a, 0x0a, 0x0d, 0xde, 0x45, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +493,16 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// SetAllowancePool creates or updates an allowance pool funded by the
+	// granter's account, which any account can use to pay its fees.
+	//
+	// Since: cosmos-sdk 0.47
+	SetAllowancePool(ctx context.Context, in *MsgSetAllowancePool, opts ...grpc.CallOption) (*MsgSetAllowancePoolResponse, error)
+	// RemoveAllowancePool removes the allowance pool funded by the granter's
+	// account.
+	//
+	// Since: cosmos-sdk 0.47
+	RemoveAllowancePool(ctx context.Context, in *MsgRemoveAllowancePool, opts ...grpc.CallOption) (*MsgRemoveAllowancePoolResponse, error)
 }
 
 type msgClient struct {
@@ -307,6 +531,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) SetAllowancePool(ctx context.Context, in *MsgSetAllowancePool, opts ...grpc.CallOption) (*MsgSetAllowancePoolResponse, error) {
+	out := new(MsgSetAllowancePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/SetAllowancePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowancePool(ctx context.Context, in *MsgRemoveAllowancePool, opts ...grpc.CallOption) (*MsgRemoveAllowancePoolResponse, error) {
+	out := new(MsgRemoveAllowancePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RemoveAllowancePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -315,6 +557,16 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// SetAllowancePool creates or updates an allowance pool funded by the
+	// granter's account, which any account can use to pay its fees.
+	//
+	// Since: cosmos-sdk 0.47
+	SetAllowancePool(context.Context, *MsgSetAllowancePool) (*MsgSetAllowancePoolResponse, error)
+	// RemoveAllowancePool removes the allowance pool funded by the granter's
+	// account.
+	//
+	// Since: cosmos-sdk 0.47
+	RemoveAllowancePool(context.Context, *MsgRemoveAllowancePool) (*MsgRemoveAllowancePoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -327,6 +579,12 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) SetAllowancePool(ctx context.Context, req *MsgSetAllowancePool) (*MsgSetAllowancePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowancePool not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowancePool(ctx context.Context, req *MsgRemoveAllowancePool) (*MsgRemoveAllowancePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowancePool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -368,6 +626,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAllowancePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowancePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowancePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/SetAllowancePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowancePool(ctx, req.(*MsgSetAllowancePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowancePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowancePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowancePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RemoveAllowancePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowancePool(ctx, req.(*MsgRemoveAllowancePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -380,6 +674,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "SetAllowancePool",
+			Handler:    _Msg_SetAllowancePool_Handler,
+		},
+		{
+			MethodName: "RemoveAllowancePool",
+			Handler:    _Msg_RemoveAllowancePool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -517,6 +819,138 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAllowancePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowancePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowancePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalSpendLimit) > 0 {
+		for iNdEx := len(m.TotalSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllowancePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowancePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowancePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowancePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowancePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowancePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowancePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowancePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowancePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -572,25 +1006,279 @@ func (m *MsgRevokeAllowance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAllowancePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TotalSpendLimit) > 0 {
+		for _, e := range m.TotalSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAllowancePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowancePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowancePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -613,10 +1301,10 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -683,7 +1371,139 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllowancePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowancePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowancePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
@@ -719,6 +1539,40 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSpendLimit = append(m.TotalSpendLimit, types1.Coin{})
+			if err := m.TotalSpendLimit[len(m.TotalSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -740,7 +1594,7 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAllowancePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -763,10 +1617,10 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAllowancePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAllowancePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -790,7 +1644,7 @@ func (m *MsgGrantAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowancePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -813,10 +1667,10 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowancePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowancePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -851,38 +1705,6 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -904,7 +1726,7 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowancePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -927,10 +1749,10 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowancePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowancePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
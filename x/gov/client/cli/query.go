@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -38,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQuerySimulateProposal(),
 	)
 
 	return govQueryCmd
@@ -508,6 +510,61 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQuerySimulateProposal implements the query simulate-proposal command.
+func GetCmdQuerySimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id|path/to/proposal.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of the messages of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an existing proposal, or of a proposal yet to be
+submitted, with the governance module account as signer. No state change is
+persisted. The proposal file has the same format as the one of the
+submit-proposal command.
+
+Example:
+$ %s query gov simulate-proposal 1
+$ %s query gov simulate-proposal path/to/proposal.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			req := &v1.QuerySimulateProposalRequest{}
+			if proposalID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				req.ProposalId = proposalID
+			} else {
+				msgs, _, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+				if err != nil {
+					return err
+				}
+
+				req.Messages, err = sdktx.SetMsgs(msgs)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -576,7 +633,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|tracks) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -584,8 +641,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param tracks
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -612,8 +670,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "tracks":
+				out = res.GetTrackParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|tracks), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
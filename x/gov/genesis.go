@@ -15,6 +15,9 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, *data.DepositParams)
 	k.SetVotingParams(ctx, *data.VotingParams)
 	k.SetTallyParams(ctx, *data.TallyParams)
+	if data.TrackParams != nil {
+		k.SetTrackParams(ctx, *data.TrackParams)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -60,6 +63,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	trackParams := k.GetTrackParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1.Deposits
@@ -80,5 +84,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		DepositParams:      &depositParams,
 		VotingParams:       &votingParams,
 		TallyParams:        &tallyParams,
+		TrackParams:        &trackParams,
 	}
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func TestDeposits(t *testing.T) {
@@ -111,3 +113,41 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestDepositsTrackMinDeposit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000000))
+
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)))
+	tenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	trackVotingPeriod := v1.DefaultPeriod * 3
+
+	app.GovKeeper.SetTrackParams(ctx, v1.NewTrackParams(v1.NewTrack(
+		"send", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		fiveStake.Add(tenStake...), trackVotingPeriod,
+		v1.DefaultQuorum, v1.DefaultThreshold, v1.DefaultVetoThreshold,
+	)))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+	require.NoError(t, err)
+	require.Equal(t, "send,default", proposal.Track.Name)
+	require.Empty(t, proposal.Track.MsgTypeUrls)
+
+	// the track is snapshotted at submission, changing params doesn't affect the proposal
+	app.GovKeeper.SetTrackParams(ctx, v1.NewTrackParams())
+
+	// the module-wide min deposit is not enough for a proposal on the track
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], tenStake)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], fiveStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockHeader().Time.Add(trackVotingPeriod), *proposal.VotingEndTime)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -172,6 +173,10 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 		tallyParams := q.GetTallyParams(ctx)
 		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
+	case v1.ParamTracks:
+		trackParams := q.GetTrackParams(ctx)
+		return &v1.QueryParamsResponse{TrackParams: &trackParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -273,6 +278,60 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// SimulateProposal executes the messages of a proposal in a cached context
+func (q Keeper) SimulateProposal(c context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		messages []sdk.Msg
+		err      error
+	)
+
+	switch {
+	case req.ProposalId != 0 && len(req.Messages) != 0:
+		return nil, status.Error(codes.InvalidArgument, "proposal id and messages can not be both set")
+
+	case req.ProposalId != 0:
+		proposal, ok := q.GetProposal(ctx, req.ProposalId)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+
+		messages, err = proposal.GetMsgs()
+
+	case uint64(len(req.Messages)) > q.config.MaxSimulateProposalMsgs:
+		return nil, status.Errorf(codes.InvalidArgument, "cannot simulate more than %d messages", q.config.MaxSimulateProposalMsgs)
+
+	case len(req.Messages) != 0:
+		messages, err = sdktx.GetMsgs(req.Messages, "sdk.MsgProposal")
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "proposal id or messages must be set")
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasUsed, events, msgIdx, err := q.SimulateProposalMsgs(ctx, messages)
+	res := &v1.QuerySimulateProposalResponse{
+		Success: err == nil,
+		GasUsed: gasUsed,
+		Events:  events,
+	}
+
+	if err != nil {
+		res.Error = err.Error()
+		res.FailedMsgIndex = uint64(msgIdx)
+	}
+
+	return res, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -4,10 +4,14 @@ import (
 	gocontext "context"
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v046 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -1461,6 +1465,135 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	var (
+		req    *v1.QuerySimulateProposalRequest
+		expRes *v1.QuerySimulateProposalResponse
+	)
+
+	sendMsgs := func(msgs ...sdk.Msg) []*codectypes.Any {
+		anys, err := sdktx.SetMsgs(msgs)
+		suite.Require().NoError(err)
+		return anys
+	}
+
+	validMsg := banktypes.NewMsgSend(govAcct, addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+	wrongAuthorityMsg := banktypes.NewMsgSend(addrs[1], addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+	insufficientFundsMsg := banktypes.NewMsgSend(govAcct, addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000000))))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{}
+			},
+			false,
+		},
+		{
+			"both proposal id and messages",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{ProposalId: 1, Messages: sendMsgs(validMsg)}
+			},
+			false,
+		},
+		{
+			"non existing proposal request",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"too many messages",
+			func() {
+				msgs := make([]sdk.Msg, types.DefaultConfig().MaxSimulateProposalMsgs+1)
+				for i := range msgs {
+					msgs[i] = validMsg
+				}
+				req = &v1.QuerySimulateProposalRequest{Messages: sendMsgs(msgs...)}
+			},
+			false,
+		},
+		{
+			"wrong authority",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{Messages: sendMsgs(validMsg, wrongAuthorityMsg)}
+				expRes = &v1.QuerySimulateProposalResponse{
+					Success:        false,
+					Error:          types.ErrInvalidSigner.Wrap(addrs[1].String()).Error(),
+					FailedMsgIndex: 1,
+				}
+			},
+			true,
+		},
+		{
+			"failing message execution",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{Messages: sendMsgs(insufficientFundsMsg)}
+				expRes = &v1.QuerySimulateProposalResponse{
+					Success:        false,
+					Error:          "100000stake is smaller than 100000000stake: insufficient funds",
+					FailedMsgIndex: 0,
+				}
+			},
+			true,
+		},
+		{
+			"valid messages",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{Messages: sendMsgs(validMsg)}
+				expRes = &v1.QuerySimulateProposalResponse{Success: true}
+			},
+			true,
+		},
+		{
+			"existing proposal",
+			func() {
+				proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{validMsg}, "")
+				suite.Require().NoError(err)
+
+				req = &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id}
+				expRes = &v1.QuerySimulateProposalResponse{Success: true}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Success, res.Success)
+				suite.Require().Equal(expRes.Error, res.Error)
+				suite.Require().Equal(expRes.FailedMsgIndex, res.FailedMsgIndex)
+				if res.Success {
+					suite.Require().NotZero(res.GasUsed)
+					suite.Require().NotEmpty(res.Events)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	// simulation does not persist any state change
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000))),
+		app.BankKeeper.GetAllBalances(ctx, govAcct),
+	)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.legacyQueryClient
 
@@ -67,6 +67,15 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If the SimulateProposal query limits are not set by app developer, set to
+	// default values.
+	if config.MaxSimulateProposalGas == 0 {
+		config.MaxSimulateProposalGas = types.DefaultConfig().MaxSimulateProposalGas
+	}
+	if config.MaxSimulateProposalMsgs == 0 {
+		config.MaxSimulateProposalMsgs = types.DefaultConfig().MaxSimulateProposalMsgs
+	}
+
 	return &Keeper{
 		storeKey:   key,
 		paramSpace: paramSpace,
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -26,6 +27,39 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
 	return tallyParams
 }
 
+// GetTrackParams returns the current TrackParams from the global param store.
+// No track is registered if the param has never been set.
+func (keeper Keeper) GetTrackParams(ctx sdk.Context) v1.TrackParams {
+	var trackParams v1.TrackParams
+	keeper.paramSpace.GetIfExists(ctx, v1.ParamStoreKeyTrackParams, &trackParams)
+	return trackParams
+}
+
+// GetProposalTrack returns the track of a proposal, as snapshotted at its
+// submission, so that params changes don't affect ongoing proposals. Proposals
+// submitted before tracks were introduced fall back to the current module-wide
+// deposit, voting and tally params.
+func (keeper Keeper) GetProposalTrack(ctx sdk.Context, proposal v1.Proposal) v1.Track {
+	if proposal.Track != nil {
+		return *proposal.Track
+	}
+
+	return keeper.defaultTrack(ctx)
+}
+
+// ResolveProposalTrack returns the params governing a proposal made of the given
+// messages under the current track params. Messages not governed by any
+// registered track fall back to the module-wide deposit, voting and tally params,
+// and the strictest params apply to messages governed by different tracks.
+func (keeper Keeper) ResolveProposalTrack(ctx sdk.Context, messages []*codectypes.Any) v1.Track {
+	return keeper.GetTrackParams(ctx).ProposalTrack(messages, keeper.defaultTrack(ctx))
+}
+
+// defaultTrack returns the track implied by the current module-wide params.
+func (keeper Keeper) defaultTrack(ctx sdk.Context) v1.Track {
+	return v1.NewDefaultTrack(keeper.GetDepositParams(ctx), keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx))
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +74,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingPara
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetTrackParams sets TrackParams to the global param store
+func (keeper Keeper) SetTrackParams(ctx sdk.Context, trackParams v1.TrackParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTrackParams, &trackParams)
+}
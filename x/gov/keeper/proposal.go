@@ -3,6 +3,9 @@ package keeper
 import (
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,25 +28,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// perform a basic validation of the message
-		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		handler, err := keeper.proposalMsgHandler(ctx, msg)
+		if err != nil {
+			return v1.Proposal{}, err
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -73,6 +60,10 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 		return v1.Proposal{}, err
 	}
 
+	// snapshot the track of the proposal, so that params changes don't affect it
+	track := keeper.ResolveProposalTrack(ctx, proposal.Messages)
+	proposal.Track = &track
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -91,6 +82,78 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// SimulateProposalMsgs executes the given proposal messages in a cached context,
+// as they would be executed by the EndBlocker once the proposal passed. It
+// returns the gas used and the events emitted by the execution, along with the
+// index of the first failing message and its error, if any. The execution is
+// bounded by the MaxSimulateProposalGas config, and panics are returned as
+// errors. No state change is persisted.
+func (keeper Keeper) SimulateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) (gasUsed uint64, events []abci.Event, msgIdx int, err error) {
+	if uint64(len(messages)) > keeper.config.MaxSimulateProposalMsgs {
+		return 0, nil, 0, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "cannot simulate more than %d messages", keeper.config.MaxSimulateProposalMsgs)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(keeper.config.MaxSimulateProposalGas))
+
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = cacheCtx.GasMeter().GasConsumedToLimit()
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gas limit: %d", oog.Descriptor, keeper.config.MaxSimulateProposalGas)
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	for idx, msg := range messages {
+		msgIdx = idx
+
+		handler, err := keeper.proposalMsgHandler(cacheCtx, msg)
+		if err != nil {
+			return cacheCtx.GasMeter().GasConsumed(), events, idx, err
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return cacheCtx.GasMeter().GasConsumed(), events, idx, err
+		}
+
+		events = append(events, res.Events...)
+	}
+
+	return cacheCtx.GasMeter().GasConsumed(), events, 0, nil
+}
+
+// proposalMsgHandler performs a basic validation of a proposal message, asserts
+// that the governance module account is its only signer and returns the
+// handler routing it.
+func (keeper Keeper) proposalMsgHandler(ctx sdk.Context, msg sdk.Msg) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := keeper.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return handler, nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,7 +300,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetProposalTrack(ctx, proposal).VotingPeriod
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
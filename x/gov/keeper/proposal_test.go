@@ -11,6 +11,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -86,6 +89,24 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSimulateProposalMsgsOutOfGas() {
+	app := suite.app
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.MsgServiceRouter(),
+		types.Config{MaxSimulateProposalGas: 1000},
+	)
+
+	govAcct := govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	msg := banktypes.NewMsgSend(govAcct, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+
+	// the out of gas panic is recovered into an error
+	gasUsed, _, msgIdx, err := govKeeper.SimulateProposalMsgs(suite.ctx, []sdk.Msg{msg})
+	suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+	suite.Require().Equal(uint64(1000), gasUsed)
+	suite.Require().Equal(0, msgIdx)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
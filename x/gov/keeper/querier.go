@@ -70,6 +70,13 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper *
 		}
 		return bz, nil
 
+	case v1.ParamTracks:
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetTrackParams(ctx))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -135,6 +136,38 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	require.False(t, tallyResults.Equals(v1.EmptyTallyResult()))
 }
 
+func TestTallyTrackThreshold(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
+
+	app.GovKeeper.SetTrackParams(ctx, v1.NewTrackParams(v1.NewTrack(
+		"send", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens)), v1.DefaultPeriod,
+		v1.DefaultQuorum, sdk.NewDecWithPrec(9, 1), v1.DefaultVetoThreshold,
+	)))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	// 6/11 yes votes pass the default threshold but not the track's one
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.False(t, tallyResults.Equals(v1.EmptyTallyResult()))
+}
+
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
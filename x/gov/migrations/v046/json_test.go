@@ -113,6 +113,7 @@ func TestMigrateJSON(t *testing.T) {
 					"denom": "stake"
 				}
 			],
+			"track": null,
 			"voting_end_time": "2001-09-09T01:46:40Z",
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
@@ -123,6 +124,7 @@ func TestMigrateJSON(t *testing.T) {
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
 	},
+	"track_params": null,
 	"votes": [
 		{
 			"metadata": "",
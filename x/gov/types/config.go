@@ -4,11 +4,19 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+	// MaxSimulateProposalGas defines the gas limit for executing the messages
+	// of a proposal in the SimulateProposal query.
+	MaxSimulateProposalGas uint64
+	// MaxSimulateProposalMsgs defines the maximum number of messages executed
+	// in the SimulateProposal query.
+	MaxSimulateProposalMsgs uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:          255,
+		MaxSimulateProposalGas:  10_000_000,
+		MaxSimulateProposalMsgs: 50,
 	}
 }
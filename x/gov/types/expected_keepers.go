@@ -10,6 +10,7 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
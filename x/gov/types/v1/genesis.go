@@ -19,12 +19,17 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 
 // DefaultGenesisState defines the default governance genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genState := NewGenesisState(
 		DefaultStartingProposalID,
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
 	)
+
+	trackParams := DefaultTrackParams()
+	genState.TrackParams = &trackParams
+
+	return genState
 }
 
 // Empty returns true if a GenesisState is empty
@@ -53,6 +58,12 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	if data.TrackParams != nil {
+		if err := validateTrackParams(*data.TrackParams); err != nil {
+			return fmt.Errorf("invalid track params: %w", err)
+		}
+	}
+
 	return nil
 }
 
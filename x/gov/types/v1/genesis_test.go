@@ -69,6 +69,17 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "invalid TrackParams",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				TrackParams:        &v1.TrackParams{Tracks: []v1.Track{{Name: "upgrade"}}},
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -251,6 +251,13 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// track holds the deposit, voting and tally params of the proposal, resolved
+	// from its messages and the track params at submission, without the msg type
+	// URLs of the tracks. Proposals submitted before tracks were introduced have
+	// no track, and use the module-wide params.
+	//
+	// Since: cosmos-sdk 0.47
+	Track *Track `protobuf:"bytes,11,opt,name=track,proto3" json:"track,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +363,13 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetTrack() *Track {
+	if m != nil {
+		return m.Track
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -664,6 +678,161 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+// TrackParams defines the governance tracks. A track overrides the deposit,
+// voting and tally params for proposals containing messages it governs.
+//
+// Since: cosmos-sdk 0.47
+type TrackParams struct {
+	// tracks defines the registered governance tracks.
+	Tracks []Track `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks"`
+}
+
+func (m *TrackParams) Reset()         { *m = TrackParams{} }
+func (m *TrackParams) String() string { return proto.CompactTextString(m) }
+func (*TrackParams) ProtoMessage()    {}
+func (*TrackParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
+}
+func (m *TrackParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrackParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrackParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrackParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackParams.Merge(m, src)
+}
+func (m *TrackParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrackParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackParams proto.InternalMessageInfo
+
+func (m *TrackParams) GetTracks() []Track {
+	if m != nil {
+		return m.Tracks
+	}
+	return nil
+}
+
+// Track defines the deposit, voting and tally params applied to proposals
+// containing at least one message whose type URL is governed by the track.
+//
+// Since: cosmos-sdk 0.47
+type Track struct {
+	// name is the unique human readable identifier of the track.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls defines the Msg type URLs governed by the track. For
+	// MsgExecLegacyContent, the type URL of the wrapped content is matched too.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	//  Minimum deposit for a proposal on this track to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,3,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	//  Length of the voting period of a proposal on this track.
+	VotingPeriod *time.Duration `protobuf:"bytes,4,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,7,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *Track) Reset()         { *m = Track{} }
+func (m *Track) String() string { return proto.CompactTextString(m) }
+func (*Track) ProtoMessage()    {}
+func (*Track) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *Track) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Track) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Track.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Track) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Track.Merge(m, src)
+}
+func (m *Track) XXX_Size() int {
+	return m.Size()
+}
+func (m *Track) XXX_DiscardUnknown() {
+	xxx_messageInfo_Track.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Track proto.InternalMessageInfo
+
+func (m *Track) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Track) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Track) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Track) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *Track) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *Track) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *Track) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -675,81 +844,91 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*TrackParams)(nil), "cosmos.gov.v1.TrackParams")
+	proto.RegisterType((*Track)(nil), "cosmos.gov.v1.Track")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xc1, 0x6f, 0xdb, 0xb6,
+	0x17, 0x8e, 0x1c, 0xd9, 0x49, 0x9e, 0x63, 0x57, 0x3f, 0x36, 0xbf, 0x55, 0x4d, 0x5b, 0x3b, 0x35,
+	0xb6, 0x21, 0x4b, 0x5b, 0x7b, 0x69, 0xd1, 0x0d, 0x58, 0x2f, 0x73, 0x62, 0x75, 0x75, 0x51, 0xc4,
+	0x9e, 0xac, 0xa6, 0xe8, 0x2e, 0x02, 0x13, 0xb1, 0x8e, 0x50, 0x4b, 0xf4, 0x44, 0xda, 0xad, 0xaf,
+	0xbb, 0xed, 0xd6, 0xe3, 0x80, 0xfd, 0x1b, 0xc5, 0xfe, 0x86, 0x9e, 0x86, 0xa2, 0x18, 0xb0, 0xed,
+	0x92, 0x6d, 0xcd, 0x2d, 0x7f, 0xc5, 0x20, 0x92, 0x8a, 0x6d, 0xc5, 0x45, 0x82, 0x9d, 0x24, 0xbd,
+	0xf7, 0x7d, 0x1f, 0x1f, 0xf9, 0x3e, 0x8a, 0x84, 0x4b, 0xfb, 0x94, 0x05, 0x94, 0xd5, 0xba, 0x74,
+	0x58, 0x1b, 0x6e, 0xc6, 0x8f, 0x6a, 0x3f, 0xa2, 0x9c, 0xa2, 0x82, 0x4c, 0x54, 0xe3, 0xc8, 0x70,
+	0x73, 0xb5, 0xa4, 0x70, 0x7b, 0x98, 0x91, 0xda, 0x70, 0x73, 0x8f, 0x70, 0xbc, 0x59, 0xdb, 0xa7,
+	0x7e, 0x28, 0xe1, 0xab, 0x2b, 0x5d, 0xda, 0xa5, 0xe2, 0xb5, 0x16, 0xbf, 0xa9, 0x68, 0xb9, 0x4b,
+	0x69, 0xb7, 0x47, 0x6a, 0xe2, 0x6b, 0x6f, 0xf0, 0xac, 0xc6, 0xfd, 0x80, 0x30, 0x8e, 0x83, 0xbe,
+	0x02, 0x5c, 0x4e, 0x03, 0x70, 0x38, 0x52, 0xa9, 0x52, 0x3a, 0xe5, 0x0d, 0x22, 0xcc, 0x7d, 0x9a,
+	0x8c, 0x78, 0x59, 0x56, 0xe4, 0xca, 0x41, 0x55, 0xb5, 0xe2, 0xa3, 0x42, 0x01, 0x3d, 0x21, 0x7e,
+	0xf7, 0x80, 0x13, 0x6f, 0x97, 0x72, 0xd2, 0xea, 0xc7, 0x34, 0xb4, 0x09, 0x39, 0x2a, 0xde, 0x4c,
+	0x6d, 0x4d, 0x5b, 0x2f, 0xde, 0xbe, 0x5c, 0x9d, 0x9a, 0x62, 0x75, 0x0c, 0xb5, 0x15, 0x10, 0x7d,
+	0x0a, 0xb9, 0x17, 0x42, 0xc8, 0xcc, 0xac, 0x69, 0xeb, 0x4b, 0x5b, 0xc5, 0x77, 0xaf, 0x6f, 0x81,
+	0x62, 0x35, 0xc8, 0xbe, 0xad, 0xb2, 0x95, 0x9f, 0x35, 0x58, 0x68, 0x90, 0x3e, 0x65, 0x3e, 0x47,
+	0x65, 0xc8, 0xf7, 0x23, 0xda, 0xa7, 0x0c, 0xf7, 0x5c, 0xdf, 0x13, 0x63, 0xe9, 0x36, 0x24, 0xa1,
+	0xa6, 0x87, 0xbe, 0x80, 0x25, 0x4f, 0x62, 0x69, 0xa4, 0x74, 0xcd, 0x77, 0xaf, 0x6f, 0xad, 0x28,
+	0xdd, 0xba, 0xe7, 0x45, 0x84, 0xb1, 0x0e, 0x8f, 0xfc, 0xb0, 0x6b, 0x8f, 0xa1, 0xe8, 0x4b, 0xc8,
+	0xe1, 0x80, 0x0e, 0x42, 0x6e, 0xce, 0xaf, 0xcd, 0xaf, 0xe7, 0xc7, 0xf5, 0xc7, 0x3d, 0xa9, 0xaa,
+	0x9e, 0x54, 0xb7, 0xa9, 0x1f, 0x6e, 0xe9, 0x6f, 0x0e, 0xcb, 0x73, 0xb6, 0x82, 0x57, 0x7e, 0xc8,
+	0xc2, 0x62, 0x5b, 0x8d, 0x8f, 0x8a, 0x90, 0x39, 0xa9, 0x2a, 0xe3, 0x7b, 0xe8, 0x73, 0x58, 0x0c,
+	0x08, 0x63, 0xb8, 0x4b, 0x98, 0x99, 0x11, 0xba, 0x2b, 0x55, 0xb9, 0xf2, 0xd5, 0x64, 0xe5, 0xab,
+	0xf5, 0x70, 0x64, 0x9f, 0xa0, 0xd0, 0x5d, 0xc8, 0x31, 0x8e, 0xf9, 0x80, 0x99, 0xf3, 0x62, 0x1d,
+	0xaf, 0xa5, 0xd6, 0x31, 0x19, 0xaa, 0x23, 0x40, 0xb6, 0x02, 0xa3, 0x07, 0x80, 0x9e, 0xf9, 0x21,
+	0xee, 0xb9, 0x1c, 0xf7, 0x7a, 0x23, 0x37, 0x22, 0x6c, 0xd0, 0xe3, 0xa6, 0xbe, 0xa6, 0xad, 0xe7,
+	0x6f, 0xaf, 0xa6, 0x24, 0x9c, 0x18, 0x62, 0x0b, 0x84, 0x6d, 0x08, 0xd6, 0x44, 0x04, 0xd5, 0x21,
+	0xcf, 0x06, 0x7b, 0x81, 0xcf, 0xdd, 0xd8, 0x4e, 0x66, 0x56, 0x49, 0xa4, 0xab, 0x76, 0x12, 0xaf,
+	0x6d, 0xe9, 0xaf, 0xfe, 0x2a, 0x6b, 0x36, 0x48, 0x52, 0x1c, 0x46, 0x0f, 0xc1, 0x50, 0x0b, 0xeb,
+	0x92, 0xd0, 0x93, 0x3a, 0xb9, 0x73, 0xea, 0x14, 0x15, 0xd3, 0x0a, 0x3d, 0xa1, 0xd5, 0x80, 0x02,
+	0xa7, 0x1c, 0xf7, 0x5c, 0x15, 0x37, 0x17, 0xce, 0xd7, 0x9e, 0x65, 0xc1, 0x4a, 0x6c, 0xf3, 0x08,
+	0xfe, 0x37, 0xa4, 0xdc, 0x0f, 0xbb, 0x2e, 0xe3, 0x38, 0x52, 0x53, 0x5b, 0x3c, 0x67, 0x49, 0x17,
+	0x24, 0xb5, 0x13, 0x33, 0x45, 0x4d, 0x0f, 0x40, 0x85, 0xc6, 0xd3, 0x5b, 0x3a, 0xa7, 0x56, 0x41,
+	0x12, 0x93, 0xd9, 0xad, 0xc6, 0xfe, 0xe0, 0xd8, 0xc3, 0x1c, 0x9b, 0x10, 0x9b, 0xd5, 0x3e, 0xf9,
+	0x46, 0x1b, 0x90, 0xe5, 0x11, 0xde, 0x7f, 0x6e, 0xe6, 0x85, 0xf6, 0x4a, 0xba, 0x8b, 0x71, 0xce,
+	0x96, 0x90, 0xca, 0xef, 0x1a, 0xe4, 0x27, 0x9b, 0x78, 0x03, 0x96, 0x46, 0x84, 0xb9, 0xfb, 0xc2,
+	0xd0, 0xda, 0xa9, 0xdd, 0xd5, 0x0c, 0xb9, 0xbd, 0x38, 0x22, 0x6c, 0x3b, 0xce, 0xa3, 0x3b, 0x50,
+	0xc0, 0x7b, 0x8c, 0x63, 0x3f, 0x54, 0x84, 0xcc, 0x4c, 0xc2, 0xb2, 0x02, 0x49, 0xd2, 0x67, 0xb0,
+	0x18, 0x52, 0x85, 0x9f, 0x9f, 0x89, 0x5f, 0x08, 0xa9, 0x84, 0xde, 0x03, 0x14, 0x52, 0xf7, 0x85,
+	0xcf, 0x0f, 0xdc, 0x21, 0xe1, 0x09, 0x49, 0x9f, 0x49, 0xba, 0x10, 0xd2, 0x27, 0x3e, 0x3f, 0xd8,
+	0x25, 0x5c, 0x92, 0x2b, 0xbf, 0x68, 0xa0, 0xc7, 0xff, 0x8e, 0xb3, 0x77, 0x7e, 0x15, 0xb2, 0x43,
+	0xca, 0xc9, 0xd9, 0xbb, 0x5e, 0xc2, 0xd0, 0x3d, 0x58, 0x90, 0x3f, 0x22, 0x66, 0xea, 0xc2, 0x53,
+	0xd7, 0x53, 0x2b, 0x7c, 0xfa, 0x2f, 0x67, 0x27, 0x8c, 0xa9, 0xc6, 0x65, 0xa7, 0x1b, 0xf7, 0x50,
+	0x5f, 0x9c, 0x37, 0xf4, 0xca, 0x9f, 0x1a, 0x14, 0x94, 0xfd, 0xda, 0x38, 0xc2, 0x01, 0x43, 0x4f,
+	0x21, 0x1f, 0xf8, 0xe1, 0x89, 0x91, 0xb5, 0xb3, 0x8c, 0x7c, 0x2d, 0x36, 0xf2, 0xf1, 0x61, 0xf9,
+	0xff, 0x13, 0xac, 0x9b, 0x34, 0xf0, 0x39, 0x09, 0xfa, 0x7c, 0x64, 0x43, 0xe0, 0x87, 0x89, 0xbf,
+	0x03, 0x40, 0x01, 0x7e, 0x99, 0x80, 0xdc, 0x3e, 0x89, 0x7c, 0xea, 0x89, 0x85, 0x88, 0x47, 0x48,
+	0x9b, 0xb2, 0xa1, 0xfe, 0xf5, 0x5b, 0x1f, 0x1f, 0x1f, 0x96, 0xaf, 0x9e, 0x26, 0x8e, 0x07, 0xf9,
+	0x29, 0xf6, 0xac, 0x11, 0xe0, 0x97, 0xc9, 0x4c, 0x44, 0xbe, 0xe2, 0xc0, 0xf2, 0xae, 0xf0, 0xb1,
+	0x9a, 0x59, 0x03, 0x94, 0xaf, 0x93, 0x91, 0xb5, 0xb3, 0x46, 0xd6, 0x85, 0xf2, 0xb2, 0x64, 0x29,
+	0xd5, 0x7f, 0x12, 0x13, 0x2b, 0xd5, 0xaf, 0x20, 0xf7, 0xfd, 0x80, 0x46, 0x83, 0x40, 0x39, 0xb8,
+	0x72, 0x7c, 0x58, 0x36, 0x64, 0x64, 0x5c, 0x61, 0xfa, 0xcc, 0x90, 0x79, 0xb4, 0x0d, 0x4b, 0xfc,
+	0x20, 0x22, 0xec, 0x80, 0xf6, 0x3c, 0x65, 0x88, 0x4f, 0x8e, 0x0f, 0xcb, 0x17, 0x4f, 0x82, 0x1f,
+	0x54, 0x18, 0xf3, 0xd0, 0xb7, 0x50, 0x14, 0x86, 0x1d, 0x2b, 0x49, 0xa7, 0x6f, 0x1c, 0x1f, 0x96,
+	0xcd, 0xe9, 0xcc, 0x07, 0xe5, 0x0a, 0x31, 0xce, 0x49, 0x60, 0x95, 0x3a, 0xe4, 0xc5, 0xc6, 0x55,
+	0x53, 0xbc, 0x0d, 0x39, 0xb1, 0x81, 0x99, 0x72, 0xc3, 0xcc, 0x4d, 0x9e, 0x1c, 0x38, 0x12, 0x59,
+	0xf9, 0x2d, 0x03, 0x59, 0x11, 0x47, 0x08, 0xf4, 0x10, 0x07, 0x44, 0x2e, 0x8f, 0x2d, 0xde, 0x51,
+	0x05, 0x0a, 0x01, 0xeb, 0xba, 0x7c, 0xd4, 0x27, 0xee, 0x20, 0xea, 0xc9, 0x63, 0x67, 0xc9, 0xce,
+	0x07, 0xac, 0xeb, 0x8c, 0xfa, 0xe4, 0x71, 0xd4, 0x63, 0xe8, 0xeb, 0x69, 0x23, 0x9e, 0xf3, 0xc0,
+	0x9b, 0xf4, 0xdb, 0xa9, 0x86, 0xeb, 0xff, 0xa1, 0xe1, 0xf1, 0x05, 0x40, 0x35, 0x38, 0x3b, 0xfb,
+	0x02, 0xa0, 0x9a, 0x79, 0x73, 0xb2, 0x99, 0xb9, 0x99, 0xd0, 0x89, 0xae, 0xdd, 0x3d, 0xd5, 0xb5,
+	0x85, 0x99, 0x94, 0xe9, 0xce, 0x6c, 0xfc, 0xa8, 0x01, 0x4c, 0xdc, 0x67, 0xae, 0xc0, 0xa5, 0xdd,
+	0x96, 0x63, 0xb9, 0xad, 0xb6, 0xd3, 0x6c, 0xed, 0xb8, 0x8f, 0x77, 0x3a, 0x6d, 0x6b, 0xbb, 0x79,
+	0xbf, 0x69, 0x35, 0x8c, 0x39, 0x74, 0x11, 0x2e, 0x4c, 0x26, 0x9f, 0x5a, 0x1d, 0x43, 0x43, 0x97,
+	0xe0, 0xe2, 0x64, 0xb0, 0xbe, 0xd5, 0x71, 0xea, 0xcd, 0x1d, 0x23, 0x83, 0x10, 0x14, 0x27, 0x13,
+	0x3b, 0x2d, 0x63, 0x1e, 0x5d, 0x05, 0x73, 0x3a, 0xe6, 0x3e, 0x69, 0x3a, 0x0f, 0xdc, 0x5d, 0xcb,
+	0x69, 0x19, 0xfa, 0xc6, 0xaf, 0x1a, 0x14, 0xa7, 0x0f, 0x7a, 0x54, 0x86, 0x2b, 0x6d, 0xbb, 0xd5,
+	0x6e, 0x75, 0xea, 0x8f, 0xdc, 0x8e, 0x53, 0x77, 0x1e, 0x77, 0x52, 0x35, 0x55, 0xa0, 0x94, 0x06,
+	0x34, 0xac, 0x76, 0xab, 0xd3, 0x74, 0xdc, 0xb6, 0x65, 0x37, 0x5b, 0x0d, 0x43, 0x43, 0xd7, 0xe1,
+	0x5a, 0x1a, 0xb3, 0xdb, 0x72, 0x9a, 0x3b, 0xdf, 0x24, 0x90, 0x0c, 0x5a, 0x85, 0x8f, 0xd2, 0x90,
+	0x76, 0xbd, 0xd3, 0xb1, 0x1a, 0xb2, 0xe8, 0x74, 0xce, 0xb6, 0x1e, 0x5a, 0xdb, 0x8e, 0xd5, 0x30,
+	0xf4, 0x59, 0xcc, 0xfb, 0xf5, 0xe6, 0x23, 0xab, 0x61, 0x64, 0xb7, 0xac, 0x37, 0xef, 0x4b, 0xda,
+	0xdb, 0xf7, 0x25, 0xed, 0xef, 0xf7, 0x25, 0xed, 0xd5, 0x51, 0x69, 0xee, 0xed, 0x51, 0x69, 0xee,
+	0x8f, 0xa3, 0xd2, 0xdc, 0x77, 0x37, 0xba, 0x3e, 0x3f, 0x18, 0xec, 0x55, 0xf7, 0x69, 0xa0, 0xae,
+	0x99, 0xea, 0x71, 0x8b, 0x79, 0xcf, 0x6b, 0x2f, 0xc5, 0xd5, 0x39, 0x36, 0x33, 0x8b, 0xef, 0xc5,
+	0x39, 0xe1, 0xab, 0x3b, 0xff, 0x0e, 0x00, 0x78, 0x66, 0x9b, 0xd8, 0x58, 0x0b, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -856,6 +1035,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Track != nil {
+		{
+			size, err := m.Track.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -864,22 +1055,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -898,22 +1089,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1084,12 +1275,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1131,12 +1322,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1187,6 +1378,127 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrackParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrackParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrackParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		for iNdEx := len(m.Tracks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tracks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Track) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Track) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Track) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingPeriod != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1284,6 +1596,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Track != nil {
+		l = m.Track.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1391,18 +1707,74 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TrackParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		for _, e := range m.Tracks {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *Track) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGov
@@ -1980,6 +2352,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Track == nil {
+				m.Track = &Track{}
+			}
+			if err := m.Track.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2698,6 +3106,370 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrackParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrackParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrackParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracks = append(m.Tracks, Track{})
+			if err := m.Tracks[len(m.Tracks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Track) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Track: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Track: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
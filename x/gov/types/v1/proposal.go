@@ -54,6 +54,13 @@ func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
 }
 
+var _ types.UnpackInterfacesMessage = QuerySimulateProposalRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QuerySimulateProposalRequest) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
 // Proposals is an array of proposal
 type Proposals []*Proposal
 
@@ -20,6 +20,7 @@ const (
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamTracks   = "tracks"
 )
 
 // QueryProposalParams Params for queries:
@@ -7,9 +7,12 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/tendermint/tendermint/abci/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -463,7 +466,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "tracks".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -515,6 +518,10 @@ type QueryParamsResponse struct {
 	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
 	// tally_params defines the parameters related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// track_params defines the governance tracks.
+	//
+	// Since: cosmos-sdk 0.47
+	TrackParams *TrackParams `protobuf:"bytes,4,opt,name=track_params,json=trackParams,proto3" json:"track_params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -571,6 +578,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetTrackParams() *TrackParams {
+	if m != nil {
+		return m.TrackParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +887,149 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QuerySimulateProposalRequest is the request type for the Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QuerySimulateProposalRequest struct {
+	// proposal_id defines the unique id of an existing proposal to simulate. It
+	// must not be set together with messages.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// messages defines the messages of a proposal yet to be submitted.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *QuerySimulateProposalRequest) Reset()         { *m = QuerySimulateProposalRequest{} }
+func (m *QuerySimulateProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalRequest) ProtoMessage()    {}
+func (*QuerySimulateProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QuerySimulateProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalRequest.Merge(m, src)
+}
+func (m *QuerySimulateProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QuerySimulateProposalRequest) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// QuerySimulateProposalResponse is the response type for the Query/SimulateProposal RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QuerySimulateProposalResponse struct {
+	// success is true if all the proposal messages were executed successfully.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// gas_used is the amount of gas consumed by the execution of the messages.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// events defines the events emitted by the execution of the messages.
+	Events []types1.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// error is the error returned by the first failing message, if any.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// failed_msg_index is the index of the first failing message. It is only
+	// meaningful when success is false.
+	FailedMsgIndex uint64 `protobuf:"varint,5,opt,name=failed_msg_index,json=failedMsgIndex,proto3" json:"failed_msg_index,omitempty"`
+}
+
+func (m *QuerySimulateProposalResponse) Reset()         { *m = QuerySimulateProposalResponse{} }
+func (m *QuerySimulateProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalResponse) ProtoMessage()    {}
+func (*QuerySimulateProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QuerySimulateProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalResponse.Merge(m, src)
+}
+func (m *QuerySimulateProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateProposalResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *QuerySimulateProposalResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateProposalResponse) GetEvents() []types1.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QuerySimulateProposalResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateProposalResponse) GetFailedMsgIndex() uint64 {
+	if m != nil {
+		return m.FailedMsgIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1047,89 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QuerySimulateProposalRequest)(nil), "cosmos.gov.v1.QuerySimulateProposalRequest")
+	proto.RegisterType((*QuerySimulateProposalResponse)(nil), "cosmos.gov.v1.QuerySimulateProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xba, 0x76, 0x62, 0xbf, 0x34, 0x21, 0x4c, 0xdd, 0xc6, 0xd9, 0xa6, 0x4e, 0xba, 0xa1,
+	0x49, 0x68, 0xd2, 0xdd, 0x26, 0x4d, 0x5b, 0xa9, 0xb4, 0x12, 0x4d, 0x69, 0xa0, 0x12, 0x48, 0x61,
+	0x53, 0x38, 0x70, 0xb1, 0x36, 0xde, 0xe9, 0xb2, 0xaa, 0xbd, 0xe3, 0xec, 0x8c, 0xad, 0x86, 0x34,
+	0x42, 0xaa, 0x84, 0xe0, 0x04, 0x48, 0x54, 0x02, 0xc1, 0xdf, 0xe0, 0x47, 0x54, 0x9c, 0x2a, 0xb8,
+	0x70, 0x42, 0x28, 0xe1, 0x47, 0x70, 0x44, 0x3b, 0x33, 0xbb, 0xde, 0xdd, 0xac, 0x1d, 0xa7, 0xaa,
+	0x38, 0xd9, 0x33, 0xf3, 0xbd, 0xef, 0x7d, 0xef, 0xcd, 0xcc, 0x7b, 0xb3, 0x30, 0x55, 0x27, 0xb4,
+	0x49, 0xa8, 0xe1, 0x90, 0x8e, 0xd1, 0x59, 0x31, 0x76, 0xda, 0xd8, 0xdf, 0xd5, 0x5b, 0x3e, 0x61,
+	0x04, 0x8d, 0x89, 0x25, 0xdd, 0x21, 0x1d, 0xbd, 0xb3, 0xa2, 0x5e, 0x96, 0xc8, 0x6d, 0x8b, 0x62,
+	0x81, 0x33, 0x3a, 0x2b, 0xdb, 0x98, 0x59, 0x2b, 0x46, 0xcb, 0x72, 0x5c, 0xcf, 0x62, 0x2e, 0xf1,
+	0x84, 0xa9, 0x3a, 0xed, 0x10, 0xe2, 0x34, 0xb0, 0x61, 0xb5, 0x5c, 0xc3, 0xf2, 0x3c, 0xc2, 0xf8,
+	0x22, 0x95, 0xab, 0x53, 0x72, 0x95, 0x8f, 0xb6, 0xdb, 0x8f, 0x0c, 0xcb, 0x93, 0x3e, 0xd5, 0xb2,
+	0x43, 0x1c, 0xc2, 0xff, 0x1a, 0xc1, 0x3f, 0x39, 0x7b, 0x9e, 0x61, 0xcf, 0xc6, 0x7e, 0xd3, 0xf5,
+	0x98, 0x61, 0x6d, 0xd7, 0x5d, 0x83, 0xed, 0xb6, 0x70, 0xc8, 0x36, 0x99, 0x8c, 0x20, 0x50, 0x2b,
+	0xdd, 0x88, 0x85, 0x9a, 0xa0, 0x93, 0xc1, 0xf0, 0x81, 0x76, 0x13, 0xca, 0x1f, 0x07, 0x11, 0x6c,
+	0xfa, 0xa4, 0x45, 0xa8, 0xd5, 0x30, 0xf1, 0x4e, 0x1b, 0x53, 0x86, 0x66, 0x60, 0xb4, 0x25, 0xa7,
+	0x6a, 0xae, 0x5d, 0x51, 0x66, 0x95, 0xc5, 0xbc, 0x09, 0xe1, 0xd4, 0x03, 0x5b, 0xfb, 0x10, 0xce,
+	0xa6, 0x0c, 0x69, 0x8b, 0x78, 0x14, 0xa3, 0x6b, 0x50, 0x0c, 0x61, 0xdc, 0x6c, 0x74, 0x75, 0x52,
+	0x4f, 0xe4, 0x4f, 0x8f, 0x4c, 0x22, 0xa0, 0xf6, 0x5d, 0x2e, 0x45, 0x47, 0x43, 0x21, 0x1b, 0xf0,
+	0x46, 0x24, 0x84, 0x32, 0x8b, 0xb5, 0x29, 0x67, 0x1d, 0x5f, 0xbd, 0xd0, 0x83, 0x75, 0x8b, 0x83,
+	0xcc, 0xf1, 0x56, 0x62, 0x8c, 0x74, 0x28, 0x74, 0x08, 0xc3, 0x7e, 0x25, 0x37, 0xab, 0x2c, 0x96,
+	0xd6, 0x2b, 0xbf, 0xff, 0x7a, 0xa5, 0x2c, 0x09, 0xee, 0xda, 0xb6, 0x8f, 0x29, 0xdd, 0x62, 0xbe,
+	0xeb, 0x39, 0xa6, 0x80, 0xa1, 0x1b, 0x50, 0xb2, 0x71, 0x8b, 0x50, 0x97, 0x11, 0xbf, 0x72, 0xea,
+	0x18, 0x9b, 0x2e, 0x14, 0x6d, 0x00, 0x74, 0x0f, 0x41, 0x25, 0xcf, 0x13, 0x30, 0x1f, 0x4a, 0x0d,
+	0x4e, 0x8c, 0x2e, 0x4e, 0x96, 0x3c, 0x31, 0xfa, 0xa6, 0xe5, 0x60, 0x19, 0xab, 0x19, 0xb3, 0xd4,
+	0x7e, 0x52, 0xe0, 0x5c, 0x3a, 0x23, 0x32, 0xc3, 0xd7, 0xa1, 0x14, 0x06, 0x17, 0x24, 0xe3, 0x54,
+	0xbf, 0x14, 0x77, 0x91, 0xe8, 0xfd, 0x84, 0xb2, 0x1c, 0x57, 0xb6, 0x70, 0xac, 0x32, 0xe1, 0x33,
+	0x21, 0xad, 0x0e, 0x13, 0x5c, 0xd9, 0xa7, 0x84, 0xe1, 0x41, 0xcf, 0xcb, 0x49, 0xf3, 0xaf, 0xdd,
+	0x86, 0x37, 0x63, 0x4e, 0x64, 0xe4, 0x0b, 0x90, 0x0f, 0x56, 0xe5, 0xb9, 0x3a, 0x93, 0x0a, 0x9a,
+	0x43, 0x39, 0x40, 0x7b, 0x1a, 0xb3, 0xa6, 0x03, 0x6b, 0xdc, 0xc8, 0xc8, 0xd0, 0xab, 0xec, 0xdd,
+	0x37, 0x0a, 0xa0, 0xb8, 0x7b, 0xa9, 0xfe, 0x6d, 0x91, 0x82, 0x70, 0xcf, 0x32, 0xe5, 0x0b, 0xc4,
+	0xeb, 0xdb, 0xab, 0xeb, 0x52, 0xc9, 0xa6, 0xe5, 0x5b, 0xcd, 0x44, 0x26, 0xf8, 0x44, 0x2d, 0xa8,
+	0x1f, 0x3c, 0x13, 0x25, 0x13, 0xc4, 0xd4, 0xc3, 0xdd, 0x16, 0xd6, 0x7e, 0xce, 0xc1, 0x99, 0x84,
+	0x9d, 0x0c, 0xe1, 0x5d, 0x18, 0xeb, 0x10, 0xe6, 0x7a, 0x4e, 0x4d, 0x80, 0xe5, 0x4e, 0x9c, 0x3f,
+	0x1a, 0x8a, 0xeb, 0x39, 0xd2, 0xf6, 0x74, 0x27, 0x36, 0x42, 0xf7, 0x60, 0x5c, 0x5e, 0x96, 0x90,
+	0x42, 0x44, 0x37, 0x9d, 0xa2, 0x78, 0x4f, 0x80, 0x24, 0xc7, 0x98, 0x1d, 0x1f, 0xa2, 0x3b, 0x70,
+	0x9a, 0x59, 0x8d, 0xc6, 0x6e, 0x48, 0x71, 0x8a, 0x53, 0xa8, 0x29, 0x8a, 0x87, 0x01, 0x44, 0x12,
+	0x8c, 0xb2, 0xee, 0x80, 0x9b, 0xfb, 0x56, 0xfd, 0x71, 0x68, 0x9e, 0xcf, 0x36, 0x0f, 0x20, 0x91,
+	0x79, 0x77, 0xa0, 0x79, 0x32, 0x37, 0x52, 0xe2, 0xc0, 0xc7, 0x2b, 0x51, 0x52, 0x72, 0x03, 0x97,
+	0x14, 0xed, 0x03, 0x28, 0x27, 0xfd, 0xc9, 0xcd, 0xb8, 0x0a, 0x23, 0x12, 0x24, 0xb7, 0xe1, 0x5c,
+	0x76, 0x0e, 0xcd, 0x10, 0xa6, 0x7d, 0x99, 0x64, 0xfa, 0xff, 0x6f, 0xc6, 0x73, 0x05, 0xce, 0xa6,
+	0x14, 0xc8, 0x60, 0x56, 0xa1, 0x28, 0x55, 0x86, 0xf7, 0xa3, 0x57, 0x34, 0x11, 0xee, 0xf5, 0xdd,
+	0x92, 0x5b, 0x30, 0xc9, 0x55, 0xf1, 0x13, 0x63, 0x62, 0xda, 0x6e, 0xb0, 0x13, 0x34, 0xc2, 0xca,
+	0x51, 0xdb, 0x68, 0x87, 0x0a, 0xfc, 0xdc, 0x55, 0x94, 0xec, 0x13, 0x16, 0x33, 0x11, 0x40, 0x6d,
+	0x07, 0xa6, 0x39, 0xdb, 0x96, 0xdb, 0x6c, 0x37, 0x2c, 0x86, 0x4f, 0xda, 0x97, 0xd1, 0x55, 0x28,
+	0x36, 0x31, 0xa5, 0x96, 0x83, 0x83, 0x9b, 0x15, 0xe4, 0xb1, 0xac, 0x8b, 0x57, 0x86, 0x1e, 0xbe,
+	0x32, 0xf4, 0xbb, 0xde, 0xae, 0x19, 0xa1, 0xb4, 0xdf, 0x14, 0xb8, 0xd0, 0xc3, 0xa7, 0x0c, 0xa3,
+	0x02, 0x23, 0xb4, 0x5d, 0xaf, 0x63, 0x2a, 0xee, 0x7b, 0xd1, 0x0c, 0x87, 0x68, 0x0a, 0x8a, 0x8e,
+	0x45, 0x6b, 0x6d, 0x8a, 0x6d, 0x9e, 0xff, 0xbc, 0x39, 0xe2, 0x58, 0xf4, 0x13, 0x8a, 0x6d, 0xb4,
+	0x06, 0xc3, 0xb8, 0x83, 0x3d, 0x16, 0xdc, 0x4e, 0xb1, 0x9d, 0xdd, 0xb7, 0x8b, 0x1e, 0xbc, 0x5d,
+	0xf4, 0xfb, 0xc1, 0xf2, 0x7a, 0xfe, 0xc5, 0x5f, 0x33, 0x43, 0xa6, 0xc4, 0xa2, 0x32, 0x14, 0xb0,
+	0xef, 0x13, 0x9f, 0xdf, 0xc9, 0x92, 0x29, 0x06, 0x68, 0x11, 0x26, 0x1e, 0x59, 0x6e, 0x03, 0xdb,
+	0xb5, 0x26, 0x75, 0x6a, 0xae, 0x67, 0xe3, 0x27, 0x95, 0x02, 0x77, 0x37, 0x2e, 0xe6, 0x3f, 0xa2,
+	0xce, 0x83, 0x60, 0x76, 0xf5, 0xdf, 0x12, 0x14, 0x78, 0x30, 0xe8, 0x2b, 0x05, 0x8a, 0x61, 0x24,
+	0x68, 0x2e, 0x95, 0xf9, 0xac, 0x37, 0x8f, 0xfa, 0x56, 0x7f, 0x90, 0x48, 0x86, 0xa6, 0x3f, 0xfb,
+	0xe3, 0x9f, 0x1f, 0x72, 0x8b, 0x68, 0xde, 0x48, 0x3e, 0xb7, 0xa2, 0x46, 0x6b, 0xec, 0xc5, 0x76,
+	0x68, 0x1f, 0x7d, 0x01, 0xa5, 0x90, 0x83, 0xa2, 0xbe, 0x2e, 0xc2, 0xeb, 0xa8, 0x5e, 0x3a, 0x06,
+	0x25, 0x95, 0xcc, 0x72, 0x25, 0x2a, 0xaa, 0xf4, 0x52, 0x82, 0xbe, 0x56, 0x20, 0x1f, 0xb4, 0x15,
+	0x34, 0x93, 0xc5, 0x18, 0xeb, 0xdf, 0xea, 0x6c, 0x6f, 0x80, 0xf4, 0x76, 0x9b, 0x7b, 0xbb, 0x81,
+	0xd6, 0x06, 0x8b, 0xdb, 0xe0, 0x8d, 0xcc, 0xd8, 0x0b, 0x7e, 0xfc, 0x7d, 0xf4, 0x4c, 0x81, 0x42,
+	0x40, 0x47, 0x51, 0x4f, 0x4f, 0x51, 0xf8, 0x17, 0xfb, 0x20, 0xa4, 0x98, 0x35, 0x2e, 0x46, 0x47,
+	0xcb, 0x27, 0x11, 0x83, 0x9e, 0xc2, 0xb0, 0xec, 0x00, 0x99, 0x2e, 0x12, 0x3d, 0x52, 0xd5, 0xfa,
+	0x41, 0xa4, 0x8c, 0x25, 0x2e, 0xe3, 0x12, 0x9a, 0x4b, 0xcb, 0xe0, 0x30, 0x63, 0x2f, 0xd6, 0x64,
+	0xf7, 0xd1, 0x8f, 0x0a, 0x8c, 0xc8, 0x1a, 0x86, 0x32, 0xc9, 0x93, 0xfd, 0x44, 0x9d, 0xeb, 0x8b,
+	0x91, 0x0a, 0xee, 0x71, 0x05, 0x77, 0xd0, 0x3b, 0x03, 0x26, 0x22, 0xac, 0x9d, 0xc6, 0x5e, 0xd4,
+	0x5f, 0xf6, 0xd1, 0xb7, 0x0a, 0x14, 0x25, 0x31, 0x45, 0xfd, 0xdc, 0xd2, 0xbe, 0x57, 0x25, 0x5d,
+	0xd3, 0xb5, 0x9b, 0x5c, 0xdc, 0x0a, 0x32, 0x4e, 0x28, 0x0e, 0x3d, 0x57, 0x60, 0x34, 0x56, 0x1c,
+	0xd1, 0x7c, 0x96, 0xbb, 0xa3, 0xc5, 0x5a, 0x5d, 0x38, 0x16, 0xf7, 0x8a, 0xe7, 0x87, 0x17, 0x67,
+	0xf4, 0x8b, 0x02, 0x13, 0xe9, 0x22, 0x89, 0x96, 0xb2, 0x7c, 0xf6, 0x28, 0xdf, 0xea, 0xf2, 0x60,
+	0x60, 0xa9, 0x72, 0x99, 0xab, 0x9c, 0xbf, 0xa5, 0x5c, 0xd6, 0x2e, 0xf6, 0x14, 0x4a, 0xa5, 0xf5,
+	0xfa, 0xfd, 0x17, 0x07, 0x55, 0xe5, 0xe5, 0x41, 0x55, 0xf9, 0xfb, 0xa0, 0xaa, 0x7c, 0x7f, 0x58,
+	0x1d, 0x7a, 0x79, 0x58, 0x1d, 0xfa, 0xf3, 0xb0, 0x3a, 0xf4, 0xd9, 0x92, 0xe3, 0xb2, 0xcf, 0xdb,
+	0xdb, 0x7a, 0x9d, 0x34, 0x43, 0x1a, 0xf1, 0x73, 0x85, 0xda, 0x8f, 0x8d, 0x27, 0x9c, 0x93, 0x7f,
+	0x48, 0x06, 0xdf, 0xb1, 0xc3, 0xbc, 0x4d, 0x5c, 0xfb, 0x6f, 0x00, 0x87, 0x2a, 0x78, 0x10, 0x10,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1160,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against a cached
+	// context, with the governance module account as signer, as if the proposal
+	// had passed. No state change is persisted.
+	//
+	// Since: cosmos-sdk 0.47
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1248,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/SimulateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1275,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// SimulateProposal executes the messages of a proposal against a cached
+	// context, with the governance module account as signer, as if the proposal
+	// had passed. No state change is persisted.
+	//
+	// Since: cosmos-sdk 0.47
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1311,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) SimulateProposal(ctx context.Context, req *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1463,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/SimulateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1517,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +1901,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrackParams != nil {
+		{
+			size, err := m.TrackParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,6 +2174,112 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedMsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedMsgIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2116,6 +2454,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.TrackParams != nil {
+		l = m.TrackParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2550,52 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySimulateProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FailedMsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FailedMsgIndex))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,6 +3677,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrackParams == nil {
+				m.TrackParams = &TrackParams{}
+			}
+			if err := m.TrackParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,6 +4301,283 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types1.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMsgIndex", wireType)
+			}
+			m.FailedMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,252 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultTrackName is the name of the track derived from the module-wide
+// deposit, voting and tally params, applied to messages not governed by any
+// registered track.
+const DefaultTrackName = "default"
+
+// Default governance tracks
+var (
+	// DefaultUpgradeTrackMsgTypeURLs are the messages and legacy proposal
+	// contents governed by the default upgrade track.
+	DefaultUpgradeTrackMsgTypeURLs = []string{
+		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		"/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
+		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
+		"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
+	}
+	// DefaultCommunitySpendTrackMsgTypeURLs are the messages and legacy proposal
+	// contents governed by the default community spend track.
+	DefaultCommunitySpendTrackMsgTypeURLs = []string{
+		"/cosmos.distribution.v1beta1.CommunityPoolSpendProposal",
+	}
+
+	DefaultUpgradeQuorum               = sdk.NewDecWithPrec(4, 1)
+	DefaultUpgradeThreshold            = sdk.NewDecWithPrec(667, 3)
+	DefaultCommunitySpendQuorum        = sdk.NewDecWithPrec(4, 1)
+	DefaultCommunitySpendThreshold     = sdk.NewDecWithPrec(6, 1)
+	DefaultUpgradeMinDepositTokens     = DefaultMinDepositTokens.MulRaw(5)
+	DefaultCommunitySpendDepositTokens = DefaultMinDepositTokens.MulRaw(2)
+)
+
+// NewTrack creates a new Track instance
+func NewTrack(
+	name string, msgTypeURLs []string, minDeposit sdk.Coins, votingPeriod time.Duration,
+	quorum, threshold, vetoThreshold sdk.Dec,
+) Track {
+	return Track{
+		Name:          name,
+		MsgTypeUrls:   msgTypeURLs,
+		MinDeposit:    minDeposit,
+		VotingPeriod:  &votingPeriod,
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+	}
+}
+
+// NewDefaultTrack returns the track implied by the module-wide deposit, voting
+// and tally params.
+func NewDefaultTrack(dp DepositParams, vp VotingParams, tp TallyParams) Track {
+	return Track{
+		Name:          DefaultTrackName,
+		MinDeposit:    dp.MinDeposit,
+		VotingPeriod:  vp.VotingPeriod,
+		Quorum:        tp.Quorum,
+		Threshold:     tp.Threshold,
+		VetoThreshold: tp.VetoThreshold,
+	}
+}
+
+// TallyParams returns the tally params of the track.
+func (t Track) TallyParams() TallyParams {
+	return TallyParams{
+		Quorum:        t.Quorum,
+		Threshold:     t.Threshold,
+		VetoThreshold: t.VetoThreshold,
+	}
+}
+
+// Stricter returns a track holding the strictest value of each param of both
+// tracks: the highest min deposit, voting period, quorum and threshold, and
+// the lowest veto threshold. The returned track governs no msg type URLs.
+func (t Track) Stricter(other Track) Track {
+	res := Track{
+		Name:          t.Name,
+		MinDeposit:    sdk.NewCoins(t.MinDeposit...).Max(sdk.NewCoins(other.MinDeposit...)),
+		VotingPeriod:  t.VotingPeriod,
+		Quorum:        sdk.MaxDec(sdk.MustNewDecFromStr(t.Quorum), sdk.MustNewDecFromStr(other.Quorum)).String(),
+		Threshold:     sdk.MaxDec(sdk.MustNewDecFromStr(t.Threshold), sdk.MustNewDecFromStr(other.Threshold)).String(),
+		VetoThreshold: sdk.MinDec(sdk.MustNewDecFromStr(t.VetoThreshold), sdk.MustNewDecFromStr(other.VetoThreshold)).String(),
+	}
+
+	if !containsTrackName(t.Name, other.Name) {
+		res.Name = t.Name + "," + other.Name
+	}
+
+	if *other.VotingPeriod > *t.VotingPeriod {
+		res.VotingPeriod = other.VotingPeriod
+	}
+
+	return res
+}
+
+// containsTrackName returns true if name is part of the comma-separated names.
+func containsTrackName(names, name string) bool {
+	for _, n := range strings.Split(names, ",") {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTrackParams creates a new TrackParams object
+func NewTrackParams(tracks ...Track) TrackParams {
+	return TrackParams{Tracks: tracks}
+}
+
+// DefaultTrackParams default governance tracks, requiring a higher deposit,
+// quorum and threshold for software upgrades and community pool spends.
+func DefaultTrackParams() TrackParams {
+	return NewTrackParams(
+		NewTrack(
+			"upgrade",
+			DefaultUpgradeTrackMsgTypeURLs,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultUpgradeMinDepositTokens)),
+			DefaultPeriod,
+			DefaultUpgradeQuorum,
+			DefaultUpgradeThreshold,
+			DefaultVetoThreshold,
+		),
+		NewTrack(
+			"community-spend",
+			DefaultCommunitySpendTrackMsgTypeURLs,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultCommunitySpendDepositTokens)),
+			DefaultPeriod,
+			DefaultCommunitySpendQuorum,
+			DefaultCommunitySpendThreshold,
+			DefaultVetoThreshold,
+		),
+	)
+}
+
+// GetTrack returns the track governing the given msg type URL.
+func (tp TrackParams) GetTrack(msgTypeURL string) (Track, bool) {
+	for _, track := range tp.Tracks {
+		for _, typeURL := range track.MsgTypeUrls {
+			if typeURL == msgTypeURL {
+				return track, true
+			}
+		}
+	}
+
+	return Track{}, false
+}
+
+// ProposalTrack returns the params governing a proposal made of the given
+// messages. Each message is governed by the track listing its type URL, or by
+// defaultTrack if there is none. When the messages are governed by different
+// tracks, the strictest value of each param is used. The returned track only
+// holds the resolved params, without the msg type URLs of the tracks.
+func (tp TrackParams) ProposalTrack(messages []*types.Any, defaultTrack Track) Track {
+	track := defaultTrack
+	for i, msg := range messages {
+		msgTrack, found := tp.msgTrack(msg)
+		if !found {
+			msgTrack = defaultTrack
+		}
+
+		if i == 0 {
+			track = msgTrack
+			continue
+		}
+
+		track = track.Stricter(msgTrack)
+	}
+
+	track.MsgTypeUrls = nil
+	return track
+}
+
+// msgTrack returns the track governing a proposal message. The content
+// wrapped in a MsgExecLegacyContent takes precedence over the message itself.
+func (tp TrackParams) msgTrack(msg *types.Any) (Track, bool) {
+	if msg.TypeUrl == sdk.MsgTypeURL(&MsgExecLegacyContent{}) {
+		legacyMsg, ok := msg.GetCachedValue().(*MsgExecLegacyContent)
+		if !ok {
+			legacyMsg = &MsgExecLegacyContent{}
+			if err := legacyMsg.Unmarshal(msg.Value); err != nil {
+				legacyMsg = nil
+			}
+		}
+
+		if legacyMsg != nil && legacyMsg.Content != nil {
+			if track, found := tp.GetTrack(legacyMsg.Content.TypeUrl); found {
+				return track, true
+			}
+		}
+	}
+
+	return tp.GetTrack(msg.TypeUrl)
+}
+
+func validateTrackParams(i interface{}) error {
+	v, ok := i.(TrackParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool, len(v.Tracks))
+	typeURLs := make(map[string]bool)
+	for _, track := range v.Tracks {
+		if strings.TrimSpace(track.Name) == "" {
+			return errors.New("track name cannot be blank")
+		}
+		if strings.Contains(track.Name, ",") {
+			return fmt.Errorf("track name cannot contain a comma: %s", track.Name)
+		}
+		if track.Name == DefaultTrackName {
+			return fmt.Errorf("track name %s is reserved", DefaultTrackName)
+		}
+		if names[track.Name] {
+			return fmt.Errorf("duplicate track name: %s", track.Name)
+		}
+		names[track.Name] = true
+
+		if len(track.MsgTypeUrls) == 0 {
+			return fmt.Errorf("track %s must govern at least one msg type URL", track.Name)
+		}
+		for _, typeURL := range track.MsgTypeUrls {
+			if strings.TrimSpace(typeURL) == "" {
+				return fmt.Errorf("track %s has a blank msg type URL", track.Name)
+			}
+			if typeURLs[typeURL] {
+				return fmt.Errorf("msg type URL %s is governed by more than one track", typeURL)
+			}
+			typeURLs[typeURL] = true
+		}
+
+		if !sdk.Coins(track.MinDeposit).IsValid() {
+			return fmt.Errorf("invalid minimum deposit of track %s: %s", track.Name, track.MinDeposit)
+		}
+		if track.VotingPeriod == nil || track.VotingPeriod.Seconds() <= 0 {
+			return fmt.Errorf("voting period of track %s must be positive: %s", track.Name, track.VotingPeriod)
+		}
+		if err := validateTallyParams(track.TallyParams()); err != nil {
+			return fmt.Errorf("invalid tally params of track %s: %w", track.Name, err)
+		}
+	}
+
+	return nil
+}
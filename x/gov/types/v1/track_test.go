@@ -0,0 +1,153 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func TestProposalTrack(t *testing.T) {
+	defaultTrack := v1.NewDefaultTrack(v1.DefaultDepositParams(), v1.DefaultVotingParams(), v1.DefaultTallyParams())
+	sendTrack := v1.NewTrack(
+		"send", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), sdk.NewInt64Coin("foo", 1)),
+		time.Hour, sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(9, 1), sdk.NewDecWithPrec(5, 1),
+	)
+	textTrack := v1.NewTrack(
+		"text", []string{"/cosmos.gov.v1beta1.TextProposal"},
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+		time.Hour*24*7, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 1),
+	)
+	trackParams := v1.NewTrackParams(sendTrack, textTrack)
+
+	sendMsg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addrs[0], addrs[1], coinsPos))
+	require.NoError(t, err)
+	multiSendMsg, err := codectypes.NewAnyWithValue(banktypes.NewMsgMultiSend(nil, nil))
+	require.NoError(t, err)
+	legacyContent, err := v1.NewLegacyContent(v1beta1.NewTextProposal("title", "description"), addrs[0].String())
+	require.NoError(t, err)
+	legacyMsg, err := codectypes.NewAnyWithValue(legacyContent)
+	require.NoError(t, err)
+
+	// drop the cached value, as for a proposal decoded without the interface registry
+	legacyMsgNoCache := &codectypes.Any{TypeUrl: legacyMsg.TypeUrl, Value: legacyMsg.Value}
+
+	testCases := []struct {
+		name     string
+		messages []*codectypes.Any
+		expName  string
+		expTrack func() v1.Track
+	}{
+		{
+			"no messages",
+			nil,
+			v1.DefaultTrackName,
+			func() v1.Track { return defaultTrack },
+		},
+		{
+			"no matching track",
+			[]*codectypes.Any{multiSendMsg},
+			v1.DefaultTrackName,
+			func() v1.Track { return defaultTrack },
+		},
+		{
+			"matching track",
+			[]*codectypes.Any{sendMsg},
+			"send",
+			func() v1.Track { return sendTrack },
+		},
+		{
+			"matching legacy content",
+			[]*codectypes.Any{legacyMsg},
+			"text",
+			func() v1.Track { return textTrack },
+		},
+		{
+			"matching legacy content without cached value",
+			[]*codectypes.Any{legacyMsgNoCache},
+			"text",
+			func() v1.Track { return textTrack },
+		},
+		{
+			"mixed messages use the strictest params",
+			[]*codectypes.Any{sendMsg, legacyMsg, multiSendMsg},
+			"send,text,default",
+			func() v1.Track {
+				return v1.Track{
+					MinDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens), sdk.NewInt64Coin("foo", 1)),
+					VotingPeriod:  textTrack.VotingPeriod,
+					Quorum:        sdk.NewDecWithPrec(5, 1).String(),
+					Threshold:     sdk.NewDecWithPrec(9, 1).String(),
+					VetoThreshold: sdk.NewDecWithPrec(2, 1).String(),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			track := trackParams.ProposalTrack(tc.messages, defaultTrack)
+			expTrack := tc.expTrack()
+
+			require.Equal(t, tc.expName, track.Name)
+			require.True(t, sdk.NewCoins(expTrack.MinDeposit...).IsEqual(track.MinDeposit))
+			require.Equal(t, *expTrack.VotingPeriod, *track.VotingPeriod)
+			require.Equal(t, expTrack.TallyParams(), track.TallyParams())
+			require.Empty(t, track.MsgTypeUrls)
+		})
+	}
+}
+
+func TestValidateTrackParams(t *testing.T) {
+	validTrack := func(name string, typeURLs ...string) v1.Track {
+		return v1.NewTrack(
+			name, typeURLs, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), time.Hour,
+			v1.DefaultQuorum, v1.DefaultThreshold, v1.DefaultVetoThreshold,
+		)
+	}
+
+	invalidTally := validTrack("invalid", "/foo")
+	invalidTally.Threshold = "0"
+	invalidVotingPeriod := validTrack("invalid", "/foo")
+	invalidVotingPeriod.VotingPeriod = nil
+
+	testCases := []struct {
+		name   string
+		tracks v1.TrackParams
+		expErr bool
+	}{
+		{"default tracks", v1.DefaultTrackParams(), false},
+		{"no tracks", v1.NewTrackParams(), false},
+		{"blank name", v1.NewTrackParams(validTrack(" ", "/foo")), true},
+		{"reserved name", v1.NewTrackParams(validTrack(v1.DefaultTrackName, "/foo")), true},
+		{"name with comma", v1.NewTrackParams(validTrack("a,b", "/foo")), true},
+		{"duplicate name", v1.NewTrackParams(validTrack("a", "/foo"), validTrack("a", "/bar")), true},
+		{"no type URLs", v1.NewTrackParams(validTrack("a")), true},
+		{"type URL in two tracks", v1.NewTrackParams(validTrack("a", "/foo"), validTrack("b", "/foo")), true},
+		{"invalid tally params", v1.NewTrackParams(invalidTally), true},
+		{"invalid voting period", v1.NewTrackParams(invalidVotingPeriod), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := v1.DefaultGenesisState()
+			genState.TrackParams = &tc.tracks
+
+			err := v1.ValidateGenesis(genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
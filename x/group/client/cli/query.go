@@ -33,6 +33,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryVotesByVoterCmd(),
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
+		QueryGroupMemberTreeCmd(),
 	)
 
 	return queryCmd
@@ -469,6 +470,41 @@ func QueryTallyResultCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryGroupMemberTreeCmd creates a CLI command for Query/GroupMemberTree.
+func QueryGroupMemberTreeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-member-tree [id]",
+		Short: "Query for the members of a group, resolving the members which are group policies into the members of their group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupMemberTree(cmd.Context(), &group.QueryGroupMemberTreeRequest{
+				GroupId: groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryVotesByVoterCmd creates a CLI command for Query/VotesByVoter.
 func QueryVotesByVoterCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -44,6 +45,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
+		MsgSubmitSubProposalCmd(),
 	)
 
 	return txCmd
@@ -734,6 +736,65 @@ Parameters:
 	return cmd
 }
 
+// MsgSubmitSubProposalCmd creates a CLI command for Msg/SubmitSubProposal.
+func MsgSubmitSubProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-sub-proposal [group-policy-account] [parent-proposal-id] [vote-option] [proposers] [metadata]",
+		Short: "Submit a proposal to a group policy to vote on a proposal of its parent group",
+		Long: `Submit a proposal to a group policy, member of the group of the parent proposal,
+whose execution casts the group policy's vote on the parent proposal.
+
+Parameters:
+			group-policy-account: account address of the group policy voting on the parent proposal
+			parent-proposal-id: unique ID of the parent proposal
+			vote-option: vote cast on the parent proposal if the sub-proposal passes
+				VOTE_OPTION_NO: no
+				VOTE_OPTION_YES: yes
+				VOTE_OPTION_ABSTAIN: abstain
+				VOTE_OPTION_NO_WITH_VETO: no-with-veto
+			proposers: comma separated account addresses of the proposers
+			metadata: metadata for the sub-proposal
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposers := strings.Split(args[3], ",")
+			err := cmd.Flags().Set(flags.FlagFrom, proposers[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parentProposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voteOption, err := group.VoteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			execStr, _ := cmd.Flags().GetString(FlagExec)
+
+			msg := group.NewMsgSubmitSubProposal(args[0], proposers, parentProposalID, voteOption, args[4], execFromString(execStr))
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute the sub-proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgExecCmd creates a CLI command for Msg/MsgExec.
 func MsgExecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&VetoDecisionPolicy{}, "cosmos-sdk/VetoDecisionPolicy", nil)
+	cdc.RegisterConcrete(&RoleDecisionPolicy{}, "cosmos-sdk/RoleDecisionPolicy", nil)
+	cdc.RegisterConcrete(&QuadraticDecisionPolicy{}, "cosmos-sdk/QuadraticDecisionPolicy", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -32,6 +35,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitSubProposal{}, "cosmos-sdk/group/MsgSubmitSubProposal")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -50,6 +54,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgSubmitSubProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -59,6 +64,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&VetoDecisionPolicy{},
+		&RoleDecisionPolicy{},
+		&QuadraticDecisionPolicy{},
 	)
 }
 
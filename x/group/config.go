@@ -9,12 +9,22 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxAutoExecutionGas defines the max gas which can be consumed by the
+	// automatic execution of a single proposal in EndBlock. Defaults to
+	// 10,000,000 if not explicitly set.
+	MaxAutoExecutionGas uint64
+	// MaxNestingDepth defines the max number of levels of groups which can be
+	// nested in a hierarchy of groups through their group policy members.
+	// Defaults to 5 if not explicitly set.
+	MaxNestingDepth uint64
 }
 
 // DefaultConfig returns the default config for group.
 func DefaultConfig() Config {
 	return Config{
-		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
-		MaxMetadataLen:     255,
+		MaxExecutionPeriod:  2 * time.Hour * 24 * 7, // Two weeks.
+		MaxMetadataLen:      255,
+		MaxAutoExecutionGas: 10_000_000,
+		MaxNestingDepth:     5,
 	}
 }
@@ -116,6 +116,14 @@ func (x Dec) Quo(y Dec) (Dec, error) {
 	return z, sdkerrors.Wrap(err, "decimal quotient error")
 }
 
+// Sqrt returns a new Dec with value `√x` (formatted as decimal128, 34 digit precision) without mutating any
+// argument and error if x is negative.
+func (x Dec) Sqrt() (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Sqrt(&z.dec, &x.dec)
+	return z, sdkerrors.Wrap(err, "decimal square root error")
+}
+
 func (x Dec) IsZero() bool {
 	return x.dec.IsZero()
 }
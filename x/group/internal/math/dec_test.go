@@ -82,6 +82,13 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(two))
 
+	res, err = four.Sqrt()
+	require.NoError(t, err)
+	require.True(t, res.IsEqual(two))
+
+	_, err = minusOne.Sqrt()
+	require.Error(t, err)
+
 	require.False(t, zero.IsNegative())
 	require.False(t, one.IsNegative())
 	require.True(t, minusOne.IsNegative())
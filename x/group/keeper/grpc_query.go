@@ -346,3 +346,19 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 		Tally: tallyResult,
 	}, nil
 }
+
+// GroupMemberTree queries the members of a group, recursively resolving the
+// members which are group policy accounts into the members of their group.
+func (k Keeper) GroupMemberTree(goCtx context.Context, request *group.QueryGroupMemberTreeRequest) (*group.QueryGroupMemberTreeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getGroupInfo(ctx, request.GroupId); err != nil {
+		return nil, err
+	}
+
+	members, err := k.memberTree(ctx, request.GroupId, 0, make(map[uint64]bool))
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupMemberTreeResponse{Members: members}, nil
+}
@@ -38,6 +38,7 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalsByNextExecTimePrefix    byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
@@ -69,6 +70,7 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalsByVotingPeriodEnd orm.Index
+	proposalsByNextExecTime    orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
@@ -177,6 +179,16 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalsByNextExecTime, err = orm.NewIndex(proposalTable, ProposalsByNextExecTimePrefix, func(value interface{}) ([]interface{}, error) {
+		nextExecutionTime := value.(*group.Proposal).NextExecutionTime
+		if nextExecutionTime == nil {
+			return nil, nil
+		}
+		return []interface{}{sdk.FormatTimeBytes(*nextExecutionTime)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -208,6 +220,12 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxAutoExecutionGas == 0 {
+		config.MaxAutoExecutionGas = group.DefaultConfig().MaxAutoExecutionGas
+	}
+	if config.MaxNestingDepth == 0 {
+		config.MaxNestingDepth = group.DefaultConfig().MaxNestingDepth
+	}
 	k.config = config
 
 	return k
@@ -225,8 +243,20 @@ func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
 
 // proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
+	return k.proposalsByTimeIndex(ctx, k.proposalsByVotingPeriodEnd, endTime)
+}
+
+// proposalsByNextExecutionTime returns all proposals scheduled for automatic
+// execution before the `endTime` time argument.
+func (k Keeper) proposalsByNextExecutionTime(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
+	return k.proposalsByTimeIndex(ctx, k.proposalsByNextExecTime, endTime)
+}
+
+// proposalsByTimeIndex returns all proposals whose time in the given index is
+// before the `endTime` time argument.
+func (k Keeper) proposalsByTimeIndex(ctx sdk.Context, index orm.Index, endTime time.Time) (proposals []group.Proposal, err error) {
 	timeBytes := sdk.FormatTimeBytes(endTime)
-	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
+	it, err := index.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
 	if err != nil {
 		return proposals, err
 	}
@@ -331,6 +361,29 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// groupMembers returns all members of a given group.
+func (k Keeper) groupMembers(ctx sdk.Context, groupID uint64) ([]group.Member, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var members []group.Member
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return members, err
+		}
+		members = append(members, *member.Member)
+	}
+	return members, nil
+}
+
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx sdk.Context, proposalID uint64) ([]group.Vote, error) {
 	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
@@ -412,3 +465,149 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// ExecuteProposalsAtNextExecTime iterates over all proposals scheduled for
+// automatic execution before the current block time and processes them:
+// submitted proposals are tallied, accepted proposals are executed and, on
+// failure, rescheduled according to their group policy's auto execution
+// settings. Errors from a single proposal are recorded on that proposal and
+// don't interrupt the processing of the other ones.
+func (k Keeper) ExecuteProposalsAtNextExecTime(ctx sdk.Context) error {
+	proposals, err := k.proposalsByNextExecutionTime(ctx, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		k.autoExecuteProposal(ctx, proposal)
+	}
+	return nil
+}
+
+// autoExecuteProposal runs doAutoExecute in a cached context whose gas meter is
+// limited to the MaxAutoExecutionGas config. If it fails, panics or runs out of
+// gas, its state changes are discarded, the failure is recorded on the
+// proposal and the proposal isn't scheduled for automatic execution anymore.
+// It can still be executed with MsgExec.
+func (k Keeper) autoExecuteProposal(ctx sdk.Context, proposal group.Proposal) {
+	cacheCtx, flush := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(k.config.MaxAutoExecutionGas))
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+					err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				} else {
+					err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+				}
+			}
+		}()
+		return k.doAutoExecute(cacheCtx, proposal)
+	}()
+	if err == nil {
+		flush()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return
+	}
+
+	k.Logger(ctx).Error("automatic proposal execution failed", "cause", err, "proposalID", proposal.Id)
+	logs := fmt.Sprintf("automatic execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
+	proposal.ExecutionAttempts++
+	proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+	proposal.NextExecutionTime = nil
+	if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+		k.Logger(ctx).Error("failed to record automatic proposal execution failure", "cause", err, "proposalID", proposal.Id)
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "cause", err, "proposalID", proposal.Id)
+	}
+}
+
+// doAutoExecute performs the automatic execution of a scheduled proposal.
+func (k Keeper) doAutoExecute(ctx sdk.Context, proposal group.Proposal) error {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	autoExec := group.PolicyAutoExecution(policy)
+	var deadline *time.Time
+	if autoExec != nil && autoExec.Deadline != 0 {
+		d := proposal.SubmitTime.Add(autoExec.Deadline)
+		deadline = &d
+	}
+
+	// Stop scheduling the proposal if the group policy doesn't enable auto
+	// execution anymore, if the proposal can't be executed, or if the
+	// deadline has passed.
+	if autoExec == nil ||
+		(proposal.Status != group.PROPOSAL_STATUS_SUBMITTED && proposal.Status != group.PROPOSAL_STATUS_ACCEPTED) ||
+		(deadline != nil && ctx.BlockTime().After(*deadline)) {
+		proposal.NextExecutionTime = nil
+		return k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal)
+	}
+
+	if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+		electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+		if err != nil {
+			return sdkerrors.Wrap(err, "group")
+		}
+
+		if err := k.doTallyAndUpdate(ctx, &proposal, electorate, policyInfo); err != nil {
+			return sdkerrors.Wrap(err, "doTallyAndUpdate")
+		}
+
+		switch proposal.Status {
+		case group.PROPOSAL_STATUS_SUBMITTED:
+			// The tally isn't final yet: postpone the execution to the end of
+			// the voting period, unless new votes bring it forward.
+			nextExecutionTime := proposal.VotingPeriodEnd
+			proposal.NextExecutionTime = &nextExecutionTime
+			return k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal)
+		case group.PROPOSAL_STATUS_REJECTED, group.PROPOSAL_STATUS_ABORTED:
+			proposal.NextExecutionTime = nil
+			return k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal)
+		}
+	}
+
+	proposal.ExecutionAttempts++
+	logs, err := k.doExecuteProposal(ctx, &proposal, policyInfo)
+	if err != nil {
+		return err
+	}
+
+	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
+			return err
+		}
+	} else {
+		proposal.NextExecutionTime = nil
+		if proposal.ExecutionAttempts <= autoExec.MaxRetries {
+			nextExecutionTime := ctx.BlockTime().Add(autoExec.RetryInterval)
+			if deadline == nil || !nextExecutionTime.After(*deadline) {
+				proposal.NextExecutionTime = &nextExecutionTime
+			}
+		}
+
+		if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	})
+}
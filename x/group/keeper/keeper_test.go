@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"sort"
 	"strings"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -2416,6 +2418,197 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestExecMemberDecisionPolicy() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "2"}, {Address: addrs[2].String(), Weight: "1"},
+	}
+	policy := group.NewVetoDecisionPolicy("2", []string{addrs[2].String()}, time.Hour, 0)
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+	groupPolicyAddr, err := sdk.AccAddressFromBech32(policyAddr)
+	s.Require().NoError(err)
+	s.Require().NoError(testutil.FundAccount(s.bankKeeper, s.sdkCtx, groupPolicyAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   addrs[3].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	submit := func() uint64 {
+		req := &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addrs[1].String()},
+		}
+		s.Require().NoError(req.SetMsgs([]sdk.Msg{msgSend}))
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(id uint64, voter sdk.AccAddress, option group.VoteOption) {
+		_, err := s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: id, Voter: voter.String(), Option: option})
+		s.Require().NoError(err)
+	}
+	exec := func(id uint64) group.ProposalExecutorResult {
+		res, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: id, Executor: addrs[1].String()})
+		s.Require().NoError(err)
+		return res.Result
+	}
+	status := func(id uint64) group.ProposalStatus {
+		res, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal.Status
+	}
+
+	// The threshold is reached, but the veto member didn't vote yet.
+	vetoedID := submit()
+	vote(vetoedID, addrs[1], group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, exec(vetoedID))
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, status(vetoedID))
+
+	vote(vetoedID, addrs[2], group.VOTE_OPTION_NO_WITH_VETO)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, exec(vetoedID))
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, status(vetoedID))
+
+	acceptedID := submit()
+	vote(acceptedID, addrs[1], group.VOTE_OPTION_YES)
+	vote(acceptedID, addrs[2], group.VOTE_OPTION_NO)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec(acceptedID))
+	s.Require().Equal(sdk.NewInt64Coin("test", 9900), s.bankKeeper.GetBalance(s.sdkCtx, groupPolicyAddr, "test"))
+
+	// Removing the veto member after submission doesn't let the proposal
+	// pass: it is aborted as the group was modified.
+	abortedID := submit()
+	vote(abortedID, addrs[1], group.VOTE_OPTION_YES)
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.MemberRequest{{Address: addrs[2].String(), Weight: "0"}},
+	})
+	s.Require().NoError(err)
+	// Group updates don't abort the proposals, only tallying does.
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, status(abortedID))
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, exec(abortedID))
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, status(abortedID))
+	s.Require().Equal(sdk.NewInt64Coin("test", 9900), s.bankKeeper.GetBalance(s.sdkCtx, groupPolicyAddr, "test"))
+}
+
+func (s *TestSuite) TestNestedGroups() {
+	addrs := s.addrs
+	childPolicyAddr, childGroupID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"}, {Address: addrs[2].String(), Weight: "1"},
+	}, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	parentPolicyAddr, parentGroupID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: childPolicyAddr, Weight: "2"}, {Address: addrs[3].String(), Weight: "1"},
+	}, group.NewThresholdDecisionPolicy("2", time.Hour, 0))
+	parentGroupPolicyAddr, err := sdk.AccAddressFromBech32(parentPolicyAddr)
+	s.Require().NoError(err)
+	s.Require().NoError(testutil.FundAccount(s.bankKeeper, s.sdkCtx, parentGroupPolicyAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	// Nested groups can't form a cycle.
+	for _, member := range []string{childPolicyAddr, parentPolicyAddr} {
+		_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+			Admin:         addrs[0].String(),
+			GroupId:       childGroupID,
+			MemberUpdates: []group.MemberRequest{{Address: member, Weight: "1"}},
+		})
+		s.Require().ErrorContains(err, "would create a cycle of nested groups")
+	}
+
+	// A cycle can't be created either by adding the precomputed address of a
+	// group policy as a member before creating the group policy.
+	var nextPolicyAddr sdk.AccAddress
+	for seq := uint64(1); ; seq++ {
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, seq)
+		nextPolicyAddr = address.Derive(address.Module(group.ModuleName, []byte{keeper.GroupPolicyTablePrefix}), buf)
+		if _, err := s.groupKeeper.GroupPolicyInfo(s.ctx, &group.QueryGroupPolicyInfoRequest{Address: nextPolicyAddr.String()}); err != nil {
+			break
+		}
+	}
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       childGroupID,
+		MemberUpdates: []group.MemberRequest{{Address: nextPolicyAddr.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	policyReq := &group.MsgCreateGroupPolicy{Admin: addrs[0].String(), GroupId: parentGroupID}
+	s.Require().NoError(policyReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0)))
+	_, err = s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+	s.Require().ErrorContains(err, "would create a cycle of nested groups")
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       childGroupID,
+		MemberUpdates: []group.MemberRequest{{Address: nextPolicyAddr.String(), Weight: "0"}},
+	})
+	s.Require().NoError(err)
+
+	// Nested groups can't be deeper than the max nesting depth of 5 groups:
+	// the parent group already nests 1 level of groups.
+	topPolicyAddr := parentPolicyAddr
+	for depth := 2; depth <= 6; depth++ {
+		groupRes, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+			Admin:   addrs[0].String(),
+			Members: []group.MemberRequest{{Address: topPolicyAddr, Weight: "1"}},
+		})
+		if depth == 6 {
+			s.Require().ErrorContains(err, "exceeds the max nesting depth")
+			break
+		}
+		s.Require().NoError(err)
+		policyReq := &group.MsgCreateGroupPolicy{Admin: addrs[0].String(), GroupId: groupRes.GroupId}
+		s.Require().NoError(policyReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0)))
+		policyRes, err := s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+		s.Require().NoError(err)
+		topPolicyAddr = policyRes.Address
+	}
+
+	treeRes, err := s.groupKeeper.GroupMemberTree(s.ctx, &group.QueryGroupMemberTreeRequest{GroupId: parentGroupID})
+	s.Require().NoError(err)
+	s.Require().Len(treeRes.Members, 2)
+	for _, node := range treeRes.Members {
+		if node.Member.Address == childPolicyAddr {
+			s.Require().Equal(childGroupID, node.GroupId)
+			s.Require().Len(node.Members, 2)
+		} else {
+			s.Require().Equal(uint64(0), node.GroupId)
+			s.Require().Empty(node.Members)
+		}
+	}
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: parentPolicyAddr,
+		Proposers:          []string{addrs[3].String()},
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: parentPolicyAddr,
+		ToAddress:   addrs[4].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}}))
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	parentID := proposalRes.ProposalId
+
+	// Only a group policy member of the parent group can vote through a sub-proposal.
+	_, err = s.groupKeeper.SubmitSubProposal(s.ctx, group.NewMsgSubmitSubProposal(
+		parentPolicyAddr, []string{addrs[3].String()}, parentID, group.VOTE_OPTION_YES, "", group.Exec_EXEC_UNSPECIFIED,
+	))
+	s.Require().ErrorContains(err, "is not a member of group")
+
+	_, err = s.groupKeeper.SubmitSubProposal(s.ctx, group.NewMsgSubmitSubProposal(
+		childPolicyAddr, []string{addrs[1].String()}, parentID, group.VOTE_OPTION_YES, "", group.Exec_EXEC_TRY,
+	))
+	s.Require().NoError(err)
+
+	voteRes, err := s.groupKeeper.VoteByProposalVoter(s.ctx, &group.QueryVoteByProposalVoterRequest{ProposalId: parentID, Voter: childPolicyAddr})
+	s.Require().NoError(err)
+	s.Require().Equal(group.VOTE_OPTION_YES, voteRes.Vote.Option)
+
+	execRes, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: parentID, Executor: addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+	s.Require().Equal(sdk.NewInt64Coin("test", 9900), s.bankKeeper.GetBalance(s.sdkCtx, parentGroupPolicyAddr, "test"))
+}
+
 func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	addrs := s.addrs
 	addr1 := addrs[0]
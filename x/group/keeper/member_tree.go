@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+)
+
+// memberGroupID returns the ID of the group of the given member if it is a
+// group policy account.
+func (k Keeper) memberGroupID(ctx sdk.Context, member string) (uint64, bool, error) {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, member)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, sdkerrors.Wrap(err, "group policy")
+	}
+	return policyInfo.GroupId, true, nil
+}
+
+// assertNoMemberCycle checks that adding the given member to a group doesn't
+// create a cycle of nested groups, i.e. that the group is not, directly or
+// indirectly, a member of the group of the member's group policy.
+func (k Keeper) assertNoMemberCycle(ctx sdk.Context, groupID uint64, member string) error {
+	visited := make(map[uint64]bool)
+	members := []string{member}
+	for len(members) > 0 {
+		addr := members[0]
+		members = members[1:]
+
+		memberGroupID, ok, err := k.memberGroupID(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if !ok || visited[memberGroupID] {
+			continue
+		}
+		if memberGroupID == groupID {
+			return sdkerrors.Wrapf(errors.ErrInvalid, "member %s would create a cycle of nested groups", member)
+		}
+		visited[memberGroupID] = true
+
+		groupMembers, err := k.groupMembers(ctx, memberGroupID)
+		if err != nil {
+			return err
+		}
+		for _, m := range groupMembers {
+			members = append(members, m.Address)
+		}
+	}
+
+	return nil
+}
+
+// assertMaxNestingDepth checks that adding the given member to a group doesn't
+// make a hierarchy of nested groups deeper than the MaxNestingDepth config,
+// counting the groups nested above the group and below the member's group.
+func (k Keeper) assertMaxNestingDepth(ctx sdk.Context, groupID uint64, member string) error {
+	memberGroupID, ok, err := k.memberGroupID(ctx, member)
+	if err != nil || !ok {
+		return err
+	}
+
+	depth, err := k.groupDepth(ctx, groupID, make(map[uint64]uint64))
+	if err != nil {
+		return err
+	}
+	height, err := k.groupHeight(ctx, memberGroupID, make(map[uint64]uint64))
+	if err != nil {
+		return err
+	}
+	if depth+1+height > k.config.MaxNestingDepth {
+		return sdkerrors.Wrapf(errors.ErrMaxLimit, "member %s exceeds the max nesting depth of %d groups", member, k.config.MaxNestingDepth)
+	}
+
+	return nil
+}
+
+// groupHeight returns the number of levels of groups nested below the given
+// group. The heights of the groups already visited are memoized, and an error
+// is returned as soon as the height exceeds the MaxNestingDepth config.
+func (k Keeper) groupHeight(ctx sdk.Context, groupID uint64, memo map[uint64]uint64) (uint64, error) {
+	if height, ok := memo[groupID]; ok {
+		return height, nil
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return 0, err
+	}
+
+	var height uint64
+	for _, member := range members {
+		memberGroupID, ok, err := k.memberGroupID(ctx, member.Address)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+		memberHeight, err := k.groupHeight(ctx, memberGroupID, memo)
+		if err != nil {
+			return 0, err
+		}
+		if memberHeight+1 > height {
+			height = memberHeight + 1
+		}
+		if height > k.config.MaxNestingDepth {
+			return 0, sdkerrors.Wrapf(errors.ErrMaxLimit, "group %d exceeds the max nesting depth of %d groups", groupID, k.config.MaxNestingDepth)
+		}
+	}
+
+	memo[groupID] = height
+	return height, nil
+}
+
+// groupDepth returns the number of levels of groups above the given group,
+// i.e. the groups having one of its group policies as a member, directly or
+// indirectly. Depths are memoized and bounded like in groupHeight.
+func (k Keeper) groupDepth(ctx sdk.Context, groupID uint64, memo map[uint64]uint64) (uint64, error) {
+	if depth, ok := memo[groupID]; ok {
+		return depth, nil
+	}
+
+	parentIDs, err := k.parentGroupIDs(ctx, groupID)
+	if err != nil {
+		return 0, err
+	}
+
+	var depth uint64
+	for _, parentID := range parentIDs {
+		parentDepth, err := k.groupDepth(ctx, parentID, memo)
+		if err != nil {
+			return 0, err
+		}
+		if parentDepth+1 > depth {
+			depth = parentDepth + 1
+		}
+		if depth > k.config.MaxNestingDepth {
+			return 0, sdkerrors.Wrapf(errors.ErrMaxLimit, "group %d exceeds the max nesting depth of %d groups", groupID, k.config.MaxNestingDepth)
+		}
+	}
+
+	memo[groupID] = depth
+	return depth, nil
+}
+
+// parentGroupIDs returns the IDs of the groups having one of the group
+// policies of the given group as a member.
+func (k Keeper) parentGroupIDs(ctx sdk.Context, groupID uint64) ([]uint64, error) {
+	policyIt, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer policyIt.Close()
+
+	var parentIDs []uint64
+	for {
+		var policyInfo group.GroupPolicyInfo
+		_, err = policyIt.LoadNext(&policyInfo)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
+		if err != nil {
+			return nil, err
+		}
+		memberIt, err := k.groupMemberByMemberIndex.Get(ctx.KVStore(k.key), addr.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var member group.GroupMember
+			_, err = memberIt.LoadNext(&member)
+			if errors.ErrORMIteratorDone.Is(err) {
+				break
+			}
+			if err != nil {
+				memberIt.Close()
+				return nil, err
+			}
+			parentIDs = append(parentIDs, member.GroupId)
+		}
+		memberIt.Close()
+	}
+
+	return parentIDs, nil
+}
+
+// assertNoPolicyCycle checks that a newly created group policy doesn't create
+// a cycle of nested groups, or exceed the max nesting depth, through the
+// groups it is already a member of. As group policy addresses are derived
+// from a sequence, an address can be added as a member before its group
+// policy is created.
+func (k Keeper) assertNoPolicyCycle(ctx sdk.Context, policyAddr sdk.AccAddress) error {
+	it, err := k.groupMemberByMemberIndex.Get(ctx.KVStore(k.key), policyAddr.Bytes())
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := k.assertNoMemberCycle(ctx, member.GroupId, policyAddr.String()); err != nil {
+			return err
+		}
+		if err := k.assertMaxNestingDepth(ctx, member.GroupId, policyAddr.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// memberTree returns the members of a group, recursively resolving the
+// members which are group policy accounts into the members of their group.
+// Each group is only expanded once: if it appears again in the tree, because
+// several of its group policies are members or because it is nested in
+// several groups, its node only references it through GroupId. Groups nested
+// deeper than the MaxNestingDepth config are not expanded either.
+func (k Keeper) memberTree(ctx sdk.Context, groupID uint64, depth uint64, expanded map[uint64]bool) ([]group.GroupMemberNode, error) {
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	expanded[groupID] = true
+
+	nodes := make([]group.GroupMemberNode, len(members))
+	for i, member := range members {
+		nodes[i].Member = member
+
+		memberGroupID, ok, err := k.memberGroupID(ctx, member.Address)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		nodes[i].GroupId = memberGroupID
+		if expanded[memberGroupID] || depth >= k.config.MaxNestingDepth {
+			continue
+		}
+		if nodes[i].Members, err = k.memberTree(ctx, memberGroupID, depth+1, expanded); err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
@@ -3,7 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
-	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -72,6 +72,9 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 	// Create new group members in the groupMemberTable.
 	for i := range members.Members {
 		m := members.Members[i]
+		if err := k.assertMaxNestingDepth(ctx, groupID, m.Address); err != nil {
+			return nil, err
+		}
 		err := k.groupMemberTable.Create(ctx.KVStore(k.key), &group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
@@ -172,6 +175,12 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 					return sdkerrors.Wrap(err, "add member")
 				}
 			} else { // else handle create.
+				if err := k.assertNoMemberCycle(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return err
+				}
+				if err := k.assertMaxNestingDepth(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return err
+				}
 				groupMember.Member.AddedAt = ctx.BlockTime()
 				if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "add member")
@@ -373,6 +382,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, sdkerrors.Wrap(err, "could not create group policy")
 	}
 
+	if err := k.assertNoPolicyCycle(ctx, accountAddr); err != nil {
+		return nil, err
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(&group.EventCreateGroupPolicy{Address: accountAddr.String()})
 	if err != nil {
 		return nil, err
@@ -518,6 +531,12 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		FinalTallyResult:   group.DefaultTallyResult(),
 	}
 
+	// Schedule automatic execution as soon as the proposal can be executed.
+	if group.PolicyAutoExecution(policy) != nil {
+		nextExecutionTime := m.SubmitTime.Add(policy.GetMinExecutionPeriod())
+		m.NextExecutionTime = &nextExecutionTime
+	}
+
 	if err := m.SetMsgs(msgs); err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
@@ -562,6 +581,61 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 	return &group.MsgSubmitProposalResponse{ProposalId: id}, nil
 }
 
+// SubmitSubProposal submits a proposal to a group policy, member of the group
+// of the parent proposal, to vote on the parent proposal.
+func (k Keeper) SubmitSubProposal(goCtx context.Context, req *group.MsgSubmitSubProposal) (*group.MsgSubmitSubProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	parent, err := k.getProposal(ctx, req.ParentProposalId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load parent proposal")
+	}
+	if parent.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrap(errors.ErrInvalid, "parent proposal not open for voting")
+	}
+	if ctx.BlockTime().After(parent.VotingPeriodEnd) {
+		return nil, sdkerrors.Wrap(errors.ErrExpired, "voting period of parent proposal has ended already")
+	}
+
+	parentPolicyInfo, err := k.getGroupPolicyInfo(ctx, parent.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load parent group policy")
+	}
+
+	// The group policy must be allowed to vote on the parent proposal.
+	member := group.GroupMember{GroupId: parentPolicyInfo.GroupId, Member: &group.Member{Address: req.GroupPolicyAddress}}
+	if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&member)) {
+		return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "group policy %s is not a member of group %d", req.GroupPolicyAddress, parentPolicyInfo.GroupId)
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, req.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group policy")
+	}
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	// Prevent sub-proposal that can't be executed before the end of the
+	// parent proposal's voting period.
+	if ctx.BlockTime().Add(policy.GetMinExecutionPeriod()).After(parent.VotingPeriodEnd) {
+		return nil, sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period of group policy ends after the voting period of parent proposal")
+	}
+
+	proposalReq, err := req.SubProposal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.SubmitProposal(goCtx, proposalReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgSubmitSubProposalResponse{ProposalId: res.ProposalId}, nil
+}
+
 func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	id := req.ProposalId
@@ -652,6 +726,23 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 		return nil, sdkerrors.Wrap(err, "store vote")
 	}
 
+	// A new vote may make the proposal pass, so bring forward its automatic
+	// execution if it was postponed to the end of the voting period.
+	if proposal.NextExecutionTime != nil && proposal.NextExecutionTime.After(ctx.BlockTime()) {
+		policy, err := policyInfo.GetDecisionPolicy()
+		if err != nil {
+			return nil, err
+		}
+
+		if ctx.BlockTime().Sub(proposal.SubmitTime) >= policy.GetMinExecutionPeriod() {
+			nextExecutionTime := ctx.BlockTime()
+			proposal.NextExecutionTime = &nextExecutionTime
+			if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(&group.EventVote{ProposalId: id})
 	if err != nil {
 		return nil, err
@@ -675,18 +766,39 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
 // - prune all the votes.
 func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
+	// The tally result of an accepted or rejected proposal is final, and its
+	// votes have already been pruned.
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+		return nil
+	}
+
 	policy, err := policyInfo.GetDecisionPolicy()
 	if err != nil {
 		return err
 	}
 
+	// Member decision policies decide on the current group members, so a
+	// proposal is aborted if the group was modified since its submission,
+	// instead of being decided by members who couldn't vote on it. Unlike
+	// group policy updates, group updates don't go through abortProposals, as
+	// the proposals of the other decision policies stay valid.
+	if _, ok := policy.(group.MemberDecisionPolicy); ok && electorate.Version != p.GroupVersion {
+		p.Status = group.PROPOSAL_STATUS_ABORTED
+		return nil
+	}
+
 	tallyResult, err := k.Tally(ctx, *p, policyInfo.GroupId)
 	if err != nil {
 		return err
 	}
 
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission)
+	var result group.DecisionPolicyResult
+	if memberPolicy, ok := policy.(group.MemberDecisionPolicy); ok {
+		result, err = k.doAllowMembers(ctx, memberPolicy, p.Id, policyInfo.GroupId, sinceSubmission)
+	} else {
+		result, err = policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission)
+	}
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd)
@@ -710,6 +822,22 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 	return nil
 }
 
+// doAllowMembers loads the votes of a proposal and the members of its group to
+// decide on the proposal with a MemberDecisionPolicy.
+func (k Keeper) doAllowMembers(ctx sdk.Context, policy group.MemberDecisionPolicy, proposalID, groupID uint64, sinceSubmission time.Duration) (group.DecisionPolicyResult, error) {
+	votes, err := k.votesByProposal(ctx, proposalID)
+	if err != nil {
+		return group.DecisionPolicyResult{}, err
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return group.DecisionPolicyResult{}, err
+	}
+
+	return policy.AllowMembers(votes, members, sinceSubmission)
+}
+
 // Exec executes the messages from a proposal.
 func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -746,22 +874,9 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
-		// Caching context so that we don't update the store in case of failure.
-		ctx, flush := ctx.CacheContext()
-
-		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
-		if err != nil {
+		if logs, err = k.doExecuteProposal(ctx, &proposal, policyInfo); err != nil {
 			return nil, err
 		}
-		_, err = k.doExecuteMsgs(ctx, k.router, proposal, addr)
-		if err != nil {
-			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
-			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", id, err.Error())
-			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", id)
-		} else {
-			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
-			flush()
-		}
 	}
 
 	// Update proposal in proposalTable
@@ -10,6 +10,29 @@ import (
 	grouperrors "github.com/cosmos/cosmos-sdk/x/group/errors"
 )
 
+// doExecuteProposal executes the messages of an accepted proposal in a cached
+// context, so that the store is only updated on success, and sets the
+// proposal's `ExecutorResult` accordingly. On failure, it returns the logs of
+// the execution.
+func (s Keeper) doExecuteProposal(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) (string, error) {
+	addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
+	if err != nil {
+		return "", err
+	}
+
+	// Caching context so that we don't update the store in case of failure.
+	cacheCtx, flush := ctx.CacheContext()
+	if _, err := s.doExecuteMsgs(cacheCtx, s.router, *proposal, addr); err != nil {
+		proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		s.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+		return fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error()), nil
+	}
+
+	proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	flush()
+	return "", nil
+}
+
 // doExecuteMsgs routes the messages to the registered handlers. Messages are limited to those that require no authZ or
 // by the account of group policy only. Otherwise this gives access to other peoples accounts as the sdk middlewares are bypassed
 func (s Keeper) doExecuteMsgs(ctx sdk.Context, router *baseapp.MsgServiceRouter, proposal group.Proposal, groupPolicyAcc sdk.AccAddress) ([]sdk.Result, error) {
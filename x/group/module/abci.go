@@ -9,6 +9,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		panic(err)
 	}
+	if err := k.ExecuteProposalsAtNextExecTime(ctx); err != nil {
+		panic(err)
+	}
 	pruneProposals(ctx, k)
 }
 
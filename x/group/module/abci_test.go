@@ -1,10 +1,13 @@
 package module_test
 
 import (
+	"bytes"
 	"context"
+	"os"
 	"testing"
 	"time"
 
+	"cosmossdk.io/core/appconfig"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -541,6 +544,210 @@ func (s *IntegrationTestSuite) TestEndBlockerTallying() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestEndBlockerAutoExecution() {
+	app := s.app
+	ctx := s.ctx
+
+	addrs := s.addrs
+
+	// Initial group, group policy and balance setup
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"}, {Address: addrs[2].String(), Weight: "2"},
+	}
+
+	groupRes, err := s.groupKeeper.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   addrs[0].String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "2",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:       time.Hour,
+			MinExecutionPeriod: time.Minute,
+			AutoExecution: &group.AutoExecution{
+				MaxRetries:    1,
+				RetryInterval: time.Minute,
+			},
+		},
+	}
+
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:   addrs[0].String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = policyReq.SetDecisionPolicy(policy)
+	s.Require().NoError(err)
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(ctx, policyReq)
+	s.Require().NoError(err)
+
+	groupPolicyAddr, err := sdk.AccAddressFromBech32(policyRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(testutil.FundAccount(s.bankKeeper, ctx, groupPolicyAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	proposers := []string{addrs[2].String()}
+	getProposal := func(ctx sdk.Context, id uint64) group.Proposal {
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return *res.Proposal
+	}
+
+	s.Run("accepted proposal is executed after min execution period", func() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: groupPolicyAddr.String(),
+			ToAddress:   addrs[3].String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}
+		pID, err := submitProposalAndVote(s, app, ctx, []sdk.Msg{msgSend}, proposers, groupPolicyAddr, group.VOTE_OPTION_YES)
+		s.Require().NoError(err)
+
+		proposal := getProposal(ctx, pID)
+		s.Require().NotNil(proposal.NextExecutionTime)
+		s.Require().Equal(ctx.BlockTime().Add(time.Minute), *proposal.NextExecutionTime)
+
+		// Nothing happens before the min execution period.
+		module.EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(30*time.Second)), s.groupKeeper)
+		s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, getProposal(ctx, pID).Status)
+
+		balanceBefore := s.bankKeeper.GetBalance(ctx, addrs[3], "test")
+		execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+		module.EndBlocker(execCtx, s.groupKeeper)
+
+		// Successfully executed proposals are pruned.
+		_, err = s.groupKeeper.Proposal(execCtx, &group.QueryProposalRequest{ProposalId: pID})
+		s.Require().Error(err)
+		balanceAfter := s.bankKeeper.GetBalance(execCtx, addrs[3], "test")
+		s.Require().Equal(balanceBefore.AddAmount(sdk.NewInt(100)), balanceAfter)
+	})
+
+	s.Run("failed execution is retried until max retries", func() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: groupPolicyAddr.String(),
+			ToAddress:   addrs[3].String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100000)},
+		}
+		pID, err := submitProposalAndVote(s, app, ctx, []sdk.Msg{msgSend}, proposers, groupPolicyAddr, group.VOTE_OPTION_YES)
+		s.Require().NoError(err)
+
+		firstCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+		module.EndBlocker(firstCtx, s.groupKeeper)
+		proposal := getProposal(firstCtx, pID)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Status)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+		s.Require().Equal(uint32(1), proposal.ExecutionAttempts)
+		s.Require().NotNil(proposal.NextExecutionTime)
+		s.Require().Equal(firstCtx.BlockTime().Add(time.Minute), *proposal.NextExecutionTime)
+
+		retryCtx := ctx.WithBlockTime(ctx.BlockTime().Add(4 * time.Minute))
+		module.EndBlocker(retryCtx, s.groupKeeper)
+		proposal = getProposal(retryCtx, pID)
+		s.Require().Equal(uint32(2), proposal.ExecutionAttempts)
+		s.Require().Nil(proposal.NextExecutionTime)
+
+		// No more retries are scheduled.
+		laterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(6 * time.Minute))
+		module.EndBlocker(laterCtx, s.groupKeeper)
+		s.Require().Equal(uint32(2), getProposal(laterCtx, pID).ExecutionAttempts)
+	})
+
+	s.Run("undecided proposal is postponed until new votes", func() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: groupPolicyAddr.String(),
+			ToAddress:   addrs[3].String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}
+		pID, err := submitProposal(s, app, ctx, []sdk.Msg{msgSend}, proposers, groupPolicyAddr)
+		s.Require().NoError(err)
+
+		tallyCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+		module.EndBlocker(tallyCtx, s.groupKeeper)
+		proposal := getProposal(tallyCtx, pID)
+		s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+		s.Require().NotNil(proposal.NextExecutionTime)
+		s.Require().Equal(proposal.VotingPeriodEnd, *proposal.NextExecutionTime)
+
+		voteCtx := ctx.WithBlockTime(ctx.BlockTime().Add(3 * time.Minute))
+		_, err = s.groupKeeper.Vote(voteCtx, &group.MsgVote{
+			ProposalId: pID,
+			Voter:      proposers[0],
+			Option:     group.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+		proposal = getProposal(voteCtx, pID)
+		s.Require().Equal(voteCtx.BlockTime(), *proposal.NextExecutionTime)
+
+		execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(4 * time.Minute))
+		module.EndBlocker(execCtx, s.groupKeeper)
+		_, err = s.groupKeeper.Proposal(execCtx, &group.QueryProposalRequest{ProposalId: pID})
+		s.Require().Error(err)
+	})
+}
+
+func (s *IntegrationTestSuite) TestEndBlockerAutoExecutionOutOfGas() {
+	appConfig, err := os.ReadFile("../testutil/app.yaml")
+	s.Require().NoError(err)
+	appConfig = bytes.Replace(appConfig,
+		[]byte(`"@type": cosmos.group.module.v1.Module`),
+		[]byte("\"@type\": cosmos.group.module.v1.Module\n      max_auto_execution_gas: 1000"), 1)
+
+	app, err := simtestutil.Setup(
+		appconfig.LoadYAML(appConfig),
+		&s.interfaceRegistry,
+		&s.bankKeeper,
+		&s.stakingKeeper,
+		&s.groupKeeper,
+	)
+	s.Require().NoError(err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	addrs := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 4, sdk.NewInt(30000000))
+
+	groupRes, err := s.groupKeeper.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   addrs[0].String(),
+		Members: []group.MemberRequest{{Address: addrs[1].String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:   addrs[0].String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = policyReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:       time.Hour,
+			MinExecutionPeriod: time.Minute,
+			AutoExecution:      &group.AutoExecution{MaxRetries: 1, RetryInterval: time.Minute},
+		},
+	})
+	s.Require().NoError(err)
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(ctx, policyReq)
+	s.Require().NoError(err)
+	groupPolicyAddr, err := sdk.AccAddressFromBech32(policyRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(testutil.FundAccount(s.bankKeeper, ctx, groupPolicyAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: groupPolicyAddr.String(),
+		ToAddress:   addrs[3].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	pID, err := submitProposalAndVote(s, app, ctx, []sdk.Msg{msgSend}, []string{addrs[1].String()}, groupPolicyAddr, group.VOTE_OPTION_YES)
+	s.Require().NoError(err)
+
+	// Running out of gas doesn't halt the chain: the failure is recorded on
+	// the proposal, which isn't scheduled for automatic execution anymore.
+	execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	s.Require().NotPanics(func() { module.EndBlocker(execCtx, s.groupKeeper) })
+
+	res, err := s.groupKeeper.Proposal(execCtx, &group.QueryProposalRequest{ProposalId: pID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Proposal.ExecutorResult)
+	s.Require().Equal(uint32(1), res.Proposal.ExecutionAttempts)
+	s.Require().Nil(res.Proposal.NextExecutionTime)
+	s.Require().True(s.bankKeeper.GetBalance(execCtx, addrs[3], "test").IsZero())
+}
+
 func submitProposal(s *IntegrationTestSuite, app *runtime.App, ctx context.Context, msgs []sdk.Msg, proposers []string, groupPolicyAddr sdk.AccAddress) (uint64, error) {
 	proposalReq := &group.MsgSubmitProposal{
 		GroupPolicyAddress: groupPolicyAddr.String(),
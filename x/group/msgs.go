@@ -801,6 +801,87 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSubmitSubProposal{}
+
+// NewMsgSubmitSubProposal creates a new MsgSubmitSubProposal.
+func NewMsgSubmitSubProposal(address string, proposers []string, parentProposalID uint64, option VoteOption, metadata string, exec Exec) *MsgSubmitSubProposal {
+	return &MsgSubmitSubProposal{
+		GroupPolicyAddress: address,
+		Proposers:          proposers,
+		Metadata:           metadata,
+		ParentProposalId:   parentProposalID,
+		Option:             option,
+		Exec:               exec,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgSubmitSubProposal) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg.
+func (m MsgSubmitSubProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgSubmitSubProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitSubProposal.
+func (m MsgSubmitSubProposal) GetSigners() []sdk.AccAddress {
+	addrs, err := m.subProposal().getProposerAccAddresses()
+	if err != nil {
+		panic(err)
+	}
+
+	return addrs
+}
+
+// ValidateBasic does a sanity check on the provided sub-proposal.
+func (m MsgSubmitSubProposal) ValidateBasic() error {
+	if m.ParentProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "parent proposal id")
+	}
+
+	proposal, err := m.SubProposal()
+	if err != nil {
+		return err
+	}
+
+	return proposal.ValidateBasic()
+}
+
+// VoteMsg returns the vote on the parent proposal executed by the
+// sub-proposal.
+func (m MsgSubmitSubProposal) VoteMsg() *MsgVote {
+	return &MsgVote{
+		ProposalId: m.ParentProposalId,
+		Voter:      m.GroupPolicyAddress,
+		Option:     m.Option,
+	}
+}
+
+// subProposal returns the MsgSubmitProposal submitting the sub-proposal.
+func (m MsgSubmitSubProposal) subProposal() *MsgSubmitProposal {
+	return &MsgSubmitProposal{
+		GroupPolicyAddress: m.GroupPolicyAddress,
+		Proposers:          m.Proposers,
+		Metadata:           m.Metadata,
+		Exec:               m.Exec,
+	}
+}
+
+// SubProposal returns the MsgSubmitProposal submitting the sub-proposal, with
+// the vote on the parent proposal as its only message.
+func (m MsgSubmitSubProposal) SubProposal() (*MsgSubmitProposal, error) {
+	proposal := m.subProposal()
+	if err := proposal.SetMsgs([]sdk.Msg{m.VoteMsg()}); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
@@ -1021,6 +1021,61 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitSubProposal(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgSubmitSubProposal
+		expErr bool
+		errMsg string
+	}{
+		{
+			"parent proposal id is required",
+			group.NewMsgSubmitSubProposal(member1.String(), []string{member2.String()}, 0, group.VOTE_OPTION_YES, "", group.Exec_EXEC_UNSPECIFIED),
+			true,
+			"parent proposal id: value is empty",
+		},
+		{
+			"invalid group policy address",
+			group.NewMsgSubmitSubProposal("policy", []string{member2.String()}, 1, group.VOTE_OPTION_YES, "", group.Exec_EXEC_UNSPECIFIED),
+			true,
+			"group policy: decoding bech32 failed",
+		},
+		{
+			"proposers required",
+			group.NewMsgSubmitSubProposal(member1.String(), nil, 1, group.VOTE_OPTION_YES, "", group.Exec_EXEC_UNSPECIFIED),
+			true,
+			"proposers: value is empty",
+		},
+		{
+			"unspecified vote option",
+			group.NewMsgSubmitSubProposal(member1.String(), []string{member2.String()}, 1, group.VOTE_OPTION_UNSPECIFIED, "", group.Exec_EXEC_UNSPECIFIED),
+			true,
+			"vote option: value is empty",
+		},
+		{
+			"valid test case",
+			group.NewMsgSubmitSubProposal(member1.String(), []string{member2.String()}, 1, group.VOTE_OPTION_YES, "", group.Exec_EXEC_TRY),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgSubmitSubProposal{}))
+				require.Equal(t, []sdk.AccAddress{member2}, msg.GetSigners())
+			}
+		})
+	}
+}
+
 func TestMsgWithdrawProposal(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -1380,6 +1380,172 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryGroupMemberTreeRequest is the Query/GroupMemberTree request type.
+//
+// Since: cosmos-sdk 0.47
+type QueryGroupMemberTreeRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *QueryGroupMemberTreeRequest) Reset()         { *m = QueryGroupMemberTreeRequest{} }
+func (m *QueryGroupMemberTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMemberTreeRequest) ProtoMessage()    {}
+func (*QueryGroupMemberTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
+}
+func (m *QueryGroupMemberTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupMemberTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupMemberTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupMemberTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMemberTreeRequest.Merge(m, src)
+}
+func (m *QueryGroupMemberTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupMemberTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMemberTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupMemberTreeRequest proto.InternalMessageInfo
+
+func (m *QueryGroupMemberTreeRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// QueryGroupMemberTreeResponse is the Query/GroupMemberTree response type.
+//
+// Since: cosmos-sdk 0.47
+type QueryGroupMemberTreeResponse struct {
+	// members are the resolved members of the group.
+	Members []GroupMemberNode `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+}
+
+func (m *QueryGroupMemberTreeResponse) Reset()         { *m = QueryGroupMemberTreeResponse{} }
+func (m *QueryGroupMemberTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMemberTreeResponse) ProtoMessage()    {}
+func (*QueryGroupMemberTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
+}
+func (m *QueryGroupMemberTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupMemberTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupMemberTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupMemberTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMemberTreeResponse.Merge(m, src)
+}
+func (m *QueryGroupMemberTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupMemberTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMemberTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupMemberTreeResponse proto.InternalMessageInfo
+
+func (m *QueryGroupMemberTreeResponse) GetMembers() []GroupMemberNode {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// GroupMemberNode is a node of the tree of nested group members.
+//
+// Since: cosmos-sdk 0.47
+type GroupMemberNode struct {
+	// member is the group member.
+	Member Member `protobuf:"bytes,1,opt,name=member,proto3" json:"member"`
+	// group_id is the unique ID of the group of the member's group policy
+	// account, or 0 if the member is not a group policy account.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// members are the resolved members of the group of the member's group
+	// policy account. They are empty if this group was already resolved
+	// elsewhere in the tree, or if it is nested deeper than the max nesting
+	// depth.
+	Members []GroupMemberNode `protobuf:"bytes,3,rep,name=members,proto3" json:"members"`
+}
+
+func (m *GroupMemberNode) Reset()         { *m = GroupMemberNode{} }
+func (m *GroupMemberNode) String() string { return proto.CompactTextString(m) }
+func (*GroupMemberNode) ProtoMessage()    {}
+func (*GroupMemberNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *GroupMemberNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMemberNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMemberNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupMemberNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMemberNode.Merge(m, src)
+}
+func (m *GroupMemberNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMemberNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMemberNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMemberNode proto.InternalMessageInfo
+
+func (m *GroupMemberNode) GetMember() Member {
+	if m != nil {
+		return m.Member
+	}
+	return Member{}
+}
+
+func (m *GroupMemberNode) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupMemberNode) GetMembers() []GroupMemberNode {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1407,91 +1573,99 @@ func init() {
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryGroupMemberTreeRequest)(nil), "cosmos.group.v1.QueryGroupMemberTreeRequest")
+	proto.RegisterType((*QueryGroupMemberTreeResponse)(nil), "cosmos.group.v1.QueryGroupMemberTreeResponse")
+	proto.RegisterType((*GroupMemberNode)(nil), "cosmos.group.v1.GroupMemberNode")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0x25, 0x69, 0x92, 0x97, 0xb6, 0x11, 0x43, 0x5a, 0x12, 0x37, 0xda, 0x04, 0x03,
-	0xf9, 0x1d, 0x3b, 0xbb, 0x49, 0xd3, 0x8a, 0x9f, 0xea, 0x4a, 0x10, 0x72, 0x28, 0x4a, 0x97, 0x88,
-	0x03, 0x97, 0xc8, 0x9b, 0x75, 0x8c, 0xc5, 0xae, 0x67, 0xbb, 0x76, 0x22, 0x56, 0xd1, 0x5e, 0x90,
-	0xca, 0x01, 0x71, 0x00, 0x8a, 0x50, 0x89, 0x38, 0xf4, 0x80, 0x04, 0x7f, 0x00, 0x08, 0x89, 0x5b,
-	0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x3c, 0xf3, 0xbc, 0xeb, 0xdf, 0xeb,
-	0x15, 0x2b, 0xc8, 0xa9, 0x5d, 0xfb, 0xbd, 0x79, 0x9f, 0xf9, 0xbe, 0xe7, 0xf1, 0xd7, 0x81, 0xeb,
-	0xfb, 0xcc, 0xae, 0x31, 0x5b, 0x35, 0x1a, 0xec, 0xb0, 0xae, 0x1e, 0xe5, 0xd5, 0x7b, 0x87, 0x7a,
-	0xa3, 0xa9, 0xd4, 0x1b, 0xcc, 0x61, 0x74, 0x5c, 0xdc, 0x54, 0xf8, 0x4d, 0xe5, 0x28, 0x2f, 0x4d,
-	0x18, 0xcc, 0x60, 0xfc, 0x9e, 0xea, 0xfe, 0x4f, 0x84, 0x49, 0xd3, 0x06, 0x63, 0x46, 0x55, 0x57,
-	0xb5, 0xba, 0xa9, 0x6a, 0x96, 0xc5, 0x1c, 0xcd, 0x31, 0x99, 0x65, 0xe3, 0xdd, 0x48, 0x05, 0xa7,
-	0x59, 0xd7, 0xbd, 0x9b, 0x4b, 0x78, 0xb3, 0xac, 0xd9, 0xba, 0x28, 0xad, 0x1e, 0xe5, 0xcb, 0xba,
-	0xa3, 0xe5, 0xd5, 0xba, 0x66, 0x98, 0x16, 0x5f, 0x09, 0x63, 0xa7, 0x44, 0xec, 0x9e, 0xa8, 0x8f,
-	0x68, 0xfc, 0x87, 0x5c, 0x80, 0xab, 0x77, 0xdd, 0xe4, 0x2d, 0xb7, 0xc6, 0xb6, 0x75, 0xc0, 0x4a,
-	0xfa, 0xbd, 0x43, 0xdd, 0x76, 0xe8, 0x14, 0x8c, 0xf0, 0xba, 0x7b, 0x66, 0x65, 0x92, 0xcc, 0x92,
-	0x85, 0xc1, 0xd2, 0x30, 0xff, 0xbd, 0x5d, 0x91, 0xdf, 0x81, 0x6b, 0xe1, 0x1c, 0xbb, 0xce, 0x2c,
-	0x5b, 0xa7, 0x0a, 0x0c, 0x9a, 0xd6, 0x01, 0xe3, 0x09, 0x63, 0x05, 0x49, 0x09, 0xa9, 0xa0, 0x74,
-	0x32, 0x78, 0x9c, 0x7c, 0x17, 0xae, 0x77, 0x56, 0xda, 0x61, 0x55, 0x73, 0xbf, 0xe9, 0x67, 0x28,
-	0xc0, 0xb0, 0x56, 0xa9, 0x34, 0x74, 0xdb, 0xe6, 0x2b, 0x8e, 0x16, 0x27, 0x7f, 0xfb, 0x69, 0x75,
-	0x02, 0x17, 0xbd, 0x2d, 0xee, 0xbc, 0xe7, 0x34, 0x4c, 0xcb, 0x28, 0x79, 0x81, 0xf2, 0x2e, 0x4c,
-	0xc7, 0x2f, 0x89, 0x88, 0x1b, 0x01, 0xc4, 0xd9, 0x78, 0x44, 0x5f, 0x9e, 0x00, 0x6d, 0xc1, 0x64,
-	0x67, 0xd5, 0x3b, 0x7a, 0xad, 0xac, 0x37, 0xec, 0xee, 0x4a, 0xd1, 0xb7, 0x01, 0x3a, 0xcd, 0x98,
-	0xbc, 0xc0, 0x4b, 0xce, 0x79, 0x25, 0xdd, 0xce, 0x29, 0x62, 0x68, 0xb0, 0x73, 0xca, 0x8e, 0x66,
-	0xe8, 0xb8, 0x6c, 0xc9, 0x97, 0x29, 0x7f, 0x47, 0x60, 0x2a, 0xa6, 0x3e, 0x6e, 0x69, 0x13, 0x86,
-	0x6b, 0xe2, 0xd2, 0x24, 0x99, 0x7d, 0x66, 0x61, 0xac, 0x30, 0x1d, 0xbf, 0x2b, 0x91, 0x57, 0xf2,
-	0x82, 0xe9, 0x56, 0x0c, 0xdd, 0x7c, 0x57, 0x3a, 0x51, 0x34, 0x80, 0xf7, 0x20, 0x80, 0x67, 0x17,
-	0x9b, 0xb7, 0x2b, 0x35, 0xd3, 0xf2, 0xf4, 0x51, 0x60, 0x48, 0x73, 0x7f, 0x77, 0xed, 0xa1, 0x08,
-	0xeb, 0x9b, 0x68, 0xdf, 0x12, 0x90, 0xe2, 0xa8, 0x50, 0xb5, 0x02, 0x5c, 0xe4, 0xf2, 0x78, 0xa2,
-	0xa5, 0x4d, 0x2b, 0x46, 0xf6, 0x4f, 0xb1, 0xfb, 0x04, 0x66, 0x43, 0x63, 0x6a, 0xea, 0x76, 0x51,
-	0xfc, 0xfc, 0x0f, 0x07, 0xeb, 0x67, 0x02, 0x2f, 0xa4, 0x70, 0xa0, 0x54, 0x5b, 0x70, 0x45, 0x80,
-	0xd4, 0x31, 0x00, 0x25, 0xeb, 0xfe, 0xf4, 0x5c, 0x36, 0xfc, 0xeb, 0xf6, 0x4f, 0xbf, 0x93, 0x04,
-	0xfd, 0xce, 0xc5, 0xe0, 0x25, 0x89, 0x1a, 0x9c, 0xbf, 0xf3, 0x27, 0xea, 0x4d, 0x98, 0xe0, 0xd8,
-	0x3b, 0x0d, 0x56, 0x67, 0xb6, 0x56, 0xf5, 0x74, 0x9c, 0x81, 0xb1, 0x3a, 0x5e, 0xea, 0x8c, 0x22,
-	0x78, 0x97, 0xb6, 0x2b, 0xf2, 0xbb, 0xf8, 0x12, 0xe9, 0x24, 0xe2, 0x1e, 0x6f, 0xc0, 0x88, 0x17,
-	0x86, 0x07, 0xee, 0x54, 0x64, 0x77, 0xed, 0xa4, 0x76, 0xa8, 0xfc, 0x88, 0x80, 0x1c, 0x58, 0xd0,
-	0x9b, 0x48, 0x21, 0xc2, 0xbf, 0x78, 0x3d, 0xf4, 0xad, 0xc7, 0x3f, 0x10, 0x78, 0x31, 0x15, 0x11,
-	0x15, 0xb8, 0x09, 0xa3, 0xde, 0xb6, 0xbc, 0x06, 0xa7, 0x48, 0xd0, 0x89, 0xed, 0x5f, 0x57, 0x1b,
-	0x30, 0xc3, 0x41, 0xdf, 0x67, 0x8e, 0x5e, 0x6c, 0xe3, 0xba, 0xbf, 0x1a, 0x59, 0x1b, 0xec, 0x3e,
-	0x49, 0x47, 0x6e, 0x02, 0xe7, 0x48, 0x7d, 0x92, 0x78, 0x98, 0x7c, 0x07, 0x9f, 0xce, 0xd8, 0x9a,
-	0xa8, 0xcc, 0x22, 0x0c, 0xba, 0xc1, 0x38, 0x17, 0x57, 0x23, 0xa2, 0xb8, 0xd1, 0x25, 0x1e, 0x22,
-	0x7f, 0x4a, 0xd0, 0x27, 0xb8, 0xd7, 0xec, 0x62, 0xcf, 0x03, 0xda, 0xb7, 0xae, 0x7f, 0x4d, 0xd0,
-	0x5d, 0x44, 0x40, 0x70, 0x53, 0xcb, 0x42, 0x28, 0xaf, 0xd5, 0x09, 0xbb, 0x12, 0x31, 0xfd, 0x6b,
-	0xf1, 0x57, 0x04, 0xed, 0x09, 0x62, 0x05, 0x9a, 0xdb, 0xee, 0x1d, 0xc9, 0xd4, 0xbb, 0xbe, 0x69,
-	0xf5, 0xa5, 0x67, 0x0a, 0x82, 0x50, 0xff, 0xab, 0x50, 0x0f, 0xc3, 0x96, 0x00, 0x2d, 0xd1, 0x39,
-	0x38, 0x50, 0x4e, 0x88, 0xdf, 0x0b, 0xfb, 0xd0, 0xce, 0x83, 0x5d, 0x79, 0x05, 0x9e, 0xe7, 0x6c,
-	0xbb, 0x5a, 0xb5, 0xea, 0x9e, 0x6d, 0x87, 0x55, 0x27, 0xf3, 0xcb, 0x61, 0x17, 0x67, 0x33, 0x90,
-	0x8b, 0x9b, 0xba, 0x05, 0x43, 0x8e, 0x7b, 0x19, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x15, 0x07,
-	0x9f, 0xfc, 0x39, 0x33, 0x50, 0x12, 0x09, 0x85, 0xfb, 0xcf, 0xc2, 0x10, 0x5f, 0x96, 0x7e, 0x4e,
-	0x60, 0xb4, 0xbd, 0x75, 0x3a, 0x17, 0x59, 0x22, 0xf6, 0xf3, 0x46, 0x9a, 0xef, 0x1a, 0x27, 0x10,
-	0x65, 0xe5, 0x93, 0xdf, 0xff, 0x7e, 0x70, 0x61, 0x81, 0xce, 0xa9, 0xe1, 0xaf, 0x31, 0xf4, 0x66,
-	0xd6, 0x01, 0x53, 0x8f, 0x3d, 0x9f, 0xd6, 0xa2, 0xdf, 0x13, 0x18, 0x0f, 0xbd, 0xb0, 0xe9, 0x4a,
-	0x4a, 0xb1, 0xc8, 0x57, 0x8f, 0xb4, 0x9a, 0x31, 0x1a, 0x01, 0x37, 0x38, 0xa0, 0x42, 0x57, 0x12,
-	0x00, 0xb9, 0xbd, 0x68, 0x22, 0x27, 0x4e, 0x6d, 0x8b, 0x3e, 0x24, 0x70, 0xc9, 0xff, 0x31, 0x41,
-	0x17, 0x53, 0xaa, 0x06, 0x3f, 0x78, 0xa4, 0xa5, 0x2c, 0xa1, 0x48, 0x97, 0xe7, 0x74, 0xcb, 0x74,
-	0x31, 0x81, 0x0e, 0xbf, 0x45, 0xfc, 0x0a, 0x9e, 0x10, 0xb8, 0x1c, 0xb0, 0xec, 0x34, 0xad, 0x60,
-	0xc8, 0xf4, 0x49, 0xcb, 0x99, 0x62, 0x91, 0x6e, 0x8d, 0xd3, 0x2d, 0xd1, 0x85, 0x78, 0x3a, 0x7b,
-	0xaf, 0xdc, 0xdc, 0xe3, 0xde, 0xd0, 0x55, 0xae, 0x66, 0x5a, 0x2d, 0xfa, 0x2b, 0x81, 0x89, 0x38,
-	0xaf, 0x4c, 0xf3, 0xdd, 0xba, 0x16, 0xf1, 0xf7, 0x52, 0xa1, 0x97, 0x14, 0x24, 0x7e, 0x95, 0x13,
-	0xdf, 0xa0, 0xeb, 0x69, 0xdd, 0x36, 0x75, 0x4e, 0x2e, 0x6e, 0xf9, 0x94, 0xfd, 0x25, 0x0a, 0x2f,
-	0x04, 0xce, 0x06, 0x1f, 0xd0, 0xb9, 0xd0, 0x4b, 0x0a, 0xc2, 0xdf, 0xe2, 0xf0, 0x05, 0xba, 0x96,
-	0x01, 0x3e, 0x28, 0xfb, 0x67, 0x04, 0x46, 0xbc, 0x97, 0x2d, 0x7d, 0x39, 0xbe, 0x74, 0xc8, 0x15,
-	0x48, 0x73, 0xdd, 0xc2, 0x90, 0x4a, 0xe5, 0x54, 0x8b, 0x74, 0x3e, 0x42, 0xe5, 0x9d, 0x62, 0xea,
-	0xb1, 0xef, 0x88, 0x6b, 0xd1, 0xc7, 0x04, 0xae, 0xc5, 0xdb, 0x3e, 0xba, 0x9e, 0x5e, 0x33, 0xd6,
-	0xc7, 0x4a, 0x1b, 0xbd, 0x25, 0x21, 0xf6, 0x6b, 0x1c, 0x7b, 0x93, 0x6e, 0x24, 0x62, 0x77, 0x86,
-	0x00, 0x0f, 0x01, 0xdf, 0xf3, 0xff, 0x98, 0xc0, 0x73, 0x31, 0xee, 0x8c, 0xae, 0xc5, 0xb3, 0x24,
-	0x9b, 0x47, 0x29, 0xdf, 0x43, 0x06, 0xa2, 0xbf, 0xc5, 0xd1, 0xdf, 0xa4, 0xaf, 0x47, 0xd0, 0xdd,
-	0xf7, 0xbd, 0x4b, 0xdd, 0xd6, 0x9b, 0x7b, 0x92, 0xa0, 0xfe, 0xea, 0x31, 0xbf, 0xd8, 0xa2, 0x3f,
-	0x12, 0x18, 0x0f, 0x19, 0xb1, 0xa4, 0xa3, 0x36, 0xde, 0x38, 0x26, 0x1d, 0xb5, 0x09, 0xee, 0x2e,
-	0x65, 0x7e, 0xb9, 0x4f, 0xf1, 0x83, 0x87, 0x46, 0xe6, 0x1b, 0x02, 0x97, 0xfc, 0x3e, 0x28, 0xe9,
-	0xb8, 0x8d, 0x31, 0x70, 0x49, 0xc7, 0x6d, 0x9c, 0xad, 0x4a, 0x99, 0xe5, 0x36, 0x21, 0x2a, 0x8a,
-	0x1a, 0x3e, 0x22, 0x70, 0x25, 0xe8, 0x38, 0x68, 0x97, 0x13, 0x34, 0x60, 0x99, 0xa4, 0x95, 0x6c,
-	0xc1, 0x88, 0xb7, 0xce, 0xf1, 0x56, 0xe9, 0x72, 0xca, 0x79, 0x2b, 0xde, 0x08, 0xbe, 0x51, 0x3d,
-	0x21, 0x30, 0xe6, 0xf3, 0x01, 0x74, 0x21, 0xbe, 0x64, 0xd4, 0x9b, 0x48, 0x8b, 0x19, 0x22, 0x91,
-	0x6c, 0x93, 0x93, 0xad, 0x51, 0x25, 0xf9, 0x69, 0x0a, 0x4d, 0x21, 0xf7, 0x21, 0xc5, 0x37, 0x9e,
-	0x9c, 0xe6, 0xc8, 0xd3, 0xd3, 0x1c, 0xf9, 0xeb, 0x34, 0x47, 0xbe, 0x38, 0xcb, 0x0d, 0x3c, 0x3d,
-	0xcb, 0x0d, 0xfc, 0x71, 0x96, 0x1b, 0xf8, 0xe0, 0x25, 0xc3, 0x74, 0x3e, 0x3c, 0x2c, 0x2b, 0xfb,
-	0xac, 0xe6, 0xad, 0x29, 0xfe, 0x59, 0xb5, 0x2b, 0x1f, 0xa9, 0x1f, 0x8b, 0x02, 0xe5, 0x8b, 0xfc,
-	0xcf, 0xb0, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x11, 0xfe, 0x04, 0xa9, 0x4e, 0x16, 0x00,
-	0x00,
+	// 1340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x69, 0xda, 0x26, 0x2f, 0x6d, 0x23, 0x0d, 0x49, 0xeb, 0x6c, 0x22, 0x27, 0x2c,
+	0x90, 0xdf, 0xde, 0x8d, 0x9d, 0x1f, 0x8d, 0xf8, 0x5d, 0x4b, 0x10, 0x72, 0x68, 0x95, 0x9a, 0x88,
+	0x03, 0x97, 0x60, 0xc7, 0x1b, 0xb3, 0xc2, 0xf6, 0xba, 0xbb, 0x9b, 0x08, 0x2b, 0xf2, 0x05, 0x09,
+	0x0e, 0x88, 0x03, 0x50, 0x84, 0x4a, 0xc4, 0xa1, 0x07, 0x10, 0x5c, 0xb8, 0x81, 0x90, 0xb8, 0xf5,
+	0xd6, 0x63, 0x05, 0x17, 0x4e, 0x08, 0x25, 0xfc, 0x21, 0x68, 0x67, 0xde, 0xda, 0xfb, 0x63, 0x76,
+	0xbd, 0x01, 0x0b, 0x72, 0x6a, 0xbd, 0xf3, 0xde, 0xcc, 0x67, 0xbe, 0xef, 0xed, 0xec, 0x77, 0x02,
+	0x13, 0x7b, 0x86, 0x55, 0x33, 0x2c, 0xb5, 0x62, 0x1a, 0x07, 0x0d, 0xf5, 0x30, 0xab, 0xde, 0x3b,
+	0xd0, 0xcc, 0xa6, 0xd2, 0x30, 0x0d, 0xdb, 0xa0, 0x23, 0x7c, 0x50, 0x61, 0x83, 0xca, 0x61, 0x56,
+	0x1a, 0xad, 0x18, 0x15, 0x83, 0x8d, 0xa9, 0xce, 0xff, 0x78, 0x98, 0x34, 0x59, 0x31, 0x8c, 0x4a,
+	0x55, 0x53, 0x8b, 0x0d, 0x5d, 0x2d, 0xd6, 0xeb, 0x86, 0x5d, 0xb4, 0x75, 0xa3, 0x6e, 0xe1, 0x68,
+	0x68, 0x05, 0xbb, 0xd9, 0xd0, 0xdc, 0xc1, 0x05, 0x1c, 0x2c, 0x15, 0x2d, 0x8d, 0x2f, 0xad, 0x1e,
+	0x66, 0x4b, 0x9a, 0x5d, 0xcc, 0xaa, 0x8d, 0x62, 0x45, 0xaf, 0xb3, 0x99, 0x30, 0x76, 0x9c, 0xc7,
+	0xee, 0xf2, 0xf5, 0x11, 0x8d, 0xfd, 0x90, 0x73, 0x30, 0x76, 0xd7, 0x49, 0xde, 0x74, 0xd6, 0xd8,
+	0xaa, 0xef, 0x1b, 0x05, 0xed, 0xde, 0x81, 0x66, 0xd9, 0x74, 0x1c, 0x06, 0xd9, 0xba, 0xbb, 0x7a,
+	0x39, 0x45, 0xa6, 0xc9, 0xdc, 0x40, 0xe1, 0x32, 0xfb, 0xbd, 0x55, 0x96, 0xdf, 0x80, 0xeb, 0xc1,
+	0x1c, 0xab, 0x61, 0xd4, 0x2d, 0x8d, 0x2a, 0x30, 0xa0, 0xd7, 0xf7, 0x0d, 0x96, 0x30, 0x9c, 0x93,
+	0x94, 0x80, 0x0a, 0x4a, 0x27, 0x83, 0xc5, 0xc9, 0x77, 0x61, 0xa2, 0x33, 0xd3, 0xb6, 0x51, 0xd5,
+	0xf7, 0x9a, 0x5e, 0x86, 0x1c, 0x5c, 0x2e, 0x96, 0xcb, 0xa6, 0x66, 0x59, 0x6c, 0xc6, 0xa1, 0x7c,
+	0xea, 0xd7, 0x1f, 0x33, 0xa3, 0x38, 0xe9, 0x2d, 0x3e, 0xf2, 0xa6, 0x6d, 0xea, 0xf5, 0x4a, 0xc1,
+	0x0d, 0x94, 0x77, 0x60, 0x52, 0x3c, 0x25, 0x22, 0xae, 0xfa, 0x10, 0xa7, 0xc5, 0x88, 0x9e, 0x3c,
+	0x0e, 0xda, 0x82, 0x54, 0x67, 0xd6, 0xdb, 0x5a, 0xad, 0xa4, 0x99, 0x56, 0x77, 0xa5, 0xe8, 0xeb,
+	0x00, 0x9d, 0x62, 0xa4, 0xfa, 0xd9, 0x92, 0x33, 0xee, 0x92, 0x4e, 0xe5, 0x14, 0xde, 0x34, 0x58,
+	0x39, 0x65, 0xbb, 0x58, 0xd1, 0x70, 0xda, 0x82, 0x27, 0x53, 0xfe, 0x9a, 0xc0, 0xb8, 0x60, 0x7d,
+	0xdc, 0xd2, 0x3a, 0x5c, 0xae, 0xf1, 0x47, 0x29, 0x32, 0x7d, 0x61, 0x6e, 0x38, 0x37, 0x29, 0xde,
+	0x15, 0xcf, 0x2b, 0xb8, 0xc1, 0x74, 0x53, 0x40, 0x37, 0xdb, 0x95, 0x8e, 0x2f, 0xea, 0xc3, 0xbb,
+	0xef, 0xc3, 0xb3, 0xf2, 0xcd, 0x5b, 0xe5, 0x9a, 0x5e, 0x77, 0xf5, 0x51, 0xe0, 0x62, 0xd1, 0xf9,
+	0xdd, 0xb5, 0x86, 0x3c, 0xac, 0x67, 0xa2, 0x7d, 0x45, 0x40, 0x12, 0x51, 0xa1, 0x6a, 0x39, 0xb8,
+	0xc4, 0xe4, 0x71, 0x45, 0x8b, 0xeb, 0x56, 0x8c, 0xec, 0x9d, 0x62, 0x1f, 0x12, 0x98, 0x0e, 0xb4,
+	0xa9, 0xae, 0x59, 0x79, 0xfe, 0xf3, 0x3f, 0x6c, 0xac, 0x9f, 0x08, 0x3c, 0x1d, 0xc3, 0x81, 0x52,
+	0x6d, 0xc2, 0x35, 0x0e, 0xd2, 0xc0, 0x00, 0x94, 0xac, 0xfb, 0xdb, 0x73, 0xb5, 0xe2, 0x9d, 0xb7,
+	0x77, 0xfa, 0x1d, 0x47, 0xe8, 0x77, 0x2e, 0x1a, 0x2f, 0x4a, 0x54, 0x7f, 0xff, 0x9d, 0x3f, 0x51,
+	0x6f, 0xc2, 0x28, 0xc3, 0xde, 0x36, 0x8d, 0x86, 0x61, 0x15, 0xab, 0xae, 0x8e, 0x53, 0x30, 0xdc,
+	0xc0, 0x47, 0x9d, 0x56, 0x04, 0xf7, 0xd1, 0x56, 0x59, 0xbe, 0x03, 0x63, 0x81, 0x44, 0xdc, 0xe3,
+	0x1a, 0x0c, 0xba, 0x61, 0x78, 0xe0, 0x8e, 0x87, 0x76, 0xd7, 0x4e, 0x6a, 0x87, 0xca, 0x0f, 0x09,
+	0xc8, 0xbe, 0x09, 0xdd, 0x8e, 0xe4, 0x22, 0xfc, 0x8b, 0xcf, 0x43, 0xcf, 0x6a, 0xfc, 0x1d, 0x81,
+	0x67, 0x62, 0x11, 0x51, 0x81, 0x9b, 0x30, 0xe4, 0x6e, 0xcb, 0x2d, 0x70, 0x8c, 0x04, 0x9d, 0xd8,
+	0xde, 0x55, 0xd5, 0x84, 0x29, 0x06, 0xfa, 0x96, 0x61, 0x6b, 0xf9, 0x36, 0xae, 0xf3, 0xcb, 0x4c,
+	0x5a, 0x60, 0xe7, 0x4d, 0x3a, 0x74, 0x12, 0x52, 0xfd, 0x5d, 0x74, 0xe6, 0x61, 0xf2, 0x6d, 0x7c,
+	0x3b, 0x85, 0x6b, 0xa2, 0x32, 0xf3, 0x30, 0xe0, 0x04, 0x63, 0x5f, 0x8c, 0x85, 0x44, 0x71, 0xa2,
+	0x0b, 0x2c, 0x44, 0xfe, 0x88, 0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x33, 0x37, 0x68, 0xcf, 0xaa,
+	0xfe, 0x05, 0x81, 0x49, 0x31, 0x08, 0x6e, 0x6a, 0x91, 0x0b, 0xe5, 0x96, 0x3a, 0x62, 0x57, 0x3c,
+	0xa6, 0x77, 0x25, 0xfe, 0x9c, 0xa0, 0x3d, 0x41, 0x2c, 0x5f, 0x71, 0xdb, 0xb5, 0x23, 0x89, 0x6a,
+	0xd7, 0x33, 0xad, 0x3e, 0x73, 0x4d, 0x81, 0x1f, 0xea, 0x7f, 0x15, 0xea, 0x41, 0xd0, 0x12, 0xa0,
+	0x25, 0x3a, 0x07, 0x07, 0xca, 0x31, 0x81, 0x09, 0x21, 0xda, 0x79, 0xb0, 0x2b, 0xcf, 0xc3, 0x0d,
+	0xc6, 0xb6, 0x53, 0xac, 0x56, 0x9d, 0xb3, 0xed, 0xa0, 0x6a, 0x27, 0xfe, 0x38, 0xec, 0x40, 0x2a,
+	0x9c, 0x8b, 0x9b, 0xda, 0x80, 0x8b, 0xb6, 0xf3, 0x18, 0x0f, 0x81, 0xb0, 0x6f, 0xf5, 0x24, 0xe5,
+	0x07, 0x1e, 0xff, 0x31, 0xd5, 0x57, 0xe0, 0x09, 0xf2, 0x86, 0x57, 0x2d, 0x2e, 0xd5, 0x8e, 0xa9,
+	0x69, 0x09, 0x6e, 0x2f, 0xef, 0xc0, 0xa4, 0x38, 0x13, 0x99, 0x5e, 0x0d, 0xba, 0xe9, 0xe9, 0x38,
+	0x37, 0x7d, 0xc7, 0x28, 0x6b, 0x48, 0xe6, 0xa6, 0xc9, 0xdf, 0x12, 0x18, 0x09, 0x84, 0xd0, 0x35,
+	0xb8, 0xc4, 0x87, 0x71, 0xab, 0x37, 0x42, 0x93, 0xf2, 0x60, 0x9c, 0x0b, 0x83, 0x7d, 0xfb, 0xe8,
+	0xf7, 0x5b, 0x40, 0x0f, 0xe7, 0x85, 0x7f, 0xc4, 0x99, 0xfb, 0x81, 0xc2, 0x45, 0x26, 0x05, 0xfd,
+	0x84, 0xc0, 0x50, 0xbb, 0x7d, 0xe8, 0x4c, 0x68, 0x22, 0xe1, 0x15, 0x51, 0x9a, 0xed, 0x1a, 0xc7,
+	0x25, 0x95, 0x95, 0x0f, 0x7e, 0xfb, 0xeb, 0x7e, 0xff, 0x1c, 0x9d, 0x51, 0x83, 0x37, 0x5a, 0xdc,
+	0x5c, 0x7d, 0xdf, 0x50, 0x8f, 0xdc, 0x8d, 0xb6, 0xe8, 0x37, 0xae, 0x80, 0x1d, 0xd3, 0x43, 0x97,
+	0x62, 0x16, 0x0b, 0xdd, 0x1c, 0xa5, 0x4c, 0xc2, 0x68, 0x04, 0x5c, 0x65, 0x80, 0x0a, 0x5d, 0x8a,
+	0x00, 0x64, 0x16, 0xad, 0x89, 0x9c, 0xf8, 0xe6, 0xb7, 0xe8, 0x03, 0x02, 0x57, 0xbc, 0x17, 0x32,
+	0x3a, 0x1f, 0xb3, 0xaa, 0xff, 0xd2, 0x28, 0x2d, 0x24, 0x09, 0x45, 0xba, 0x2c, 0xa3, 0x5b, 0xa4,
+	0xf3, 0x11, 0x74, 0x58, 0x4f, 0xaf, 0x82, 0xc7, 0x04, 0xae, 0xfa, 0xae, 0x3d, 0x34, 0x6e, 0xc1,
+	0x80, 0x71, 0x96, 0x16, 0x13, 0xc5, 0x22, 0xdd, 0x32, 0xa3, 0x5b, 0xa0, 0x73, 0x62, 0x3a, 0x6b,
+	0xb7, 0xd4, 0xdc, 0x65, 0xfe, 0xda, 0x51, 0xae, 0xa6, 0xd7, 0x5b, 0xf4, 0x17, 0x02, 0xa3, 0xa2,
+	0xfb, 0x06, 0xcd, 0x76, 0xab, 0x5a, 0xe8, 0x8e, 0x24, 0xe5, 0xce, 0x92, 0x82, 0xc4, 0x2f, 0x30,
+	0xe2, 0x35, 0xba, 0x12, 0x57, 0x6d, 0x5d, 0x63, 0xe4, 0x7c, 0xc8, 0xa3, 0xec, 0xcf, 0x61, 0x78,
+	0x2e, 0x70, 0x32, 0x78, 0x9f, 0xce, 0xb9, 0xb3, 0xa4, 0x20, 0xfc, 0x06, 0x83, 0xcf, 0xd1, 0xe5,
+	0x04, 0xf0, 0x7e, 0xd9, 0x3f, 0x26, 0x30, 0xe8, 0x1a, 0x16, 0xfa, 0x9c, 0x78, 0xe9, 0x80, 0xb3,
+	0x92, 0x66, 0xba, 0x85, 0x21, 0x95, 0xca, 0xa8, 0xe6, 0xe9, 0x6c, 0x88, 0xca, 0xfd, 0x12, 0xa8,
+	0x47, 0x9e, 0xcf, 0x44, 0x8b, 0x3e, 0x22, 0x70, 0x5d, 0x6c, 0x9d, 0xe9, 0x4a, 0xfc, 0x9a, 0xc2,
+	0xbb, 0x80, 0xb4, 0x7a, 0xb6, 0x24, 0xc4, 0x7e, 0x91, 0x61, 0xaf, 0xd3, 0xd5, 0x48, 0xec, 0x4e,
+	0x13, 0xe0, 0x21, 0xe0, 0x79, 0xff, 0x1f, 0x11, 0x78, 0x4a, 0xe0, 0x70, 0xe9, 0xb2, 0x98, 0x25,
+	0xda, 0x80, 0x4b, 0xd9, 0x33, 0x64, 0x20, 0xfa, 0x6b, 0x0c, 0xfd, 0x15, 0xfa, 0x52, 0x08, 0xdd,
+	0xf1, 0x4c, 0x0e, 0x75, 0x5b, 0x6f, 0xe7, 0x81, 0xe9, 0xd7, 0x5f, 0x3d, 0x62, 0x0f, 0x5b, 0xf4,
+	0x7b, 0x02, 0x23, 0x01, 0x33, 0x1b, 0x75, 0xd4, 0x8a, 0xcd, 0xb7, 0x94, 0x49, 0x18, 0xdd, 0xb5,
+	0x7f, 0x1d, 0x22, 0xcb, 0x0b, 0x1e, 0x68, 0x99, 0x2f, 0x09, 0x5c, 0xf1, 0x7a, 0xc9, 0xa8, 0xe3,
+	0x56, 0x60, 0x82, 0xa3, 0x8e, 0x5b, 0x91, 0x35, 0x8d, 0xe9, 0xe5, 0x36, 0x21, 0x2a, 0x8a, 0x1a,
+	0x3e, 0x24, 0x70, 0xcd, 0xef, 0xda, 0x68, 0x97, 0x13, 0xd4, 0x67, 0x3b, 0xa5, 0xa5, 0x64, 0xc1,
+	0x88, 0xb7, 0xc2, 0xf0, 0x32, 0x74, 0x31, 0xe6, 0xbc, 0xe5, 0x5f, 0x04, 0x4f, 0xab, 0x1e, 0x13,
+	0x18, 0xf6, 0x78, 0x29, 0x3a, 0x27, 0x5e, 0x32, 0xec, 0xef, 0xa4, 0xf9, 0x04, 0x91, 0x48, 0xb6,
+	0xce, 0xc8, 0x96, 0xa9, 0x12, 0xfd, 0x36, 0x05, 0xba, 0x90, 0x79, 0x39, 0x1a, 0xf0, 0x4b, 0x8e,
+	0x1b, 0x8b, 0xfd, 0xdc, 0x87, 0xec, 0x9e, 0x94, 0x49, 0x18, 0x8d, 0xa0, 0x6b, 0x0c, 0x54, 0xa5,
+	0x99, 0xd8, 0x0f, 0xea, 0xae, 0x6d, 0x6a, 0x9a, 0xe7, 0xe8, 0xcf, 0xbf, 0xfc, 0xf8, 0x24, 0x4d,
+	0x9e, 0x9c, 0xa4, 0xc9, 0x9f, 0x27, 0x69, 0xf2, 0xe9, 0x69, 0xba, 0xef, 0xc9, 0x69, 0xba, 0xef,
+	0xf7, 0xd3, 0x74, 0xdf, 0xdb, 0xcf, 0x56, 0x74, 0xfb, 0xdd, 0x83, 0x92, 0xb2, 0x67, 0xd4, 0xdc,
+	0x29, 0xf9, 0x3f, 0x19, 0xab, 0xfc, 0x9e, 0xfa, 0x3e, 0x9f, 0xb6, 0x74, 0x89, 0xfd, 0xc9, 0x7d,
+	0xe5, 0xef, 0x01, 0x00, 0xe7, 0x08, 0x2d, 0x77, 0x3a, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1536,6 +1710,11 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// GroupMemberTree queries the members of a group, recursively resolving the
+	// members which are group policy accounts into the members of their group.
+	//
+	// Since: cosmos-sdk 0.47
+	GroupMemberTree(ctx context.Context, in *QueryGroupMemberTreeRequest, opts ...grpc.CallOption) (*QueryGroupMemberTreeResponse, error)
 }
 
 type queryClient struct {
@@ -1663,6 +1842,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) GroupMemberTree(ctx context.Context, in *QueryGroupMemberTreeRequest, opts ...grpc.CallOption) (*QueryGroupMemberTreeResponse, error) {
+	out := new(QueryGroupMemberTreeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupMemberTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1695,6 +1883,11 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// GroupMemberTree queries the members of a group, recursively resolving the
+	// members which are group policy accounts into the members of their group.
+	//
+	// Since: cosmos-sdk 0.47
+	GroupMemberTree(context.Context, *QueryGroupMemberTreeRequest) (*QueryGroupMemberTreeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1740,6 +1933,9 @@ func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) GroupMemberTree(ctx context.Context, req *QueryGroupMemberTreeRequest) (*QueryGroupMemberTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMemberTree not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1979,6 +2175,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupMemberTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMemberTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupMemberTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupMemberTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupMemberTree(ctx, req.(*QueryGroupMemberTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2035,6 +2249,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "GroupMemberTree",
+			Handler:    _Query_GroupMemberTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3084,6 +3302,123 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGroupMemberTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMemberTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMemberTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupMemberTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMemberTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMemberTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMemberNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMemberNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMemberNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3508,22 +3843,69 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGroupMemberTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryGroupMemberTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *GroupMemberNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Member.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -6196,6 +6578,295 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGroupMemberTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMemberTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMemberTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMemberTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMemberTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMemberTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, GroupMemberNode{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMemberNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMemberNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMemberNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, GroupMemberNode{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
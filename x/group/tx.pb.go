@@ -1460,6 +1460,152 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgSubmitSubProposal is the Msg/SubmitSubProposal request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubmitSubProposal struct {
+	// group_policy_address is the account address of the group policy voting on
+	// the parent proposal. It must be a member of the parent proposal's group.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// proposers are the account addresses of the proposers.
+	// Proposers signatures will be counted as yes votes.
+	Proposers []string `protobuf:"bytes,2,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	// metadata is any arbitrary metadata to attached to the proposal.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// parent_proposal_id is the unique ID of the proposal to vote on.
+	ParentProposalId uint64 `protobuf:"varint,4,opt,name=parent_proposal_id,json=parentProposalId,proto3" json:"parent_proposal_id,omitempty"`
+	// option is the vote cast by the group policy on the parent proposal if
+	// the sub-proposal passes.
+	Option VoteOption `protobuf:"varint,5,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// exec defines the mode of execution of the sub-proposal,
+	// whether it should be executed immediately on creation or not.
+	// If so, proposers signatures are considered as Yes votes.
+	Exec Exec `protobuf:"varint,6,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
+}
+
+func (m *MsgSubmitSubProposal) Reset()         { *m = MsgSubmitSubProposal{} }
+func (m *MsgSubmitSubProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSubProposal) ProtoMessage()    {}
+func (*MsgSubmitSubProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgSubmitSubProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSubProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSubProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSubProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSubProposal.Merge(m, src)
+}
+func (m *MsgSubmitSubProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSubProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSubProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSubProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitSubProposal) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitSubProposal) GetProposers() []string {
+	if m != nil {
+		return m.Proposers
+	}
+	return nil
+}
+
+func (m *MsgSubmitSubProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitSubProposal) GetParentProposalId() uint64 {
+	if m != nil {
+		return m.ParentProposalId
+	}
+	return 0
+}
+
+func (m *MsgSubmitSubProposal) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *MsgSubmitSubProposal) GetExec() Exec {
+	if m != nil {
+		return m.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
+}
+
+// MsgSubmitSubProposalResponse is the Msg/SubmitSubProposal response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubmitSubProposalResponse struct {
+	// proposal is the unique ID of the sub-proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitSubProposalResponse) Reset()         { *m = MsgSubmitSubProposalResponse{} }
+func (m *MsgSubmitSubProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSubProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitSubProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgSubmitSubProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSubProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSubProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSubProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSubProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitSubProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSubProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSubProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSubProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitSubProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1490,92 +1636,98 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgSubmitSubProposal)(nil), "cosmos.group.v1.MsgSubmitSubProposal")
+	proto.RegisterType((*MsgSubmitSubProposalResponse)(nil), "cosmos.group.v1.MsgSubmitSubProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0xb6, 0x6c, 0x37, 0x71, 0x9e, 0x1b, 0x27, 0x51, 0x9c, 0xd6, 0x51, 0x5a, 0xdb, 0x88, 0x34,
-	0x49, 0x3d, 0x8d, 0x4c, 0x1c, 0x7a, 0x09, 0x4c, 0x99, 0x24, 0x35, 0x4c, 0x00, 0x43, 0x46, 0x69,
-	0x29, 0x70, 0x31, 0x8a, 0xb5, 0x55, 0x35, 0xd8, 0x96, 0xd1, 0xca, 0x89, 0x73, 0x84, 0x13, 0xd0,
-	0x0b, 0x33, 0xfd, 0x03, 0xcc, 0x70, 0xe1, 0xc8, 0xa1, 0x37, 0x6e, 0x70, 0xe9, 0x70, 0xea, 0x70,
-	0x62, 0x38, 0x30, 0x4c, 0x72, 0xe0, 0xca, 0x4f, 0x60, 0xb4, 0x2b, 0xad, 0x2d, 0x5b, 0x8e, 0x14,
-	0xe3, 0xc2, 0xa9, 0xb5, 0xde, 0xf7, 0xde, 0xfb, 0xbe, 0xb7, 0x6f, 0xf7, 0xed, 0x06, 0x32, 0x35,
-	0x03, 0x37, 0x0c, 0x5c, 0xd4, 0x4c, 0xa3, 0xdd, 0x2a, 0x1e, 0x6d, 0x14, 0xad, 0x8e, 0xd4, 0x32,
-	0x0d, 0xcb, 0xe0, 0x67, 0xa8, 0x45, 0x22, 0x16, 0xe9, 0x68, 0x43, 0x48, 0x6b, 0x86, 0x66, 0x10,
-	0x5b, 0xd1, 0xfe, 0x1f, 0x85, 0x09, 0x8b, 0x14, 0x56, 0xa5, 0x06, 0xc7, 0xc7, 0x31, 0x69, 0x86,
-	0xa1, 0xd5, 0x51, 0x91, 0xfc, 0x3a, 0x6c, 0x3f, 0x2c, 0x2a, 0xcd, 0x13, 0xc7, 0xb4, 0x34, 0x90,
-	0xf6, 0xa4, 0x85, 0x5c, 0xbf, 0xab, 0x8e, 0xb1, 0x81, 0x35, 0xdb, 0xd4, 0xc0, 0x1a, 0x35, 0x88,
-	0xdf, 0x73, 0x90, 0xaa, 0x60, 0x6d, 0xd7, 0x44, 0x8a, 0x85, 0xde, 0xb2, 0x5d, 0x79, 0x09, 0x2e,
-	0x29, 0x6a, 0x43, 0x6f, 0x66, 0xb8, 0x3c, 0xb7, 0x36, 0xb5, 0x93, 0xf9, 0xf5, 0xe9, 0x7a, 0xda,
-	0x21, 0xb1, 0xad, 0xaa, 0x26, 0xc2, 0xf8, 0xc0, 0x32, 0xf5, 0xa6, 0x26, 0x53, 0x18, 0x7f, 0x07,
-	0x26, 0x1b, 0xa8, 0x71, 0x88, 0x4c, 0x9c, 0x89, 0xe6, 0x63, 0x6b, 0xc9, 0x52, 0x56, 0xea, 0xd3,
-	0x29, 0x55, 0x88, 0x5d, 0x46, 0x9f, 0xb5, 0x11, 0xb6, 0x76, 0xe2, 0xcf, 0xfe, 0xc8, 0x45, 0x64,
-	0xd7, 0x89, 0x17, 0x20, 0xd1, 0x40, 0x96, 0xa2, 0x2a, 0x96, 0x92, 0x89, 0xd9, 0x29, 0x65, 0xf6,
-	0x7b, 0x0b, 0xbe, 0xf8, 0xeb, 0x87, 0x02, 0xcd, 0x23, 0x6e, 0xc2, 0x15, 0x2f, 0x53, 0x19, 0xe1,
-	0x96, 0xd1, 0xc4, 0x88, 0x5f, 0x84, 0x04, 0x49, 0x55, 0xd5, 0x55, 0x42, 0x3a, 0x2e, 0x4f, 0x92,
-	0xdf, 0x7b, 0xaa, 0xf8, 0x23, 0x07, 0x0b, 0x15, 0xac, 0xdd, 0x6f, 0xa9, 0xae, 0x57, 0xc5, 0x49,
-	0x7b, 0x51, 0x99, 0xbd, 0x49, 0xa2, 0x9e, 0x24, 0xfc, 0x3b, 0x90, 0xa2, 0x62, 0xaa, 0x6d, 0x92,
-	0x07, 0x67, 0x62, 0x17, 0x28, 0xc4, 0x34, 0xf5, 0xa5, 0x14, 0xb1, 0x47, 0x72, 0x0e, 0xae, 0xfb,
-	0x92, 0x77, 0x95, 0x8b, 0xdf, 0x71, 0x30, 0xef, 0x45, 0x6c, 0x13, 0xb2, 0x63, 0x14, 0x77, 0x1b,
-	0xa6, 0x9a, 0xe8, 0xb8, 0x4a, 0xc3, 0xc5, 0x02, 0xc2, 0x25, 0x9a, 0xe8, 0x98, 0x30, 0xf0, 0xc8,
-	0xb8, 0x0e, 0x4b, 0x3e, 0x24, 0x99, 0x88, 0xc7, 0x1c, 0x59, 0x59, 0x8f, 0x4c, 0xba, 0xfe, 0xe3,
-	0xd4, 0x11, 0xb6, 0xcd, 0xf2, 0x90, 0xf5, 0x27, 0xc3, 0xf8, 0xfe, 0xcd, 0x41, 0xda, 0xdb, 0x89,
-	0xfb, 0x46, 0x5d, 0xaf, 0x9d, 0xfc, 0x47, 0x6c, 0x79, 0x05, 0x66, 0x54, 0x54, 0xd3, 0xb1, 0x6e,
-	0x34, 0xab, 0x2d, 0x92, 0x39, 0x13, 0xcf, 0x73, 0x6b, 0xc9, 0x52, 0x5a, 0xa2, 0xc7, 0x83, 0xe4,
-	0x1e, 0x0f, 0xd2, 0x76, 0xf3, 0x64, 0x47, 0xfc, 0xe5, 0xe9, 0x7a, 0xb6, 0xbf, 0x11, 0xef, 0x3a,
-	0x01, 0x28, 0x73, 0x39, 0xa5, 0x7a, 0x7e, 0x6f, 0xa5, 0xbe, 0xfc, 0x36, 0x17, 0xe9, 0x29, 0x8a,
-	0x0c, 0xd7, 0xfc, 0x14, 0xb3, 0x1d, 0x58, 0x82, 0x49, 0x85, 0x2a, 0x0c, 0xd4, 0xee, 0x02, 0xc5,
-	0xdf, 0x39, 0x58, 0xf4, 0x56, 0x9a, 0x06, 0x1d, 0xad, 0x83, 0xdf, 0x86, 0x34, 0xad, 0x25, 0xad,
-	0x48, 0xd5, 0xa5, 0x13, 0x0d, 0x70, 0xe7, 0xb5, 0xde, 0xcc, 0xc4, 0x32, 0x8e, 0x96, 0x7f, 0x1c,
-	0x83, 0x8c, 0xb7, 0x62, 0x0f, 0x74, 0xeb, 0xd1, 0x88, 0x7d, 0xf2, 0x6f, 0x4f, 0xd8, 0x1b, 0x90,
-	0xa2, 0xb5, 0xe9, 0x6b, 0xa9, 0x69, 0xcd, 0xb3, 0xd9, 0x4a, 0xb0, 0xe0, 0x29, 0x21, 0x43, 0xc7,
-	0x09, 0x7a, 0xbe, 0xa7, 0x52, 0xcc, 0x67, 0xa3, 0xcf, 0x47, 0xc1, 0x4e, 0xd9, 0x2e, 0xe5, 0xb9,
-	0xb5, 0x84, 0xb7, 0xba, 0x98, 0xae, 0xac, 0x4f, 0xfb, 0x4e, 0xbc, 0xe0, 0xf6, 0xfd, 0x8a, 0x83,
-	0xfc, 0xb0, 0xd5, 0x08, 0x31, 0x45, 0xc6, 0xd9, 0x5c, 0xe2, 0xcb, 0xf0, 0xd2, 0xd0, 0xae, 0x67,
-	0x47, 0xcc, 0x93, 0x28, 0x88, 0x7e, 0x28, 0xaf, 0xee, 0xff, 0x75, 0x93, 0xf8, 0x2c, 0x63, 0xec,
-	0x05, 0x2f, 0xe3, 0x2d, 0x28, 0x04, 0x17, 0x85, 0xd5, 0xf0, 0x27, 0x8e, 0x1c, 0x5a, 0x03, 0xf0,
-	0x91, 0x87, 0xcb, 0x38, 0xab, 0x17, 0x76, 0x1a, 0xad, 0xc0, 0xf2, 0x79, 0x1a, 0x98, 0xd8, 0xaf,
-	0xa3, 0x30, 0x57, 0xc1, 0xda, 0x41, 0xfb, 0xb0, 0xa1, 0x5b, 0xfb, 0xa6, 0xd1, 0x32, 0xb0, 0x52,
-	0x1f, 0xca, 0x98, 0x1b, 0x81, 0xf1, 0x35, 0x98, 0x6a, 0x91, 0xb8, 0xee, 0x31, 0x34, 0x25, 0x77,
-	0x3f, 0x9c, 0x3b, 0xaf, 0x5e, 0xb1, 0x6d, 0x18, 0x2b, 0x1a, 0xc2, 0x99, 0x38, 0x39, 0xbf, 0x7c,
-	0x5b, 0x44, 0x66, 0x28, 0xfe, 0x26, 0xc4, 0x51, 0x07, 0xd5, 0xc8, 0x21, 0x92, 0x2a, 0x2d, 0x0c,
-	0x9c, 0x76, 0xe5, 0x0e, 0xaa, 0xc9, 0x04, 0xb2, 0xc5, 0xbb, 0x3d, 0xd2, 0x25, 0x23, 0xbe, 0x4e,
-	0x06, 0x8b, 0xb7, 0x16, 0x6c, 0x9b, 0xe7, 0x20, 0xd9, 0x72, 0xbe, 0x75, 0x77, 0x3a, 0xb8, 0x9f,
-	0xf6, 0x54, 0xb1, 0x43, 0xae, 0x54, 0xf6, 0x01, 0xa1, 0x9a, 0xca, 0x31, 0xab, 0x65, 0x90, 0x5f,
-	0xef, 0x0c, 0x8c, 0x86, 0x9c, 0x81, 0x5b, 0x97, 0x6d, 0xe6, 0x6c, 0x22, 0xd2, 0x7b, 0x52, 0x7f,
-	0x66, 0xb6, 0xc6, 0xa7, 0x1c, 0x4c, 0x56, 0xb0, 0xf6, 0x81, 0x61, 0x05, 0xab, 0xb0, 0x9b, 0xfb,
-	0xc8, 0xb0, 0x90, 0x19, 0xc8, 0x85, 0xc2, 0xf8, 0x4d, 0x98, 0x30, 0x5a, 0x96, 0x6e, 0xd0, 0x81,
-	0x97, 0x2a, 0x2d, 0x0d, 0x14, 0xdd, 0xce, 0xfb, 0x3e, 0x81, 0xc8, 0x0e, 0xd4, 0xb3, 0xea, 0xf1,
-	0xbe, 0x55, 0xbf, 0xc0, 0x1a, 0xd2, 0x86, 0x27, 0x3c, 0xc4, 0x39, 0x98, 0x71, 0x34, 0x32, 0xdd,
-	0x0d, 0x22, 0xdb, 0xc6, 0x07, 0xcb, 0x7e, 0x15, 0x12, 0x76, 0xc8, 0xb6, 0x65, 0x04, 0x2b, 0x67,
-	0xc8, 0xad, 0xa4, 0x4d, 0x60, 0x02, 0xeb, 0x5a, 0x13, 0x99, 0xa2, 0x4c, 0x18, 0x10, 0x7a, 0x6e,
-	0xcf, 0xbc, 0x01, 0x13, 0x26, 0xc2, 0xed, 0xba, 0x45, 0x62, 0xa6, 0x4a, 0xab, 0x03, 0x6a, 0xdc,
-	0xc5, 0x2a, 0x3b, 0x21, 0x65, 0x02, 0x97, 0x1d, 0x37, 0xb1, 0x0e, 0xd3, 0x15, 0xac, 0xbd, 0x8b,
-	0x94, 0x23, 0xe7, 0x91, 0x35, 0xc2, 0x85, 0xe9, 0x9c, 0xeb, 0x62, 0x5f, 0x1f, 0x5d, 0x25, 0x6f,
-	0x9e, 0x6e, 0x36, 0x57, 0x47, 0xa1, 0x00, 0x71, 0x52, 0xc6, 0x34, 0xcc, 0x96, 0x3f, 0x2c, 0xef,
-	0x56, 0xef, 0xbf, 0x77, 0xb0, 0x5f, 0xde, 0xdd, 0x7b, 0x73, 0xaf, 0x7c, 0x77, 0x36, 0xc2, 0x5f,
-	0x86, 0x04, 0xf9, 0x7a, 0x4f, 0xfe, 0x68, 0x96, 0x2b, 0xfd, 0x9c, 0x84, 0x58, 0x05, 0x6b, 0xfc,
-	0x03, 0x48, 0xf6, 0xbe, 0x0e, 0x73, 0x83, 0x57, 0x0f, 0xcf, 0x60, 0x15, 0x56, 0x03, 0x00, 0xac,
-	0xa8, 0x75, 0xe0, 0x7d, 0x9e, 0x65, 0x2b, 0x7e, 0xee, 0x83, 0x38, 0x41, 0x0a, 0x87, 0x63, 0xd9,
-	0x1e, 0xc2, 0xec, 0xc0, 0x2b, 0x69, 0x39, 0x20, 0x06, 0x41, 0x09, 0xb7, 0xc2, 0xa0, 0x58, 0x1e,
-	0x03, 0xe6, 0xfd, 0x1e, 0x32, 0xab, 0x81, 0x74, 0x29, 0x50, 0x28, 0x86, 0x04, 0xb2, 0x84, 0x3a,
-	0xcc, 0x0d, 0xbe, 0x44, 0x6e, 0x04, 0x2c, 0x02, 0x85, 0x09, 0xeb, 0xa1, 0x60, 0x2c, 0x55, 0x1b,
-	0x16, 0xfc, 0x2f, 0xb4, 0x37, 0x03, 0xe2, 0x74, 0xa1, 0xc2, 0x46, 0x68, 0x28, 0x4b, 0xdb, 0x81,
-	0x2b, 0x43, 0x1e, 0x09, 0x85, 0x80, 0x62, 0xf5, 0x60, 0x85, 0x52, 0x78, 0x2c, 0xcb, 0xfc, 0x84,
-	0x83, 0x5c, 0xd0, 0x1d, 0x6c, 0x33, 0x54, 0x5c, 0xaf, 0x93, 0xf0, 0xda, 0x08, 0x4e, 0x8c, 0xd5,
-	0xe7, 0x1c, 0x2c, 0x0e, 0xbf, 0xd5, 0xac, 0x87, 0x0a, 0xcd, 0xfa, 0xed, 0xf6, 0x85, 0xe0, 0x8c,
-	0xc3, 0x27, 0x90, 0xea, 0xbb, 0x6b, 0x88, 0x7e, 0x81, 0xbc, 0x18, 0xa1, 0x10, 0x8c, 0xe9, 0xdd,
-	0xb0, 0x03, 0x33, 0xd8, 0x77, 0xc3, 0xf6, 0xa3, 0xfc, 0x37, 0xec, 0xb0, 0xa9, 0xca, 0xef, 0x40,
-	0x9c, 0x4c, 0xd4, 0x8c, 0x9f, 0x97, 0x6d, 0x11, 0xf2, 0xc3, 0x2c, 0xbd, 0x31, 0xc8, 0xb9, 0xea,
-	0x1b, 0xc3, 0xb6, 0xf8, 0xc7, 0xf0, 0xcc, 0x98, 0x7b, 0x00, 0x3d, 0xf3, 0x21, 0xeb, 0x87, 0xef,
-	0xda, 0x85, 0x95, 0xf3, 0xed, 0x6e, 0xd4, 0x9d, 0x3b, 0xcf, 0x4e, 0xb3, 0xdc, 0xf3, 0xd3, 0x2c,
-	0xf7, 0xe7, 0x69, 0x96, 0xfb, 0xe6, 0x2c, 0x1b, 0x79, 0x7e, 0x96, 0x8d, 0xfc, 0x76, 0x96, 0x8d,
-	0x7c, 0xbc, 0xac, 0xe9, 0xd6, 0xa3, 0xf6, 0xa1, 0x54, 0x33, 0x1a, 0xce, 0xdf, 0x18, 0x9d, 0x7f,
-	0xd6, 0xb1, 0xfa, 0x69, 0xb1, 0x43, 0xff, 0x8e, 0x78, 0x38, 0x41, 0x6e, 0x68, 0x9b, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x01, 0x59, 0xf1, 0xd5, 0x14, 0x00, 0x00,
+	// 1341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0xb7, 0x6c, 0xd7, 0x71, 0x5e, 0x5a, 0x27, 0x55, 0x9d, 0xd6, 0x51, 0x53, 0xdb, 0x5f, 0x7d,
+	0xdb, 0x24, 0xf5, 0xc4, 0x36, 0x71, 0xe8, 0x25, 0x30, 0xed, 0x24, 0xa9, 0x61, 0x02, 0x18, 0x32,
+	0x4a, 0x4b, 0x81, 0x8b, 0x91, 0xad, 0xad, 0xaa, 0xc1, 0xb6, 0x8c, 0x56, 0x4e, 0x9c, 0x23, 0x9c,
+	0x80, 0x5e, 0x98, 0xe9, 0x3f, 0xc0, 0x0c, 0x17, 0x4e, 0x0c, 0x87, 0x9e, 0xe0, 0xc6, 0xa9, 0xc3,
+	0xa9, 0xc3, 0x89, 0xe1, 0xc0, 0x30, 0xc9, 0x81, 0x2b, 0x7f, 0x02, 0xa3, 0x5d, 0x69, 0x2d, 0xd9,
+	0x72, 0xa4, 0x98, 0x14, 0x38, 0x25, 0xab, 0xf7, 0x79, 0xef, 0x7d, 0xde, 0x8f, 0x7d, 0xbb, 0x6b,
+	0xc8, 0x34, 0x75, 0xdc, 0xd6, 0x71, 0x59, 0x35, 0xf4, 0x5e, 0xb7, 0xbc, 0xbf, 0x56, 0x36, 0xfb,
+	0xa5, 0xae, 0xa1, 0x9b, 0x3a, 0x3f, 0x4b, 0x25, 0x25, 0x22, 0x29, 0xed, 0xaf, 0x09, 0x69, 0x55,
+	0x57, 0x75, 0x22, 0x2b, 0x5b, 0xff, 0x51, 0x98, 0xb0, 0x40, 0x61, 0x75, 0x2a, 0xb0, 0x75, 0x6c,
+	0x91, 0xaa, 0xeb, 0x6a, 0x0b, 0x95, 0xc9, 0xaa, 0xd1, 0x7b, 0x58, 0x96, 0x3b, 0x87, 0xb6, 0xe8,
+	0xea, 0x88, 0xdb, 0xc3, 0x2e, 0x72, 0xf4, 0xae, 0xd8, 0xc2, 0x36, 0x56, 0x2d, 0x51, 0x1b, 0xab,
+	0x54, 0x20, 0x7e, 0xc3, 0x41, 0xaa, 0x86, 0xd5, 0x6d, 0x03, 0xc9, 0x26, 0x7a, 0xdd, 0x52, 0xe5,
+	0x4b, 0x70, 0x4e, 0x56, 0xda, 0x5a, 0x27, 0xc3, 0xe5, 0xb9, 0x95, 0xe9, 0xad, 0xcc, 0xcf, 0x4f,
+	0x8b, 0x69, 0x9b, 0xc4, 0xa6, 0xa2, 0x18, 0x08, 0xe3, 0x3d, 0xd3, 0xd0, 0x3a, 0xaa, 0x44, 0x61,
+	0xfc, 0x6d, 0x98, 0x6a, 0xa3, 0x76, 0x03, 0x19, 0x38, 0x13, 0xcd, 0xc7, 0x56, 0x66, 0x2a, 0xd9,
+	0xd2, 0x50, 0x9c, 0xa5, 0x1a, 0x91, 0x4b, 0xe8, 0xe3, 0x1e, 0xc2, 0xe6, 0x56, 0xfc, 0xd9, 0x6f,
+	0xb9, 0x88, 0xe4, 0x28, 0xf1, 0x02, 0x24, 0xdb, 0xc8, 0x94, 0x15, 0xd9, 0x94, 0x33, 0x31, 0xcb,
+	0xa5, 0xc4, 0xd6, 0x1b, 0xf0, 0xe9, 0x1f, 0xdf, 0x15, 0xa8, 0x1f, 0x71, 0x1d, 0x2e, 0x7b, 0x99,
+	0x4a, 0x08, 0x77, 0xf5, 0x0e, 0x46, 0xfc, 0x02, 0x24, 0x89, 0xab, 0xba, 0xa6, 0x10, 0xd2, 0x71,
+	0x69, 0x8a, 0xac, 0x77, 0x14, 0xf1, 0x07, 0x0e, 0xe6, 0x6b, 0x58, 0xbd, 0xdf, 0x55, 0x1c, 0xad,
+	0x9a, 0xed, 0xf6, 0xb4, 0x61, 0xba, 0x9d, 0x44, 0x3d, 0x4e, 0xf8, 0x37, 0x21, 0x45, 0x83, 0xa9,
+	0xf7, 0x88, 0x1f, 0x9c, 0x89, 0x9d, 0x22, 0x11, 0x17, 0xa8, 0x2e, 0xa5, 0x88, 0x3d, 0x21, 0xe7,
+	0xe0, 0x9a, 0x2f, 0x79, 0x27, 0x72, 0xf1, 0x6b, 0x0e, 0x2e, 0x79, 0x11, 0x9b, 0x84, 0xec, 0x19,
+	0x06, 0x77, 0x0b, 0xa6, 0x3b, 0xe8, 0xa0, 0x4e, 0xcd, 0xc5, 0x02, 0xcc, 0x25, 0x3b, 0xe8, 0x80,
+	0x30, 0xf0, 0x84, 0x71, 0x0d, 0xae, 0xfa, 0x90, 0x64, 0x41, 0x3c, 0xe6, 0xe0, 0xb2, 0x57, 0x5e,
+	0xb3, 0xeb, 0x7f, 0x96, 0x71, 0x84, 0x6d, 0xb3, 0x3c, 0x64, 0xfd, 0xc9, 0x30, 0xbe, 0x7f, 0x72,
+	0x90, 0xf6, 0x76, 0xe2, 0xae, 0xde, 0xd2, 0x9a, 0x87, 0xff, 0x10, 0x5b, 0x5e, 0x86, 0x59, 0x05,
+	0x35, 0x35, 0xac, 0xe9, 0x9d, 0x7a, 0x97, 0x78, 0xce, 0xc4, 0xf3, 0xdc, 0xca, 0x4c, 0x25, 0x5d,
+	0xa2, 0xe3, 0xa1, 0xe4, 0x8c, 0x87, 0xd2, 0x66, 0xe7, 0x70, 0x4b, 0xfc, 0xe9, 0x69, 0x31, 0x3b,
+	0xdc, 0x88, 0x77, 0x6d, 0x03, 0x94, 0xb9, 0x94, 0x52, 0x3c, 0xeb, 0x8d, 0xd4, 0x67, 0x5f, 0xe5,
+	0x22, 0xae, 0xa4, 0x48, 0xb0, 0xe8, 0x17, 0x31, 0xdb, 0x81, 0x15, 0x98, 0x92, 0x69, 0x84, 0x81,
+	0xb1, 0x3b, 0x40, 0xf1, 0x57, 0x0e, 0x16, 0xbc, 0x99, 0xa6, 0x46, 0x27, 0xeb, 0xe0, 0x37, 0x20,
+	0x4d, 0x73, 0x49, 0x33, 0x52, 0x77, 0xe8, 0x44, 0x03, 0xd4, 0x79, 0xd5, 0xed, 0x99, 0x48, 0xce,
+	0xa2, 0xe5, 0x1f, 0xc7, 0x20, 0xe3, 0xcd, 0xd8, 0x03, 0xcd, 0x7c, 0x34, 0x61, 0x9f, 0xfc, 0xdd,
+	0x09, 0x7b, 0x03, 0x52, 0x34, 0x37, 0x43, 0x2d, 0x75, 0x41, 0xf5, 0x6c, 0xb6, 0x0a, 0xcc, 0x7b,
+	0x52, 0xc8, 0xd0, 0x71, 0x82, 0xbe, 0xe4, 0xca, 0x14, 0xd3, 0x59, 0x1b, 0xd2, 0x91, 0xb1, 0x9d,
+	0xb6, 0x73, 0x79, 0x6e, 0x25, 0xe9, 0xcd, 0x2e, 0xa6, 0x95, 0xf5, 0x69, 0xdf, 0xc4, 0x0b, 0x6e,
+	0xdf, 0xcf, 0x39, 0xc8, 0x8f, 0xab, 0x46, 0x88, 0x53, 0xe4, 0x2c, 0x9b, 0x4b, 0xfc, 0x3f, 0xfc,
+	0x6f, 0x6c, 0xd7, 0xb3, 0x11, 0xf3, 0x24, 0x0a, 0xa2, 0x1f, 0xca, 0x1b, 0xf7, 0xbf, 0xba, 0x49,
+	0x7c, 0xca, 0x18, 0x7b, 0xc1, 0x65, 0x5c, 0x85, 0x42, 0x70, 0x52, 0x58, 0x0e, 0x7f, 0xe4, 0x60,
+	0xd1, 0x0f, 0x3e, 0xf1, 0xe1, 0x72, 0x96, 0xd9, 0x0b, 0x7b, 0x1a, 0x2d, 0xc1, 0xf5, 0x93, 0x62,
+	0x60, 0xc1, 0x7e, 0x11, 0x85, 0x8b, 0x35, 0xac, 0xee, 0xf5, 0x1a, 0x6d, 0xcd, 0xdc, 0x35, 0xf4,
+	0xae, 0x8e, 0xe5, 0xd6, 0x58, 0xc6, 0xdc, 0x04, 0x8c, 0x17, 0x61, 0xba, 0x4b, 0xec, 0x3a, 0x63,
+	0x68, 0x5a, 0x1a, 0x7c, 0x38, 0xf1, 0xbc, 0x7a, 0xc9, 0x92, 0x61, 0x2c, 0xab, 0x08, 0x67, 0xe2,
+	0xf9, 0xd8, 0xb8, 0x16, 0x91, 0x18, 0x8a, 0xbf, 0x09, 0x71, 0xd4, 0x47, 0x4d, 0x32, 0x44, 0x52,
+	0x95, 0xf9, 0x91, 0x69, 0x57, 0xed, 0xa3, 0xa6, 0x44, 0x20, 0x1b, 0xbc, 0xd3, 0x23, 0x03, 0x32,
+	0xe2, 0xab, 0xb0, 0x30, 0x92, 0x0b, 0xb6, 0xcd, 0x73, 0x30, 0xd3, 0xb5, 0xbf, 0x0d, 0x76, 0x3a,
+	0x38, 0x9f, 0x76, 0x14, 0xb1, 0x4f, 0xae, 0x54, 0xd6, 0x80, 0x50, 0x0c, 0xf9, 0x80, 0xe5, 0x32,
+	0x48, 0xcf, 0x7d, 0x06, 0x46, 0x43, 0x9e, 0x81, 0x1b, 0xe7, 0x2d, 0xe6, 0xce, 0xca, 0xbe, 0x27,
+	0x0d, 0x7b, 0x66, 0x35, 0x3e, 0xe2, 0x60, 0xaa, 0x86, 0xd5, 0x77, 0x75, 0x33, 0x38, 0x0a, 0xab,
+	0xb9, 0xf7, 0x75, 0x13, 0x19, 0x81, 0x5c, 0x28, 0x8c, 0x5f, 0x87, 0x84, 0xde, 0x35, 0x35, 0x9d,
+	0x1e, 0x78, 0xa9, 0xca, 0xd5, 0x91, 0xa4, 0x5b, 0x7e, 0xdf, 0x21, 0x10, 0xc9, 0x86, 0x7a, 0xaa,
+	0x1e, 0x1f, 0xaa, 0xfa, 0x29, 0x6a, 0x48, 0x1b, 0x9e, 0xf0, 0x10, 0x2f, 0xc2, 0xac, 0x1d, 0x23,
+	0x8b, 0xbb, 0x4d, 0xc2, 0xb6, 0xf0, 0xc1, 0x61, 0xbf, 0x0c, 0x49, 0xcb, 0x64, 0xcf, 0xd4, 0x83,
+	0x23, 0x67, 0xc8, 0x8d, 0x19, 0x8b, 0x40, 0x02, 0x6b, 0x6a, 0x07, 0x19, 0xa2, 0x04, 0xb3, 0xb6,
+	0x3b, 0xd6, 0x33, 0x77, 0x20, 0x61, 0x20, 0xdc, 0x6b, 0x99, 0xc4, 0x66, 0xaa, 0xb2, 0x3c, 0x12,
+	0x8d, 0x53, 0xac, 0xaa, 0x6d, 0x52, 0x22, 0x70, 0xc9, 0x56, 0x13, 0x5b, 0x70, 0xa1, 0x86, 0xd5,
+	0xb7, 0x90, 0xbc, 0x6f, 0x3f, 0xb2, 0x26, 0xb8, 0x30, 0x9d, 0x70, 0x5d, 0x1c, 0xea, 0xa3, 0x2b,
+	0x30, 0xef, 0xf1, 0xc6, 0x32, 0xf9, 0x7d, 0x14, 0xd2, 0x6c, 0x67, 0xec, 0xf5, 0x1a, 0xff, 0xb1,
+	0x41, 0xb1, 0x0a, 0x7c, 0x57, 0x36, 0x50, 0xc7, 0xac, 0xbb, 0x8b, 0x1c, 0x27, 0xa1, 0xce, 0x51,
+	0xc9, 0xee, 0xa0, 0xd4, 0x83, 0x8e, 0x3d, 0x17, 0xbe, 0x63, 0x9d, 0xae, 0x4c, 0x04, 0x77, 0x65,
+	0x6a, 0x68, 0xaa, 0xdc, 0x81, 0x45, 0xbf, 0xdc, 0x85, 0x1e, 0x2c, 0x85, 0x02, 0xc4, 0x49, 0x13,
+	0xa7, 0x61, 0xae, 0xfa, 0x5e, 0x75, 0xbb, 0x7e, 0xff, 0xed, 0xbd, 0xdd, 0xea, 0xf6, 0xce, 0x6b,
+	0x3b, 0xd5, 0xbb, 0x73, 0x11, 0xfe, 0x3c, 0x24, 0xc9, 0xd7, 0x7b, 0xd2, 0xfb, 0x73, 0x5c, 0xe5,
+	0xdb, 0xf3, 0x10, 0xab, 0x61, 0x95, 0x7f, 0x00, 0x33, 0xee, 0xb7, 0x79, 0x6e, 0xf4, 0xe2, 0xe7,
+	0xb9, 0xd6, 0x08, 0xcb, 0x01, 0x00, 0xc6, 0xb6, 0x05, 0xbc, 0xcf, 0xa3, 0x78, 0xc9, 0x4f, 0x7d,
+	0x14, 0x27, 0x94, 0xc2, 0xe1, 0x98, 0xb7, 0x87, 0x30, 0x37, 0xf2, 0x46, 0xbd, 0x1e, 0x60, 0x83,
+	0xa0, 0x84, 0xd5, 0x30, 0x28, 0xe6, 0x47, 0x87, 0x4b, 0x7e, 0xcf, 0xc8, 0xe5, 0x40, 0xba, 0x14,
+	0x28, 0x94, 0x43, 0x02, 0x99, 0x43, 0x0d, 0x2e, 0x8e, 0xbe, 0x03, 0x6f, 0x04, 0x14, 0x81, 0xc2,
+	0x84, 0x62, 0x28, 0x18, 0x73, 0xd5, 0x83, 0x79, 0xff, 0xe7, 0xc4, 0xcd, 0x00, 0x3b, 0x03, 0xa8,
+	0xb0, 0x16, 0x1a, 0xca, 0xdc, 0xf6, 0xe1, 0xf2, 0x98, 0x27, 0x5a, 0x21, 0x20, 0x59, 0x2e, 0xac,
+	0x50, 0x09, 0x8f, 0x65, 0x9e, 0x9f, 0x70, 0x90, 0x0b, 0xba, 0x01, 0xaf, 0x87, 0xb2, 0xeb, 0x55,
+	0x12, 0x5e, 0x99, 0x40, 0x89, 0xb1, 0xfa, 0x84, 0x83, 0x85, 0xf1, 0x77, 0xca, 0x62, 0x28, 0xd3,
+	0xac, 0xdf, 0x6e, 0x9d, 0x0a, 0xce, 0x38, 0x7c, 0x08, 0xa9, 0xa1, 0x9b, 0x9e, 0xe8, 0x67, 0xc8,
+	0x8b, 0x11, 0x0a, 0xc1, 0x18, 0xf7, 0x86, 0x1d, 0xb9, 0x01, 0xf9, 0x6e, 0xd8, 0x61, 0x94, 0xb0,
+	0x1a, 0x06, 0xc5, 0xfc, 0x6c, 0x41, 0x9c, 0xdc, 0x67, 0x32, 0x7e, 0x5a, 0x96, 0x44, 0xc8, 0x8f,
+	0x93, 0xb8, 0x6d, 0x90, 0xb9, 0xea, 0x6b, 0xc3, 0x92, 0x08, 0xf9, 0x71, 0x12, 0x66, 0xe3, 0x1e,
+	0x80, 0xeb, 0x74, 0xce, 0xfa, 0xe1, 0x07, 0x72, 0x61, 0xe9, 0x64, 0xb9, 0x7b, 0x3a, 0x8c, 0x9e,
+	0xb5, 0x37, 0xc6, 0x97, 0xc1, 0x05, 0x13, 0x8a, 0xa1, 0x60, 0x8e, 0xab, 0xad, 0xdb, 0xcf, 0x8e,
+	0xb2, 0xdc, 0xf3, 0xa3, 0x2c, 0xf7, 0xfb, 0x51, 0x96, 0xfb, 0xf2, 0x38, 0x1b, 0x79, 0x7e, 0x9c,
+	0x8d, 0xfc, 0x72, 0x9c, 0x8d, 0x7c, 0x70, 0x5d, 0xd5, 0xcc, 0x47, 0xbd, 0x46, 0xa9, 0xa9, 0xb7,
+	0xed, 0x1f, 0x93, 0xed, 0x3f, 0x45, 0xac, 0x7c, 0x54, 0xee, 0xd3, 0x1f, 0x8c, 0x1b, 0x09, 0x72,
+	0x15, 0x5f, 0xff, 0x6b, 0x00, 0x00, 0x31, 0x89, 0xc2, 0xbe, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +1770,11 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// SubmitSubProposal submits a proposal to a group policy, member of another
+	// group, to vote on a proposal of that parent group.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitSubProposal(ctx context.Context, in *MsgSubmitSubProposal, opts ...grpc.CallOption) (*MsgSubmitSubProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1754,6 +1911,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SubmitSubProposal(ctx context.Context, in *MsgSubmitSubProposal, opts ...grpc.CallOption) (*MsgSubmitSubProposalResponse, error) {
+	out := new(MsgSubmitSubProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitSubProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1784,6 +1950,11 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// SubmitSubProposal submits a proposal to a group policy, member of another
+	// group, to vote on a proposal of that parent group.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitSubProposal(context.Context, *MsgSubmitSubProposal) (*MsgSubmitSubProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1832,6 +2003,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) SubmitSubProposal(ctx context.Context, req *MsgSubmitSubProposal) (*MsgSubmitSubProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSubProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2089,6 +2263,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitSubProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSubProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSubProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitSubProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSubProposal(ctx, req.(*MsgSubmitSubProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2341,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "SubmitSubProposal",
+			Handler:    _Msg_SubmitSubProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3187,6 +3383,95 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitSubProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSubProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSubProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ParentProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ParentProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSubProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSubProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSubProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3656,6 +3941,50 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitSubProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proposers) > 0 {
+		for _, s := range m.Proposers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ParentProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ParentProposalId))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	if m.Exec != 0 {
+		n += 1 + sovTx(uint64(m.Exec))
+	}
+	return n
+}
+
+func (m *MsgSubmitSubProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6571,6 +6900,278 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitSubProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSubProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSubProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentProposalId", wireType)
+			}
+			m.ParentProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSubProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSubProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSubProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
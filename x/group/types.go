@@ -2,6 +2,7 @@ package group
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,6 +32,9 @@ type DecisionPolicy interface {
 	// GetVotingPeriod returns the duration after proposal submission where
 	// votes are accepted.
 	GetVotingPeriod() time.Duration
+	// GetMinExecutionPeriod returns the duration after proposal submission
+	// before which the proposal cannot be executed.
+	GetMinExecutionPeriod() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -40,18 +44,63 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
-// Implements DecisionPolicy Interface
-var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+// MemberDecisionPolicy is a DecisionPolicy whose outcome depends on which
+// group members voted, and not only on the weighted sum of the votes. For such
+// policies, AllowMembers is used instead of Allow to decide on proposals.
+type MemberDecisionPolicy interface {
+	DecisionPolicy
+
+	// AllowMembers defines policy-specific logic to allow a proposal to pass
+	// or not, based on its votes, the current group members and the time
+	// since the proposal was submitted. Votes from addresses which are not
+	// group members anymore are ignored.
+	AllowMembers(votes []Vote, members []Member, sinceSubmission time.Duration) (DecisionPolicyResult, error)
+}
+
+// AutoExecutionDecisionPolicy is an optional interface which can be
+// implemented by a DecisionPolicy to have its accepted proposals executed
+// automatically in EndBlock.
+type AutoExecutionDecisionPolicy interface {
+	DecisionPolicy
+
+	// GetAutoExecution returns the automatic execution settings of the policy,
+	// or nil if its proposals must be executed with MsgExec.
+	GetAutoExecution() *AutoExecution
+}
+
+// PolicyAutoExecution returns the automatic execution settings of the given
+// decision policy, or nil if it doesn't implement AutoExecutionDecisionPolicy.
+func PolicyAutoExecution(policy DecisionPolicy) *AutoExecution {
+	autoExecPolicy, ok := policy.(AutoExecutionDecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return autoExecPolicy.GetAutoExecution()
+}
+
+// Implements DecisionPolicy and AutoExecutionDecisionPolicy Interfaces
+var (
+	_ DecisionPolicy              = &ThresholdDecisionPolicy{}
+	_ AutoExecutionDecisionPolicy = &ThresholdDecisionPolicy{}
+)
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p ThresholdDecisionPolicy) GetAutoExecution() *AutoExecution {
+	return p.Windows.GetAutoExecution()
+}
+
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
 		return sdkerrors.Wrap(err, "threshold")
@@ -136,31 +185,74 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 		return sdkerrors.Wrap(err, "group total weight")
 	}
 
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+	return validateWindows(p.Windows, config)
+}
+
+// validateWindows checks that the windows leave a non-empty window for the
+// execution of proposals.
+func validateWindows(windows *DecisionPolicyWindows, config Config) error {
+	if windows.MinExecutionPeriod > windows.VotingPeriod+config.MaxExecutionPeriod {
 		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	return validateAutoExecution(windows, config)
+}
+
+// validateAutoExecution checks that the automatic execution settings, if any,
+// leave a non-empty window for automatic execution.
+func validateAutoExecution(windows *DecisionPolicyWindows, config Config) error {
+	autoExec := windows.GetAutoExecution()
+	if autoExec == nil {
+		return nil
+	}
+
+	if autoExec.RetryInterval < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "retry_interval cannot be negative")
+	}
+	if autoExec.MaxRetries > 0 && autoExec.RetryInterval == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "retry_interval must be positive when max_retries is set")
+	}
+
+	if autoExec.Deadline < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "auto execution deadline cannot be negative")
+	}
+	if autoExec.Deadline != 0 {
+		if autoExec.Deadline < windows.MinExecutionPeriod {
+			return sdkerrors.Wrap(errors.ErrInvalid, "auto execution deadline should be greater than min_execution_period")
+		}
+		if autoExec.Deadline > windows.VotingPeriod+config.MaxExecutionPeriod {
+			return sdkerrors.Wrap(errors.ErrInvalid, "auto execution deadline should be smaller than voting_period + max_execution_period")
+		}
+	}
+
 	return nil
 }
 
-// Implements DecisionPolicy Interface
-var _ DecisionPolicy = &PercentageDecisionPolicy{}
+// Implements DecisionPolicy and AutoExecutionDecisionPolicy Interfaces
+var (
+	_ DecisionPolicy              = &PercentageDecisionPolicy{}
+	_ AutoExecutionDecisionPolicy = &PercentageDecisionPolicy{}
+)
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
 	return p.Windows.VotingPeriod
 }
 
+func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p PercentageDecisionPolicy) GetAutoExecution() *AutoExecution {
+	return p.Windows.GetAutoExecution()
+}
+
 func (p PercentageDecisionPolicy) ValidateBasic() error {
-	percentage, err := math.NewPositiveDecFromString(p.Percentage)
-	if err != nil {
-		return sdkerrors.Wrap(err, "percentage threshold")
-	}
-	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
-		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	if err := validatePercentage(p.Percentage); err != nil {
+		return err
 	}
 
 	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
@@ -171,10 +263,7 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 }
 
 func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
-		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
-	}
-	return nil
+	return validateWindows(p.Windows, config)
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
@@ -227,6 +316,440 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, si
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements MemberDecisionPolicy and AutoExecutionDecisionPolicy Interfaces
+var (
+	_ MemberDecisionPolicy        = &VetoDecisionPolicy{}
+	_ AutoExecutionDecisionPolicy = &VetoDecisionPolicy{}
+)
+
+// NewVetoDecisionPolicy creates a veto DecisionPolicy
+func NewVetoDecisionPolicy(threshold string, vetoMembers []string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &VetoDecisionPolicy{threshold, vetoMembers, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
+}
+
+func (p VetoDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p VetoDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p VetoDecisionPolicy) GetAutoExecution() *AutoExecution {
+	return p.Windows.GetAutoExecution()
+}
+
+func (p VetoDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	if len(p.VetoMembers) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "veto members")
+	}
+	if err := validateAddresses(p.VetoMembers); err != nil {
+		return sdkerrors.Wrap(err, "veto members")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Allow is not supported by the veto decision policy, which needs to know
+// whether the veto members voted, see AllowMembers.
+func (p VetoDecisionPolicy) Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	return DecisionPolicyResult{}, sdkerrors.Wrap(errors.ErrInvalid, "veto decision policy requires the votes of the group members")
+}
+
+// AllowMembers allows a proposal to pass when the tally of yes votes equals
+// or exceeds the threshold before the timeout, and none of the veto members
+// vetoed it. Before the end of the voting period, the result is final only
+// once all veto members have voted.
+func (p VetoDecisionPolicy) AllowMembers(votes []Vote, members []Member, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	weights := memberWeights(members)
+	vetoMembers := make(map[string]bool, len(p.VetoMembers))
+	for _, addr := range p.VetoMembers {
+		// Veto members who left the group don't hold veto rights anymore.
+		if _, ok := weights[addr]; ok {
+			vetoMembers[addr] = true
+		}
+	}
+
+	tallyResult := DefaultTallyResult()
+	vetoVotes := 0
+	for _, vote := range votes {
+		weight, ok := weights[vote.Voter]
+		if !ok {
+			continue
+		}
+		if vetoMembers[vote.Voter] {
+			if vote.Option == VOTE_OPTION_NO_WITH_VETO {
+				return DecisionPolicyResult{Allow: false, Final: true}, nil
+			}
+			vetoVotes++
+		}
+		if err := tallyResult.Add(vote, weight); err != nil {
+			return DecisionPolicyResult{}, sdkerrors.Wrap(err, "add new vote")
+		}
+	}
+
+	totalPower, err := membersTotalWeight(members)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	thresholdPolicy := ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}
+	result, err := thresholdPolicy.Allow(tallyResult, totalPower.String(), sinceSubmission)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// Give all veto members the opportunity to veto the proposal.
+	if result.Allow && vetoVotes < len(vetoMembers) {
+		return DecisionPolicyResult{Allow: true, Final: false}, nil
+	}
+	return result, nil
+}
+
+// Validate validates the policy against the group. Veto members who are not
+// members of the group hold no veto rights.
+func (p *VetoDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	_, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	_, err = math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+
+	return validateWindows(p.Windows, config)
+}
+
+// Implements MemberDecisionPolicy and AutoExecutionDecisionPolicy Interfaces
+var (
+	_ MemberDecisionPolicy        = &RoleDecisionPolicy{}
+	_ AutoExecutionDecisionPolicy = &RoleDecisionPolicy{}
+)
+
+// NewRoleDecisionPolicy creates a role DecisionPolicy
+func NewRoleDecisionPolicy(roles []Role, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &RoleDecisionPolicy{roles, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
+}
+
+func (p RoleDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p RoleDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p RoleDecisionPolicy) GetAutoExecution() *AutoExecution {
+	return p.Windows.GetAutoExecution()
+}
+
+func (p RoleDecisionPolicy) ValidateBasic() error {
+	if len(p.Roles) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "roles")
+	}
+
+	names := make(map[string]bool, len(p.Roles))
+	for _, role := range p.Roles {
+		if strings.TrimSpace(role.Name) == "" {
+			return sdkerrors.Wrap(errors.ErrEmpty, "role name")
+		}
+		if names[role.Name] {
+			return sdkerrors.Wrapf(errors.ErrDuplicate, "role %s", role.Name)
+		}
+		names[role.Name] = true
+
+		if len(role.Members) == 0 {
+			return sdkerrors.Wrapf(errors.ErrEmpty, "members of role %s", role.Name)
+		}
+		if err := validateAddresses(role.Members); err != nil {
+			return sdkerrors.Wrapf(err, "members of role %s", role.Name)
+		}
+		if _, err := math.NewPositiveDecFromString(role.Threshold); err != nil {
+			return sdkerrors.Wrapf(err, "threshold of role %s", role.Name)
+		}
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Allow is not supported by the role decision policy, which needs to know
+// the roles of the voters, see AllowMembers.
+func (p RoleDecisionPolicy) Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	return DecisionPolicyResult{}, sdkerrors.Wrap(errors.ErrInvalid, "role decision policy requires the votes of the group members")
+}
+
+// AllowMembers allows a proposal to pass when, for each role, the tally of
+// yes votes from the role's members equals or exceeds the role's threshold
+// before the timeout. As for the threshold decision policy, the real
+// threshold of a role is `min(threshold,role_weight)`, where `role_weight` is
+// the sum of the weights of the role's members still in the group.
+func (p RoleDecisionPolicy) AllowMembers(votes []Vote, members []Member, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	weights := memberWeights(members)
+	options := make(map[string]VoteOption, len(votes))
+	for _, vote := range votes {
+		options[vote.Voter] = vote.Option
+	}
+
+	allowed := true
+	for _, role := range p.Roles {
+		threshold, err := math.NewPositiveDecFromString(role.Threshold)
+		if err != nil {
+			return DecisionPolicyResult{}, sdkerrors.Wrapf(err, "threshold of role %s", role.Name)
+		}
+
+		roleWeight, yesCount, undecided := math.NewDecFromInt64(0), math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+		for _, addr := range role.Members {
+			w, ok := weights[addr]
+			if !ok {
+				continue
+			}
+			weight, err := math.NewNonNegativeDecFromString(w)
+			if err != nil {
+				return DecisionPolicyResult{}, sdkerrors.Wrap(err, "member weight")
+			}
+			if roleWeight, err = roleWeight.Add(weight); err != nil {
+				return DecisionPolicyResult{}, err
+			}
+
+			option, voted := options[addr]
+			switch {
+			case !voted:
+				undecided, err = undecided.Add(weight)
+			case option == VOTE_OPTION_YES:
+				yesCount, err = yesCount.Add(weight)
+			}
+			if err != nil {
+				return DecisionPolicyResult{}, err
+			}
+		}
+
+		// A role without any members in the group can't approve proposals.
+		if roleWeight.IsZero() {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+
+		realThreshold := min(threshold, roleWeight)
+		if yesCount.Cmp(realThreshold) >= 0 {
+			continue
+		}
+
+		maxYesCount, err := yesCount.Add(undecided)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if maxYesCount.Cmp(realThreshold) < 0 {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+		allowed = false
+	}
+
+	return DecisionPolicyResult{Allow: allowed, Final: allowed}, nil
+}
+
+// Validate validates the policy against the group. Role members who are not
+// members of the group are ignored when deciding on proposals.
+func (p *RoleDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	for _, role := range p.Roles {
+		if _, err := math.NewPositiveDecFromString(role.Threshold); err != nil {
+			return sdkerrors.Wrapf(err, "threshold of role %s", role.Name)
+		}
+	}
+
+	return validateWindows(p.Windows, config)
+}
+
+// Implements MemberDecisionPolicy and AutoExecutionDecisionPolicy Interfaces
+var (
+	_ MemberDecisionPolicy        = &QuadraticDecisionPolicy{}
+	_ AutoExecutionDecisionPolicy = &QuadraticDecisionPolicy{}
+)
+
+// NewQuadraticDecisionPolicy creates a quadratic DecisionPolicy
+func NewQuadraticDecisionPolicy(percentage string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &QuadraticDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
+}
+
+func (p QuadraticDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p QuadraticDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p QuadraticDecisionPolicy) GetAutoExecution() *AutoExecution {
+	return p.Windows.GetAutoExecution()
+}
+
+func (p QuadraticDecisionPolicy) ValidateBasic() error {
+	if err := validatePercentage(p.Percentage); err != nil {
+		return err
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+// Allow is not supported by the quadratic decision policy, which needs to
+// know the weight of each voter, see AllowMembers.
+func (p QuadraticDecisionPolicy) Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	return DecisionPolicyResult{}, sdkerrors.Wrap(errors.ErrInvalid, "quadratic decision policy requires the votes of the group members")
+}
+
+// AllowMembers allows a proposal to pass when the sum of the square roots of
+// the yes voters' weights, out of the sum of the square roots of all the
+// members' weights, equals or exceeds the percentage threshold before the
+// timeout.
+func (p QuadraticDecisionPolicy) AllowMembers(votes []Vote, members []Member, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+
+	powers := make(map[string]math.Dec, len(members))
+	totalPower := math.NewDecFromInt64(0)
+	for _, m := range members {
+		weight, err := math.NewNonNegativeDecFromString(m.Weight)
+		if err != nil {
+			return DecisionPolicyResult{}, sdkerrors.Wrap(err, "member weight")
+		}
+		power, err := weight.Sqrt()
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		powers[m.Address] = power
+		if totalPower, err = totalPower.Add(power); err != nil {
+			return DecisionPolicyResult{}, err
+		}
+	}
+
+	if totalPower.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	yesPower, votedPower := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+	for _, vote := range votes {
+		power, ok := powers[vote.Voter]
+		if !ok {
+			continue
+		}
+		if votedPower, err = votedPower.Add(power); err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if vote.Option == VOTE_OPTION_YES {
+			if yesPower, err = yesPower.Add(power); err != nil {
+				return DecisionPolicyResult{}, err
+			}
+		}
+	}
+
+	yesPercentage, err := yesPower.Quo(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesPercentage.Cmp(percentage) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := math.SubNonNegative(totalPower, votedPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxYesPower, err := yesPower.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxYesPercentage, err := maxYesPower.Quo(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if maxYesPercentage.Cmp(percentage) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+func (p *QuadraticDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return validateWindows(p.Windows, config)
+}
+
+// validatePercentage checks that the percentage is > 0 and <= 1.
+func validatePercentage(p string) error {
+	percentage, err := math.NewPositiveDecFromString(p)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage threshold")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	return nil
+}
+
+// validateAddresses checks that the addresses are valid and unique.
+func validateAddresses(addrs []string) error {
+	accAddrs := make(accAddresses, len(addrs))
+	for i, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "address %s", addr)
+		}
+		accAddrs[i] = accAddr
+	}
+	return accAddrs.ValidateBasic()
+}
+
+// memberWeights returns the weight of each member indexed by address.
+func memberWeights(members []Member) map[string]string {
+	weights := make(map[string]string, len(members))
+	for _, m := range members {
+		weights[m.Address] = m.Weight
+	}
+	return weights
+}
+
+// membersTotalWeight returns the sum of the members' weights.
+func membersTotalWeight(members []Member) (math.Dec, error) {
+	total := math.NewDecFromInt64(0)
+	for _, m := range members {
+		weight, err := math.NewNonNegativeDecFromString(m.Weight)
+		if err != nil {
+			return math.Dec{}, sdkerrors.Wrap(err, "member weight")
+		}
+		if total, err = total.Add(weight); err != nil {
+			return math.Dec{}, err
+		}
+	}
+	return total, nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -297,10 +297,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +358,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -417,6 +417,277 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// VetoDecisionPolicy is a decision policy where a proposal passes when it
+// satisfies the three following conditions:
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. None of the `veto_members` voted `NO_WITH_VETO`. Such a vote rejects the
+//     proposal regardless of the other votes.
+//  3. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// The proposal only passes before the end of its voting period if all veto
+// members still in the group have voted.
+//
+// Since: cosmos-sdk 0.47
+type VetoDecisionPolicy struct {
+	// threshold is the minimum weighted sum of `YES` votes that must be met or
+	// exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_members are the account addresses of the group members holding veto
+	// rights.
+	VetoMembers []string `protobuf:"bytes,2,rep,name=veto_members,json=vetoMembers,proto3" json:"veto_members,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,3,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *VetoDecisionPolicy) Reset()         { *m = VetoDecisionPolicy{} }
+func (m *VetoDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*VetoDecisionPolicy) ProtoMessage()    {}
+func (*VetoDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *VetoDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoDecisionPolicy.Merge(m, src)
+}
+func (m *VetoDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoDecisionPolicy proto.InternalMessageInfo
+
+func (m *VetoDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *VetoDecisionPolicy) GetVetoMembers() []string {
+	if m != nil {
+		return m.VetoMembers
+	}
+	return nil
+}
+
+func (m *VetoDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// RoleDecisionPolicy is a decision policy where a proposal passes when it
+// satisfies the two following conditions:
+//  1. For each of the `roles`, the sum of the weights of the role's members
+//     voting `YES` is greater or equal than the role's `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// Since: cosmos-sdk 0.47
+type RoleDecisionPolicy struct {
+	// roles are the sub-groups of members which must all approve a proposal.
+	Roles []Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *RoleDecisionPolicy) Reset()         { *m = RoleDecisionPolicy{} }
+func (m *RoleDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RoleDecisionPolicy) ProtoMessage()    {}
+func (*RoleDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+}
+func (m *RoleDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleDecisionPolicy.Merge(m, src)
+}
+func (m *RoleDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleDecisionPolicy proto.InternalMessageInfo
+
+func (m *RoleDecisionPolicy) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *RoleDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// Role is a named sub-group of group members used by RoleDecisionPolicy.
+//
+// Since: cosmos-sdk 0.47
+type Role struct {
+	// name is the unique name of the role within the decision policy.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members are the account addresses of the group members holding the role.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// threshold is the minimum weighted sum of `YES` votes from the role's
+	// members that must be met or exceeded for the role to approve a proposal.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+}
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return m.Size()
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Role) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+// QuadraticDecisionPolicy is a decision policy where a proposal passes when it
+// satisfies the two following conditions:
+//  1. The sum of the square roots of all `YES` voters' weights, out of the sum
+//     of the square roots of all members' weights, is greater or equal than
+//     the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// Since: cosmos-sdk 0.47
+type QuadraticDecisionPolicy struct {
+	// percentage is the minimum percentage the sum of the square roots of the
+	// `YES` voters' weights must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *QuadraticDecisionPolicy) Reset()         { *m = QuadraticDecisionPolicy{} }
+func (m *QuadraticDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuadraticDecisionPolicy) ProtoMessage()    {}
+func (*QuadraticDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+}
+func (m *QuadraticDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuadraticDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuadraticDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuadraticDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuadraticDecisionPolicy.Merge(m, src)
+}
+func (m *QuadraticDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuadraticDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuadraticDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuadraticDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuadraticDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *QuadraticDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -434,13 +705,19 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// auto_execution, if set, makes the proposals of the group policy execute
+	// automatically at the end of a block once they are accepted and
+	// `min_execution_period` has elapsed, without the need to send a MsgExec.
+	//
+	// Since: cosmos-sdk 0.47
+	AutoExecution *AutoExecution `protobuf:"bytes,3,opt,name=auto_execution,json=autoExecution,proto3" json:"auto_execution,omitempty"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,6 +760,86 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetAutoExecution() *AutoExecution {
+	if m != nil {
+		return m.AutoExecution
+	}
+	return nil
+}
+
+// AutoExecution defines how accepted proposals of a group policy are executed
+// automatically in EndBlock.
+//
+// Since: cosmos-sdk 0.47
+type AutoExecution struct {
+	// max_retries is the number of times a failed automatic execution is retried.
+	// If not set, a failed proposal is not retried automatically, but can still
+	// be executed with MsgExec.
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// retry_interval is the duration to wait after a failed automatic execution
+	// before retrying. It must be positive when `max_retries` is set.
+	RetryInterval time.Duration `protobuf:"bytes,2,opt,name=retry_interval,json=retryInterval,proto3,stdduration" json:"retry_interval"`
+	// deadline is the duration after the proposal submission after which no
+	// automatic execution is attempted anymore. If not set, proposals are
+	// executed automatically until they are pruned, i.e. until
+	// `voting_period + max_execution_period`.
+	Deadline time.Duration `protobuf:"bytes,3,opt,name=deadline,proto3,stdduration" json:"deadline"`
+}
+
+func (m *AutoExecution) Reset()         { *m = AutoExecution{} }
+func (m *AutoExecution) String() string { return proto.CompactTextString(m) }
+func (*AutoExecution) ProtoMessage()    {}
+func (*AutoExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *AutoExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoExecution.Merge(m, src)
+}
+func (m *AutoExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoExecution proto.InternalMessageInfo
+
+func (m *AutoExecution) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *AutoExecution) GetRetryInterval() time.Duration {
+	if m != nil {
+		return m.RetryInterval
+	}
+	return 0
+}
+
+func (m *AutoExecution) GetDeadline() time.Duration {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -506,7 +863,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +946,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +1012,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,13 +1081,25 @@ type Proposal struct {
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,11,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of `sdk.Msg`s that will be executed if the proposal passes.
 	Messages []*types.Any `protobuf:"bytes,12,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execution_attempts is the number of automatic executions attempted for
+	// this proposal.
+	//
+	// Since: cosmos-sdk 0.47
+	ExecutionAttempts uint32 `protobuf:"varint,13,opt,name=execution_attempts,json=executionAttempts,proto3" json:"execution_attempts,omitempty"`
+	// next_execution_time is the timestamp after which the proposal is next
+	// processed for automatic execution. It is only set when the proposal's
+	// group policy has automatic execution enabled, and is cleared once no
+	// further automatic execution is scheduled.
+	//
+	// Since: cosmos-sdk 0.47
+	NextExecutionTime *time.Time `protobuf:"bytes,14,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +1144,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{14}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1191,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{15}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1263,12 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*VetoDecisionPolicy)(nil), "cosmos.group.v1.VetoDecisionPolicy")
+	proto.RegisterType((*RoleDecisionPolicy)(nil), "cosmos.group.v1.RoleDecisionPolicy")
+	proto.RegisterType((*Role)(nil), "cosmos.group.v1.Role")
+	proto.RegisterType((*QuadraticDecisionPolicy)(nil), "cosmos.group.v1.QuadraticDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
+	proto.RegisterType((*AutoExecution)(nil), "cosmos.group.v1.AutoExecution")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
@@ -906,89 +1280,103 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	// 1536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xd8, 0x8e, 0xe3, 0x1c, 0xc7, 0x8e, 0x7b, 0x9b, 0x36, 0x4e, 0xd2, 0x67, 0xe7, 0xf9,
+	0x55, 0xef, 0x45, 0x7d, 0x8a, 0xdd, 0xa6, 0x12, 0x48, 0x45, 0x02, 0x6c, 0x67, 0x4a, 0x5d, 0xb5,
+	0xb1, 0x19, 0xdb, 0x09, 0x65, 0x33, 0xba, 0xf1, 0xdc, 0x3a, 0x23, 0xec, 0xb9, 0x66, 0xe6, 0x3a,
+	0x89, 0xff, 0x83, 0x6e, 0x50, 0xbb, 0x64, 0x83, 0x54, 0x89, 0xbf, 0x00, 0xa9, 0x2b, 0x24, 0x24,
+	0x36, 0x48, 0x55, 0x17, 0xa8, 0x62, 0xc5, 0x0a, 0x50, 0xbb, 0x81, 0x15, 0x5b, 0x76, 0xa0, 0xfb,
+	0x31, 0x8e, 0x3f, 0x12, 0xb7, 0xa9, 0x5a, 0xc4, 0x2a, 0xb9, 0xe7, 0xf7, 0x3b, 0xf7, 0x9e, 0xef,
+	0x33, 0x32, 0xac, 0x34, 0xa8, 0xd7, 0xa6, 0x5e, 0xae, 0xe9, 0xd2, 0x6e, 0x27, 0xb7, 0x7f, 0x25,
+	0xc7, 0x7a, 0x1d, 0xe2, 0x65, 0x3b, 0x2e, 0x65, 0x14, 0xcd, 0x4b, 0x30, 0x2b, 0xc0, 0xec, 0xfe,
+	0x95, 0xe5, 0x85, 0x26, 0x6d, 0x52, 0x81, 0xe5, 0xf8, 0x7f, 0x92, 0xb6, 0x9c, 0x6a, 0x52, 0xda,
+	0x6c, 0x91, 0x9c, 0x38, 0xed, 0x76, 0xef, 0xe6, 0xac, 0xae, 0x8b, 0x99, 0x4d, 0x1d, 0x85, 0xa7,
+	0x47, 0x71, 0x66, 0xb7, 0x89, 0xc7, 0x70, 0xbb, 0xa3, 0x08, 0x4b, 0xf2, 0x1d, 0x53, 0xde, 0xac,
+	0x1e, 0x55, 0xd0, 0xa8, 0x2e, 0x76, 0x7a, 0x12, 0xca, 0x7c, 0xa5, 0x41, 0xf8, 0x36, 0x69, 0xef,
+	0x12, 0x17, 0x6d, 0xc0, 0x0c, 0xb6, 0x2c, 0x97, 0x78, 0x5e, 0x52, 0x5b, 0xd5, 0xd6, 0x66, 0x0b,
+	0xc9, 0x1f, 0x1e, 0xad, 0x2f, 0xa8, 0x8b, 0xf2, 0x12, 0xa9, 0x32, 0xd7, 0x76, 0x9a, 0x86, 0x4f,
+	0x44, 0xe7, 0x21, 0x7c, 0x40, 0xec, 0xe6, 0x1e, 0x4b, 0x06, 0xb8, 0x8a, 0xa1, 0x4e, 0x68, 0x19,
+	0x22, 0x6d, 0xc2, 0xb0, 0x85, 0x19, 0x4e, 0x06, 0x05, 0xd2, 0x3f, 0xa3, 0xf7, 0x20, 0x82, 0x2d,
+	0x8b, 0x58, 0x26, 0x66, 0xc9, 0xd0, 0xaa, 0xb6, 0x16, 0xdd, 0x58, 0xce, 0x4a, 0x03, 0xb3, 0xbe,
+	0x81, 0xd9, 0x9a, 0xef, 0x5c, 0x21, 0xf2, 0xf8, 0xa7, 0xf4, 0xd4, 0x83, 0x9f, 0xd3, 0x9a, 0x78,
+	0x94, 0x58, 0x79, 0x96, 0x39, 0x80, 0x98, 0x34, 0xd9, 0x20, 0x9f, 0x76, 0x89, 0xc7, 0xfe, 0x2e,
+	0xcb, 0x33, 0x9f, 0x69, 0xb0, 0x58, 0xdb, 0x73, 0x89, 0xb7, 0x47, 0x5b, 0xd6, 0x26, 0x69, 0xd8,
+	0x9e, 0x4d, 0x9d, 0x0a, 0x6d, 0xd9, 0x8d, 0x1e, 0xba, 0x00, 0xb3, 0xcc, 0x87, 0xa4, 0x15, 0xc6,
+	0x91, 0x00, 0xbd, 0x0f, 0x33, 0x07, 0xb6, 0x63, 0xd1, 0x03, 0x4f, 0x3c, 0x17, 0xdd, 0xf8, 0x6f,
+	0x76, 0xa4, 0x2c, 0xb2, 0xc3, 0xf7, 0xed, 0x48, 0xb6, 0xe1, 0xab, 0x5d, 0x43, 0x4f, 0x1e, 0xad,
+	0xc7, 0x87, 0x39, 0x99, 0x07, 0x1a, 0x24, 0x2b, 0xc4, 0x6d, 0x10, 0x87, 0xe1, 0x26, 0x19, 0x31,
+	0x28, 0x05, 0xd0, 0xe9, 0x63, 0xca, 0xa2, 0x01, 0xc9, 0x1b, 0x32, 0xe9, 0x3b, 0x0d, 0xd0, 0x36,
+	0x61, 0xf4, 0x54, 0xd1, 0x79, 0x07, 0xe6, 0xf6, 0x09, 0xa3, 0x66, 0x5b, 0x64, 0x95, 0xdb, 0x13,
+	0x9c, 0x98, 0xc4, 0x28, 0x67, 0xcb, 0x12, 0xf0, 0x06, 0xfd, 0x08, 0xbe, 0x3e, 0x3f, 0xbe, 0xd0,
+	0x00, 0x19, 0xb4, 0x35, 0x1a, 0xd4, 0x2b, 0x30, 0xed, 0xd2, 0x16, 0xe1, 0x75, 0x16, 0x5c, 0x8b,
+	0x6e, 0x9c, 0x1b, 0x7b, 0x8a, 0xeb, 0x14, 0x42, 0xbc, 0x66, 0x0d, 0xc9, 0x7c, 0x43, 0x71, 0x6e,
+	0x41, 0x88, 0x3f, 0x85, 0x10, 0x84, 0x1c, 0xdc, 0xf6, 0xf3, 0x2b, 0xfe, 0xe7, 0xed, 0xf0, 0xb2,
+	0x91, 0xf4, 0x89, 0xc3, 0x09, 0x0a, 0x8e, 0x24, 0x28, 0x73, 0x5f, 0x83, 0xc5, 0x0f, 0xbb, 0xd8,
+	0xe2, 0x13, 0xa9, 0xf1, 0x8f, 0xa8, 0xb3, 0x3f, 0x35, 0x38, 0x77, 0xac, 0x1a, 0xba, 0x01, 0xb1,
+	0x7d, 0xca, 0x6c, 0xa7, 0x69, 0x76, 0x88, 0x6b, 0x53, 0x59, 0x6e, 0xd1, 0x8d, 0xa5, 0xb1, 0x19,
+	0xb3, 0xa9, 0x06, 0xac, 0x1c, 0x31, 0x9f, 0xf3, 0x11, 0x33, 0x27, 0x35, 0x2b, 0x42, 0x11, 0xd5,
+	0x61, 0xa1, 0x6d, 0x3b, 0x26, 0x39, 0x24, 0x8d, 0x2e, 0x27, 0xfa, 0x17, 0x06, 0x5e, 0xfe, 0x42,
+	0xd4, 0xb6, 0x1d, 0xdd, 0xd7, 0x57, 0xd7, 0xea, 0x10, 0xc7, 0x5d, 0x46, 0x8f, 0xee, 0x55, 0x75,
+	0x9b, 0x1a, 0x8b, 0x4b, 0xbe, 0xcb, 0x68, 0x5f, 0xdb, 0x88, 0xe1, 0xc1, 0x63, 0xe6, 0x1b, 0x0d,
+	0x62, 0x43, 0x04, 0x94, 0x86, 0x68, 0x1b, 0x1f, 0x9a, 0x2e, 0x61, 0xae, 0x4d, 0xe4, 0x28, 0x8c,
+	0x19, 0xd0, 0xc6, 0x87, 0x86, 0x94, 0xa0, 0x9b, 0x10, 0xe7, 0x60, 0xcf, 0xb4, 0x1d, 0x46, 0xdc,
+	0x7d, 0xdc, 0x3a, 0x8d, 0x2b, 0x31, 0xa1, 0x5a, 0x52, 0x9a, 0x7c, 0x8a, 0x5b, 0x04, 0x5b, 0x2d,
+	0xdb, 0x21, 0xc9, 0xe0, 0xcb, 0xdf, 0xd2, 0x57, 0xca, 0xfc, 0xa6, 0xc1, 0xec, 0x07, 0xdc, 0xd3,
+	0x92, 0x73, 0x97, 0xa2, 0x38, 0x04, 0x6c, 0x99, 0xaa, 0x90, 0x11, 0xb0, 0x2d, 0x94, 0x85, 0x69,
+	0x6c, 0xb5, 0x6d, 0x47, 0x4e, 0xe7, 0x09, 0x15, 0x2c, 0x69, 0x13, 0x17, 0x4e, 0x12, 0x66, 0xf6,
+	0x89, 0xcb, 0x2b, 0x45, 0xec, 0x9b, 0x90, 0xe1, 0x1f, 0xd1, 0xbf, 0x61, 0x8e, 0x51, 0x86, 0x5b,
+	0xa6, 0x5a, 0x05, 0xd3, 0x42, 0x33, 0x2a, 0x64, 0x3b, 0x42, 0x84, 0x8a, 0x00, 0x0d, 0x97, 0x60,
+	0x26, 0xf7, 0x55, 0xf8, 0x14, 0xfb, 0x6a, 0x56, 0xe9, 0xe5, 0x59, 0xe6, 0x0e, 0x44, 0x85, 0xab,
+	0x6a, 0xd3, 0x2e, 0x41, 0x44, 0xe4, 0xd8, 0xec, 0xbb, 0x3c, 0x23, 0xce, 0x25, 0x0b, 0xe5, 0x20,
+	0x2c, 0x5b, 0x52, 0xa5, 0x66, 0x71, 0xac, 0x28, 0xd4, 0xea, 0x53, 0xb4, 0xcc, 0x1f, 0x01, 0x98,
+	0x17, 0x77, 0xcb, 0x2e, 0x10, 0xc1, 0x7c, 0x95, 0x7d, 0x38, 0x68, 0x53, 0x60, 0xd8, 0xa6, 0x7e,
+	0x2e, 0x82, 0xa7, 0xcf, 0x45, 0xe8, 0xe4, 0x5c, 0x4c, 0x0f, 0xe7, 0x02, 0xc3, 0xbc, 0xa5, 0x1a,
+	0xda, 0xec, 0x08, 0x5f, 0x54, 0xb4, 0x17, 0xc6, 0xa2, 0x9d, 0x77, 0x7a, 0x85, 0xcc, 0x93, 0x47,
+	0xeb, 0xa9, 0xc9, 0x83, 0xc4, 0x88, 0x5b, 0x43, 0xe7, 0x91, 0x5c, 0xce, 0xbc, 0x52, 0x2e, 0xaf,
+	0x45, 0xee, 0x3d, 0x4c, 0x4f, 0xfd, 0xfa, 0x30, 0xad, 0x65, 0xbe, 0x0d, 0x43, 0xa4, 0xe2, 0xd2,
+	0x0e, 0xf5, 0x70, 0x6b, 0xac, 0x80, 0x6f, 0xc2, 0x82, 0x8c, 0xa7, 0xf4, 0xc5, 0xf4, 0x13, 0xf2,
+	0xa2, 0x7a, 0x46, 0xcd, 0xa3, 0x64, 0x2a, 0x64, 0x62, 0x71, 0xbf, 0x05, 0xb3, 0x1d, 0x61, 0x03,
+	0x1f, 0xf7, 0xa1, 0x17, 0x8c, 0xfb, 0x23, 0x2a, 0xd2, 0x21, 0xea, 0x75, 0x77, 0xdb, 0x36, 0x33,
+	0xf9, 0x87, 0x64, 0x72, 0xfa, 0x14, 0xc1, 0x00, 0xa9, 0xc8, 0x21, 0xf4, 0x1f, 0x88, 0x49, 0x37,
+	0xfd, 0xac, 0x86, 0x45, 0x04, 0xe6, 0x84, 0x70, 0x5b, 0xa5, 0xf6, 0xf2, 0x48, 0x2c, 0x7c, 0xee,
+	0x8c, 0xe0, 0x0e, 0x7a, 0xec, 0x6b, 0xbc, 0x0d, 0x61, 0x8f, 0x61, 0xd6, 0xf5, 0x92, 0x91, 0x55,
+	0x6d, 0x2d, 0xbe, 0x91, 0x1e, 0x6b, 0x03, 0x3f, 0xf0, 0x55, 0x41, 0x33, 0x14, 0x1d, 0x55, 0x00,
+	0xdd, 0xb5, 0x1d, 0xdc, 0x32, 0x19, 0x6e, 0xb5, 0x7a, 0xa6, 0x4b, 0xbc, 0x6e, 0x8b, 0x25, 0x67,
+	0x85, 0x77, 0x17, 0xc6, 0x2e, 0xa9, 0x71, 0x92, 0x21, 0x38, 0x6a, 0x69, 0x27, 0x84, 0xf6, 0x80,
+	0x1c, 0x55, 0xe0, 0xcc, 0xd0, 0x3e, 0x31, 0x89, 0x63, 0x25, 0xe1, 0x14, 0xe1, 0x9a, 0x1f, 0x5c,
+	0x2a, 0xba, 0x63, 0xa1, 0x0a, 0xcc, 0xcb, 0xd9, 0x4f, 0x5d, 0xdf, 0xc0, 0xa8, 0xf0, 0xf2, 0x7f,
+	0x27, 0x7a, 0xa9, 0x2b, 0xbe, 0xb4, 0xc9, 0x88, 0x93, 0xa1, 0x33, 0xba, 0xcc, 0x0b, 0xc4, 0xf3,
+	0x70, 0x93, 0x78, 0xc9, 0xb9, 0xd5, 0xe0, 0x49, 0x4d, 0x63, 0xf4, 0x59, 0x68, 0x1d, 0xd0, 0xd1,
+	0x5e, 0xc3, 0x8c, 0x91, 0x76, 0x87, 0x79, 0xc9, 0x98, 0x58, 0x19, 0x67, 0xfa, 0x48, 0x5e, 0x01,
+	0xa8, 0x02, 0x67, 0x1d, 0x72, 0xc8, 0x06, 0x76, 0xa1, 0xa8, 0x9a, 0xf8, 0x0b, 0xc3, 0x10, 0x12,
+	0x21, 0x38, 0xc3, 0x95, 0xfb, 0x8b, 0x8a, 0xa3, 0xd7, 0x42, 0xbc, 0x8d, 0xf8, 0x67, 0x56, 0x74,
+	0x30, 0xd8, 0x2b, 0x30, 0xdb, 0x23, 0x9e, 0xd9, 0xa0, 0x5d, 0x87, 0xa9, 0x6f, 0x89, 0x48, 0x8f,
+	0x78, 0x45, 0x7e, 0xe6, 0xb5, 0x86, 0x77, 0x3d, 0x86, 0x6d, 0x47, 0x11, 0xe4, 0x97, 0xfb, 0x9c,
+	0x12, 0x4a, 0xd2, 0x12, 0x44, 0x1c, 0xaa, 0x70, 0xd9, 0x2b, 0x33, 0x0e, 0x95, 0xd0, 0xff, 0x01,
+	0x39, 0xd4, 0x3c, 0xb0, 0xd9, 0x9e, 0x29, 0x3e, 0x37, 0x25, 0x49, 0x4e, 0xa8, 0x79, 0x87, 0xee,
+	0xd8, 0x6c, 0x8f, 0x7f, 0xba, 0x0a, 0xb2, 0xb2, 0xef, 0x77, 0x0d, 0x42, 0xdb, 0x94, 0x11, 0xbe,
+	0x5b, 0x3b, 0x2a, 0x17, 0x47, 0x53, 0x1b, 0x7c, 0x91, 0x1c, 0x92, 0xfb, 0x94, 0xa9, 0xb9, 0x3d,
+	0x71, 0x48, 0x0a, 0x1a, 0xba, 0x0a, 0x61, 0xda, 0xe9, 0x6f, 0xff, 0xf8, 0xc6, 0xca, 0x58, 0xee,
+	0xf9, 0xbb, 0x65, 0x41, 0x31, 0x14, 0x75, 0xe2, 0x64, 0x7d, 0x3d, 0x0d, 0x7d, 0xe9, 0xbe, 0x06,
+	0x70, 0xf4, 0x32, 0x5a, 0x81, 0xc5, 0xed, 0x72, 0x4d, 0x37, 0xcb, 0x95, 0x5a, 0xa9, 0xbc, 0x65,
+	0xd6, 0xb7, 0xaa, 0x15, 0xbd, 0x58, 0xba, 0x5e, 0xd2, 0x37, 0x13, 0x53, 0xe8, 0x2c, 0xcc, 0x0f,
+	0x82, 0x77, 0xf4, 0x6a, 0x42, 0x43, 0x8b, 0x70, 0x76, 0x50, 0x98, 0x2f, 0x54, 0x6b, 0xf9, 0xd2,
+	0x56, 0x22, 0x80, 0x10, 0xc4, 0x07, 0x81, 0xad, 0x72, 0x22, 0x88, 0x2e, 0x40, 0x72, 0x58, 0x66,
+	0xee, 0x94, 0x6a, 0x37, 0xcc, 0x6d, 0xbd, 0x56, 0x4e, 0x84, 0x96, 0x43, 0xf7, 0xbe, 0x4c, 0x4d,
+	0x5d, 0xfa, 0x5e, 0x83, 0xf8, 0x70, 0xb7, 0xa3, 0x34, 0xac, 0x54, 0x8c, 0x72, 0xa5, 0x5c, 0xcd,
+	0xdf, 0x32, 0xab, 0xb5, 0x7c, 0xad, 0x5e, 0x1d, 0xb1, 0xec, 0x5f, 0xb0, 0x34, 0x4a, 0xa8, 0xd6,
+	0x0b, 0xb7, 0x4b, 0xb5, 0x9a, 0xbe, 0x99, 0xd0, 0xf8, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0xd4, 0x2b,
+	0x1c, 0x0d, 0x1c, 0x87, 0x1a, 0xfa, 0x4d, 0xbd, 0xc8, 0xd1, 0x20, 0x8f, 0xc8, 0x98, 0x6e, 0xa1,
+	0x6c, 0x70, 0x30, 0x74, 0xdc, 0xbb, 0xdc, 0xa1, 0x4d, 0x23, 0xbf, 0xb3, 0x95, 0x98, 0x56, 0x0e,
+	0x7d, 0xad, 0xc1, 0xf9, 0xe3, 0x1b, 0x1b, 0xad, 0xc1, 0xc5, 0xbe, 0xbe, 0xfe, 0x91, 0x5e, 0xac,
+	0xd7, 0xca, 0x86, 0x69, 0xe8, 0xd5, 0xfa, 0xad, 0xda, 0x88, 0x87, 0x17, 0x61, 0xf5, 0x44, 0xe6,
+	0x56, 0xb9, 0x66, 0x1a, 0xf5, 0xad, 0x84, 0x36, 0x91, 0x55, 0xad, 0x17, 0x8b, 0x7a, 0xb5, 0x9a,
+	0x08, 0x4c, 0x64, 0x5d, 0xcf, 0x97, 0x6e, 0xd5, 0x0d, 0x3d, 0x11, 0x94, 0xc6, 0x17, 0xde, 0x7d,
+	0xfc, 0x2c, 0xa5, 0x3d, 0x7d, 0x96, 0xd2, 0x7e, 0x79, 0x96, 0xd2, 0x1e, 0x3c, 0x4f, 0x4d, 0x3d,
+	0x7d, 0x9e, 0x9a, 0xfa, 0xf1, 0x79, 0x6a, 0xea, 0xe3, 0x8b, 0x4d, 0x9b, 0xed, 0x75, 0x77, 0xb3,
+	0x0d, 0xda, 0x56, 0x3f, 0x3f, 0xa8, 0x3f, 0xeb, 0x9e, 0xf5, 0x49, 0xee, 0x50, 0xfe, 0x3a, 0xb2,
+	0x1b, 0x16, 0x95, 0x78, 0xf5, 0xaf, 0x01, 0x00, 0x50, 0x0c, 0x1d, 0xe5, 0x34, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1213,7 +1601,7 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
+func (m *VetoDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1223,36 +1611,48 @@ func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
+func (m *VetoDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VetoDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.VetoMembers) > 0 {
+		for iNdEx := len(m.VetoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VetoMembers[iNdEx])
+			copy(dAtA[i:], m.VetoMembers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+func (m *RoleDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1262,32 +1662,264 @@ func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RoleDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RoleDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x32
-	if len(m.TotalWeight) > 0 {
-		i -= len(m.TotalWeight)
-		copy(dAtA[i:], m.TotalWeight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if m.Version != 0 {
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Role) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuadraticDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuadraticDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuadraticDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecution != nil {
+		{
+			size, err := m.AutoExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Deadline):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RetryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetryInterval):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
 		i--
 		dAtA[i] = 0x20
@@ -1374,12 +2006,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1448,6 +2080,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextExecutionTime != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextExecutionTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTypes(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ExecutionAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionAttempts))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1467,12 +2114,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +2147,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +2257,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err20 != nil {
+		return 0, err20
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n20
+	i = encodeVarintTypes(dAtA, i, uint64(n20))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1734,59 +2381,161 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
-func (m *DecisionPolicyWindows) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func (m *GroupInfo) Size() (n int) {
+func (m *VetoDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
+	l = len(m.Threshold)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
+	if len(m.VetoMembers) > 0 {
+		for _, s := range m.VetoMembers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
-	l = len(m.TotalWeight)
-	if l > 0 {
+	if m.Windows != nil {
+		l = m.Windows.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
-func (m *GroupMember) Size() (n int) {
+func (m *RoleDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTypes(uint64(m.GroupId))
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
-	if m.Member != nil {
-		l = m.Member.Size()
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuadraticDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DecisionPolicyWindows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoExecution != nil {
+		l = m.AutoExecution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovTypes(uint64(m.MaxRetries))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetryInterval)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Deadline)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
@@ -1872,6 +2621,13 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.ExecutionAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutionAttempts))
+	}
+	if m.NextExecutionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextExecutionTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1990,11 +2746,572 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoMembers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2022,13 +3339,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.VetoMembers = append(m.VetoMembers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2038,27 +3355,81 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2085,7 +3456,44 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Roles = append(m.Roles, Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2110,7 +3518,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRequest) Unmarshal(dAtA []byte) error {
+func (m *Role) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2133,15 +3541,15 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2169,11 +3577,11 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2201,11 +3609,11 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,7 +3641,7 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2256,7 +3664,7 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *QuadraticDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2279,15 +3687,15 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuadraticDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuadraticDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2315,7 +3723,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Percentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2374,7 +3782,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2397,17 +3805,17 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2417,27 +3825,28 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Percentage = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2464,10 +3873,43 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Windows == nil {
-				m.Windows = &DecisionPolicyWindows{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoExecution == nil {
+				m.AutoExecution = &AutoExecution{}
+			}
+			if err := m.AutoExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2492,7 +3934,7 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *AutoExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,15 +3957,34 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2550,13 +4011,13 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RetryInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2583,7 +4044,7 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3536,6 +4997,61 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionAttempts", wireType)
+			}
+			m.ExecutionAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextExecutionTime == nil {
+				m.NextExecutionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
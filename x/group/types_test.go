@@ -41,6 +41,62 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"auto execution retries without retry interval",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+					AutoExecution: &group.AutoExecution{
+						MaxRetries: 3,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"auto execution deadline before min exec period",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:       time.Hour,
+					MinExecutionPeriod: time.Hour,
+					AutoExecution: &group.AutoExecution{
+						Deadline: time.Minute,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"auto execution deadline too big",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+					AutoExecution: &group.AutoExecution{
+						Deadline: time.Hour * 24 * 30,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"auto execution all good",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:       time.Hour,
+					MinExecutionPeriod: time.Minute,
+					AutoExecution: &group.AutoExecution{
+						MaxRetries:    3,
+						RetryInterval: time.Minute,
+						Deadline:      time.Hour * 2,
+					},
+				},
+			},
+			false,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -429,3 +485,266 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestVetoDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	testCases := []struct {
+		name   string
+		policy group.VetoDecisionPolicy
+		expErr bool
+	}{
+		{"no veto members", group.VetoDecisionPolicy{Threshold: "1", Windows: windows}, true},
+		{"invalid veto member", group.VetoDecisionPolicy{Threshold: "1", VetoMembers: []string{"invalid"}, Windows: windows}, true},
+		{"duplicate veto member", group.VetoDecisionPolicy{Threshold: "1", VetoMembers: []string{member1.String(), member1.String()}, Windows: windows}, true},
+		{"invalid threshold", group.VetoDecisionPolicy{Threshold: "0", VetoMembers: []string{member1.String()}, Windows: windows}, true},
+		{"no voting period", group.VetoDecisionPolicy{Threshold: "1", VetoMembers: []string{member1.String()}, Windows: &group.DecisionPolicyWindows{}}, true},
+		{"all good", group.VetoDecisionPolicy{Threshold: "1", VetoMembers: []string{member1.String()}, Windows: windows}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVetoDecisionPolicyAllowMembers(t *testing.T) {
+	policy := group.VetoDecisionPolicy{
+		Threshold:   "2",
+		VetoMembers: []string{member1.String(), member4.String()},
+		Windows:     &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+	}
+	// member4 is not a group member anymore, so it doesn't hold veto rights.
+	members := []group.Member{
+		{Address: member1.String(), Weight: "1"},
+		{Address: member2.String(), Weight: "1"},
+		{Address: member3.String(), Weight: "1"},
+	}
+
+	testCases := []struct {
+		name   string
+		votes  []group.Vote
+		result group.DecisionPolicyResult
+	}{
+		{
+			"threshold reached, veto member didn't vote",
+			[]group.Vote{
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_YES},
+			},
+			group.DecisionPolicyResult{Allow: true, Final: false},
+		},
+		{
+			"threshold reached, veto member voted",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_NO},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_YES},
+			},
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"vetoed",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_NO_WITH_VETO},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_YES},
+			},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"veto from a member without veto rights",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_NO_WITH_VETO},
+			},
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"threshold not reached",
+			[]group.Vote{
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+			},
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.AllowMembers(tc.votes, members, time.Second)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, res)
+		})
+	}
+}
+
+func TestRoleDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	role := group.Role{Name: "engineering", Members: []string{member1.String()}, Threshold: "1"}
+	testCases := []struct {
+		name   string
+		policy group.RoleDecisionPolicy
+		expErr bool
+	}{
+		{"no roles", group.RoleDecisionPolicy{Windows: windows}, true},
+		{"blank role name", group.RoleDecisionPolicy{Roles: []group.Role{{Members: role.Members, Threshold: "1"}}, Windows: windows}, true},
+		{"duplicate role name", group.RoleDecisionPolicy{Roles: []group.Role{role, role}, Windows: windows}, true},
+		{"no role members", group.RoleDecisionPolicy{Roles: []group.Role{{Name: "finance", Threshold: "1"}}, Windows: windows}, true},
+		{"invalid threshold", group.RoleDecisionPolicy{Roles: []group.Role{{Name: "finance", Members: role.Members, Threshold: "-1"}}, Windows: windows}, true},
+		{"no voting period", group.RoleDecisionPolicy{Roles: []group.Role{role}, Windows: &group.DecisionPolicyWindows{}}, true},
+		{"all good", group.RoleDecisionPolicy{Roles: []group.Role{role}, Windows: windows}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleDecisionPolicyAllowMembers(t *testing.T) {
+	// 2 of engineering AND 1 of finance.
+	policy := group.RoleDecisionPolicy{
+		Roles: []group.Role{
+			{Name: "engineering", Members: []string{member1.String(), member2.String(), member3.String()}, Threshold: "2"},
+			{Name: "finance", Members: []string{member4.String()}, Threshold: "1"},
+		},
+		Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+	}
+	members := []group.Member{
+		{Address: member1.String(), Weight: "1"},
+		{Address: member2.String(), Weight: "1"},
+		{Address: member3.String(), Weight: "1"},
+		{Address: member4.String(), Weight: "1"},
+	}
+
+	testCases := []struct {
+		name    string
+		votes   []group.Vote
+		members []group.Member
+		result  group.DecisionPolicyResult
+	}{
+		{
+			"all roles approve",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member4.String(), Option: group.VOTE_OPTION_YES},
+			},
+			members,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"one role pending",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_YES},
+			},
+			members,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"one role rejects",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_NO},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_NO},
+				{Voter: member4.String(), Option: group.VOTE_OPTION_YES},
+			},
+			members,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"role without group members",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+			},
+			members[:3],
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.AllowMembers(tc.votes, tc.members, time.Second)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, res)
+		})
+	}
+}
+
+func TestQuadraticDecisionPolicyAllowMembers(t *testing.T) {
+	policy := group.QuadraticDecisionPolicy{
+		Percentage: "0.5",
+		Windows:    &group.DecisionPolicyWindows{VotingPeriod: time.Hour, MinExecutionPeriod: time.Minute},
+	}
+	// Quadratic voting powers are 3, 1, 1 and 1.
+	members := []group.Member{
+		{Address: member1.String(), Weight: "9"},
+		{Address: member2.String(), Weight: "1"},
+		{Address: member3.String(), Weight: "1"},
+		{Address: member4.String(), Weight: "1"},
+	}
+
+	testCases := []struct {
+		name            string
+		votes           []group.Vote
+		sinceSubmission time.Duration
+		result          group.DecisionPolicyResult
+		expErr          bool
+	}{
+		{
+			"min execution period not passed",
+			nil,
+			time.Second,
+			group.DecisionPolicyResult{},
+			true,
+		},
+		{
+			"heaviest member alone passes",
+			[]group.Vote{{Voter: member1.String(), Option: group.VOTE_OPTION_YES}},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"other members alone don't pass",
+			[]group.Vote{
+				{Voter: member2.String(), Option: group.VOTE_OPTION_YES},
+				{Voter: member3.String(), Option: group.VOTE_OPTION_YES},
+			},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"heaviest member against",
+			[]group.Vote{
+				{Voter: member1.String(), Option: group.VOTE_OPTION_NO},
+				{Voter: member2.String(), Option: group.VOTE_OPTION_NO},
+			},
+			time.Hour,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.AllowMembers(tc.votes, members, tc.sinceSubmission)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.result, res)
+		})
+	}
+}
@@ -18,14 +18,23 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	params := k.GetParams(ctx)
 
 	// recalculate inflation rate
-	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	mintDenomSupply := k.MintDenomSupply(ctx)
+	switch params.InflationSchedule {
+	case types.InflationScheduleBondedRatio:
+		totalStakingSupply := k.StakingTokenSupply(ctx)
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	case types.InflationScheduleHalving:
+		minter = minter.NextHalvingMinter(params, ctx.BlockHeight(), mintDenomSupply)
+	default:
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, mintDenomSupply)
+	}
 	k.SetMinter(ctx, minter)
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	// mint coins, update supply, up to the maximum supply
+	mintedCoin := params.CapBlockProvision(minter.BlockProvision(params), mintDenomSupply)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
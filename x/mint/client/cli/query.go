@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedSupply(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +117,37 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedSupply implements a command to return the supply of the
+// mint denom projected at a block height.
+func GetCmdQueryProjectedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-supply [height]",
+		Short: "Query the supply of the mint denom projected at a block height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[0])
+			}
+
+			params := &types.QueryProjectedSupplyRequest{Height: height}
+			res, err := queryClient.ProjectedSupply(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Supply)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
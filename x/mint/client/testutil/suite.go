@@ -67,16 +67,23 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","inflation_schedule":"INFLATION_SCHEDULE_BONDED_RATIO","max_supply":"0","initial_block_provision":"0","halving_interval":"0","inflation_steps":[],"fixed_inflation":"0.000000000000000000","halving_start_height":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+fixed_inflation: "0.000000000000000000"
 goal_bonded: "0.670000000000000000"
+halving_interval: "0"
+halving_start_height: "0"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
+inflation_schedule: INFLATION_SCHEDULE_BONDED_RATIO
+inflation_steps: []
+initial_block_provision: "0"
+max_supply: "0"
 mint_denom: stake`,
 		},
 	}
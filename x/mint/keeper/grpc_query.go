@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,27 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// ProjectedSupply returns the supply of the mint denom projected at a block
+// height under the current params.
+func (k Keeper) ProjectedSupply(c context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height < ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is lower than the current height %d", req.Height, ctx.BlockHeight())
+	}
+
+	// the maximum height is computed on big integers as it can overflow
+	params := k.GetParams(ctx)
+	maxHeight := sdk.NewIntFromUint64(params.BlocksPerYear).MulRaw(types.MaxProjectionYears).AddRaw(ctx.BlockHeight())
+	if sdk.NewInt(req.Height).GT(maxHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is more than %d years ahead", req.Height, types.MaxProjectionYears)
+	}
+
+	supply := k.GetMinter(ctx).ProjectSupply(params, k.MintDenomSupply(ctx), ctx.BlockHeight(), req.Height)
+
+	return &types.QueryProjectedSupplyResponse{Supply: sdk.NewCoin(params.MintDenom, supply)}, nil
+}
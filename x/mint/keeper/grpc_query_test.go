@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"math"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -25,6 +26,7 @@ type MintTestSuite struct {
 	ctx         sdk.Context
 	queryClient types.QueryClient
 	mintKeeper  keeper.Keeper
+	bankKeeper  *minttestutil.MockBankKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -37,6 +39,7 @@ func (suite *MintTestSuite) SetupTest() {
 	ctrl := gomock.NewController(suite.T())
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
+	suite.bankKeeper = bankKeeper
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
@@ -75,6 +78,47 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, suite.mintKeeper.GetMinter(suite.ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectedSupply() {
+	ctx := suite.ctx.WithBlockHeight(100)
+	params := suite.mintKeeper.GetParams(ctx)
+	params.InflationSchedule = types.InflationScheduleHalving
+	params.InitialBlockProvision = sdk.NewInt(10)
+	params.HalvingInterval = 1000
+	params.MaxSupply = sdk.NewInt(2000)
+	suite.Require().NoError(suite.mintKeeper.SetParams(ctx, params))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{}).InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, suite.mintKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.bankKeeper.EXPECT().GetSupply(gomock.Any(), params.MintDenom).Return(sdk.NewInt64Coin(params.MintDenom, 1000)).AnyTimes()
+
+	res, err := queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Height: 150})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(params.MintDenom, 1500), res.Supply)
+
+	// the maximum supply caps the projection
+	res, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Height: 300})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(params.MintDenom, 2000), res.Supply)
+
+	// the supply can't be projected in the past
+	_, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Height: 99})
+	suite.Require().Error(err)
+
+	// nor too far ahead, even when the blocks per year overflow the height
+	params.BlocksPerYear = math.MaxUint64
+	suite.Require().NoError(suite.mintKeeper.SetParams(ctx, params))
+	res, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Height: math.MaxInt64})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(params.MintDenom, 2000), res.Supply)
+
+	params.BlocksPerYear = 1
+	suite.Require().NoError(suite.mintKeeper.SetParams(ctx, params))
+	_, err = queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Height: 100 + types.MaxProjectionYears + 1})
+	suite.Require().Error(err)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
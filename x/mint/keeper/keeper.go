@@ -116,6 +116,11 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
+// MintDenomSupply returns the total supply of the mint denom.
+func (k Keeper) MintDenomSupply(ctx sdk.Context) math.Int {
+	return k.bankKeeper.GetSupply(ctx, k.GetParams(ctx).MintDenom).Amount
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
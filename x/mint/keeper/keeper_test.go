@@ -81,15 +81,60 @@ func (s *IntegrationTestSuite) TestParams() {
 		{
 			name: "set full valid params",
 			input: types.Params{
-				MintDenom:           sdk.DefaultBondDenom,
-				InflationRateChange: sdk.NewDecWithPrec(8, 2),
-				InflationMax:        sdk.NewDecWithPrec(20, 2),
-				InflationMin:        sdk.NewDecWithPrec(2, 2),
-				GoalBonded:          sdk.NewDecWithPrec(37, 2),
-				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				MintDenom:             sdk.DefaultBondDenom,
+				InflationRateChange:   sdk.NewDecWithPrec(8, 2),
+				InflationMax:          sdk.NewDecWithPrec(20, 2),
+				InflationMin:          sdk.NewDecWithPrec(2, 2),
+				GoalBonded:            sdk.NewDecWithPrec(37, 2),
+				BlocksPerYear:         uint64(60 * 60 * 8766 / 5),
+				InflationSchedule:     types.InflationScheduleHalving,
+				MaxSupply:             sdk.NewInt(21_000_000_000_000),
+				InitialBlockProvision: sdk.NewInt(50_000_000),
+				HalvingInterval:       210_000,
+				InflationSteps: []types.InflationStep{
+					{StartHeight: 0, Inflation: sdk.NewDecWithPrec(10, 2)},
+					{StartHeight: 1000, Inflation: sdk.NewDecWithPrec(5, 2)},
+				},
+				FixedInflation: sdk.NewDecWithPrec(2, 2),
 			},
 			expectErr: false,
 		},
+		{
+			name: "set invalid halving params",
+			input: types.Params{
+				MintDenom:             sdk.DefaultBondDenom,
+				InflationRateChange:   sdk.NewDecWithPrec(8, 2),
+				InflationMax:          sdk.NewDecWithPrec(20, 2),
+				InflationMin:          sdk.NewDecWithPrec(2, 2),
+				GoalBonded:            sdk.NewDecWithPrec(37, 2),
+				BlocksPerYear:         uint64(60 * 60 * 8766 / 5),
+				InflationSchedule:     types.InflationScheduleHalving,
+				MaxSupply:             sdk.ZeroInt(),
+				InitialBlockProvision: sdk.NewInt(50_000_000),
+				FixedInflation:        sdk.ZeroDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set unsorted inflation steps",
+			input: types.Params{
+				MintDenom:             sdk.DefaultBondDenom,
+				InflationRateChange:   sdk.NewDecWithPrec(8, 2),
+				InflationMax:          sdk.NewDecWithPrec(20, 2),
+				InflationMin:          sdk.NewDecWithPrec(2, 2),
+				GoalBonded:            sdk.NewDecWithPrec(37, 2),
+				BlocksPerYear:         uint64(60 * 60 * 8766 / 5),
+				InflationSchedule:     types.InflationScheduleStep,
+				MaxSupply:             sdk.ZeroInt(),
+				InitialBlockProvision: sdk.ZeroInt(),
+				InflationSteps: []types.InflationStep{
+					{StartHeight: 1000, Inflation: sdk.NewDecWithPrec(10, 2)},
+					{StartHeight: 1000, Inflation: sdk.NewDecWithPrec(5, 2)},
+				},
+				FixedInflation: sdk.ZeroDec(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/exported"
 	v2 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v2"
+	v3 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v3"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -24,5 +26,39 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 // and managed by the x/params modules and stores them directly into the x/mint
 // module state.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
+	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), legacySubspace{m.legacySubspace}, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it sets the inflation schedule params to their
+// default values, following the bonded ratio inflation schedule.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// legacySubspace wraps the legacy x/params subspace read by the v2 migration.
+// The subspace holds no MaxSupply, InitialBlockProvision and FixedInflation,
+// added along with the inflation schedules, so they are zeroed for the
+// migrated params to be valid, as the v3 migration does for chains already
+// past v2.
+type legacySubspace struct {
+	exported.Subspace
+}
+
+func (s legacySubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	s.Subspace.GetParamSet(ctx, ps)
+
+	params, ok := ps.(*types.Params)
+	if !ok {
+		return
+	}
+	if params.MaxSupply.IsNil() {
+		params.MaxSupply = sdk.ZeroInt()
+	}
+	if params.InitialBlockProvision.IsNil() {
+		params.InitialBlockProvision = sdk.ZeroInt()
+	}
+	if params.FixedInflation.IsNil() {
+		params.FixedInflation = sdk.ZeroDec()
+	}
 }
@@ -28,7 +28,19 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := ms.SetParams(ctx, req.Params); err != nil {
+
+	// the halvings are counted from the activation of the halving schedule,
+	// unless the update sets the height to count them from
+	params := req.Params
+	if params.InflationSchedule == types.InflationScheduleHalving && params.HalvingStartHeight == 0 {
+		if current := ms.GetParams(ctx); current.InflationSchedule == types.InflationScheduleHalving {
+			params.HalvingStartHeight = current.HalvingStartHeight
+		} else {
+			params.HalvingStartHeight = ctx.BlockHeight()
+		}
+	}
+
+	if err := ms.SetParams(ctx, params); err != nil {
 		return nil, err
 	}
 
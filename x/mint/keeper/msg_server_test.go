@@ -38,12 +38,16 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.mintKeeper.GetAuthority(),
 				Params: types.Params{
-					MintDenom:           sdk.DefaultBondDenom,
-					InflationRateChange: sdk.NewDecWithPrec(8, 2),
-					InflationMax:        sdk.NewDecWithPrec(20, 2),
-					InflationMin:        sdk.NewDecWithPrec(2, 2),
-					GoalBonded:          sdk.NewDecWithPrec(37, 2),
-					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					MintDenom:             sdk.DefaultBondDenom,
+					InflationRateChange:   sdk.NewDecWithPrec(8, 2),
+					InflationMax:          sdk.NewDecWithPrec(20, 2),
+					InflationMin:          sdk.NewDecWithPrec(2, 2),
+					GoalBonded:            sdk.NewDecWithPrec(37, 2),
+					BlocksPerYear:         uint64(60 * 60 * 8766 / 5),
+					InflationSchedule:     types.InflationScheduleFixed,
+					MaxSupply:             sdk.ZeroInt(),
+					InitialBlockProvision: sdk.ZeroInt(),
+					FixedInflation:        sdk.NewDecWithPrec(2, 2),
 				},
 			},
 			expectErr: false,
@@ -62,3 +66,27 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestUpdateParamsHalvingStartHeight() {
+	ctx := s.ctx.WithBlockHeight(100)
+	params := types.DefaultParams()
+	params.InflationSchedule = types.InflationScheduleHalving
+	params.InitialBlockProvision = sdk.NewInt(10)
+	params.HalvingInterval = 1000
+
+	// activating the halving schedule starts counting the halvings
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), s.mintKeeper.GetParams(ctx).HalvingStartHeight)
+
+	// which the updates leaving it zero keep
+	ctx = ctx.WithBlockHeight(200)
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), s.mintKeeper.GetParams(ctx).HalvingStartHeight)
+
+	params.HalvingStartHeight = 150
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(150), s.mintKeeper.GetParams(ctx).HalvingStartHeight)
+}
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const (
+	ModuleName = "mint"
+)
+
+var ParamsKey = []byte{0x01}
+
+// Migrate migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it sets the MaxSupply, InitialBlockProvision and
+// FixedInflation params to zero, the params following the bonded ratio
+// inflation schedule.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	cdc.MustUnmarshal(bz, &params)
+
+	if params.MaxSupply.IsNil() {
+		params.MaxSupply = sdk.ZeroInt()
+	}
+	if params.InitialBlockProvision.IsNil() {
+		params.InitialBlockProvision = sdk.ZeroInt()
+	}
+	if params.FixedInflation.IsNil() {
+		params.FixedInflation = sdk.ZeroDec()
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
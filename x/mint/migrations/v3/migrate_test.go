@@ -0,0 +1,43 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	v3 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v3"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the inflation schedule params were added
+	defaultParams := types.DefaultParams()
+	oldParams := types.Params{
+		MintDenom:           defaultParams.MintDenom,
+		InflationRateChange: defaultParams.InflationRateChange,
+		InflationMax:        defaultParams.InflationMax,
+		InflationMin:        defaultParams.InflationMin,
+		GoalBonded:          defaultParams.GoalBonded,
+		BlocksPerYear:       defaultParams.BlocksPerYear,
+	}
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v3.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, defaultParams, res)
+}
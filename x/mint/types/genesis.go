@@ -12,8 +12,8 @@ import (
 type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
 
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
-func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
-	return minter.NextInflationRate(params, bondedRatio)
+func DefaultInflationCalculationFn(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.ScheduledInflationRate(params, ctx.BlockHeight(), bondedRatio)
 }
 
 // NewGenesisState creates a new GenesisState object
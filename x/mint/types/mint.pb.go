@@ -25,6 +25,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationSchedule defines the schedules the minted tokens can follow.
+type InflationSchedule int32
+
+const (
+	// BONDED_RATIO adjusts the inflation rate towards the goal bonded ratio,
+	// within the minimum and maximum inflation rates.
+	InflationScheduleBondedRatio InflationSchedule = 0
+	// HALVING mints a number of tokens per block which is halved every halving
+	// interval.
+	InflationScheduleHalving InflationSchedule = 1
+	// STEP uses the annual inflation rate of the inflation step of the block
+	// height.
+	InflationScheduleStep InflationSchedule = 2
+	// FIXED uses a fixed annual inflation rate.
+	InflationScheduleFixed InflationSchedule = 3
+)
+
+var InflationSchedule_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_BONDED_RATIO",
+	1: "INFLATION_SCHEDULE_HALVING",
+	2: "INFLATION_SCHEDULE_STEP",
+	3: "INFLATION_SCHEDULE_FIXED",
+}
+
+var InflationSchedule_value = map[string]int32{
+	"INFLATION_SCHEDULE_BONDED_RATIO": 0,
+	"INFLATION_SCHEDULE_HALVING":      1,
+	"INFLATION_SCHEDULE_STEP":         2,
+	"INFLATION_SCHEDULE_FIXED":        3,
+}
+
+func (x InflationSchedule) String() string {
+	return proto.EnumName(InflationSchedule_name, int32(x))
+}
+
+func (InflationSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -80,6 +119,26 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// schedule followed by the minted tokens
+	InflationSchedule InflationSchedule `protobuf:"varint,7,opt,name=inflation_schedule,json=inflationSchedule,proto3,enum=cosmos.mint.v1beta1.InflationSchedule" json:"inflation_schedule,omitempty"`
+	// maximum supply of the mint denom, beyond which no tokens are minted under
+	// any inflation schedule. Zero means no maximum supply.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// tokens minted per block before the first halving, under the halving
+	// inflation schedule
+	InitialBlockProvision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=initial_block_provision,json=initialBlockProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_block_provision"`
+	// number of blocks between two halvings of the tokens minted per block,
+	// under the halving inflation schedule
+	HalvingInterval uint64 `protobuf:"varint,10,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// annual inflation rates by block height, under the step inflation schedule
+	InflationSteps []InflationStep `protobuf:"bytes,11,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps"`
+	// annual inflation rate, under the fixed inflation schedule
+	FixedInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=fixed_inflation,json=fixedInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fixed_inflation"`
+	// block height from which the halvings are counted, under the halving
+	// inflation schedule. A params update which leaves it zero sets it to the
+	// current height when activating the halving inflation schedule, and keeps
+	// its current value otherwise.
+	HalvingStartHeight int64 `protobuf:"varint,13,opt,name=halving_start_height,json=halvingStartHeight,proto3" json:"halving_start_height,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +187,141 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationScheduleBondedRatio
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetInflationSteps() []InflationStep {
+	if m != nil {
+		return m.InflationSteps
+	}
+	return nil
+}
+
+func (m *Params) GetHalvingStartHeight() int64 {
+	if m != nil {
+		return m.HalvingStartHeight
+	}
+	return 0
+}
+
+// InflationStep defines the annual inflation rate from a block height on,
+// under the step inflation schedule.
+type InflationStep struct {
+	// block height from which the inflation rate applies
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// annual inflation rate
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationSchedule", InflationSchedule_name, InflationSchedule_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationStep)(nil), "cosmos.mint.v1beta1.InflationStep")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0xe3, 0x24, 0x9b, 0x25, 0x13, 0x42, 0xc2, 0x00, 0x8b, 0xb1, 0x58, 0xc7, 0x9b, 0x03,
+	0x0a, 0x2b, 0x91, 0x2c, 0xac, 0xb4, 0x5a, 0xad, 0xb8, 0x10, 0x12, 0x36, 0x96, 0x20, 0xa4, 0x0e,
+	0x54, 0x2d, 0x55, 0x65, 0x4d, 0x92, 0x21, 0x19, 0x61, 0x8f, 0x2d, 0x7b, 0x12, 0x85, 0xff, 0xa0,
+	0xca, 0xa1, 0xe2, 0xd8, 0x4b, 0xa4, 0x4a, 0x3d, 0xf7, 0xd6, 0x3f, 0x82, 0x5b, 0x51, 0x4f, 0x55,
+	0x0f, 0xa8, 0x82, 0x7f, 0xa4, 0xf2, 0x0f, 0x12, 0x20, 0x51, 0xab, 0x4a, 0x3e, 0x81, 0xbf, 0x6f,
+	0xde, 0xe7, 0xbd, 0xf7, 0x8d, 0xdf, 0x18, 0x88, 0x4d, 0xc3, 0xd6, 0x0d, 0xbb, 0xa0, 0x13, 0xca,
+	0x0a, 0xbd, 0xcd, 0x06, 0x66, 0x68, 0xd3, 0x7d, 0xc8, 0x9b, 0x96, 0xc1, 0x0c, 0xb8, 0xe0, 0xc5,
+	0xf3, 0xae, 0xe4, 0xc7, 0x85, 0xc5, 0xb6, 0xd1, 0x36, 0xdc, 0x78, 0xc1, 0xf9, 0xcf, 0x3b, 0x2a,
+	0xac, 0x78, 0x47, 0x55, 0x2f, 0xe0, 0xe7, 0xb9, 0x0f, 0xd9, 0x8f, 0x1c, 0x88, 0x1d, 0x10, 0xca,
+	0xb0, 0x05, 0x4f, 0x40, 0x9c, 0xd0, 0x53, 0x0d, 0x31, 0x62, 0x50, 0x9e, 0x93, 0xb8, 0x5c, 0xbc,
+	0xb8, 0x7d, 0x79, 0x9d, 0x09, 0x7d, 0xb9, 0xce, 0xac, 0xb5, 0x09, 0xeb, 0x74, 0x1b, 0xf9, 0xa6,
+	0xa1, 0xfb, 0xe9, 0xfe, 0x9f, 0x0d, 0xbb, 0x75, 0x56, 0x60, 0xe7, 0x26, 0xb6, 0xf3, 0x25, 0xdc,
+	0xfc, 0xf4, 0x61, 0x03, 0xf8, 0xf4, 0x12, 0x6e, 0x2a, 0x63, 0x1c, 0x24, 0x60, 0x1e, 0x51, 0xda,
+	0x45, 0x9a, 0xd3, 0x43, 0x8f, 0xd8, 0xc4, 0xa0, 0x36, 0x1f, 0x0e, 0xa0, 0x46, 0xda, 0xc3, 0xd6,
+	0x46, 0xd4, 0xec, 0xfb, 0x19, 0x10, 0xab, 0x21, 0x0b, 0xe9, 0x36, 0xfc, 0x1d, 0x00, 0xc7, 0x1d,
+	0xb5, 0x85, 0xa9, 0xa1, 0x7b, 0x23, 0x29, 0x71, 0x47, 0x29, 0x39, 0x02, 0x34, 0xc1, 0xd2, 0xa8,
+	0x43, 0xd5, 0x42, 0x0c, 0xab, 0xcd, 0x0e, 0xa2, 0x6d, 0x1c, 0x48, 0x63, 0x0b, 0x23, 0xb4, 0x82,
+	0x18, 0xde, 0x75, 0xc1, 0x10, 0x81, 0xe4, 0xb8, 0xa2, 0x8e, 0xfa, 0x7c, 0x24, 0x80, 0x4a, 0xb3,
+	0x23, 0xe4, 0x01, 0xea, 0x3f, 0x2a, 0x41, 0x28, 0x1f, 0x0d, 0xb6, 0x04, 0xa1, 0xf0, 0x25, 0x48,
+	0xb4, 0x0d, 0xa4, 0xa9, 0x0d, 0x83, 0xb6, 0x70, 0x8b, 0xff, 0x25, 0x80, 0x02, 0xc0, 0x01, 0x16,
+	0x5d, 0x1e, 0x5c, 0x03, 0xa9, 0x86, 0x66, 0x34, 0xcf, 0x6c, 0xd5, 0xc4, 0x96, 0x7a, 0x8e, 0x91,
+	0xc5, 0xc7, 0x24, 0x2e, 0x17, 0x55, 0x92, 0x9e, 0x5c, 0xc3, 0xd6, 0x73, 0x8c, 0x2c, 0x78, 0x0c,
+	0xe0, 0x78, 0x52, 0xbb, 0xd9, 0xc1, 0xad, 0xae, 0x86, 0xf9, 0x5f, 0x25, 0x2e, 0x37, 0xb7, 0xb5,
+	0x96, 0x9f, 0xb2, 0x1d, 0x79, 0xf9, 0xee, 0x78, 0xdd, 0x3f, 0xad, 0xcc, 0x93, 0xc7, 0x12, 0x7c,
+	0x01, 0x80, 0x8e, 0xfa, 0xaa, 0xdd, 0x35, 0x4d, 0xed, 0x9c, 0x9f, 0xf9, 0xe9, 0xe1, 0x64, 0xca,
+	0xee, 0x0d, 0x27, 0x53, 0xa6, 0xc4, 0x75, 0xd4, 0xaf, 0xbb, 0x38, 0xc8, 0xc0, 0x32, 0xa1, 0x84,
+	0x11, 0xc7, 0x3d, 0x67, 0x98, 0xf1, 0x3a, 0xf0, 0xf1, 0x00, 0x2a, 0x2d, 0xf9, 0xf0, 0xa2, 0xc3,
+	0x1e, 0xed, 0x04, 0x5c, 0x07, 0xe9, 0x0e, 0xd2, 0x7a, 0x84, 0xb6, 0x55, 0x77, 0xd5, 0x7b, 0x48,
+	0xe3, 0x81, 0x6b, 0x69, 0xca, 0xd7, 0x65, 0x5f, 0x86, 0x4f, 0x40, 0xea, 0x9e, 0xa9, 0x0c, 0x9b,
+	0x36, 0x9f, 0x90, 0x22, 0xb9, 0xc4, 0x56, 0xf6, 0x07, 0x8e, 0x32, 0x6c, 0x16, 0xa3, 0x4e, 0xf3,
+	0xca, 0x1c, 0xb9, 0x2f, 0xda, 0x10, 0x83, 0xd4, 0x29, 0xe9, 0xe3, 0x96, 0x3a, 0xbe, 0x5d, 0x66,
+	0x03, 0x78, 0x65, 0xe6, 0x5c, 0xe8, 0xa8, 0x01, 0xf8, 0x17, 0x58, 0xbc, 0x1b, 0xd2, 0x66, 0xc8,
+	0x62, 0x6a, 0x07, 0x93, 0x76, 0x87, 0xf1, 0x49, 0x89, 0xcb, 0x45, 0x14, 0xe8, 0xc7, 0xea, 0x4e,
+	0xa8, 0xe2, 0x46, 0xfe, 0x8b, 0xbe, 0x79, 0x9b, 0x09, 0x65, 0x5f, 0x73, 0x20, 0xf9, 0x60, 0x0c,
+	0xf8, 0x07, 0x98, 0x7d, 0x40, 0xe0, 0x5c, 0x42, 0xc2, 0x1e, 0xa7, 0x3e, 0xbc, 0x2b, 0xc3, 0x81,
+	0xde, 0x95, 0x7f, 0x5e, 0x84, 0xc1, 0xfc, 0xc4, 0x9b, 0x0a, 0xcb, 0x20, 0x23, 0x57, 0xf7, 0xf6,
+	0x77, 0x8e, 0xe4, 0xc3, 0xaa, 0x5a, 0xdf, 0xad, 0x94, 0x4b, 0xc7, 0xfb, 0x65, 0xb5, 0x78, 0x58,
+	0x2d, 0x95, 0x4b, 0xaa, 0xe2, 0xc8, 0xe9, 0x90, 0x20, 0x0d, 0x86, 0xd2, 0xea, 0x44, 0xae, 0xb7,
+	0x57, 0x8a, 0xa3, 0xc1, 0x6d, 0x20, 0x4c, 0xc1, 0x54, 0x76, 0xf6, 0x9f, 0xca, 0xd5, 0xff, 0xd3,
+	0x9c, 0xb0, 0x3a, 0x18, 0x4a, 0xfc, 0x04, 0xa1, 0xe2, 0x99, 0x07, 0xff, 0x01, 0xcb, 0x53, 0xb2,
+	0xeb, 0x47, 0xe5, 0x5a, 0x3a, 0x2c, 0xac, 0x0c, 0x86, 0xd2, 0xd2, 0x44, 0xaa, 0xeb, 0xe8, 0xbf,
+	0x80, 0x9f, 0x92, 0xb7, 0x27, 0x3f, 0x2b, 0x97, 0xd2, 0x11, 0x41, 0x18, 0x0c, 0xa5, 0xdf, 0x26,
+	0x12, 0xf7, 0x9c, 0x9f, 0x57, 0x88, 0xbe, 0x7a, 0x27, 0x86, 0x8a, 0xbb, 0x97, 0x37, 0x22, 0x77,
+	0x75, 0x23, 0x72, 0x5f, 0x6f, 0x44, 0xee, 0xe2, 0x56, 0x0c, 0x5d, 0xdd, 0x8a, 0xa1, 0xcf, 0xb7,
+	0x62, 0xe8, 0x64, 0xfd, 0xbb, 0x6e, 0xf7, 0xbd, 0xaf, 0xa7, 0x6b, 0x7a, 0x23, 0xe6, 0x7e, 0xf1,
+	0xfe, 0xfe, 0x36, 0x00, 0xed, 0xc2, 0xa2, 0x2b, 0x59, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +387,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HalvingStartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingStartHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.FixedInflation.Size()
+		i -= size
+		if _, err := m.FixedInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.InflationSteps) > 0 {
+		for iNdEx := len(m.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.InitialBlockProvision.Size()
+		i -= size
+		if _, err := m.InitialBlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.InflationSchedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSchedule))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -282,6 +501,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -327,6 +584,41 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.InflationSchedule != 0 {
+		n += 1 + sovMint(uint64(m.InflationSchedule))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InitialBlockProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	if len(m.InflationSteps) > 0 {
+		for _, e := range m.InflationSteps {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.FixedInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingStartHeight != 0 {
+		n += 1 + sovMint(uint64(m.HalvingStartHeight))
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -670,6 +962,302 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			m.InflationSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSchedule |= InflationSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSteps = append(m.InflationSteps, InflationStep{})
+			if err := m.InflationSteps[len(m.InflationSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingStartHeight", wireType)
+			}
+			m.HalvingStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
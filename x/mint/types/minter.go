@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/math"
 
@@ -80,3 +81,161 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// halvings returns the number of halvings which occurred at a height, counted
+// every halving interval from the halving start height.
+func (p Params) halvings(height int64) uint64 {
+	if p.HalvingInterval == 0 || height < p.HalvingStartHeight {
+		return 0
+	}
+
+	return uint64(height-p.HalvingStartHeight) / p.HalvingInterval
+}
+
+// HalvingBlockProvision returns the tokens minted for the block at a height
+// under the halving inflation schedule, the initial block provision being
+// halved every halving interval from the halving start height.
+func (p Params) HalvingBlockProvision(height int64) math.Int {
+	halvings := p.halvings(height)
+	if halvings >= uint64(p.InitialBlockProvision.BigInt().BitLen()) {
+		return sdk.ZeroInt()
+	}
+
+	return sdk.NewIntFromBigInt(new(big.Int).Rsh(p.InitialBlockProvision.BigInt(), uint(halvings)))
+}
+
+// StepInflation returns the annual inflation rate of the block at a height
+// under the step inflation schedule, which is the rate of the last inflation
+// step started at that height, or zero before the first one.
+func (p Params) StepInflation(height int64) sdk.Dec {
+	inflation := sdk.ZeroDec()
+	for _, step := range p.InflationSteps {
+		if step.StartHeight > height {
+			break
+		}
+		inflation = step.Inflation
+	}
+
+	return inflation
+}
+
+// ScheduledInflationRate returns the annual inflation rate of the block at a
+// height under the step and fixed inflation schedules, and the next inflation
+// rate under the bonded ratio one.
+func (m Minter) ScheduledInflationRate(params Params, height int64, bondedRatio sdk.Dec) sdk.Dec {
+	switch params.InflationSchedule {
+	case InflationScheduleStep:
+		return params.StepInflation(height)
+
+	case InflationScheduleFixed:
+		return params.FixedInflation
+
+	default:
+		return m.NextInflationRate(params, bondedRatio)
+	}
+}
+
+// NextHalvingMinter returns the minter of the block at a height under the
+// halving inflation schedule, given the current supply of the mint denom. The
+// halving schedule sets the block provisions rather than an inflation rate.
+func (m Minter) NextHalvingMinter(params Params, height int64, totalSupply math.Int) Minter {
+	annualProvisions := sdk.NewDecFromInt(params.HalvingBlockProvision(height)).MulInt64(int64(params.BlocksPerYear))
+	inflation := sdk.ZeroDec()
+	if totalSupply.IsPositive() {
+		inflation = annualProvisions.QuoInt(totalSupply)
+	}
+
+	return NewMinter(inflation, annualProvisions)
+}
+
+// CapBlockProvision caps the provisions for a block so that the supply of the
+// mint denom doesn't exceed the maximum supply.
+func (p Params) CapBlockProvision(provision sdk.Coin, totalSupply math.Int) sdk.Coin {
+	if !p.MaxSupply.IsPositive() {
+		return provision
+	}
+
+	headroom := math.MaxInt(p.MaxSupply.Sub(totalSupply), sdk.ZeroInt())
+	return sdk.NewCoin(provision.Denom, math.MinInt(provision.Amount, headroom))
+}
+
+// MaxProjectionYears is the number of years ahead of the current height up to
+// which the supply can be projected.
+const MaxProjectionYears = 100
+
+// ProjectSupply returns the supply of the mint denom projected at a height,
+// given its supply at a lower height at which the minter was last updated.
+// The supply compounds under the inflation schedules based on an annual
+// inflation rate, the bonded ratio schedule keeping the current inflation
+// rate, so the projection ignores the truncation of the block provisions.
+func (m Minter) ProjectSupply(params Params, totalSupply math.Int, fromHeight, toHeight int64) math.Int {
+	supply := sdk.NewDecFromInt(totalSupply)
+
+	switch params.InflationSchedule {
+	case InflationScheduleHalving:
+		for height := fromHeight + 1; height <= toHeight; {
+			provision := params.HalvingBlockProvision(height)
+			if provision.IsZero() {
+				break
+			}
+
+			// blocks until the next halving, computed on big integers as the
+			// next halving can be beyond the maximum height
+			end := toHeight
+			if params.HalvingInterval > 0 {
+				next := sdk.NewIntFromUint64(params.HalvingInterval).
+					Mul(sdk.NewIntFromUint64(params.halvings(height) + 1)).
+					AddRaw(params.HalvingStartHeight)
+				if next.LTE(sdk.NewInt(end)) {
+					end = next.Int64() - 1
+				}
+			}
+
+			supply = supply.Add(sdk.NewDecFromInt(provision.MulRaw(end - height + 1)))
+			height = end + 1
+		}
+
+	case InflationScheduleStep:
+		height := fromHeight
+		for i, step := range params.InflationSteps {
+			end := toHeight
+			if i+1 < len(params.InflationSteps) && params.InflationSteps[i+1].StartHeight-1 < end {
+				end = params.InflationSteps[i+1].StartHeight - 1
+			}
+			if end <= height {
+				continue
+			}
+
+			start := height
+			if step.StartHeight-1 > start {
+				start = step.StartHeight - 1
+			}
+			supply = compoundSupply(supply, step.Inflation, params.BlocksPerYear, end-start)
+			height = end
+		}
+
+	case InflationScheduleFixed:
+		supply = compoundSupply(supply, params.FixedInflation, params.BlocksPerYear, toHeight-fromHeight)
+
+	default:
+		supply = compoundSupply(supply, m.Inflation, params.BlocksPerYear, toHeight-fromHeight)
+	}
+
+	projected := supply.TruncateInt()
+	if params.MaxSupply.IsPositive() && projected.GT(params.MaxSupply) {
+		projected = math.MaxInt(params.MaxSupply, totalSupply)
+	}
+
+	return projected
+}
+
+// compoundSupply compounds a supply over a number of blocks at an annual
+// inflation rate.
+func compoundSupply(supply, inflation sdk.Dec, blocksPerYear uint64, blocks int64) sdk.Dec {
+	if blocks <= 0 || blocksPerYear == 0 {
+		return supply
+	}
+
+	rate := sdk.OneDec().Add(inflation.QuoInt64(int64(blocksPerYear)))
+	return supply.Mul(rate.Power(uint64(blocks)))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 
@@ -86,6 +87,100 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestHalvingSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleHalving
+	params.InitialBlockProvision = sdk.NewInt(100)
+	params.HalvingInterval = 10
+	params.BlocksPerYear = 20
+
+	require.Equal(t, sdk.NewInt(100), params.HalvingBlockProvision(9))
+	require.Equal(t, sdk.NewInt(50), params.HalvingBlockProvision(10))
+	require.Equal(t, sdk.NewInt(25), params.HalvingBlockProvision(25))
+	require.True(t, params.HalvingBlockProvision(10*7).IsZero())
+
+	// the minter mints the block provision of the halving epoch
+	minter := DefaultInitialMinter().NextHalvingMinter(params, 10, sdk.NewInt(10_000))
+	require.Equal(t, sdk.NewDec(1000), minter.AnnualProvisions)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), minter.Inflation)
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 50), minter.BlockProvision(params))
+
+	// the projection sums the provisions of the epochs: 100 for height 9,
+	// 10*50 for the second epoch and 5*25 for the third one
+	require.Equal(t, sdk.NewInt(10_725), minter.ProjectSupply(params, sdk.NewInt(10_000), 8, 24))
+
+	// and stops at the maximum supply
+	params.MaxSupply = sdk.NewInt(10_200)
+	require.Equal(t, sdk.NewInt(10_200), minter.ProjectSupply(params, sdk.NewInt(10_000), 8, 24))
+	require.Equal(t, sdk.NewInt(10_500), minter.ProjectSupply(params, sdk.NewInt(10_500), 8, 24))
+
+	// the halvings are counted from the halving start height
+	params.MaxSupply = sdk.ZeroInt()
+	params.HalvingStartHeight = 5
+	require.Equal(t, sdk.NewInt(100), params.HalvingBlockProvision(14))
+	require.Equal(t, sdk.NewInt(50), params.HalvingBlockProvision(15))
+	require.Equal(t, sdk.NewInt(11_100), minter.ProjectSupply(params, sdk.NewInt(10_000), 8, 24))
+
+	// a halving beyond the maximum height doesn't overflow the projection
+	params.HalvingInterval = math.MaxUint64
+	require.Equal(t, sdk.NewInt(11_600), minter.ProjectSupply(params, sdk.NewInt(10_000), 8, 24))
+}
+
+func TestStepSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleStep
+	params.InflationSteps = []InflationStep{
+		{StartHeight: 10, Inflation: sdk.NewDecWithPrec(10, 2)},
+		{StartHeight: 20, Inflation: sdk.NewDecWithPrec(5, 2)},
+	}
+	params.BlocksPerYear = 1
+
+	require.True(t, params.StepInflation(9).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), params.StepInflation(19))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), params.StepInflation(20))
+
+	minter := DefaultInitialMinter()
+	minter.Inflation = minter.ScheduledInflationRate(params, 15, sdk.ZeroDec())
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), minter.Inflation)
+	require.Equal(t, sdk.NewDec(100), minter.NextAnnualProvisions(params, sdk.NewInt(1000)))
+
+	// no inflation up to height 9, 10% for heights 10 and 11, then 5% for
+	// height 20
+	supply := minter.ProjectSupply(params, sdk.NewInt(1_000_000), 5, 11)
+	require.Equal(t, sdk.NewInt(1_210_000), supply)
+	supply = minter.ProjectSupply(params, sdk.NewInt(1_000_000), 18, 20)
+	require.Equal(t, sdk.NewInt(1_155_000), supply)
+}
+
+func TestFixedSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleFixed
+	params.FixedInflation = sdk.NewDecWithPrec(2, 2)
+
+	minter := DefaultInitialMinter()
+	minter.Inflation = minter.ScheduledInflationRate(params, 1, sdk.ZeroDec())
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), minter.Inflation)
+	require.Equal(t, sdk.NewDec(20_000), minter.NextAnnualProvisions(params, sdk.NewInt(1_000_000)))
+
+	// the supply compounds every block over a year
+	supply := minter.ProjectSupply(params, sdk.NewInt(1_000_000), 0, int64(params.BlocksPerYear))
+	require.True(t, supply.GT(sdk.NewInt(1_020_000)))
+	require.True(t, supply.LT(sdk.NewInt(1_020_202)))
+}
+
+func TestCapBlockProvision(t *testing.T) {
+	params := DefaultParams()
+	provision := sdk.NewInt64Coin(params.MintDenom, 100)
+
+	// no maximum supply
+	require.Equal(t, provision, params.CapBlockProvision(provision, sdk.NewInt(1_000_000)))
+
+	params.MaxSupply = sdk.NewInt(1050)
+	require.Equal(t, provision, params.CapBlockProvision(provision, sdk.NewInt(900)))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 50), params.CapBlockProvision(provision, sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 0), params.CapBlockProvision(provision, sdk.NewInt(2000)))
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -5,31 +5,42 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// NewParams returns x/mint module parameters following the bonded ratio
+// inflation schedule, without maximum supply.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64) Params {
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:             mintDenom,
+		InflationRateChange:   inflationRateChange,
+		InflationMax:          inflationMax,
+		InflationMin:          inflationMin,
+		GoalBonded:            goalBonded,
+		BlocksPerYear:         blocksPerYear,
+		InflationSchedule:     InflationScheduleBondedRatio,
+		MaxSupply:             sdk.ZeroInt(),
+		InitialBlockProvision: sdk.ZeroInt(),
+		FixedInflation:        sdk.ZeroDec(),
 	}
 }
 
 // DefaultParams returns default x/mint module parameters.
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:             sdk.DefaultBondDenom,
+		InflationRateChange:   sdk.NewDecWithPrec(13, 2),
+		InflationMax:          sdk.NewDecWithPrec(20, 2),
+		InflationMin:          sdk.NewDecWithPrec(7, 2),
+		GoalBonded:            sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:         uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		InflationSchedule:     InflationScheduleBondedRatio,
+		MaxSupply:             sdk.ZeroInt(),
+		InitialBlockProvision: sdk.ZeroInt(),
+		FixedInflation:        sdk.ZeroDec(),
 	}
 }
 
@@ -59,6 +70,30 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateInitialBlockProvision(p.InitialBlockProvision); err != nil {
+		return err
+	}
+	if err := validateInflationSteps(p.InflationSteps); err != nil {
+		return err
+	}
+	if err := validateFixedInflation(p.FixedInflation); err != nil {
+		return err
+	}
+	if p.HalvingStartHeight < 0 {
+		return fmt.Errorf("halving start height cannot be negative: %d", p.HalvingStartHeight)
+	}
+	if p.InflationSchedule == InflationScheduleHalving && p.HalvingInterval == 0 {
+		return errors.New("halving interval must be positive under the halving inflation schedule")
+	}
+	if p.InflationSchedule == InflationScheduleStep && len(p.InflationSteps) == 0 {
+		return errors.New("inflation steps cannot be empty under the step inflation schedule")
+	}
 
 	return nil
 }
@@ -161,3 +196,91 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(InflationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationSchedule_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation schedule: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max supply cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInitialBlockProvision(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("initial block provision cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("initial block provision cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationSteps(i interface{}) error {
+	v, ok := i.([]InflationStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, step := range v {
+		if step.StartHeight < 0 {
+			return fmt.Errorf("inflation step start height cannot be negative: %d", step.StartHeight)
+		}
+		if j > 0 && step.StartHeight <= v[j-1].StartHeight {
+			return fmt.Errorf("inflation steps must be sorted by strictly increasing start height: %d", step.StartHeight)
+		}
+		if step.Inflation.IsNil() || step.Inflation.IsNegative() {
+			return fmt.Errorf("inflation step inflation must be non-negative: %s", step.Inflation)
+		}
+		if step.Inflation.GT(sdk.OneDec()) {
+			return fmt.Errorf("inflation step inflation too large: %s", step.Inflation)
+		}
+	}
+
+	return nil
+}
+
+func validateFixedInflation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("fixed inflation cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fixed inflation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fixed inflation too large: %s", v)
+	}
+
+	return nil
+}
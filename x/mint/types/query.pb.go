@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -268,6 +269,100 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedSupplyRequest is the request type for the
+// Query/ProjectedSupply RPC method.
+type QueryProjectedSupplyRequest struct {
+	// height is the block height at which the supply is projected.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryProjectedSupplyRequest) Reset()         { *m = QueryProjectedSupplyRequest{} }
+func (m *QueryProjectedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyRequest) ProtoMessage()    {}
+func (*QueryProjectedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyRequest.Merge(m, src)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryProjectedSupplyResponse is the response type for the
+// Query/ProjectedSupply RPC method.
+type QueryProjectedSupplyResponse struct {
+	// supply is the projected supply of the mint denom.
+	Supply types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryProjectedSupplyResponse) Reset()         { *m = QueryProjectedSupplyResponse{} }
+func (m *QueryProjectedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyResponse) ProtoMessage()    {}
+func (*QueryProjectedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyResponse.Merge(m, src)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +370,49 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectedSupplyRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedSupplyRequest")
+	proto.RegisterType((*QueryProjectedSupplyResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedSupplyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x18, 0x91, 0x66, 0x90, 0x18, 0xde, 0x18, 0x90, 0x76, 0xe9, 0x14, 0xa4, 0x52,
+	0x40, 0xd8, 0x6b, 0x11, 0x20, 0x8e, 0x74, 0x5c, 0x90, 0x38, 0x94, 0x72, 0x40, 0x82, 0xc3, 0xe4,
+	0x66, 0x5e, 0x1a, 0x68, 0xed, 0x2c, 0x76, 0x26, 0x2a, 0x40, 0x42, 0x9c, 0x39, 0x20, 0xf1, 0x29,
+	0xb8, 0xf0, 0x39, 0x76, 0x9c, 0xc4, 0x05, 0x71, 0x98, 0x50, 0xcb, 0x67, 0xe0, 0x8c, 0x62, 0x3b,
+	0x41, 0xcb, 0xd2, 0xc1, 0x76, 0x6a, 0xeb, 0xff, 0xcb, 0xf3, 0x73, 0x9e, 0x27, 0x05, 0x75, 0x9f,
+	0x8b, 0x11, 0x17, 0x78, 0x14, 0x32, 0x89, 0x77, 0x5a, 0x7d, 0x2a, 0x49, 0x0b, 0x6f, 0x27, 0x34,
+	0x1e, 0xa3, 0x28, 0xe6, 0x92, 0xc3, 0x45, 0xdd, 0x80, 0xd2, 0x06, 0x64, 0x1a, 0x9c, 0xa5, 0x80,
+	0x07, 0x5c, 0xd5, 0x71, 0xfa, 0x4d, 0xb7, 0x3a, 0xb5, 0x80, 0xf3, 0x60, 0x48, 0x31, 0x89, 0x42,
+	0x4c, 0x18, 0xe3, 0x92, 0xc8, 0x90, 0x33, 0x61, 0xaa, 0xae, 0x51, 0xea, 0x13, 0x41, 0x73, 0x25,
+	0x9f, 0x87, 0xac, 0x50, 0x3f, 0x40, 0xa2, 0x54, 0x55, 0xdd, 0x5b, 0x02, 0xf0, 0x49, 0xca, 0xd5,
+	0x25, 0x31, 0x19, 0x89, 0x1e, 0xdd, 0x4e, 0xa8, 0x90, 0x5e, 0x17, 0x2c, 0x1e, 0x38, 0x15, 0x11,
+	0x67, 0x82, 0xc2, 0xfb, 0xc0, 0x8e, 0xd4, 0xc9, 0x65, 0x6b, 0xd5, 0x6a, 0x9e, 0x6d, 0x57, 0x51,
+	0xc9, 0x35, 0x90, 0x1e, 0xea, 0xcc, 0xed, 0xee, 0xd7, 0x2b, 0x3d, 0x33, 0xe0, 0x5d, 0x02, 0x17,
+	0xd5, 0xc6, 0x47, 0x6c, 0x6b, 0xa8, 0x2e, 0x90, 0x49, 0x6d, 0x81, 0xe5, 0x62, 0xc1, 0xa8, 0x3d,
+	0x06, 0xf3, 0x61, 0x76, 0xa8, 0x04, 0xcf, 0x75, 0x50, 0xba, 0xf3, 0xc7, 0x7e, 0xbd, 0x11, 0x84,
+	0x72, 0x90, 0xf4, 0x91, 0xcf, 0x47, 0xd8, 0x5c, 0x50, 0x7f, 0xdc, 0x12, 0x9b, 0xaf, 0xb0, 0x1c,
+	0x47, 0x54, 0xa0, 0x87, 0xd4, 0xef, 0xfd, 0x5d, 0xe0, 0xb9, 0xa0, 0xa6, 0x74, 0x1e, 0x30, 0x96,
+	0x90, 0x61, 0x37, 0xe6, 0x3b, 0xa1, 0x48, 0x9f, 0x63, 0xc6, 0xf1, 0x16, 0xac, 0xcc, 0xa8, 0x1b,
+	0x9c, 0x17, 0xe0, 0x02, 0x51, 0xb5, 0x8d, 0x28, 0x2f, 0x9e, 0x10, 0x6b, 0x81, 0x14, 0x44, 0xbc,
+	0x3b, 0xa0, 0xaa, 0x1f, 0x78, 0xcc, 0x5f, 0x52, 0x5f, 0xd2, 0xcd, 0xa7, 0x49, 0x14, 0x0d, 0xc7,
+	0x06, 0x0e, 0x2e, 0x03, 0x7b, 0x40, 0xc3, 0x60, 0x20, 0x95, 0xe0, 0xe9, 0x9e, 0xf9, 0xe5, 0x3d,
+	0x03, 0xb5, 0xf2, 0x31, 0xc3, 0x7c, 0x0f, 0xd8, 0x42, 0x9d, 0x18, 0xc3, 0xae, 0x64, 0x86, 0xa5,
+	0x71, 0xc9, 0x0d, 0x5b, 0xe7, 0x21, 0xcb, 0xec, 0xd2, 0xed, 0xed, 0xdf, 0x73, 0xe0, 0x8c, 0xda,
+	0x0c, 0xdf, 0x5b, 0xc0, 0xd6, 0x8e, 0xc2, 0x6b, 0xa5, 0x76, 0x1f, 0x8e, 0x8f, 0xd3, 0xfc, 0x77,
+	0xa3, 0x06, 0xf4, 0xae, 0x7e, 0xf8, 0xf6, 0xeb, 0xf3, 0xa9, 0x15, 0x58, 0xc5, 0x65, 0x39, 0xd5,
+	0xd9, 0x81, 0x1f, 0x2d, 0x30, 0x9f, 0xc7, 0x03, 0xde, 0x98, 0xbd, 0xbc, 0x18, 0x2e, 0xe7, 0xe6,
+	0x7f, 0xf5, 0x1a, 0x96, 0x86, 0x62, 0x59, 0x85, 0x6e, 0x29, 0x4b, 0x9e, 0x24, 0xf8, 0xc5, 0x02,
+	0x0b, 0xc5, 0x94, 0xc0, 0xd6, 0x6c, 0xa5, 0x19, 0x89, 0x73, 0xda, 0xc7, 0x19, 0x31, 0x8c, 0x48,
+	0x31, 0x36, 0x61, 0xa3, 0x94, 0xf1, 0x50, 0x3e, 0xe1, 0x57, 0x0b, 0x9c, 0x2f, 0x84, 0x03, 0xae,
+	0x1d, 0xe1, 0x4e, 0x69, 0xfc, 0x9c, 0xd6, 0x31, 0x26, 0x0c, 0xe8, 0x5d, 0x05, 0xba, 0x06, 0x51,
+	0xb9, 0xb1, 0xd9, 0xd4, 0x86, 0xce, 0x1b, 0x7e, 0xa3, 0x03, 0xfd, 0xae, 0xb3, 0xbe, 0x3b, 0x71,
+	0xad, 0xbd, 0x89, 0x6b, 0xfd, 0x9c, 0xb8, 0xd6, 0xa7, 0xa9, 0x5b, 0xd9, 0x9b, 0xba, 0x95, 0xef,
+	0x53, 0xb7, 0xf2, 0xfc, 0xfa, 0x91, 0x2f, 0xd7, 0x6b, 0x2d, 0xa0, 0xde, 0xb1, 0xbe, 0xad, 0xfe,
+	0xdb, 0x6e, 0xff, 0x19, 0x00, 0x55, 0x33, 0x04, 0x01, 0x87, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +433,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the supply of the mint denom projected at a block
+	// height under the current params.
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +473,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +490,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the supply of the mint denom projected at a block
+	// height under the current params.
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +508,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedSupply(ctx context.Context, req *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +570,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +604,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +781,67 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +913,29 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryProjectedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1341,158 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
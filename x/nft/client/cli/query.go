@@ -39,6 +39,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryClassConfig(),
+		GetCmdQueryRoyalty(),
+		GetCmdQueryApproved(),
+		GetCmdQueryApprovedForAll(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +278,126 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryClassConfig implements the query class config command.
+func GetCmdQueryClassConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-config [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the permissions of an NFT class created with create-class",
+		Example: fmt.Sprintf(`$ %s query %s class-config <class-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.ClassConfig(cmd.Context(), &nft.QueryClassConfigRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRoyalty implements the query royalty command.
+func GetCmdQueryRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [class-id] [sale-price]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "query the royalty of an NFT class, and the royalty amount due on an optional sale price",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id> 100stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &nft.QueryRoyaltyRequest{
+				ClassId: args[0],
+			}
+			if len(args) > 1 {
+				salePrice, err := sdk.ParseCoinNormalized(args[1])
+				if err != nil {
+					return err
+				}
+				req.SalePrice = &salePrice
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Royalty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryApproved implements the query approved command.
+func GetCmdQueryApproved() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the account approved to send the NFT based on its class and id.",
+		Example: fmt.Sprintf(`$ %s query %s approved <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Approved(cmd.Context(), &nft.QueryApprovedRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryApprovedForAll implements the query approved-for-all command.
+func GetCmdQueryApprovedForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved-for-all [class-id] [owner] [operator]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query whether an operator may manage all NFTs of a class owned by the owner.",
+		Example: fmt.Sprintf(`$ %s query %s approved-for-all <class-id> <owner> <operator>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.IsApprovedForAll(cmd.Context(), &nft.QueryIsApprovedForAllRequest{
+				ClassId:  args[0],
+				Owner:    args[1],
+				Operator: args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
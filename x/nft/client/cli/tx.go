@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,10 +10,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName         = "name"
+	FlagSymbol       = "symbol"
+	FlagDescription  = "description"
+	FlagURI          = "uri"
+	FlagURIHash      = "uri-hash"
+	FlagMinter       = "minter"
+	FlagTransferable = "transferable"
+	FlagBurnable     = "burnable"
+	FlagUpdatable    = "updatable"
+	FlagMaxSupply    = "max-supply"
+
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyRate     = "royalty-rate"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +45,13 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
+		NewCmdApprove(),
+		NewCmdRevokeApproval(),
+		NewCmdSetApprovalForAll(),
 	)
 
 	return nftTxCmd
@@ -58,3 +84,271 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a nft class owned by the sender, with a class id prefixed with <owner>/",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <owner>/<name> --name <name> --symbol <symbol> --minter <minter> --transferable --burnable --max-supply 10000 --royalty-receiver <receiver> --royalty-rate 0.05 --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			minter, _ := cmd.Flags().GetString(FlagMinter)
+			transferable, _ := cmd.Flags().GetBool(FlagTransferable)
+			burnable, _ := cmd.Flags().GetBool(FlagBurnable)
+			updatable, _ := cmd.Flags().GetBool(FlagUpdatable)
+			maxSupply, _ := cmd.Flags().GetUint64(FlagMaxSupply)
+			royaltyReceiver, _ := cmd.Flags().GetString(FlagRoyaltyReceiver)
+
+			royaltyRate := sdk.ZeroDec()
+			if rate, _ := cmd.Flags().GetString(FlagRoyaltyRate); rate != "" {
+				royaltyRate, err = sdk.NewDecFromStr(rate)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := nft.MsgCreateClass{
+				Owner: clientCtx.GetFromAddress().String(),
+				Class: &nft.Class{
+					Id:          args[0],
+					Name:        name,
+					Symbol:      symbol,
+					Description: description,
+					Uri:         uri,
+					UriHash:     uriHash,
+				},
+				Minter:          minter,
+				Transferable:    transferable,
+				Burnable:        burnable,
+				Updatable:       updatable,
+				MaxSupply:       maxSupply,
+				RoyaltyReceiver: royaltyReceiver,
+				RoyaltyRate:     royaltyRate,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The symbol of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagMinter, "", "The address of an account allowed to mint nfts besides the owner")
+	cmd.Flags().Bool(FlagTransferable, false, "Allow the nfts of the class to be sent")
+	cmd.Flags().Bool(FlagBurnable, false, "Allow the nfts of the class to be burnt by their owner")
+	cmd.Flags().Bool(FlagUpdatable, false, "Allow the nfts of the class to be updated by the class owner")
+	cmd.Flags().Uint64(FlagMaxSupply, 0, "The maximum number of nfts of the class, zero means no limit")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The address of the account receiving the royalty on the sales of the nfts")
+	cmd.Flags().String(FlagRoyaltyRate, "", "The share of the sale price paid as royalty, between 0 and 1")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a nft of a class created with create-class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgMintNFT{
+				Minter: clientCtx.GetFromAddress().String(),
+				Nft: &nft.NFT{
+					ClassId: args[0],
+					Id:      args[1],
+					Uri:     uri,
+					UriHash: uriHash,
+				},
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft of a burnable class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurnNFT{
+				ClassId: args[0],
+				Id:      args[1],
+				Owner:   clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [class-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update a nft of an updatable class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --from <class-owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgUpdateNFT{
+				Sender: clientCtx.GetFromAddress().String(),
+				Nft: &nft.NFT{
+					ClassId: args[0],
+					Id:      args[1],
+					Uri:     uri,
+					UriHash: uriHash,
+				},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [class-id] [nft-id] [approved] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "approve an account to send a nft on behalf of its owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <class-id> <nft-id> <approved> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+
+			msg := nft.MsgApprove{
+				ClassId:  args[0],
+				Id:       args[1],
+				Sender:   clientCtx.GetFromAddress().String(),
+				Approved: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdRevokeApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "revoke the approval of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke-approval <class-id> <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgRevokeApproval{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSetApprovalForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-for-all [class-id] [operator] [true|false] --from [owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "grant or revoke an operator the right to manage all nfts of a class owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-approval-for-all <class-id> <operator> true --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			approved, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetApprovalForAll{
+				ClassId:  args[0],
+				Owner:    clientCtx.GetFromAddress().String(),
+				Operator: args[1],
+				Approved: approved,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -6,11 +6,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
 )
 
 const (
@@ -142,6 +144,143 @@ func (s *IntegrationTestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLITxCreateClassAndMint() {
+	val := s.network.Validators[0]
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+	puppyID := nft.ClassIDPrefix(s.owner.String()) + "puppy"
+	testCases := []struct {
+		name      string
+		exec      func(val *network.Validator, args []string) (testutil.BufferWriter, error)
+		args      []string
+		expectErr bool
+		expMsg    sdk.Msg
+	}{
+		{
+			"valid create class",
+			ExecCreateClass,
+			[]string{
+				puppyID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, "Crypto Puppy"),
+				fmt.Sprintf("--%s=%s", cli.FlagMinter, val.Address),
+				fmt.Sprintf("--%s=true", cli.FlagTransferable),
+				fmt.Sprintf("--%s=%d", cli.FlagMaxSupply, 10),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, val.Address),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "0.05"),
+			},
+			false,
+			&nft.MsgCreateClass{
+				Owner:           s.owner.String(),
+				Class:           &nft.Class{Id: puppyID, Name: "Crypto Puppy"},
+				Minter:          val.Address.String(),
+				Transferable:    true,
+				MaxSupply:       10,
+				RoyaltyReceiver: val.Address.String(),
+				RoyaltyRate:     sdk.NewDecWithPrec(5, 2),
+			},
+		},
+		{
+			"invalid royalty rate",
+			ExecCreateClass,
+			[]string{
+				puppyID,
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, val.Address),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "1.5"),
+			},
+			true,
+			nil,
+		},
+		{
+			"class id without the owner prefix",
+			ExecCreateClass,
+			[]string{"puppy"},
+			true,
+			nil,
+		},
+		{
+			"invalid class id",
+			ExecCreateClass,
+			[]string{"1"},
+			true,
+			nil,
+		},
+		{
+			"valid mint",
+			ExecMint,
+			[]string{puppyID, "puppy1", val.Address.String(), fmt.Sprintf("--%s=%s", cli.FlagURI, testURI)},
+			false,
+			&nft.MsgMintNFT{
+				Minter:   s.owner.String(),
+				Nft:      &nft.NFT{ClassId: puppyID, Id: "puppy1", Uri: testURI},
+				Receiver: val.Address.String(),
+			},
+		},
+		{
+			"invalid receiver",
+			ExecMint,
+			[]string{puppyID, "puppy1", "invalid"},
+			true,
+			nil,
+		},
+		{
+			"valid approve",
+			ExecApprove,
+			[]string{testClassID, testID, val.Address.String()},
+			false,
+			&nft.MsgApprove{
+				ClassId:  testClassID,
+				Id:       testID,
+				Sender:   s.owner.String(),
+				Approved: val.Address.String(),
+			},
+		},
+		{
+			"invalid approved",
+			ExecApprove,
+			[]string{testClassID, testID, "invalid"},
+			true,
+			nil,
+		},
+		{
+			"valid set approval for all",
+			ExecSetApprovalForAll,
+			[]string{testClassID, val.Address.String(), "true"},
+			false,
+			&nft.MsgSetApprovalForAll{
+				ClassId:  testClassID,
+				Owner:    s.owner.String(),
+				Operator: val.Address.String(),
+				Approved: true,
+			},
+		},
+		{
+			"invalid approved flag",
+			ExecSetApprovalForAll,
+			[]string{testClassID, val.Address.String(), "maybe"},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := tc.exec(val, append(tc.args, args...))
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Equal([]sdk.Msg{tc.expMsg}, tx.GetMsgs())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
@@ -16,3 +16,16 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
+
+// NftHooks event hooks for nft objects (noalias)
+type NftHooks interface {
+	BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error // Must be called before a nft is transferred
+	AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error  // Must be called after a nft is transferred
+	AfterMint(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error                 // Must be called after a nft is minted
+	AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error                 // Must be called after a nft is burnt
+}
+
+type NftHooksWrapper struct{ NftHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (NftHooksWrapper) IsOnePerModuleType() {}
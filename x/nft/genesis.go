@@ -2,14 +2,48 @@ package nft
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	classIDs := make(map[string]bool, len(data.Classes))
 	for _, class := range data.Classes {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		classIDs[class.Id] = true
+	}
+	configs := make(map[string]bool, len(data.ClassConfigs))
+	for _, config := range data.ClassConfigs {
+		if !classIDs[config.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, config.ClassId)
+		}
+		if configs[config.ClassId] {
+			return sdkerrors.Wrapf(ErrInvalidClassID, "duplicate class config: %s", config.ClassId)
+		}
+		configs[config.ClassId] = true
+		if _, err := sdk.AccAddressFromBech32(config.Owner); err != nil {
+			return err
+		}
+		if config.Minter != "" {
+			if _, err := sdk.AccAddressFromBech32(config.Minter); err != nil {
+				return err
+			}
+		}
+	}
+	royalties := make(map[string]bool, len(data.Royalties))
+	for _, royalty := range data.Royalties {
+		if !classIDs[royalty.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, royalty.ClassId)
+		}
+		if royalties[royalty.ClassId] {
+			return sdkerrors.Wrapf(ErrInvalidRoyalty, "duplicate royalty: %s", royalty.ClassId)
+		}
+		royalties[royalty.ClassId] = true
+		if err := royalty.ValidateBasic(); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -21,6 +55,28 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		if !classIDs[approval.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, approval.ClassId)
+		}
+		if err := ValidateNFTID(approval.Id); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Approved); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if !classIDs[approval.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, approval.ClassId)
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Owner); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
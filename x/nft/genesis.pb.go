@@ -27,6 +27,14 @@ type GenesisState struct {
 	// class defines the class of the nft type.
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// class_configs defines the permissions of the classes created with Msg/CreateClass.
+	ClassConfigs []*ClassConfig `protobuf:"bytes,3,rep,name=class_configs,json=classConfigs,proto3" json:"class_configs,omitempty"`
+	// royalties defines the royalties of the classes.
+	Royalties []*Royalty `protobuf:"bytes,4,rep,name=royalties,proto3" json:"royalties,omitempty"`
+	// approvals defines the accounts approved to send an NFT.
+	Approvals []*Approval `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// operator_approvals defines the operators approved to send all the NFTs of a class owned by an owner.
+	OperatorApprovals []*OperatorApproval `protobuf:"bytes,6,rep,name=operator_approvals,json=operatorApprovals,proto3" json:"operator_approvals,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +84,34 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetClassConfigs() []*ClassConfig {
+	if m != nil {
+		return m.ClassConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoyalties() []*Royalty {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovals() []*Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorApprovals() []*OperatorApproval {
+	if m != nil {
+		return m.OperatorApprovals
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -139,22 +175,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xd9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x52, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x34, 0x20, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x28, 0x24, 0x92, 0xd8, 0xc0,
-	0x41, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xee, 0x44, 0x0c, 0xb7, 0x60, 0x01, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x4b, 0xc3, 0x30,
+	0x18, 0x80, 0x57, 0xf7, 0x21, 0x8b, 0xf3, 0x60, 0x10, 0xac, 0x3a, 0xea, 0x18, 0x1e, 0x06, 0x62,
+	0xca, 0xdc, 0x49, 0xf1, 0xa2, 0xf3, 0x03, 0x3c, 0x28, 0x64, 0x9e, 0xbc, 0x8c, 0xac, 0xa6, 0xb3,
+	0xb8, 0x25, 0x25, 0x6f, 0x9c, 0xee, 0x2f, 0x78, 0xf2, 0x67, 0x79, 0xdc, 0xd1, 0xa3, 0x6c, 0x7f,
+	0x44, 0x9a, 0xb6, 0x16, 0xb4, 0x3b, 0x95, 0x34, 0xcf, 0xf3, 0xbc, 0x10, 0x5e, 0xd4, 0xf0, 0x24,
+	0x8c, 0x25, 0xb8, 0xc2, 0xd7, 0xee, 0xa4, 0x3d, 0xe0, 0x9a, 0xb5, 0xdd, 0x21, 0x17, 0x1c, 0x02,
+	0x20, 0xa1, 0x92, 0x5a, 0x62, 0x1c, 0x13, 0x44, 0xf8, 0x9a, 0x24, 0xc4, 0x4e, 0x3d, 0xc7, 0x8a,
+	0xee, 0x8d, 0xd1, 0x7c, 0x2f, 0xa2, 0xda, 0x75, 0xdc, 0xe8, 0x69, 0xa6, 0x39, 0xee, 0xa0, 0x55,
+	0x6f, 0xc4, 0x00, 0x38, 0xd8, 0x56, 0xa3, 0xd8, 0x5a, 0x3b, 0xda, 0x26, 0xff, 0xa3, 0xa4, 0x1b,
+	0x21, 0x34, 0x25, 0x23, 0x89, 0x0b, 0xad, 0x02, 0x0e, 0xf6, 0xca, 0x72, 0xe9, 0x52, 0x68, 0x35,
+	0xa5, 0x29, 0x89, 0x2f, 0xd0, 0xba, 0xf1, 0xfb, 0x9e, 0x14, 0x7e, 0x30, 0x04, 0xbb, 0x68, 0xd4,
+	0xbd, 0xa5, 0xf3, 0xba, 0x86, 0xa3, 0x35, 0x2f, 0x3b, 0x00, 0x3e, 0x46, 0x55, 0x25, 0xa7, 0x6c,
+	0xa4, 0xa3, 0xe1, 0x25, 0x53, 0xd8, 0xcd, 0x2b, 0x50, 0x03, 0x4d, 0x69, 0x46, 0xe3, 0x13, 0x54,
+	0x65, 0x61, 0xa8, 0xe4, 0x84, 0x8d, 0xc0, 0x2e, 0x1b, 0xb5, 0x9e, 0xa7, 0x9e, 0x25, 0x10, 0xcd,
+	0x70, 0xdc, 0x43, 0x58, 0x86, 0x5c, 0x31, 0x2d, 0x55, 0x3f, 0x8b, 0x54, 0x4c, 0x64, 0x3f, 0x2f,
+	0x72, 0x97, 0xd0, 0xbf, 0xb1, 0x0d, 0xf9, 0xe7, 0x0f, 0x34, 0x6f, 0x50, 0xd9, 0xbc, 0x11, 0xde,
+	0x44, 0x65, 0xf9, 0x2a, 0xb8, 0xb2, 0xad, 0x86, 0xd5, 0xaa, 0xd2, 0xf8, 0x80, 0x0f, 0x50, 0x49,
+	0xf8, 0x3a, 0x7d, 0xe2, 0xad, 0xbc, 0x29, 0xb7, 0x57, 0xf7, 0xd4, 0x40, 0xe7, 0xa7, 0x9f, 0x73,
+	0xc7, 0x9a, 0xcd, 0x1d, 0xeb, 0x7b, 0xee, 0x58, 0x1f, 0x0b, 0xa7, 0x30, 0x5b, 0x38, 0x85, 0xaf,
+	0x85, 0x53, 0x78, 0x68, 0x0e, 0x03, 0xfd, 0xf4, 0x32, 0x20, 0x9e, 0x1c, 0xbb, 0xc9, 0x6e, 0xc4,
+	0x9f, 0x43, 0x78, 0x7c, 0x76, 0xdf, 0xa2, 0xe5, 0x18, 0x54, 0xcc, 0x76, 0x74, 0x7e, 0x06, 0x00,
+	0x6a, 0xe8, 0x04, 0xff, 0x73, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +220,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperatorApprovals) > 0 {
+		for iNdEx := len(m.OperatorApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassConfigs) > 0 {
+		for iNdEx := len(m.ClassConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +380,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassConfigs) > 0 {
+		for _, e := range m.ClassConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorApprovals) > 0 {
+		for _, e := range m.OperatorApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +529,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassConfigs = append(m.ClassConfigs, &ClassConfig{})
+			if err := m.ClassConfigs[len(m.ClassConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, &Royalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, &Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorApprovals = append(m.OperatorApprovals, &OperatorApproval{})
+			if err := m.OperatorApprovals[len(m.OperatorApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
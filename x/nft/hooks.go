@@ -0,0 +1,50 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ NftHooks = MultiNftHooks{}
+
+// combine multiple nft hooks, all hook functions are run in array sequence
+type MultiNftHooks []NftHooks
+
+func NewMultiNftHooks(hooks ...NftHooks) MultiNftHooks {
+	return hooks
+}
+
+func (h MultiNftHooks) BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNftHooks) AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNftHooks) AfterMint(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNftHooks) AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
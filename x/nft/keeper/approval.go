@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SetApproval defines a method for approving an account to send a nft on behalf of its owner.
+// The approval is cleared when the nft is transferred or burnt.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) SetApproval(ctx sdk.Context, classID, nftID string, approved sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(approvalStoreKey(classID, nftID), approved.Bytes())
+	return nil
+}
+
+// RemoveApproval defines a method for revoking the approval of a nft
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) RemoveApproval(ctx sdk.Context, classID, nftID string) error {
+	if k.GetApproved(ctx, classID, nftID) == nil {
+		return sdkerrors.ErrNotFound.Wrapf("no approval for nft %s", nftID)
+	}
+
+	k.deleteApproval(ctx, classID, nftID)
+	return nil
+}
+
+// GetApproved returns the account approved to send the specified nft, or nil if there is none
+func (k Keeper) GetApproved(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(approvalStoreKey(classID, nftID))
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// GetApprovals returns all the nft approvals
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []*nft.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ApprovalKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseApprovalStoreKey(iterator.Key())
+		approvals = append(approvals, &nft.Approval{
+			ClassId:  classID,
+			Id:       nftID,
+			Approved: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return
+}
+
+// SetOperatorApproval defines a method for approving or removing an operator sending all
+// the nfts of the specified class owned by the owner.
+// Note: When the upper module uses this method, it needs to authenticate the owner
+func (k Keeper) SetOperatorApproval(ctx sdk.Context, classID string, owner, operator sdk.AccAddress, approved bool) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := operatorApprovalStoreKey(classID, owner, operator)
+	if approved {
+		store.Set(key, Placeholder)
+	} else {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// IsOperator determines whether the operator is approved to send all the nfts of the
+// specified class owned by the owner
+func (k Keeper) IsOperator(ctx sdk.Context, classID string, owner, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(operatorApprovalStoreKey(classID, owner, operator))
+}
+
+// GetOperatorApprovals returns all the operator approvals
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context) (approvals []*nft.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, OperatorApprovalKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID, owner, operator := parseOperatorApprovalStoreKey(iterator.Key())
+		approvals = append(approvals, &nft.OperatorApproval{
+			ClassId:  classID,
+			Owner:    owner.String(),
+			Operator: operator.String(),
+		})
+	}
+	return
+}
+
+// IsApprovedOrOwner determines whether the spender is the owner of the specified nft, the
+// account approved to send it, or an operator of its owner
+func (k Keeper) IsApprovedOrOwner(ctx sdk.Context, classID, nftID string, spender sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Equals(spender) {
+		return true
+	}
+	if approved := k.GetApproved(ctx, classID, nftID); approved != nil && approved.Equals(spender) {
+		return true
+	}
+	return k.IsOperator(ctx, classID, owner, spender)
+}
+
+// isOwnerOrOperator determines whether the spender is the owner of the specified nft or an
+// operator of its owner
+func (k Keeper) isOwnerOrOperator(ctx sdk.Context, classID, nftID string, spender sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	return owner.Equals(spender) || k.IsOperator(ctx, classID, owner, spender)
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, classID, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(approvalStoreKey(classID, nftID))
+}
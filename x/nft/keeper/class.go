@@ -65,3 +65,87 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// SaveClassConfig defines a method for setting the permissions of an exist nft class
+func (k Keeper) SaveClassConfig(ctx sdk.Context, config nft.ClassConfig) error {
+	if !k.HasClass(ctx, config.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, config.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.ClassConfig failed")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classConfigStoreKey(config.ClassId), bz)
+	return nil
+}
+
+// GetClassConfig defines a method for returning the permissions of the specified class.
+// Only the classes created with Msg/CreateClass have a config.
+func (k Keeper) GetClassConfig(ctx sdk.Context, classID string) (nft.ClassConfig, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classConfigStoreKey(classID))
+
+	var config nft.ClassConfig
+	if len(bz) == 0 {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetClassConfigs defines a method for returning the permissions of all classes
+func (k Keeper) GetClassConfigs(ctx sdk.Context) (configs []*nft.ClassConfig) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ClassConfigKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var config nft.ClassConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, &config)
+	}
+	return
+}
+
+// SetRoyalty defines a method for setting the royalty of an exist nft class
+func (k Keeper) SetRoyalty(ctx sdk.Context, royalty nft.Royalty) error {
+	if err := royalty.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, royalty.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, royalty.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&royalty)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.Royalty failed")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(royaltyStoreKey(royalty.ClassId), bz)
+	return nil
+}
+
+// GetRoyalty defines a method for returning the royalty of the specified class
+func (k Keeper) GetRoyalty(ctx sdk.Context, classID string) (nft.Royalty, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(royaltyStoreKey(classID))
+
+	var royalty nft.Royalty
+	if len(bz) == 0 {
+		return royalty, false
+	}
+	k.cdc.MustUnmarshal(bz, &royalty)
+	return royalty, true
+}
+
+// GetRoyalties defines a method for returning the royalties of all classes
+func (k Keeper) GetRoyalties(ctx sdk.Context) (royalties []*nft.Royalty) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RoyaltyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var royalty nft.Royalty
+		k.cdc.MustUnmarshal(iterator.Value(), &royalty)
+		royalties = append(royalties, &royalty)
+	}
+	return
+}
@@ -14,6 +14,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		if err := k.SaveClassConfig(ctx, *config); err != nil {
+			panic(err)
+		}
+	}
+	for _, royalty := range data.Royalties {
+		if err := k.SetRoyalty(ctx, *royalty); err != nil {
+			panic(err)
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner := sdk.MustAccAddressFromBech32(entry.Owner)
@@ -23,6 +33,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		approved := sdk.MustAccAddressFromBech32(approval.Approved)
+		if err := k.SetApproval(ctx, approval.ClassId, approval.Id, approved); err != nil {
+			panic(err)
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		owner := sdk.MustAccAddressFromBech32(approval.Owner)
+		operator := sdk.MustAccAddressFromBech32(approval.Operator)
+		if err := k.SetOperatorApproval(ctx, approval.ClassId, owner, operator, true); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -55,7 +78,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:           classes,
+		Entries:           entries,
+		ClassConfigs:      k.GetClassConfigs(ctx),
+		Royalties:         k.GetRoyalties(ctx),
+		Approvals:         k.GetApprovals(ctx),
+		OperatorApprovals: k.GetOperatorApprovals(ctx),
 	}
 }
@@ -198,3 +198,103 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassConfig return the permissions of an NFT class created with Msg/CreateClass
+func (k Keeper) ClassConfig(goCtx context.Context, r *nft.QueryClassConfigRequest) (*nft.QueryClassConfigResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, has := k.GetClassConfig(ctx, r.ClassId)
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("not found class config: %s", r.ClassId)
+	}
+	return &nft.QueryClassConfigResponse{Config: &config}, nil
+}
+
+// Royalty return the royalty of an NFT class and the amount due on a sale price, same as royaltyInfo in ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	royalty, has := k.GetRoyalty(ctx, r.ClassId)
+	if !has {
+		return nil, sdkerrors.ErrNotFound.Wrapf("not found royalty: %s", r.ClassId)
+	}
+
+	res := &nft.QueryRoyaltyResponse{Royalty: &royalty}
+	if r.SalePrice != nil {
+		amount := royalty.RoyaltyAmount(*r.SalePrice)
+		res.RoyaltyAmount = &amount
+	}
+	return res, nil
+}
+
+// Approved return the account approved to send an NFT, same as getApproved in ERC721
+func (k Keeper) Approved(goCtx context.Context, r *nft.QueryApprovedRequest) (*nft.QueryApprovedResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasNFT(ctx, r.ClassId, r.Id) {
+		return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+	}
+
+	var approved string
+	if addr := k.GetApproved(ctx, r.ClassId, r.Id); addr != nil {
+		approved = addr.String()
+	}
+	return &nft.QueryApprovedResponse{Approved: approved}, nil
+}
+
+// IsApprovedForAll return whether an operator is approved to send all the NFTs of a class owned by an owner,
+// same as isApprovedForAll in ERC721
+func (k Keeper) IsApprovedForAll(goCtx context.Context, r *nft.QueryIsApprovedForAllRequest) (*nft.QueryIsApprovedForAllResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(r.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	approved := k.IsOperator(ctx, r.ClassId, owner, operator)
+	return &nft.QueryIsApprovedForAllResponse{Approved: approved}, nil
+}
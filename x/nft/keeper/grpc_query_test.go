@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,138 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestClassConfig() {
+	config := nft.ClassConfig{
+		ClassId:      testClassID,
+		Owner:        s.addrs[0].String(),
+		Transferable: true,
+	}
+	s.createClass(config)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "kitty2"}))
+
+	testCases := []struct {
+		msg      string
+		req      *nft.QueryClassConfigRequest
+		expError string
+	}{
+		{"fail empty ClassId", &nft.QueryClassConfigRequest{}, "invalid class id"},
+		{"fail class not created with Msg/CreateClass", &nft.QueryClassConfigRequest{ClassId: "kitty2"}, "not found class config"},
+		{"success", &nft.QueryClassConfigRequest{ClassId: testClassID}, ""},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := s.queryClient.ClassConfig(gocontext.Background(), tc.req)
+			if tc.expError == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(config, *result.Config)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestRoyalty() {
+	royalty := nft.Royalty{
+		ClassId:  testClassID,
+		Receiver: s.addrs[1].String(),
+		Rate:     sdk.NewDecWithPrec(25, 3),
+	}
+	s.Require().ErrorIs(s.nftKeeper.SetRoyalty(s.ctx, royalty), nft.ErrClassNotExists)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.SetRoyalty(s.ctx, royalty))
+
+	salePrice := sdk.NewInt64Coin("stake", 1000)
+	royaltyAmount := sdk.NewInt64Coin("stake", 25)
+
+	testCases := []struct {
+		msg       string
+		req       *nft.QueryRoyaltyRequest
+		expError  string
+		expAmount *sdk.Coin
+	}{
+		{"fail empty ClassId", &nft.QueryRoyaltyRequest{}, "invalid class id", nil},
+		{"fail royalty not exist", &nft.QueryRoyaltyRequest{ClassId: "kitty2"}, "not found royalty", nil},
+		{"fail invalid sale price", &nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, "invalid sale price", nil},
+		{"success without sale price", &nft.QueryRoyaltyRequest{ClassId: testClassID}, "", nil},
+		{"success with sale price", &nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: &salePrice}, "", &royaltyAmount},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := s.queryClient.Royalty(gocontext.Background(), tc.req)
+			if tc.expError == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(royalty.Receiver, result.Royalty.Receiver)
+				s.Require().True(royalty.Rate.Equal(result.Royalty.Rate))
+				s.Require().Equal(tc.expAmount, result.RoyaltyAmount)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestApproved() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID + "2"}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.SetApproval(s.ctx, testClassID, testID, s.addrs[1]))
+
+	testCases := []struct {
+		msg         string
+		req         *nft.QueryApprovedRequest
+		expError    string
+		expApproved string
+	}{
+		{"fail empty ClassId", &nft.QueryApprovedRequest{Id: testID}, "invalid class id", ""},
+		{"fail empty nft id", &nft.QueryApprovedRequest{ClassId: testClassID}, "invalid nft id", ""},
+		{"fail nft not exist", &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID + "3"}, "not found nft", ""},
+		{"success approved", &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID}, "", s.addrs[1].String()},
+		{"success not approved", &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID + "2"}, "", ""},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := s.queryClient.Approved(gocontext.Background(), tc.req)
+			if tc.expError == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expApproved, result.Approved)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestIsApprovedForAll() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.SetOperatorApproval(s.ctx, testClassID, s.addrs[0], s.addrs[1], true))
+
+	testCases := []struct {
+		msg         string
+		req         *nft.QueryIsApprovedForAllRequest
+		expError    string
+		expApproved bool
+	}{
+		{"fail empty ClassId", &nft.QueryIsApprovedForAllRequest{Owner: s.addrs[0].String(), Operator: s.addrs[1].String()}, "invalid class id", false},
+		{"fail invalid owner", &nft.QueryIsApprovedForAllRequest{ClassId: testClassID, Owner: "owner", Operator: s.addrs[1].String()}, "decoding bech32 failed", false},
+		{"fail invalid operator", &nft.QueryIsApprovedForAllRequest{ClassId: testClassID, Owner: s.addrs[0].String(), Operator: "operator"}, "decoding bech32 failed", false},
+		{"success approved", &nft.QueryIsApprovedForAllRequest{ClassId: testClassID, Owner: s.addrs[0].String(), Operator: s.addrs[1].String()}, "", true},
+		{"success not approved", &nft.QueryIsApprovedForAllRequest{ClassId: testClassID, Owner: s.addrs[1].String(), Operator: s.addrs[0].String()}, "", false},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := s.queryClient.IsApprovedForAll(gocontext.Background(), tc.req)
+			if tc.expError == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expApproved, result.Approved)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// beforeTransfer - call hook if registered
+func (k Keeper) beforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTransfer(ctx, classID, nftID, sender, receiver)
+	}
+	return nil
+}
+
+// afterTransfer - call hook if registered
+func (k Keeper) afterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterTransfer(ctx, classID, nftID, sender, receiver)
+	}
+	return nil
+}
+
+// afterMint - call hook if registered
+func (k Keeper) afterMint(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterMint(ctx, classID, nftID, owner)
+	}
+	return nil
+}
+
+// afterBurn - call hook if registered
+func (k Keeper) afterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterBurn(ctx, classID, nftID, owner)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var _ nft.NftHooks = &mockHooks{}
+
+// mockHooks records the hook calls, and rejects the transfers of the soulbound class.
+type mockHooks struct {
+	calls []string
+}
+
+func (h *mockHooks) BeforeTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if classID == "soulbound" {
+		return sdkerrors.ErrUnauthorized.Wrap("soulbound nft")
+	}
+	h.calls = append(h.calls, fmt.Sprintf("BeforeTransfer %s/%s %s %s", classID, nftID, sender, receiver))
+	return nil
+}
+
+func (h *mockHooks) AfterTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterTransfer %s/%s %s %s", classID, nftID, sender, receiver))
+	return nil
+}
+
+func (h *mockHooks) AfterMint(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterMint %s/%s %s", classID, nftID, owner))
+	return nil
+}
+
+func (h *mockHooks) AfterBurn(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterBurn %s/%s %s", classID, nftID, owner))
+	return nil
+}
+
+func (s *TestSuite) TestHooks() {
+	hooks := &mockHooks{}
+	k := s.nftKeeper
+	k.SetHooks(nft.NewMultiNftHooks(hooks))
+	s.Require().Panics(func() { k.SetHooks(hooks) })
+
+	s.Require().NoError(k.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(k.SaveClass(s.ctx, nft.Class{Id: "soulbound"}))
+
+	s.Require().NoError(k.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(k.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+	s.Require().NoError(k.Burn(s.ctx, testClassID, testID))
+	s.Require().Equal([]string{
+		fmt.Sprintf("AfterMint %s/%s %s", testClassID, testID, s.addrs[0]),
+		fmt.Sprintf("BeforeTransfer %s/%s %s %s", testClassID, testID, s.addrs[0], s.addrs[1]),
+		fmt.Sprintf("AfterTransfer %s/%s %s %s", testClassID, testID, s.addrs[0], s.addrs[1]),
+		fmt.Sprintf("AfterBurn %s/%s %s", testClassID, testID, s.addrs[1]),
+	}, hooks.calls)
+
+	// the hooks can reject a transfer, also when it is sent with Msg/Send
+	s.Require().NoError(k.Mint(s.ctx, nft.NFT{ClassId: "soulbound", Id: testID}, s.addrs[0]))
+	s.Require().ErrorIs(k.Transfer(s.ctx, "soulbound", testID, s.addrs[1]), sdkerrors.ErrUnauthorized)
+	s.Require().ErrorIs(k.BatchTransfer(s.ctx, "soulbound", []string{testID}, s.addrs[1]), sdkerrors.ErrUnauthorized)
+	_, err := k.Send(sdk.WrapSDKContext(s.ctx), &nft.MsgSend{
+		ClassId:  "soulbound",
+		Id:       testID,
+		Sender:   s.addrs[0].String(),
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().True(s.addrs[0].Equals(k.GetOwner(s.ctx, "soulbound", testID)))
+}
@@ -11,6 +11,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+	hooks    nft.NftHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -28,3 +29,14 @@ func NewKeeper(key storetypes.StoreKey,
 		bk:       bk,
 	}
 }
+
+// SetHooks sets the nft hooks
+func (k *Keeper) SetHooks(nh nft.NftHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = nh
+
+	return k
+}
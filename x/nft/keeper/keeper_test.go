@@ -361,12 +361,37 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expConfig := nft.ClassConfig{
+		ClassId:   testClassID,
+		Owner:     s.addrs[1].String(),
+		Burnable:  true,
+		MaxSupply: 100,
+	}
+	expRoyalty := nft.Royalty{
+		ClassId:  testClassID,
+		Receiver: s.addrs[2].String(),
+		Rate:     sdk.NewDecWithPrec(1, 1),
+	}
+	expApproval := nft.Approval{
+		ClassId:  testClassID,
+		Id:       testID,
+		Approved: s.addrs[2].String(),
+	}
+	expOperatorApproval := nft.OperatorApproval{
+		ClassId:  testClassID,
+		Owner:    s.addrs[0].String(),
+		Operator: s.addrs[1].String(),
+	}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassConfigs:      []*nft.ClassConfig{&expConfig},
+		Royalties:         []*nft.Royalty{&expRoyalty},
+		Approvals:         []*nft.Approval{&expApproval},
+		OperatorApprovals: []*nft.OperatorApproval{&expOperatorApproval},
 	}
 	s.nftKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -378,4 +403,17 @@ func (s *TestSuite) TestInitGenesis() {
 	actNFT, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	actConfig, has := s.nftKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(expConfig, actConfig)
+
+	actRoyalty, has := s.nftKeeper.GetRoyalty(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(expRoyalty, actRoyalty)
+
+	s.Require().True(s.addrs[2].Equals(s.nftKeeper.GetApproved(s.ctx, testClassID, testID)))
+	s.Require().True(s.nftKeeper.IsOperator(s.ctx, testClassID, s.addrs[0], s.addrs[1]))
+
+	s.Require().Equal(expGenesis, s.nftKeeper.ExportGenesis(s.ctx))
 }
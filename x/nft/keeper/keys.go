@@ -15,6 +15,10 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassConfigKey       = []byte{0x06}
+	RoyaltyKey           = []byte{0x07}
+	ApprovalKey          = []byte{0x08}
+	OperatorApprovalKey  = []byte{0x09}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -31,6 +35,22 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classConfigStoreKey returns the byte representation of the nft class config key
+func classConfigStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassConfigKey)+len(classID))
+	copy(key, ClassConfigKey)
+	copy(key[len(ClassConfigKey):], classID)
+	return key
+}
+
+// royaltyStoreKey returns the byte representation of the nft class royalty key
+func royaltyStoreKey(classID string) []byte {
+	key := make([]byte, len(RoyaltyKey)+len(classID))
+	copy(key, RoyaltyKey)
+	copy(key[len(RoyaltyKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
@@ -104,3 +124,59 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// approvalStoreKey returns the byte representation of the nft approval key
+// Items are stored with the following key: values
+// 0x08<classID><Delimiter(1 Byte)><nftID>
+func approvalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(ApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, ApprovalKey)
+	copy(key[len(ApprovalKey):], classIDBz)
+	copy(key[len(ApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// parseApprovalStoreKey returns the classID and nftID of the result of the method approvalStoreKey
+func parseApprovalStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.SplitN(key[len(ApprovalKey):], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid approvalStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// operatorApprovalStoreKey returns the byte representation of the operator approval key
+// Items are stored with the following key: values
+// 0x09<classID><Delimiter(1 Byte)><owner><operator>
+func operatorApprovalStoreKey(classID string, owner, operator sdk.AccAddress) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	owner = address.MustLengthPrefix(owner)
+	operator = address.MustLengthPrefix(operator)
+
+	key := make([]byte, len(OperatorApprovalKey)+len(classIDBz)+len(Delimiter)+len(owner)+len(operator))
+	copy(key, OperatorApprovalKey)
+	copy(key[len(OperatorApprovalKey):], classIDBz)
+	copy(key[len(OperatorApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(OperatorApprovalKey)+len(classIDBz)+len(Delimiter):], owner)
+	copy(key[len(OperatorApprovalKey)+len(classIDBz)+len(Delimiter)+len(owner):], operator)
+	return key
+}
+
+// parseOperatorApprovalStoreKey returns the classID, owner and operator of the result of the method operatorApprovalStoreKey
+func parseOperatorApprovalStoreKey(key []byte) (classID string, owner, operator sdk.AccAddress) {
+	ret := bytes.SplitN(key[len(OperatorApprovalKey):], Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid operatorApprovalStoreKey")
+	}
+	classID = string(ret[0])
+
+	addrs := ret[1]
+	ownerLen := int(addrs[0])
+	owner = sdk.AccAddress(addrs[1 : 1+ownerLen])
+	operator = sdk.AccAddress(addrs[2+ownerLen:])
+	return
+}
@@ -18,9 +18,12 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, err
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	if config, has := k.GetClassConfig(ctx, msg.ClassId); has && !config.Transferable {
+		return nil, sdkerrors.Wrap(nft.ErrNotTransferable, msg.ClassId)
+	}
+
+	if !k.IsApprovedOrOwner(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s or approved to send it", sender, msg.Id)
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
@@ -40,3 +43,235 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SaveClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	err := k.SaveClassConfig(ctx, nft.ClassConfig{
+		ClassId:      msg.Class.Id,
+		Owner:        msg.Owner,
+		Minter:       msg.Minter,
+		Transferable: msg.Transferable,
+		Burnable:     msg.Burnable,
+		Updatable:    msg.Updatable,
+		MaxSupply:    msg.MaxSupply,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.RoyaltyReceiver != "" {
+		err := k.SetRoyalty(ctx, nft.Royalty{
+			ClassId:  msg.Class.Id,
+			Receiver: msg.RoyaltyReceiver,
+			Rate:     msg.RoyaltyRate,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Class.Id,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// MintNFT implement MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, err := k.getClassConfig(ctx, msg.Nft.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Minter != config.Owner && msg.Minter != config.Minter {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nft of class %s", msg.Minter, msg.Nft.ClassId)
+	}
+
+	// burnt NFTs still count towards the max supply, so that it caps the number of NFTs ever minted.
+	if config.MaxSupply > 0 && config.Minted >= config.MaxSupply {
+		return nil, sdkerrors.Wrapf(nft.ErrMaxSupply, "max supply of class %s is %d", msg.Nft.ClassId, config.MaxSupply)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	config.Minted++
+	if err := k.SaveClassConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implement BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, err := k.getClassConfig(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Burnable {
+		return nil, sdkerrors.Wrap(nft.ErrNotBurnable, msg.ClassId)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, err := k.getClassConfig(ctx, msg.Nft.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Updatable {
+		return nil, sdkerrors.Wrap(nft.ErrNotUpdatable, msg.Nft.ClassId)
+	}
+
+	if msg.Sender != config.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of class %s", msg.Sender, msg.Nft.ClassId)
+	}
+
+	if err := k.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// Approve implement Approve method of the types.MsgServer.
+func (k Keeper) Approve(goCtx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := sdk.AccAddressFromBech32(msg.Approved)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasNFT(ctx, msg.ClassId, msg.Id) {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	if !k.isOwnerOrOperator(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s or an operator of its owner", sender, msg.Id)
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if owner.Equals(approved) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve the owner of nft")
+	}
+
+	if err := k.SetApproval(ctx, msg.ClassId, msg.Id, approved); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprove{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Owner:    owner.String(),
+		Approved: msg.Approved,
+	})
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// RevokeApproval implement RevokeApproval method of the types.MsgServer.
+func (k Keeper) RevokeApproval(goCtx context.Context, msg *nft.MsgRevokeApproval) (*nft.MsgRevokeApprovalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasNFT(ctx, msg.ClassId, msg.Id) {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	if !k.isOwnerOrOperator(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s or an operator of its owner", sender, msg.Id)
+	}
+
+	if err := k.RemoveApproval(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventRevokeApproval{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   k.GetOwner(ctx, msg.ClassId, msg.Id).String(),
+	})
+	return &nft.MsgRevokeApprovalResponse{}, nil
+}
+
+// SetApprovalForAll implement SetApprovalForAll method of the types.MsgServer.
+func (k Keeper) SetApprovalForAll(goCtx context.Context, msg *nft.MsgSetApprovalForAll) (*nft.MsgSetApprovalForAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetOperatorApproval(ctx, msg.ClassId, owner, operator, msg.Approved); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprovalForAll{
+		ClassId:  msg.ClassId,
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		Approved: msg.Approved,
+	})
+	return &nft.MsgSetApprovalForAllResponse{}, nil
+}
+
+// getClassConfig returns the config of the class, which is only set for the classes created
+// with Msg/CreateClass. The nfts of other classes are managed by the modules that created them.
+func (k Keeper) getClassConfig(ctx sdk.Context, classID string) (nft.ClassConfig, error) {
+	if !k.HasClass(ctx, classID) {
+		return nft.ClassConfig{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	config, has := k.GetClassConfig(ctx, classID)
+	if !has {
+		return nft.ClassConfig{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "class %s was not created with Msg/CreateClass", classID)
+	}
+	return config, nil
+}
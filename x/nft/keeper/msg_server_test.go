@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) createClass(config nft.ClassConfig) {
+	_, err := s.nftKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+		Owner: config.Owner,
+		Class: &nft.Class{
+			Id:   config.ClassId,
+			Name: testClassName,
+			Uri:  testClassURI,
+		},
+		Minter:       config.Minter,
+		Transferable: config.Transferable,
+		Burnable:     config.Burnable,
+		Updatable:    config.Updatable,
+		MaxSupply:    config.MaxSupply,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestCreateClass() {
+	config := nft.ClassConfig{
+		ClassId:      testClassID,
+		Owner:        s.addrs[0].String(),
+		Minter:       s.addrs[1].String(),
+		Transferable: true,
+		MaxSupply:    10,
+	}
+	s.createClass(config)
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(testClassName, class.Name)
+
+	actConfig, has := s.nftKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(config, actConfig)
+
+	_, has = s.nftKeeper.GetRoyalty(s.ctx, testClassID)
+	s.Require().False(has)
+
+	// the class has a royalty
+	_, err := s.nftKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+		Owner:           s.addrs[0].String(),
+		Class:           &nft.Class{Id: "kitty2"},
+		RoyaltyReceiver: s.addrs[2].String(),
+		RoyaltyRate:     sdk.NewDecWithPrec(5, 2),
+	})
+	s.Require().NoError(err)
+	royalty, has := s.nftKeeper.GetRoyalty(s.ctx, "kitty2")
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[2].String(), royalty.Receiver)
+	s.Require().True(sdk.NewDecWithPrec(5, 2).Equal(royalty.Rate))
+
+	// the class id is already taken
+	_, err = s.nftKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+		Owner: s.addrs[1].String(),
+		Class: &nft.Class{Id: testClassID},
+	})
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestMintNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createClass(nft.ClassConfig{
+		ClassId:   testClassID,
+		Owner:     s.addrs[0].String(),
+		Minter:    s.addrs[1].String(),
+		MaxSupply: 2,
+	})
+
+	mint := func(minter sdk.AccAddress, id string) error {
+		_, err := s.nftKeeper.MintNFT(goCtx, &nft.MsgMintNFT{
+			Minter:   minter.String(),
+			Nft:      &nft.NFT{ClassId: testClassID, Id: id, Uri: testURI},
+			Receiver: s.addrs[2].String(),
+		})
+		return err
+	}
+
+	// the owner and the minter can mint
+	s.Require().NoError(mint(s.addrs[0], testID))
+	s.Require().NoError(mint(s.addrs[1], testID+"2"))
+	s.Require().True(s.addrs[2].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID)))
+
+	// the max supply is reached
+	s.Require().ErrorIs(mint(s.addrs[0], testID+"3"), nft.ErrMaxSupply)
+
+	// burnt nfts still count towards the max supply
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+	s.Require().ErrorIs(mint(s.addrs[0], testID+"3"), nft.ErrMaxSupply)
+	config, has := s.nftKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(uint64(2), config.Minted)
+
+	// other accounts can't mint
+	s.Require().ErrorIs(mint(s.addrs[2], testID+"3"), sdkerrors.ErrUnauthorized)
+
+	// the class was not created with Msg/CreateClass
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "kitty2"}))
+	_, err := s.nftKeeper.MintNFT(goCtx, &nft.MsgMintNFT{
+		Minter:   s.addrs[0].String(),
+		Nft:      &nft.NFT{ClassId: "kitty2", Id: testID},
+		Receiver: s.addrs[0].String(),
+	})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestBurnNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createClass(nft.ClassConfig{ClassId: testClassID, Owner: s.addrs[0].String(), Burnable: true})
+	s.createClass(nft.ClassConfig{ClassId: "kitty2", Owner: s.addrs[0].String()})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "kitty2", Id: testID}, s.addrs[1]))
+
+	// only the nft owner can burn it, not the class owner
+	_, err := s.nftKeeper.BurnNFT(goCtx, &nft.MsgBurnNFT{ClassId: testClassID, Id: testID, Owner: s.addrs[0].String()})
+	s.Require().Error(err)
+
+	_, err = s.nftKeeper.BurnNFT(goCtx, &nft.MsgBurnNFT{ClassId: testClassID, Id: testID, Owner: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	_, err = s.nftKeeper.BurnNFT(goCtx, &nft.MsgBurnNFT{ClassId: "kitty2", Id: testID, Owner: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrNotBurnable)
+}
+
+func (s *TestSuite) TestUpdateNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createClass(nft.ClassConfig{ClassId: testClassID, Owner: s.addrs[0].String(), Updatable: true})
+	s.createClass(nft.ClassConfig{ClassId: "kitty2", Owner: s.addrs[0].String()})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "kitty2", Id: testID}, s.addrs[1]))
+
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI, UriHash: testURIHash}
+
+	// only the class owner can update the nft, not the nft owner
+	_, err := s.nftKeeper.UpdateNFT(goCtx, &nft.MsgUpdateNFT{Sender: s.addrs[1].String(), Nft: &expNFT})
+	s.Require().Error(err)
+
+	_, err = s.nftKeeper.UpdateNFT(goCtx, &nft.MsgUpdateNFT{Sender: s.addrs[0].String(), Nft: &expNFT})
+	s.Require().NoError(err)
+	actNFT, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(expNFT, actNFT)
+
+	_, err = s.nftKeeper.UpdateNFT(goCtx, &nft.MsgUpdateNFT{
+		Sender: s.addrs[0].String(),
+		Nft:    &nft.NFT{ClassId: "kitty2", Id: testID, Uri: testURI},
+	})
+	s.Require().ErrorIs(err, nft.ErrNotUpdatable)
+
+	// the nft doesn't exist
+	_, err = s.nftKeeper.UpdateNFT(goCtx, &nft.MsgUpdateNFT{
+		Sender: s.addrs[0].String(),
+		Nft:    &nft.NFT{ClassId: testClassID, Id: testID + "2"},
+	})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestSendNotTransferable() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.createClass(nft.ClassConfig{ClassId: testClassID, Owner: s.addrs[0].String(), Transferable: true})
+	s.createClass(nft.ClassConfig{ClassId: "kitty2", Owner: s.addrs[0].String()})
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "kitty2", Id: testID}, s.addrs[1]))
+
+	_, err := s.nftKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String(), Receiver: s.addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().True(s.addrs[2].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID)))
+
+	_, err = s.nftKeeper.Send(goCtx, &nft.MsgSend{ClassId: "kitty2", Id: testID, Sender: s.addrs[1].String(), Receiver: s.addrs[2].String()})
+	s.Require().ErrorIs(err, nft.ErrNotTransferable)
+}
+
+func (s *TestSuite) TestApprove() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	send := func(sender, receiver sdk.AccAddress) error {
+		_, err := s.nftKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: sender.String(), Receiver: receiver.String()})
+		return err
+	}
+
+	// only the owner or an operator can approve
+	_, err := s.nftKeeper.Approve(goCtx, &nft.MsgApprove{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String(), Approved: s.addrs[1].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the owner can't be approved
+	_, err = s.nftKeeper.Approve(goCtx, &nft.MsgApprove{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Approved: s.addrs[0].String()})
+	s.Require().Error(err)
+
+	// the nft doesn't exist
+	_, err = s.nftKeeper.Approve(goCtx, &nft.MsgApprove{ClassId: testClassID, Id: testID + "2", Sender: s.addrs[0].String(), Approved: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	s.Require().Error(send(s.addrs[1], s.addrs[2]))
+	_, err = s.nftKeeper.Approve(goCtx, &nft.MsgApprove{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String(), Approved: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().True(s.addrs[1].Equals(s.nftKeeper.GetApproved(s.ctx, testClassID, testID)))
+
+	// the approved account can send the nft, which clears the approval
+	s.Require().NoError(send(s.addrs[1], s.addrs[2]))
+	s.Require().True(s.addrs[2].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID)))
+	s.Require().Nil(s.nftKeeper.GetApproved(s.ctx, testClassID, testID))
+	s.Require().Error(send(s.addrs[1], s.addrs[0]))
+}
+
+func (s *TestSuite) TestRevokeApproval() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.SetApproval(s.ctx, testClassID, testID, s.addrs[1]))
+
+	// the approved account can't revoke the approval
+	_, err := s.nftKeeper.RevokeApproval(goCtx, &nft.MsgRevokeApproval{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.nftKeeper.RevokeApproval(goCtx, &nft.MsgRevokeApproval{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Nil(s.nftKeeper.GetApproved(s.ctx, testClassID, testID))
+
+	// there is no approval to revoke
+	_, err = s.nftKeeper.RevokeApproval(goCtx, &nft.MsgRevokeApproval{ClassId: testClassID, Id: testID, Sender: s.addrs[0].String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *TestSuite) TestSetApprovalForAll() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID + "2"}, s.addrs[0]))
+
+	// the class doesn't exist
+	_, err := s.nftKeeper.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{ClassId: "kitty2", Owner: s.addrs[0].String(), Operator: s.addrs[1].String(), Approved: true})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	_, err = s.nftKeeper.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{ClassId: testClassID, Owner: s.addrs[0].String(), Operator: s.addrs[1].String(), Approved: true})
+	s.Require().NoError(err)
+	s.Require().True(s.nftKeeper.IsOperator(s.ctx, testClassID, s.addrs[0], s.addrs[1]))
+
+	// the operator can approve another account and send the nfts of the owner
+	_, err = s.nftKeeper.Approve(goCtx, &nft.MsgApprove{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String(), Approved: s.addrs[2].String()})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID + "2", Sender: s.addrs[1].String(), Receiver: s.addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().True(s.addrs[2].Equals(s.nftKeeper.GetOwner(s.ctx, testClassID, testID+"2")))
+
+	// the operator is removed
+	_, err = s.nftKeeper.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{ClassId: testClassID, Owner: s.addrs[0].String(), Operator: s.addrs[1].String(), Approved: false})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.IsOperator(s.ctx, testClassID, s.addrs[0], s.addrs[1]))
+	_, err = s.nftKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.addrs[1].String(), Receiver: s.addrs[1].String()})
+	s.Require().Error(err)
+}
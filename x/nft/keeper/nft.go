@@ -17,14 +17,13 @@ func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) er
 		return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
-	k.mintWithNoCheck(ctx, token, receiver)
-	return nil
+	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
 // mintWithNoCheck defines a method for minting a new nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
-func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) {
+func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
@@ -34,6 +33,7 @@ func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.Acc
 		Id:      token.Id,
 		Owner:   receiver.String(),
 	})
+	return k.afterMint(ctx, token.ClassId, token.Id, receiver)
 }
 
 // Burn defines a method for burning a nft from a specific account.
@@ -47,8 +47,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.burnWithNoCheck(ctx, classID, nftID)
-	return nil
+	return k.burnWithNoCheck(ctx, classID, nftID)
 }
 
 // burnWithNoCheck defines a method for burning a nft from a specific account.
@@ -60,13 +59,14 @@ func (k Keeper) burnWithNoCheck(ctx sdk.Context, classID string, nftID string) e
 	nftStore.Delete([]byte(nftID))
 
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.decrTotalSupply(ctx, classID)
 	ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
 		ClassId: classID,
 		Id:      nftID,
 		Owner:   owner.String(),
 	})
-	return nil
+	return k.afterBurn(ctx, classID, nftID, owner)
 }
 
 // Update defines a method for updating an exist nft
@@ -105,8 +105,7 @@ func (k Keeper) Transfer(ctx sdk.Context,
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.transferWithNoCheck(ctx, classID, nftID, receiver)
-	return nil
+	return k.transferWithNoCheck(ctx, classID, nftID, receiver)
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -118,9 +117,14 @@ func (k Keeper) transferWithNoCheck(ctx sdk.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.beforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+		return err
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.afterTransfer(ctx, classID, nftID, owner, receiver)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
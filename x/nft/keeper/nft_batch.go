@@ -22,7 +22,9 @@ func (k Keeper) BatchMint(ctx sdk.Context,
 		}
 
 		checked[token.ClassId] = true
-		k.mintWithNoCheck(ctx, token, receiver)
+		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -77,7 +79,7 @@ func (k Keeper) BatchTransfer(ctx sdk.Context,
 			return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
 		if err := k.transferWithNoCheck(ctx, classID, nftID, receiver); err != nil {
-			return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+			return err
 		}
 	}
 	return nil
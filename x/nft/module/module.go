@@ -3,11 +3,14 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
+	"sort"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -95,7 +98,7 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 // AppModule implements the sdk.AppModule interface
 type AppModule struct {
 	AppModuleBasic
-	keeper keeper.Keeper
+	keeper *keeper.Keeper
 	// TODO accountKeeper,bankKeeper will be replaced by query service
 	accountKeeper nft.AccountKeeper
 	bankKeeper    nft.BankKeeper
@@ -103,7 +106,7 @@ type AppModule struct {
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak nft.AccountKeeper, bk nft.BankKeeper, registry cdctypes.InterfaceRegistry) AppModule {
+func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, ak nft.AccountKeeper, bk nft.BankKeeper, registry cdctypes.InterfaceRegistry) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
@@ -187,7 +190,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	return simulation.WeightedOperations(
 		am.registry,
 		simState.AppParams, simState.Cdc,
-		am.accountKeeper, am.bankKeeper, am.keeper,
+		am.accountKeeper, am.bankKeeper, *am.keeper,
 	)
 }
 
@@ -198,6 +201,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(provideModuleBasic, provideModule),
+		appmodule.Invoke(invokeSetNftHooks),
 	)
 }
 
@@ -219,13 +223,37 @@ type nftInputs struct {
 type nftOutputs struct {
 	depinject.Out
 
-	NFTKeeper keeper.Keeper
+	NFTKeeper *keeper.Keeper
 	Module    runtime.AppModuleWrapper
 }
 
 func provideModule(in nftInputs) nftOutputs {
 	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper)
-	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
+	m := NewAppModule(in.Cdc, &k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
-	return nftOutputs{NFTKeeper: k, Module: runtime.WrapAppModule(m)}
+	return nftOutputs{NFTKeeper: &k, Module: runtime.WrapAppModule(m)}
+}
+
+func invokeSetNftHooks(keeper *keeper.Keeper, nftHooks map[string]nft.NftHooksWrapper) error {
+	if keeper == nil || nftHooks == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	modNames := maps.Keys(nftHooks)
+	order := modNames
+	sort.Strings(order)
+
+	var multiHooks nft.MultiNftHooks
+	for _, modName := range order {
+		hook, ok := nftHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find nft hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
 }
@@ -1,16 +1,35 @@
 package nft
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend        = "send"
+	TypeMsgCreateClass = "create_class"
+	TypeMsgMintNFT     = "mint_nft"
+	TypeMsgBurnNFT     = "burn_nft"
+	TypeMsgUpdateNFT   = "update_nft"
+
+	TypeMsgApprove           = "approve"
+	TypeMsgRevokeApproval    = "revoke_approval"
+	TypeMsgSetApprovalForAll = "set_approval_for_all"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+	_ sdk.Msg = &MsgApprove{}
+	_ sdk.Msg = &MsgRevokeApproval{}
+	_ sdk.Msg = &MsgSetApprovalForAll{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +58,206 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if m.Minter != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Minter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+		}
+	}
+
+	if m.Class == nil {
+		return sdkerrors.Wrap(ErrInvalidClassID, "empty class")
+	}
+
+	if err := ValidateClassID(m.Class.Id); err != nil {
+		return err
+	}
+
+	prefix := ClassIDPrefix(m.Owner)
+	if len(m.Class.Id) <= len(prefix) || !strings.HasPrefix(m.Class.Id, prefix) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class id %s must be prefixed with %s", m.Class.Id, prefix)
+	}
+
+	if m.RoyaltyReceiver != "" {
+		return m.royalty().ValidateBasic()
+	}
+	if !m.RoyaltyRate.IsNil() && !m.RoyaltyRate.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidRoyalty, "royalty rate without royalty receiver")
+	}
+	return nil
+}
+
+func (m MsgCreateClass) royalty() Royalty {
+	return Royalty{
+		ClassId:  m.Class.Id,
+		Receiver: m.RoyaltyReceiver,
+		Rate:     m.RoyaltyRate,
+	}
+}
+
+// GetSigners implements Msg
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMintNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+
+	return validateNFT(m.Nft)
+}
+
+// GetSigners implements Msg
+func (m MsgMintNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurnNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	return validateNFT(m.Nft)
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgApprove) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Approved)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid approved address (%s)", m.Approved)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgRevokeApproval) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSetApprovalForAll) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", m.Operator)
+	}
+
+	if m.Owner == m.Operator {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "owner and operator cannot be the same")
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSetApprovalForAll) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+func validateNFT(token *NFT) error {
+	if token == nil {
+		return sdkerrors.Wrap(ErrInvalidNFT, "empty nft")
+	}
+
+	if err := ValidateClassID(token.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", token.ClassId)
+	}
+
+	if err := ValidateNFTID(token.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", token.Id)
+	}
+	return nil
+}
@@ -38,6 +38,23 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassConfigKey):
+			var configA, configB nft.ClassConfig
+			cdc.MustUnmarshal(kvA.Value, &configA)
+			cdc.MustUnmarshal(kvB.Value, &configB)
+			return fmt.Sprintf("%v\n%v", configA, configB)
+		case bytes.Equal(kvA.Key[:1], keeper.RoyaltyKey):
+			var royaltyA, royaltyB nft.Royalty
+			cdc.MustUnmarshal(kvA.Value, &royaltyA)
+			cdc.MustUnmarshal(kvB.Value, &royaltyB)
+			return fmt.Sprintf("%v\n%v", royaltyA, royaltyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ApprovalKey):
+			var approvedA, approvedB sdk.AccAddress
+			approvedA = sdk.AccAddress(kvA.Value)
+			approvedB = sdk.AccAddress(kvB.Value)
+			return fmt.Sprintf("%v\n%v", approvedA, approvedB)
+		case bytes.Equal(kvA.Key[:1], keeper.OperatorApprovalKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
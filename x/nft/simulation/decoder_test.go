@@ -35,6 +35,23 @@ func TestDecodeStore(t *testing.T) {
 	classBz, err := encCfg.Codec.Marshal(&class)
 	require.NoError(t, err)
 
+	config := nft.ClassConfig{
+		ClassId:      "ClassID",
+		Owner:        ownerAddr1.String(),
+		Transferable: true,
+		MaxSupply:    10,
+	}
+	configBz, err := encCfg.Codec.Marshal(&config)
+	require.NoError(t, err)
+
+	royalty := nft.Royalty{
+		ClassId:  "ClassID",
+		Receiver: ownerAddr1.String(),
+		Rate:     sdk.NewDecWithPrec(5, 2),
+	}
+	royaltyBz, err := encCfg.Codec.Marshal(&royalty)
+	require.NoError(t, err)
+
 	nft := nft.NFT{
 		ClassId: "ClassID",
 		Id:      "NFTID",
@@ -55,6 +72,10 @@ func TestDecodeStore(t *testing.T) {
 			{Key: []byte(keeper.NFTOfClassByOwnerKey), Value: nftOfClassByOwnerValue},
 			{Key: []byte(keeper.OwnerKey), Value: ownerAddr1},
 			{Key: []byte(keeper.ClassTotalSupply), Value: totalSupplyBz},
+			{Key: []byte(keeper.ClassConfigKey), Value: configBz},
+			{Key: []byte(keeper.RoyaltyKey), Value: royaltyBz},
+			{Key: []byte(keeper.ApprovalKey), Value: ownerAddr1},
+			{Key: []byte(keeper.OperatorApprovalKey), Value: keeper.Placeholder},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +90,10 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ClassConfig", false, fmt.Sprintf("%v\n%v", config, config)},
+		{"Royalty", false, fmt.Sprintf("%v\n%v", royalty, royalty)},
+		{"ApprovalKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
+		{"OperatorApprovalKey", false, fmt.Sprintf("%v\n%v", keeper.Placeholder, keeper.Placeholder)},
 		{"other", true, ""},
 	}
 
@@ -37,7 +37,7 @@ type SimTestSuite struct {
 	accountKeeper     authkeeper.AccountKeeper
 	bankKeeper        bankkeeper.Keeper
 	stakingKeeper     *stakingkeeper.Keeper
-	nftKeeper         nftkeeper.Keeper
+	nftKeeper         *nftkeeper.Keeper
 }
 
 func (suite *SimTestSuite) SetupTest() {
@@ -63,7 +63,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		suite.codec,
 		suite.accountKeeper,
 		suite.bankKeeper,
-		suite.nftKeeper,
+		*suite.nftKeeper,
 	)
 
 	// setup 3 accounts
@@ -125,7 +125,7 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 
 	// execute operation
 	registry := suite.interfaceRegistry
-	op := simulation.SimulateMsgSend(codec.NewProtoCodec(registry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	op := simulation.SimulateMsgSend(codec.NewProtoCodec(registry), suite.accountKeeper, suite.bankKeeper, *suite.nftKeeper)
 	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
 	suite.Require().NoError(err)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -26,6 +27,13 @@ func ValidateClassID(id string) error {
 	return nil
 }
 
+// ClassIDPrefix returns the prefix of the ids of the classes created by the owner with
+// Msg/CreateClass. Ids without the prefix of their owner are reserved for the classes saved
+// by other modules or in genesis, so that no account can squat the class id of another.
+func ClassIDPrefix(owner string) string {
+	return owner + "/"
+}
+
 // ValidateNFTID returns whether the nft id is valid
 func ValidateNFTID(id string) error {
 	if !reNFTID.MatchString(id) {
@@ -33,3 +41,22 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidateBasic returns whether the royalty is valid
+func (r Royalty) ValidateBasic() error {
+	if err := ValidateClassID(r.ClassId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty receiver address (%s)", r.Receiver)
+	}
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty rate must be between 0 and 1: %s", r.Rate)
+	}
+	return nil
+}
+
+// RoyaltyAmount returns the royalty due on the sale price
+func (r Royalty) RoyaltyAmount(salePrice sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(salePrice.Denom, r.Rate.MulInt(salePrice.Amount).TruncateInt())
+}
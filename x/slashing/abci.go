@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,10 +17,25 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// During a chain-wide outage, the missed signatures of the last block are
+	// not counted against the validators
+	votes := req.LastCommitInfo.GetVotes()
+	outage, missedPower := k.IsChainOutage(ctx, votes)
+	if outage {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOutage,
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+				sdk.NewAttribute(types.AttributeKeyMissedPower, fmt.Sprintf("%d", missedPower)),
+			),
+		)
+		k.Logger(ctx).Info("not counting missed signatures during chain-wide outage", "height", ctx.BlockHeight(), "missed_power", missedPower)
+	}
+
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
-	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
-		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
+	for _, voteInfo := range votes {
+		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock || outage)
 	}
 }
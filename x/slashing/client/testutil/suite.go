@@ -67,7 +67,7 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 				fmt.Sprintf("--%s=1", flags.FlagHeight),
 			},
 			false,
-			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\"}", sdk.ConsAddress(val.PubKey.Address())),
+			fmt.Sprintf("{\"address\":\"%s\",\"start_height\":\"0\",\"index_offset\":\"0\",\"jailed_until\":\"1970-01-01T00:00:00Z\",\"tombstoned\":false,\"missed_blocks_counter\":\"0\",\"downtime_jail_times\":[]}", sdk.ConsAddress(val.PubKey.Address())),
 		},
 		{
 			"valid address (text output)",
@@ -78,6 +78,7 @@ func (s *IntegrationTestSuite) TestGetCmdQuerySigningInfo() {
 			},
 			false,
 			fmt.Sprintf(`address: %s
+downtime_jail_times: []
 index_offset: "0"
 jailed_until: "1970-01-01T00:00:00Z"
 missed_blocks_counter: "0"
@@ -115,16 +116,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","downtime_lookback_window":"0s","downtime_jail_duration_multiplier":"2.000000000000000000","slash_fraction_downtime_multiplier":"1.000000000000000000","outage_missed_power_threshold":"0.000000000000000000"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`downtime_jail_duration: 600s
+downtime_jail_duration_multiplier: "2.000000000000000000"
+downtime_lookback_window: 0s
 min_signed_per_window: "0.500000000000000000"
+outage_missed_power_threshold: "0.000000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+slash_fraction_downtime_multiplier: "1.000000000000000000"`,
 		},
 	}
 
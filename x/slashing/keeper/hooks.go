@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -47,6 +48,28 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	return nil
 }
 
+// AfterConsensusPubKeyUpdate moves the address-pubkey relation, the signing
+// info and the missed blocks of a validator from its old consensus address to
+// its new one when it rotates its consensus key.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+	if signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr); found {
+		signingInfo.Address = newConsAddr.String()
+		k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+		k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+	}
+
+	k.moveValidatorMissedBlockBitArray(ctx, oldConsAddr, newConsAddr)
+
+	k.deleteAddrPubkeyRelation(ctx, oldPubKey.Address())
+
+	return nil
+}
+
 // Hooks wrapper struct for slashing keeper
 type Hooks struct {
 	k Keeper
@@ -101,3 +124,16 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
 	return nil
 }
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	return h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
+
+func (h Hooks) BeforeTokenizeSharesRedeemed(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h Hooks) BeforeTokenizeShareRecordRemoved(_ sdk.Context, _ uint64) error {
+	return nil
+}
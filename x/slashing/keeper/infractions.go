@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"math"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,9 +17,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
-	// fetch the validator public key
+	// fetch the validator public key, the votes signed with the old consensus
+	// key of a validator which rotated its key are counted for the new key
 	consAddr := sdk.ConsAddress(addr)
-	if _, err := k.GetPubkey(ctx, addr); err != nil {
+	if newConsAddr, found := k.sk.GetRotatedConsAddr(ctx, consAddr); found {
+		consAddr = newConsAddr
+	}
+	if _, err := k.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
@@ -84,7 +92,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			// the penalties escalate with the recent downtime jailings of the validator
+			slashFraction, jailDuration, recentJailTimes := k.downtimePenalties(ctx, signInfo.DowntimeJailTimes)
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			if k.DowntimeLookbackWindow(ctx) > 0 {
+				signInfo.DowntimeJailTimes = append(recentJailTimes, ctx.BlockHeader().Time)
+			} else {
+				signInfo.DowntimeJailTimes = nil
+			}
+
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -93,12 +110,11 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.Format(time.RFC3339)),
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
-
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
@@ -110,7 +126,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
@@ -125,3 +141,53 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// downtimePenalties returns the slash fraction and the jail duration of a
+// downtime of a validator, given the times at which it was previously jailed
+// for downtime. Both are multiplied by their multiplier for each jailing within
+// the downtime lookback window, the slash fraction being capped at 1. The
+// jailings within the lookback window are returned along.
+func (k Keeper) downtimePenalties(ctx sdk.Context, jailTimes []time.Time) (slashFraction sdk.Dec, jailDuration time.Duration, recentJailTimes []time.Time) {
+	params := k.GetParams(ctx)
+	slashFraction = params.SlashFractionDowntime
+	if params.DowntimeLookbackWindow == 0 {
+		return slashFraction, params.DowntimeJailDuration, nil
+	}
+
+	windowStart := ctx.BlockHeader().Time.Add(-params.DowntimeLookbackWindow)
+	for _, jailTime := range jailTimes {
+		if jailTime.After(windowStart) {
+			recentJailTimes = append(recentJailTimes, jailTime)
+		}
+	}
+
+	maxDuration := sdk.NewDec(math.MaxInt64)
+	duration := sdk.NewDec(int64(params.DowntimeJailDuration))
+	for range recentJailTimes {
+		slashFraction = sdk.MinDec(slashFraction.Mul(params.SlashFractionDowntimeMultiplier), sdk.OneDec())
+		duration = sdk.MinDec(duration.Mul(params.DowntimeJailDurationMultiplier), maxDuration)
+	}
+
+	return slashFraction, time.Duration(duration.TruncateInt64()), recentJailTimes
+}
+
+// IsChainOutage returns whether the validators which missed signing a block
+// hold at least the outage missed power threshold of the voting power of its
+// commit, in which case their missed signatures are not counted. The voting
+// power which missed signing the block is returned along.
+func (k Keeper) IsChainOutage(ctx sdk.Context, votes []abci.VoteInfo) (outage bool, missedPower int64) {
+	var totalPower int64
+	for _, vote := range votes {
+		totalPower += vote.Validator.Power
+		if !vote.SignedLastBlock {
+			missedPower += vote.Validator.Power
+		}
+	}
+
+	threshold := k.OutageMissedPowerThreshold(ctx)
+	if threshold.IsZero() || totalPower == 0 {
+		return false, missedPower
+	}
+
+	return sdk.NewDec(missedPower).QuoInt64(totalPower).GTE(threshold), missedPower
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -15,6 +16,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
@@ -139,6 +141,34 @@ func (s *KeeperTestSuite) TestUnJailNotBonded() {
 	tstaking.CheckValidator(addr, -1, false)
 }
 
+func (s *KeeperTestSuite) TestUnjailGlobalMinSelfBond() {
+	ctx := s.ctx
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	addr, val := valAddrs[0], pks[0]
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	s.stakingKeeper.Jail(ctx, sdk.ConsAddress(val.Address()))
+	tstaking.CheckValidator(addr, -1, true)
+	ctx = ctx.WithBlockTime(time.Unix(1, 0))
+
+	// the self-bond is below the global minimum self-bond
+	p := s.stakingKeeper.GetParams(ctx)
+	p.GlobalMinSelfBond = s.stakingKeeper.TokensFromConsensusPower(ctx, 101)
+	s.Require().NoError(s.stakingKeeper.SetParams(ctx, p))
+	s.Require().ErrorIs(s.slashingKeeper.Unjail(ctx, addr), types.ErrSelfDelegationTooLowToUnjail)
+
+	p.GlobalMinSelfBond = s.stakingKeeper.TokensFromConsensusPower(ctx, 100)
+	s.Require().NoError(s.stakingKeeper.SetParams(ctx, p))
+	s.Require().NoError(s.slashingKeeper.Unjail(ctx, addr))
+	tstaking.CheckValidator(addr, -1, false)
+}
+
 // Test a new validator entering the validator set
 // Ensure that SigningInfo.StartHeight is set correctly
 // and that they are not immediately jailed
@@ -344,6 +374,179 @@ func (s *KeeperTestSuite) TestValidatorDippingInAndOut() {
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
 
+// Test that the signing info and the missed blocks of a validator follow its
+// consensus key rotation, and that the votes signed with the old key are
+// counted for the new key
+func (s *KeeperTestSuite) TestHandleRotatedConsPubKey() {
+	ctx := s.ctx
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(2)
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	power := int64(100)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, oldPk, power, true)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	// miss a few blocks with the old key
+	height := int64(0)
+	for ; height < 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, false)
+	}
+
+	validator, found := s.stakingKeeper.GetValidator(ctx, addr)
+	s.Require().True(found)
+	s.Require().NoError(s.stakingKeeper.RotateConsPubKey(ctx, validator, newPk))
+
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	_, found = s.slashingKeeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	s.Require().False(found)
+	_, err := s.slashingKeeper.GetPubkey(ctx, oldPk.Address())
+	s.Require().Error(err)
+	_, err = s.slashingKeeper.GetPubkey(ctx, newPk.Address())
+	s.Require().NoError(err)
+
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	s.Require().True(found)
+	s.Require().Equal(newConsAddr.String(), info.Address)
+	s.Require().Equal(int64(10), info.MissedBlocksCounter)
+	s.Require().Len(s.slashingKeeper.GetValidatorMissedBlocks(ctx, newConsAddr), 10)
+	s.Require().Empty(s.slashingKeeper.GetValidatorMissedBlocks(ctx, oldConsAddr))
+
+	// the last commit can still contain votes signed with the old key
+	ctx = ctx.WithBlockHeight(height)
+	s.slashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), power, false)
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	s.Require().Equal(int64(11), info.MissedBlocksCounter)
+}
+
+func (s *KeeperTestSuite) TestDowntimeEscalation() {
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeLookbackWindow = 2 * time.Hour
+	params.SlashFractionDowntimeMultiplier = sdk.NewDec(2)
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	// missBlocks misses blocks until the validator is jailed
+	height := int64(0)
+	missBlocks := func() {
+		for !s.stakingKeeper.ValidatorByConsAddr(ctx, consAddr).IsJailed() {
+			height++
+			ctx = ctx.WithBlockHeight(height)
+			s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, s.stakingKeeper)
+	}
+	unjail := func() {
+		info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		s.Require().True(found)
+		ctx = ctx.WithBlockTime(info.JailedUntil)
+		_, err := s.msgServer.Unjail(sdk.WrapSDKContext(ctx), types.NewMsgUnjail(addr))
+		s.Require().NoError(err)
+		staking.EndBlocker(ctx, s.stakingKeeper)
+	}
+	tokens := func() sdk.Int {
+		return s.stakingKeeper.Validator(ctx, addr).GetTokens()
+	}
+
+	// the first downtime is penalized with the base slash fraction and jail duration
+	missBlocks()
+	firstJailTime := ctx.BlockTime()
+	info, _ := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().Equal(firstJailTime.Add(time.Hour), info.JailedUntil)
+	s.Require().Equal([]time.Time{firstJailTime}, info.DowntimeJailTimes)
+	amt = amt.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+	s.Require().Equal(amt, tokens())
+
+	// a downtime within the lookback window doubles both penalties
+	unjail()
+	missBlocks()
+	secondJailTime := ctx.BlockTime()
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().Equal(secondJailTime.Add(2*time.Hour), info.JailedUntil)
+	s.Require().Equal([]time.Time{firstJailTime, secondJailTime}, info.DowntimeJailTimes)
+	amt = amt.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, 2))
+	s.Require().Equal(amt, tokens())
+
+	// the jailings older than the lookback window are pruned and no longer
+	// escalate the penalties
+	unjail()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	missBlocks()
+	thirdJailTime := ctx.BlockTime()
+	info, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().Equal(thirdJailTime.Add(time.Hour), info.JailedUntil)
+	s.Require().Equal([]time.Time{thirdJailTime}, info.DowntimeJailTimes)
+	amt = amt.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+	s.Require().Equal(amt, tokens())
+}
+
+func (s *KeeperTestSuite) TestChainOutageGrace() {
+	ctx := s.ctx
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 2, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 20, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 80, true)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.OutageMissedPowerThreshold = sdk.NewDecWithPrec(3, 1)
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	beginBlock := func(signed0, signed1 bool) {
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{
+			LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{
+				{Validator: abci.Validator{Address: pks[0].Address(), Power: 20}, SignedLastBlock: signed0},
+				{Validator: abci.Validator{Address: pks[1].Address(), Power: 80}, SignedLastBlock: signed1},
+			}},
+		}, s.slashingKeeper)
+	}
+	missedBlocks := func(i int) int64 {
+		info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(pks[i].Address()))
+		s.Require().True(found)
+		return info.MissedBlocksCounter
+	}
+
+	// missing less than the threshold counts the missed signatures
+	beginBlock(false, true)
+	s.Require().Equal(int64(1), missedBlocks(0))
+
+	// missing at least the threshold is a chain-wide outage
+	ctx = ctx.WithBlockHeight(1)
+	beginBlock(false, false)
+	s.Require().Equal(int64(1), missedBlocks(0))
+	s.Require().Equal(int64(0), missedBlocks(1))
+
+	// unless the outage grace is disabled
+	params.OutageMissedPowerThreshold = sdk.ZeroDec()
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(2)
+	beginBlock(false, false)
+	s.Require().Equal(int64(2), missedBlocks(0))
+	s.Require().Equal(int64(1), missedBlocks(1))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
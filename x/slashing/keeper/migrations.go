@@ -5,6 +5,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v043"
 	v3 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v3"
+	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -28,5 +31,46 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 // and managed by the x/params modules and stores them directly into the x/slashing
 // module state.
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
-	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), legacySubspace{m.legacySubspace}, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/slashing module state from the consensus
+// version 3 to version 4. Specifically, it sets the downtime escalation and
+// outage grace parameters to their default values.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it moves the missed block bit arrays
+// to bitmaps stored in chunks.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// legacySubspace wraps the legacy x/params subspace read by the v3 migration.
+// The subspace holds no DowntimeJailDurationMultiplier,
+// SlashFractionDowntimeMultiplier and OutageMissedPowerThreshold, so they are
+// set to their default values for the migrated params to be valid, as the v4
+// migration does for chains already past v3.
+type legacySubspace struct {
+	exported.Subspace
+}
+
+func (s legacySubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	s.Subspace.GetParamSet(ctx, ps)
+
+	params, ok := ps.(*types.Params)
+	if !ok {
+		return
+	}
+	if params.DowntimeJailDurationMultiplier.IsNil() {
+		params.DowntimeJailDurationMultiplier = types.DefaultDowntimeJailDurationMultiplier
+	}
+	if params.SlashFractionDowntimeMultiplier.IsNil() {
+		params.SlashFractionDowntimeMultiplier = types.DefaultSlashFractionDowntimeMultiplier
+	}
+	if params.OutageMissedPowerThreshold.IsNil() {
+		params.OutageMissedPowerThreshold = types.DefaultOutageMissedPowerThreshold
+	}
 }
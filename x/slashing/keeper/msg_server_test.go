@@ -39,11 +39,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      0,
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              0,
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: true,
@@ -54,11 +58,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      invalidVal,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              invalidVal,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: true,
@@ -69,11 +77,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(0),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(0),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: true,
@@ -84,11 +96,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(10),
-					SlashFractionDoubleSign: invalidVal,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(10),
+					SlashFractionDoubleSign:         invalidVal,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: true,
@@ -99,26 +115,53 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(10),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   invalidVal,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(10),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           invalidVal,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime jail duration multiplier",
+			request: &types.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: types.Params{
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(10),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  types.MaxDowntimeMultiplier.Add(sdk.OneDec()),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail duration multiplier cannot be greater than",
+		},
 		{
 			name: "set full valid params",
 			request: &types.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: types.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackWindow:          time.Hour,
+					DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+					SlashFractionDowntimeMultiplier: sdk.OneDec(),
+					OutageMissedPowerThreshold:      sdk.ZeroDec(),
 				},
 			},
 			expectErr: false,
@@ -37,6 +37,26 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// DowntimeLookbackWindow - duration during which a downtime jailing escalates the next downtime penalties
+func (k Keeper) DowntimeLookbackWindow(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).DowntimeLookbackWindow
+}
+
+// DowntimeJailDurationMultiplier - factor applied to the downtime jail duration per recent downtime jailing
+func (k Keeper) DowntimeJailDurationMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).DowntimeJailDurationMultiplier
+}
+
+// SlashFractionDowntimeMultiplier - factor applied to the downtime slash fraction per recent downtime jailing
+func (k Keeper) SlashFractionDowntimeMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).SlashFractionDowntimeMultiplier
+}
+
+// OutageMissedPowerThreshold - fraction of missing voting power from which missed signatures are forgiven
+func (k Keeper) OutageMissedPowerThreshold(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).OutageMissedPowerThreshold
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -29,11 +29,15 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set invalid signed blocks window",
 			input: types.Params{
-				SignedBlocksWindow:      0,
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              0,
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: true,
 			expErrMsg: "signed blocks window must be positive",
@@ -41,11 +45,15 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set invalid min signed per window",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      invalidVal,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              invalidVal,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: true,
 			expErrMsg: "min signed per window cannot be negative",
@@ -53,11 +61,15 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set invalid downtime jail duration",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(0),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(0),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: true,
 			expErrMsg: "downtime jail duration must be positive",
@@ -65,11 +77,15 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set invalid slash fraction double sign",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(10),
-				SlashFractionDoubleSign: invalidVal,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(10),
+				SlashFractionDoubleSign:         invalidVal,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: true,
 			expErrMsg: "double sign slash fraction cannot be negative",
@@ -77,23 +93,79 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set invalid slash fraction downtime",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(10),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   invalidVal,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(10),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           invalidVal,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime jail duration multiplier",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(10),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDecWithPrec(5, 1),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail duration multiplier cannot be less than 1",
+		},
+		{
+			name: "set invalid outage missed power threshold",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(10),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      invalidVal,
+			},
+			expectErr: true,
+			expErrMsg: "outage missed power threshold cannot be negative",
+		},
+		{
+			name: "set outage missed power threshold greater than 1/3",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(10),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.NewDecWithPrec(34, 2),
+			},
+			expectErr: true,
+			expErrMsg: "outage missed power threshold cannot be greater than 1/3",
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeLookbackWindow:          time.Hour,
+				DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+				SlashFractionDowntimeMultiplier: sdk.OneDec(),
+				OutageMissedPowerThreshold:      sdk.ZeroDec(),
 			},
 			expectErr: false,
 		},
@@ -3,8 +3,6 @@ package keeper
 import (
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -37,6 +35,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo deletes the signing info of a validator
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -57,35 +61,37 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 // GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-	var missed gogotypes.BoolValue
-	if bz == nil {
-		// lazy: treat empty key as not missed
+	chunk := store.Get(types.ValidatorMissedBlockBitmapKey(address, index/types.MissedBlockBitmapChunkSize))
+	if chunk == nil {
+		// lazy: treat empty chunk as not missed
 		return false
 	}
-	k.cdc.MustUnmarshal(bz, &missed)
 
-	return missed.Value
+	return getMissedBlockBit(chunk, index%types.MissedBlockBitmapChunkSize)
 }
 
 // IterateValidatorMissedBlockBitArray iterates over the signed blocks window
-// and performs a callback function
+// and performs a callback function. The bitmap being stored in chunks, the
+// callback is only called for the indexes of the chunks in the store.
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool),
 ) {
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed gogotypes.BoolValue
-		bz := store.Get(types.ValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
-
-		k.cdc.MustUnmarshal(bz, &missed)
-		if handler(index, missed.Value) {
-			break
+	window := k.SignedBlocksWindow(ctx)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		chunkIndex := types.ValidatorMissedBlockBitmapChunkIndex(iter.Key())
+		chunk := iter.Value()
+		for bit := int64(0); bit < int64(len(chunk))*8; bit++ {
+			index := chunkIndex*types.MissedBlockBitmapChunkSize + bit
+			if index >= window {
+				return
+			}
+
+			if handler(index, getMissedBlockBit(chunk, bit)) {
+				return
+			}
 		}
 	}
 }
@@ -94,7 +100,9 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) []types.MissedBlock {
 	missedBlocks := []types.MissedBlock{}
 	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
-		missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		if missed {
+			missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		}
 		return false
 	})
 
@@ -140,11 +148,52 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 }
 
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
-// missed a block in the current window
+// missed a block in the current window. A chunk of the bitmap is deleted once
+// none of its bits is set.
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.ValidatorMissedBlockBitArrayKey(address, index), bz)
+	key := types.ValidatorMissedBlockBitmapKey(address, index/types.MissedBlockBitmapChunkSize)
+
+	// the chunk is copied as the store may share its value
+	chunk := store.Get(key)
+	if chunk == nil {
+		if !missed {
+			return
+		}
+		chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+	} else {
+		chunk = append([]byte{}, chunk...)
+	}
+
+	setMissedBlockBit(chunk, index%types.MissedBlockBitmapChunkSize, missed)
+	for _, b := range chunk {
+		if b != 0 {
+			store.Set(key, chunk)
+			return
+		}
+	}
+	store.Delete(key)
+}
+
+// moveValidatorMissedBlockBitArray moves the missed blocks array of a
+// validator to another consensus address
+func (k Keeper) moveValidatorMissedBlockBitArray(ctx sdk.Context, from, to sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var (
+		chunkIndexes []int64
+		chunks       [][]byte
+	)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(from))
+	for ; iter.Valid(); iter.Next() {
+		chunkIndexes = append(chunkIndexes, types.ValidatorMissedBlockBitmapChunkIndex(iter.Key()))
+		chunks = append(chunks, iter.Value())
+	}
+	iter.Close()
+
+	k.clearValidatorMissedBlockBitArray(ctx, from)
+	for i, chunkIndex := range chunkIndexes {
+		store.Set(types.ValidatorMissedBlockBitmapKey(to, chunkIndex), chunks[i])
+	}
 }
 
 // clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
@@ -156,3 +205,17 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// getMissedBlockBit returns the bit of a missed block bitmap chunk
+func getMissedBlockBit(chunk []byte, bit int64) bool {
+	return chunk[bit/8]&(1<<(bit%8)) != 0
+}
+
+// setMissedBlockBit sets the bit of a missed block bitmap chunk
+func setMissedBlockBit(chunk []byte, bit int64, missed bool) {
+	if missed {
+		chunk[bit/8] |= 1 << (bit % 8)
+	} else {
+		chunk[bit/8] &^= 1 << (bit % 8)
+	}
+}
@@ -42,6 +42,42 @@ func (suite *KeeperTestSuite) TestGetSetValidatorMissedBlockBitArray() {
 	suite.Require().True(missed) // now should be missed
 }
 
+func (suite *KeeperTestSuite) TestValidatorMissedBlockBitmapChunks() {
+	ctx := suite.ctx
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+
+	params := suite.slashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 2*types.MissedBlockBitmapChunkSize + 10
+	suite.Require().NoError(suite.slashingKeeper.SetParams(ctx, params))
+
+	// bits set in the first and last chunks
+	lastIndex := params.SignedBlocksWindow - 1
+	suite.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	suite.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, lastIndex, true)
+	suite.Require().False(suite.slashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, types.MissedBlockBitmapChunkSize+1))
+	suite.Require().Equal([]types.MissedBlock{
+		types.NewMissedBlock(1, true),
+		types.NewMissedBlock(lastIndex, true),
+	}, suite.slashingKeeper.GetValidatorMissedBlocks(ctx, consAddr))
+
+	// the iteration covers the indexes of the stored chunks within the window
+	countIndexes := func() (indexes int64) {
+		suite.slashingKeeper.IterateValidatorMissedBlockBitArray(ctx, consAddr, func(index int64, missed bool) (stop bool) {
+			indexes++
+			return false
+		})
+		return indexes
+	}
+	suite.Require().Equal(int64(types.MissedBlockBitmapChunkSize+10), countIndexes())
+
+	// a chunk without any bit set is deleted
+	suite.slashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, false)
+	suite.Require().Equal(int64(10), countIndexes())
+	suite.Require().Equal([]types.MissedBlock{
+		types.NewMissedBlock(lastIndex, true),
+	}, suite.slashingKeeper.GetValidatorMissedBlocks(ctx, consAddr))
+}
+
 func (suite *KeeperTestSuite) TestTombstoned() {
 	ctx := suite.ctx
 	addrDels := suite.addrDels
@@ -21,7 +21,7 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	}
 
 	tokens := validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
-	minSelfBond := validator.GetMinSelfDelegation()
+	minSelfBond := sdk.MaxInt(validator.GetMinSelfDelegation(), k.sk.GlobalMinSelfBond(ctx))
 	if tokens.LT(minSelfBond) {
 		return sdkerrors.Wrapf(
 			types.ErrSelfDelegationTooLowToUnjail, "%s less than %s", tokens, minSelfBond,
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v042"
 	v043slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v043"
+	v5slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -37,7 +38,7 @@ func TestStoreMigration(t *testing.T) {
 		{
 			"ValidatorMissedBlockBitArrayKey",
 			v040slashing.ValidatorMissedBlockBitArrayKey(consAddr, 2),
-			types.ValidatorMissedBlockBitArrayKey(consAddr, 2),
+			v5slashing.ValidatorMissedBlockBitArrayKey(consAddr, 2),
 		},
 		{
 			"AddrPubkeyRelationKey",
@@ -0,0 +1,45 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+const (
+	ModuleName = "slashing"
+)
+
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/slashing module state from the consensus version 3 to
+// version 4. Specifically, it sets the DowntimeJailDurationMultiplier,
+// SlashFractionDowntimeMultiplier and OutageMissedPowerThreshold params to
+// their default values, the DowntimeLookbackWindow param defaulting to zero.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	cdc.MustUnmarshal(bz, &params)
+
+	// the multipliers are invalid when zero
+	if params.DowntimeJailDurationMultiplier.IsNil() || params.DowntimeJailDurationMultiplier.IsZero() {
+		params.DowntimeJailDurationMultiplier = types.DefaultDowntimeJailDurationMultiplier
+	}
+	if params.SlashFractionDowntimeMultiplier.IsNil() || params.SlashFractionDowntimeMultiplier.IsZero() {
+		params.SlashFractionDowntimeMultiplier = types.DefaultSlashFractionDowntimeMultiplier
+	}
+	if params.OutageMissedPowerThreshold.IsNil() {
+		params.OutageMissedPowerThreshold = types.DefaultOutageMissedPowerThreshold
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the downtime escalation params were added
+	oldParams := types.Params{
+		SignedBlocksWindow:      types.DefaultSignedBlocksWindow,
+		MinSignedPerWindow:      types.DefaultMinSignedPerWindow,
+		DowntimeJailDuration:    types.DefaultDowntimeJailDuration,
+		SlashFractionDoubleSign: types.DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:   types.DefaultSlashFractionDowntime,
+	}
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.DefaultParams(), res)
+}
@@ -0,0 +1,112 @@
+package v5
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+const (
+	ModuleName = "slashing"
+)
+
+var ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02}
+
+// ValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitArrayKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorMissedBlockBitArrayKey - stored by *Consensus* address (not operator address)
+// and by index, each bit being stored in its own entry
+func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(i))
+
+	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
+}
+
+// Migrate migrates the x/slashing module state from the consensus version 4 to
+// version 5. Specifically, it moves the missed block bit arrays, stored with an
+// entry per bit, to bitmaps stored in chunks of MissedBlockBitmapChunkSize bits.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	bitArrayStore := prefix.NewStore(store, ValidatorMissedBlockBitArrayKeyPrefix)
+
+	// the entries of a validator being contiguous, its address is collected
+	// once before migrating its entries
+	var addrs []sdk.ConsAddress
+	iter := bitArrayStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		addr := missedBlockBitArrayAddress(iter.Key())
+		if len(addrs) == 0 || !bytes.Equal(addrs[len(addrs)-1], addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	iter.Close()
+
+	for _, addr := range addrs {
+		migrateValidatorMissedBlockBitArray(store, cdc, addr)
+	}
+
+	return nil
+}
+
+// missedBlockBitArrayAddress extracts the address from a missed block bit
+// array key without its prefix.
+func missedBlockBitArrayAddress(key []byte) sdk.ConsAddress {
+	kv.AssertKeyAtLeastLength(key, 1)
+	addrLen := int(key[0])
+	kv.AssertKeyLength(key, 1+addrLen+8)
+
+	return sdk.ConsAddress(append([]byte{}, key[1:1+addrLen]...))
+}
+
+// migrateValidatorMissedBlockBitArray moves the missed block bit array of a
+// validator to its missed block bitmap, only the missed blocks being kept.
+func migrateValidatorMissedBlockBitArray(store sdk.KVStore, cdc codec.BinaryCodec, addr sdk.ConsAddress) {
+	validatorStore := prefix.NewStore(store, ValidatorMissedBlockBitArrayPrefixKey(addr))
+
+	var (
+		oldKeys      [][]byte
+		chunkIndexes []int64
+		chunks       = make(map[int64][]byte)
+	)
+	iter := validatorStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		oldKeys = append(oldKeys, key)
+
+		var missed gogotypes.BoolValue
+		cdc.MustUnmarshal(iter.Value(), &missed)
+		if !missed.Value {
+			continue
+		}
+
+		index := int64(binary.LittleEndian.Uint64(key))
+		chunkIndex, bit := index/types.MissedBlockBitmapChunkSize, index%types.MissedBlockBitmapChunkSize
+		chunk, found := chunks[chunkIndex]
+		if !found {
+			chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+			chunks[chunkIndex] = chunk
+			chunkIndexes = append(chunkIndexes, chunkIndex)
+		}
+		chunk[bit/8] |= 1 << (bit % 8)
+	}
+	iter.Close()
+
+	// the chunks share the prefix of the entries, which are deleted first
+	for _, key := range oldKeys {
+		validatorStore.Delete(key)
+	}
+	for _, chunkIndex := range chunkIndexes {
+		store.Set(types.ValidatorMissedBlockBitmapKey(addr, chunkIndex), chunks[chunkIndex])
+	}
+}
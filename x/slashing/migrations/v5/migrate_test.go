@@ -0,0 +1,67 @@
+package v5_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v5.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	consAddr1, consAddr2 := sdk.ConsAddress(addr1), sdk.ConsAddress(addr2)
+
+	setBit := func(addr sdk.ConsAddress, index int64, missed bool) {
+		store.Set(v5.ValidatorMissedBlockBitArrayKey(addr, index), cdc.MustMarshal(&gogotypes.BoolValue{Value: missed}))
+	}
+
+	// bits in the first and third chunks of the first validator, and only
+	// blocks which were not missed for the second one
+	setBit(consAddr1, 0, true)
+	setBit(consAddr1, 1, false)
+	setBit(consAddr1, 9, true)
+	setBit(consAddr1, 2*types.MissedBlockBitmapChunkSize+3, true)
+	setBit(consAddr2, 5, false)
+
+	require.NoError(t, v5.Migrate(ctx, store, cdc))
+
+	chunk := store.Get(types.ValidatorMissedBlockBitmapKey(consAddr1, 0))
+	require.Len(t, chunk, types.MissedBlockBitmapChunkSize/8)
+	require.Equal(t, byte(0x01), chunk[0])
+	require.Equal(t, byte(0x02), chunk[1])
+
+	require.Nil(t, store.Get(types.ValidatorMissedBlockBitmapKey(consAddr1, 1)))
+
+	chunk = store.Get(types.ValidatorMissedBlockBitmapKey(consAddr1, 2))
+	require.Equal(t, byte(0x08), chunk[0])
+
+	// the entries per bit are deleted
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(consAddr1))
+	var n int
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+	iter.Close()
+	require.Equal(t, 2, n)
+
+	iter = sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayPrefixKey(consAddr2))
+	require.False(t, iter.Valid())
+	iter.Close()
+}
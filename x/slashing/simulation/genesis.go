@@ -83,6 +83,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		types.DefaultDowntimeLookbackWindow, types.DefaultDowntimeJailDurationMultiplier,
+		types.DefaultSlashFractionDowntimeMultiplier, types.DefaultOutageMissedPowerThreshold,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
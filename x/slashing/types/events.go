@@ -4,6 +4,7 @@ package types
 const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
+	EventTypeOutage   = "outage"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,8 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyJailedUntil  = "jailed_until"
+	AttributeKeyMissedPower  = "missed_power"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
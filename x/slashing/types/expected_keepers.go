@@ -42,6 +42,11 @@ type StakingKeeper interface {
 	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI // get a particular validator by consensus address
 
+	// GetRotatedConsAddr returns the new consensus address of a validator
+	// which rotated its consensus key away from the given consensus address,
+	// until the rotation matures.
+	GetRotatedConsAddr(sdk.Context, sdk.ConsAddress) (sdk.ConsAddress, bool)
+
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) math.Int
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
@@ -53,6 +58,9 @@ type StakingKeeper interface {
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
+
+	// GlobalMinSelfBond returns the minimum self-bond of all the validators
+	GlobalMinSelfBond(sdk.Context) math.Int
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -20,6 +20,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize is the number of bits stored in each chunk of
+	// a missed block bitmap
+	MissedBlockBitmapChunkSize = 1024
 )
 
 // Keys for slashing store
@@ -27,7 +31,7 @@ const (
 //
 // - 0x01<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
+// - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunkIndex_Bytes>: []byte (bitmap chunk)
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
 
@@ -57,14 +61,22 @@ func ValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorMissedBlockBitArrayKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
-// ValidatorMissedBlockBitArrayKey - stored by *Consensus* address (not operator address)
-func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
+// ValidatorMissedBlockBitmapKey - stored by *Consensus* address (not operator address)
+// and by chunk index, a chunk holding MissedBlockBitmapChunkSize bits
+func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, uint64(i))
+	binary.BigEndian.PutUint64(b, uint64(chunkIndex))
 
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// ValidatorMissedBlockBitmapChunkIndex - extract the chunk index from a missed
+// block bitmap key
+func ValidatorMissedBlockBitmapChunkIndex(key []byte) int64 {
+	kv.AssertKeyAtLeastLength(key, 10)
+	return int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
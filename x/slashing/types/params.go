@@ -11,25 +11,55 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultDowntimeLookbackWindow is set to 0, i.e. the downtime penalties
+	// don't escalate.
+	DefaultDowntimeLookbackWindow time.Duration = 0
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	// DefaultDowntimeJailDurationMultiplier doubles the jail duration for each
+	// downtime jailing in the lookback window.
+	DefaultDowntimeJailDurationMultiplier = sdk.NewDec(2)
+
+	// DefaultSlashFractionDowntimeMultiplier keeps the slash fraction constant.
+	DefaultSlashFractionDowntimeMultiplier = sdk.OneDec()
+
+	// MaxDowntimeMultiplier bounds the downtime multipliers, so that the
+	// escalated jail duration, capped at the max duration, can't overflow a
+	// Dec when multiplied.
+	MaxDowntimeMultiplier = sdk.NewDec(100)
+
+	// DefaultOutageMissedPowerThreshold is set to 0, i.e. missed signatures
+	// are always counted.
+	DefaultOutageMissedPowerThreshold = sdk.ZeroDec()
+
+	// MaxOutageMissedPowerThreshold is the fraction of the voting power which
+	// can be missing from a commit.
+	MaxOutageMissedPowerThreshold = sdk.OneDec().QuoInt64(3)
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeLookbackWindow time.Duration, downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier sdk.Dec,
+	outageMissedPowerThreshold sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeLookbackWindow:          downtimeLookbackWindow,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		OutageMissedPowerThreshold:      outageMissedPowerThreshold,
 	}
 }
 
@@ -41,6 +71,10 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeLookbackWindow,
+		DefaultDowntimeJailDurationMultiplier,
+		DefaultSlashFractionDowntimeMultiplier,
+		DefaultOutageMissedPowerThreshold,
 	)
 }
 
@@ -61,6 +95,18 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeLookbackWindow(p.DowntimeLookbackWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeMultiplier("downtime jail duration", p.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+	if err := validateDowntimeMultiplier("downtime slash fraction", p.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+	if err := validateOutageMissedPowerThreshold(p.OutageMissedPowerThreshold); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -137,3 +183,41 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeLookbackWindow(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("downtime lookback window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeMultiplier(name string, v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s multiplier cannot be nil", name)
+	}
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("%s multiplier cannot be less than 1: %s", name, v)
+	}
+	if v.GT(MaxDowntimeMultiplier) {
+		return fmt.Errorf("%s multiplier cannot be greater than %s: %s", name, MaxDowntimeMultiplier, v)
+	}
+
+	return nil
+}
+
+func validateOutageMissedPowerThreshold(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("outage missed power threshold cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("outage missed power threshold cannot be negative: %s", v)
+	}
+	// the validators signing a commit hold more than two thirds of its voting
+	// power, so that a higher threshold would never be reached
+	if v.GT(MaxOutageMissedPowerThreshold) {
+		return fmt.Errorf("outage missed power threshold cannot be greater than 1/3: %s", v)
+	}
+
+	return nil
+}
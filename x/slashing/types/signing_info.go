@@ -32,9 +32,10 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Jail Times:   %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeJailTimes)
 }
 
 // unmarshal a validator signing info from a store value
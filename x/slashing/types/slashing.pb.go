@@ -48,6 +48,10 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Times at which the validator was jailed for downtime, oldest first. Those
+	// within the `DowntimeLookbackWindow` escalate the penalties of the next
+	// downtime, the older ones being pruned at that time.
+	DowntimeJailTimes []time.Time `protobuf:"bytes,7,rep,name=downtime_jail_times,json=downtimeJailTimes,proto3,stdtime" json:"downtime_jail_times"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +128,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeJailTimes() []time.Time {
+	if m != nil {
+		return m.DowntimeJailTimes
+	}
+	return nil
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,6 +142,22 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_lookback_window is the duration during which a downtime jailing
+	// escalates the penalties of the next downtime of the validator. Zero
+	// disables the escalation.
+	DowntimeLookbackWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_lookback_window,json=downtimeLookbackWindow,proto3,stdduration" json:"downtime_lookback_window"`
+	// downtime_jail_duration_multiplier is the factor applied to the downtime
+	// jail duration for each downtime jailing in the lookback window.
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier"`
+	// slash_fraction_downtime_multiplier is the factor applied to the downtime
+	// slash fraction for each downtime jailing in the lookback window.
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier"`
+	// outage_missed_power_threshold is the fraction of the voting power missing
+	// from a commit at or above which the block is considered a chain-wide
+	// outage, during which missed signatures are not counted. Zero disables the
+	// outage grace, and it can't be greater than 1/3, the most voting power
+	// which can miss a commit.
+	OutageMissedPowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=outage_missed_power_threshold,json=outageMissedPowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outage_missed_power_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,6 +207,13 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeLookbackWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeLookbackWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -190,43 +224,51 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xbf, 0xb4, 0x49, 0x3a, 0xe9, 0xe6, 0x9b, 0xa6, 0xd4, 0x8d, 0x84, 0x93, 0x76, 0x51,
+	0x65, 0x53, 0x87, 0x86, 0x1d, 0x3b, 0x42, 0xc5, 0x7f, 0x45, 0x95, 0x16, 0x10, 0x48, 0xc8, 0x1a,
+	0xdb, 0x13, 0x67, 0x88, 0x3d, 0x13, 0x3c, 0x63, 0xd2, 0x8a, 0x67, 0x40, 0xea, 0x8e, 0x2e, 0xbb,
+	0xe4, 0x01, 0x78, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x41, 0xe9, 0x86, 0xc7, 0x40, 0x9e, 0x19,
+	0xa7, 0xa1, 0x2d, 0xa8, 0xca, 0x2a, 0xf6, 0xbd, 0x67, 0xce, 0xb9, 0xe7, 0x5c, 0x8f, 0x02, 0xd6,
+	0x3c, 0xc6, 0x23, 0xc6, 0x9b, 0x3c, 0x44, 0xbc, 0x47, 0x68, 0xd0, 0x7c, 0xbf, 0xe1, 0x62, 0x81,
+	0x36, 0xc6, 0x05, 0x7b, 0x10, 0x33, 0xc1, 0xe0, 0x92, 0xc2, 0xd9, 0xe3, 0xb2, 0xc6, 0x55, 0x2b,
+	0x01, 0x0b, 0x98, 0xc4, 0x34, 0xd3, 0x27, 0x05, 0xaf, 0x5a, 0x01, 0x63, 0x41, 0x88, 0x9b, 0xf2,
+	0xcd, 0x4d, 0xba, 0x4d, 0x3f, 0x89, 0x91, 0x20, 0x8c, 0xea, 0x7e, 0xed, 0x62, 0x5f, 0x90, 0x08,
+	0x73, 0x81, 0xa2, 0x81, 0x06, 0x2c, 0x2b, 0x3d, 0x47, 0x31, 0x6b, 0x71, 0xf9, 0xb2, 0xfa, 0x31,
+	0x0f, 0x2a, 0x2f, 0x50, 0x48, 0x7c, 0x24, 0x58, 0xbc, 0x43, 0x02, 0x4a, 0x68, 0xf0, 0x88, 0x76,
+	0x19, 0x6c, 0x81, 0x22, 0xf2, 0xfd, 0x18, 0x73, 0x6e, 0x1a, 0x75, 0xa3, 0x31, 0xd7, 0x36, 0xbf,
+	0x7e, 0x59, 0xaf, 0xe8, 0xb3, 0x77, 0x55, 0x67, 0x47, 0xc4, 0x84, 0x06, 0x9d, 0x0c, 0x08, 0x57,
+	0xc0, 0x3c, 0x17, 0x28, 0x16, 0x4e, 0x0f, 0x93, 0xa0, 0x27, 0xcc, 0xff, 0xea, 0x46, 0x23, 0xdf,
+	0x29, 0xcb, 0xda, 0x43, 0x59, 0x4a, 0x21, 0x84, 0xfa, 0x78, 0xcf, 0x61, 0xdd, 0x2e, 0xc7, 0xc2,
+	0xcc, 0x2b, 0x88, 0xac, 0x3d, 0x93, 0x25, 0xf8, 0x00, 0xcc, 0xbf, 0x45, 0x24, 0xc4, 0xbe, 0x93,
+	0x50, 0x41, 0x42, 0x73, 0xa6, 0x6e, 0x34, 0xca, 0xad, 0xaa, 0xad, 0x5c, 0xda, 0x99, 0x4b, 0x7b,
+	0x37, 0x73, 0xd9, 0x2e, 0x1d, 0x9f, 0xd6, 0x72, 0x07, 0x3f, 0x6a, 0x46, 0xa7, 0xac, 0x4e, 0x3e,
+	0x4f, 0x0f, 0x42, 0x0b, 0x00, 0xc1, 0x22, 0x97, 0x0b, 0x46, 0xb1, 0x6f, 0xce, 0xd6, 0x8d, 0x46,
+	0xa9, 0x33, 0x51, 0x81, 0x2d, 0xb0, 0x18, 0x11, 0xce, 0xb1, 0xef, 0xb8, 0x21, 0xf3, 0xfa, 0xdc,
+	0xf1, 0x58, 0x42, 0x05, 0x8e, 0xcd, 0x82, 0x1c, 0x6a, 0x41, 0x35, 0xdb, 0xb2, 0x77, 0x4f, 0xb5,
+	0xe0, 0x2e, 0x58, 0xf0, 0xd9, 0x90, 0xa6, 0x09, 0x3b, 0xa9, 0x96, 0x93, 0x3e, 0x71, 0xb3, 0x58,
+	0xcf, 0x5f, 0x7b, 0xc6, 0xff, 0x33, 0x82, 0xc7, 0x88, 0x84, 0x12, 0x70, 0xa7, 0x74, 0x78, 0x54,
+	0xcb, 0xfd, 0x3a, 0xaa, 0x19, 0xab, 0x9f, 0x8a, 0xa0, 0xb0, 0x8d, 0x62, 0x14, 0x71, 0x78, 0x0b,
+	0x54, 0x38, 0x09, 0xe8, 0xf9, 0x78, 0x43, 0x42, 0x7d, 0x36, 0x94, 0xeb, 0xc8, 0x77, 0xa0, 0xea,
+	0xa9, 0xe9, 0x5e, 0xca, 0x0e, 0x44, 0xa9, 0x21, 0xea, 0xe8, 0x53, 0x03, 0x1c, 0x67, 0x47, 0xd2,
+	0x45, 0xcc, 0xb7, 0xed, 0x74, 0x84, 0xef, 0xa7, 0xb5, 0xb5, 0x80, 0x88, 0x5e, 0xe2, 0xda, 0x1e,
+	0x8b, 0xf4, 0xc7, 0xa0, 0x7f, 0xd6, 0xb9, 0xdf, 0x6f, 0x8a, 0xfd, 0x01, 0xe6, 0xf6, 0x26, 0xf6,
+	0x3a, 0x30, 0x22, 0x74, 0x47, 0x72, 0x6d, 0xe3, 0x58, 0x4b, 0xbc, 0x02, 0x37, 0xfe, 0xf4, 0x9f,
+	0x7d, 0x8b, 0x72, 0x93, 0xe5, 0xd6, 0xf2, 0xa5, 0x08, 0x36, 0x35, 0x40, 0x25, 0x70, 0x98, 0x26,
+	0x50, 0x99, 0x4c, 0x20, 0xeb, 0xc3, 0x3e, 0xa8, 0xca, 0x0b, 0xe1, 0x74, 0x63, 0xe4, 0xa5, 0x15,
+	0xc7, 0x67, 0x89, 0x1b, 0x62, 0xe9, 0xc7, 0x9c, 0x99, 0xca, 0xc2, 0x92, 0x64, 0xbc, 0xaf, 0x09,
+	0x37, 0x25, 0x5f, 0x6a, 0x09, 0x76, 0xc1, 0xd2, 0x25, 0x31, 0x35, 0x93, 0x39, 0x3b, 0x95, 0xd2,
+	0xe2, 0x05, 0x25, 0x45, 0x06, 0xdf, 0x00, 0x73, 0x9c, 0x57, 0xc8, 0x58, 0xdf, 0x45, 0x5e, 0x3f,
+	0xdb, 0x4a, 0xe1, 0xfa, 0x89, 0x8d, 0x43, 0x7f, 0xaa, 0x39, 0xf4, 0x3a, 0xf6, 0xc1, 0xca, 0xd5,
+	0xeb, 0x70, 0xa2, 0x24, 0x14, 0x64, 0x10, 0x12, 0x1c, 0x9b, 0xc5, 0xa9, 0x0c, 0x59, 0x57, 0x2d,
+	0x69, 0x6b, 0xcc, 0x0a, 0x3f, 0x80, 0xd5, 0xbf, 0x24, 0x38, 0xa9, 0x5d, 0x9a, 0x4a, 0xbb, 0x76,
+	0x65, 0x98, 0x13, 0xe2, 0xef, 0xc0, 0x4d, 0x96, 0x08, 0x14, 0x60, 0x47, 0xdf, 0xe0, 0x01, 0x1b,
+	0xe2, 0xd8, 0x11, 0xbd, 0x18, 0xf3, 0x1e, 0x0b, 0x7d, 0x73, 0x6e, 0x2a, 0xdd, 0xaa, 0x22, 0xdd,
+	0x92, 0x9c, 0xdb, 0x29, 0xe5, 0x6e, 0xc6, 0xd8, 0x7e, 0xf2, 0x79, 0x64, 0x19, 0xc7, 0x23, 0xcb,
+	0x38, 0x19, 0x59, 0xc6, 0xcf, 0x91, 0x65, 0x1c, 0x9c, 0x59, 0xb9, 0x93, 0x33, 0x2b, 0xf7, 0xed,
+	0xcc, 0xca, 0xbd, 0x5e, 0xff, 0xa7, 0xc2, 0xde, 0xf9, 0x5f, 0x82, 0x14, 0x73, 0x0b, 0x72, 0xd9,
+	0xb7, 0x7f, 0x0f, 0x00, 0xf9, 0x6c, 0xcf, 0x4e, 0x32, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +308,14 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if len(this.DowntimeJailTimes) != len(that1.DowntimeJailTimes) {
+		return false
+	}
+	for i := range this.DowntimeJailTimes {
+		if !this.DowntimeJailTimes[i].Equal(that1.DowntimeJailTimes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +352,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeLookbackWindow != that1.DowntimeLookbackWindow {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if !this.OutageMissedPowerThreshold.Equal(that1.OutageMissedPowerThreshold) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +386,18 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeJailTimes) > 0 {
+		for iNdEx := len(m.DowntimeJailTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DowntimeJailTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DowntimeJailTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintSlashing(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -387,6 +461,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.OutageMissedPowerThreshold.Size()
+		i -= size
+		if _, err := m.OutageMissedPowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +519,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +580,12 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if len(m.DowntimeJailTimes) > 0 {
+		for _, e := range m.DowntimeJailTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -488,6 +606,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeLookbackWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.OutageMissedPowerThreshold.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,6 +794,40 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeJailTimes = append(m.DowntimeJailTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DowntimeJailTimes[len(m.DowntimeJailTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -869,6 +1029,138 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutageMissedPowerThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutageMissedPowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
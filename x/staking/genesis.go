@@ -43,9 +43,56 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	for _, history := range data.ConsPubKeyRotationHistories {
+		if err := history.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateGenesisStatePendingCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastID)
+		}
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStatePendingCommissionChanges(changes []types.PendingCommissionChange) error {
+	vals := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if vals[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+		vals[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
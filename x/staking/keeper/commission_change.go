@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ScheduleCommissionChange schedules a change of the commission rate of a
+// validator to newRate, taking effect after the commission change notice
+// period and replacing the pending commission change of the validator if any.
+// The new rate is checked against the commission of the validator as of the
+// effective time, and again when the change takes effect.
+func (k Keeper) ScheduleCommissionChange(
+	ctx sdk.Context, validator types.Validator, newRate sdk.Dec,
+) (types.PendingCommissionChange, error) {
+	blockTime := ctx.BlockHeader().Time
+	effectiveTime := blockTime.Add(k.CommissionChangeNoticePeriod(ctx))
+
+	if err := validator.Commission.ValidateNewRate(newRate, effectiveTime); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return types.PendingCommissionChange{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "cannot set validator commission to less than minimum rate of %s", minRate,
+		)
+	}
+
+	valAddr := validator.GetOperator()
+	if change, found := k.GetPendingCommissionChange(ctx, valAddr); found {
+		k.DeletePendingCommissionChange(ctx, change)
+	}
+
+	change := types.NewPendingCommissionChange(valAddr, newRate, blockTime, effectiveTime)
+	k.SetPendingCommissionChange(ctx, change)
+
+	return change, nil
+}
+
+// GetPendingCommissionChange returns the pending commission change of a
+// validator.
+func (k Keeper) GetPendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) (change types.PendingCommissionChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingCommissionChangeEffectiveByValIndexKey(valAddr))
+	if bz == nil {
+		return change, false
+	}
+
+	effectiveTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	bz = store.Get(types.GetCommissionChangeQueueKey(effectiveTime, valAddr))
+	if bz == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &change)
+	return change, true
+}
+
+// SetPendingCommissionChange inserts a pending commission change in the
+// commission change queue.
+func (k Keeper) SetPendingCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := change.GetOperator()
+	store.Set(types.GetCommissionChangeQueueKey(change.EffectiveTime, valAddr), k.cdc.MustMarshal(&change))
+	store.Set(types.GetPendingCommissionChangeEffectiveByValIndexKey(valAddr), sdk.FormatTimeBytes(change.EffectiveTime))
+}
+
+// DeletePendingCommissionChange removes a pending commission change from the
+// commission change queue.
+func (k Keeper) DeletePendingCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := change.GetOperator()
+	store.Delete(types.GetCommissionChangeQueueKey(change.EffectiveTime, valAddr))
+	store.Delete(types.GetPendingCommissionChangeEffectiveByValIndexKey(valAddr))
+}
+
+// GetAllPendingCommissionChanges returns the pending commission changes of all
+// the validators, in the order they take effect.
+func (k Keeper) GetAllPendingCommissionChanges(ctx sdk.Context) []types.PendingCommissionChange {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommissionChangeQueueKey)
+	defer iterator.Close()
+
+	changes := []types.PendingCommissionChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingCommissionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// ApplyMatureCommissionChanges applies the pending commission changes which
+// take effect at currTime. A change which is no longer valid, e.g. because the
+// commission of the validator was decreased in the meantime, is dropped.
+func (k Keeper) ApplyMatureCommissionChanges(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.CommissionChangeQueueKey,
+		sdk.PrefixEndBytes(types.GetCommissionChangeTimeKey(currTime)))
+
+	var changes []types.PendingCommissionChange
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingCommissionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	iterator.Close()
+
+	for _, change := range changes {
+		k.DeletePendingCommissionChange(ctx, change)
+
+		if err := k.applyCommissionChange(ctx, change); err != nil {
+			k.Logger(ctx).Error(
+				"failed to apply commission change",
+				"validator", change.ValidatorAddress, "commission_rate", change.CommissionRate.String(), "err", err,
+			)
+		}
+	}
+}
+
+// applyCommissionChange sets the commission rate of a validator to the rate of
+// its pending commission change, discarding the state changes on failure.
+func (k Keeper) applyCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) error {
+	valAddr := change.GetOperator()
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	commission, err := k.UpdateValidatorCommission(cacheCtx, validator, change.CommissionRate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BeforeValidatorModified(cacheCtx, valAddr); err != nil {
+		return err
+	}
+
+	validator.Commission = commission
+	k.SetValidator(cacheCtx, validator)
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApplyCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, change.CommissionRate.String()),
+		),
+	)
+
+	return nil
+}
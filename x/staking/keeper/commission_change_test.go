@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestScheduleCommissionChange(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0).UTC()})
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	noticePeriod := 7 * 24 * time.Hour
+	params := app.StakingKeeper.GetParams(ctx)
+	params.CommissionChangeNoticePeriod = noticePeriod
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	// deliver handles a message as in a transaction, discarding its state
+	// changes on failure
+	deliver := func(msg sdk.Msg) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	rate := func() sdk.Dec {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		return validator.Commission.Rate
+	}
+	editRate := func(newRate sdk.Dec) error {
+		return deliver(types.NewMsgEditValidator(valAddr, types.Description{Moniker: "validator"}, &newRate, nil))
+	}
+
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, PKs[0], sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), types.Description{Moniker: "validator"},
+		types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(5, 2)), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, deliver(msg))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+
+	// commission increases can't be applied immediately with a notice period
+	require.ErrorIs(t, editRate(sdk.NewDecWithPrec(10, 2)), types.ErrCommissionIncreaseNotScheduled)
+
+	// the scheduled rate is checked against the commission rates
+	err = deliver(types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(15, 2)))
+	require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+
+	// a new schedule replaces the pending one
+	require.NoError(t, deliver(types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(8, 2))))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, deliver(types.NewMsgScheduleCommissionChange(valAddr, sdk.NewDecWithPrec(10, 2))))
+
+	expected := types.NewPendingCommissionChange(
+		valAddr, sdk.NewDecWithPrec(10, 2), ctx.BlockTime(), ctx.BlockTime().Add(noticePeriod),
+	)
+	res, err := querier.PendingCommissionChange(sdk.WrapSDKContext(ctx), &types.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.PendingCommissionChange)
+
+	allRes, err := querier.PendingCommissionChanges(sdk.WrapSDKContext(ctx), &types.QueryPendingCommissionChangesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingCommissionChange{expected}, allRes.PendingCommissionChanges)
+
+	// the change takes effect at the end of the notice period
+	ctx = ctx.WithBlockTime(expected.EffectiveTime.Add(-time.Second))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), rate())
+
+	ctx = ctx.WithBlockTime(expected.EffectiveTime)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), rate())
+
+	_, found := app.StakingKeeper.GetPendingCommissionChange(ctx, valAddr)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetAllPendingCommissionChanges(ctx))
+
+	// commission decreases still apply immediately
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(t, editRate(sdk.NewDecWithPrec(5, 2)))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), rate())
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey rotates the consensus public key of a validator to
+// newPubKey and charges the key rotation fee to its operator. A validator can
+// rotate its key once per unbonding period. Until the rotation matures, the old
+// consensus address keeps pointing to the validator so that the evidence of
+// infractions committed with the old key can be handled. The validator set
+// update replacing the old key with the new one is sent to Tendermint at the
+// end of the block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+	if maturity, found := k.GetConsPubKeyRotationMaturity(ctx, valAddr); found {
+		return sdkerrors.Wrapf(types.ErrConsPubKeyRotationLimit, "next rotation allowed after %s", maturity)
+	}
+
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return err
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+	validator.ConsensusPubkey = pkAny
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockTime(), fee)
+	if err != nil {
+		return err
+	}
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	k.setPendingConsPubKeyRotation(ctx, history)
+
+	maturity := ctx.BlockTime().Add(k.UnbondingTime(ctx))
+	k.insertConsPubKeyRotationQueue(ctx, valAddr, sdk.GetConsAddress(oldPubKey), newConsAddr, maturity)
+
+	return k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, fee)
+}
+
+// SetConsPubKeyRotationHistory sets a consensus public key rotation in the
+// history of its validator.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(history.GetOperator(), history.Height), k.cdc.MustMarshal(&history))
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus public key
+// rotations of a validator, oldest first.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) []types.ConsPubKeyRotationHistory {
+	return k.getConsPubKeyRotationHistories(ctx, types.GetConsPubKeyRotationHistoriesKey(valAddr))
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus public key rotations
+// of all the validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) []types.ConsPubKeyRotationHistory {
+	return k.getConsPubKeyRotationHistories(ctx, types.ConsPubKeyRotationHistoryKey)
+}
+
+func (k Keeper) getConsPubKeyRotationHistories(ctx sdk.Context, prefix []byte) []types.ConsPubKeyRotationHistory {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	histories := []types.ConsPubKeyRotationHistory{}
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// GetRotatedConsAddr returns the new consensus address of the validator which
+// rotated its consensus key away from oldConsAddr, as long as the rotation has
+// not matured.
+func (k Keeper) GetRotatedConsAddr(ctx sdk.Context, oldConsAddr sdk.ConsAddress) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRotatedConsAddrKey(oldConsAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.ConsAddress(bz), true
+}
+
+// GetConsPubKeyRotationMaturity returns the maturity time of the last
+// consensus public key rotation of a validator, as long as it has not matured.
+func (k Keeper) GetConsPubKeyRotationMaturity(ctx sdk.Context, valAddr sdk.ValAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConsPubKeyRotationMaturityByValIndexKey(valAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	maturity, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return maturity, true
+}
+
+// insertConsPubKeyRotationQueue keeps the old consensus address of a validator
+// pointing to the validator and to its new consensus address until maturity.
+func (k Keeper) insertConsPubKeyRotationQueue(
+	ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress, maturity time.Time,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), valAddr)
+	store.Set(types.GetRotatedConsAddrKey(oldConsAddr), newConsAddr)
+	store.Set(types.GetConsPubKeyRotationQueueKey(maturity, valAddr), oldConsAddr)
+	store.Set(types.GetConsPubKeyRotationMaturityByValIndexKey(valAddr), sdk.FormatTimeBytes(maturity))
+}
+
+// DeleteMatureConsPubKeyRotations deletes the old consensus addresses of the
+// consensus public key rotations which matured at currTime, after which the
+// validators can rotate their keys again.
+func (k Keeper) DeleteMatureConsPubKeyRotations(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ConsPubKeyRotationQueueKey,
+		sdk.PrefixEndBytes(types.GetConsPubKeyRotationTimeKey(currTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := types.ParseConsPubKeyRotationQueueKey(iterator.Key())
+		oldConsAddr := sdk.ConsAddress(iterator.Value())
+
+		store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+		store.Delete(types.GetRotatedConsAddrKey(oldConsAddr))
+		store.Delete(types.GetConsPubKeyRotationMaturityByValIndexKey(valAddr))
+		store.Delete(iterator.Key())
+	}
+}
+
+func (k Keeper) setPendingConsPubKeyRotation(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingConsPubKeyRotationKey(history.GetOperator()), k.cdc.MustMarshal(&history))
+}
+
+// getAndDeletePendingConsPubKeyRotations returns the old consensus public keys
+// of the validators which rotated their keys in the current block, by
+// operator address, and deletes the pending rotations.
+func (k Keeper) getAndDeletePendingConsPubKeyRotations(ctx sdk.Context) (map[string]cryptotypes.PubKey, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	oldPubKeys := make(map[string]cryptotypes.PubKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		oldPubKeys[history.OperatorAddress] = oldPubKey
+
+		store.Delete(iterator.Key())
+	}
+
+	return oldPubKeys, nil
+}
+
+// abciValidatorUpdateZero returns the validator update removing a consensus
+// public key from the validator set.
+func abciValidatorUpdateZero(pubKey cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmProtoPk, err := cryptocodec.ToTmProtoPublicKey(pubKey)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{PubKey: tmProtoPk, Power: 0}, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr, otherValAddr := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+
+	// deliver handles a message as in a transaction, discarding its state
+	// changes on failure
+	deliver := func(msg sdk.Msg) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	createValidator := func(valAddr sdk.ValAddress, pk cryptotypes.PubKey) {
+		msg, err := types.NewMsgCreateValidator(
+			valAddr, pk, sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), types.Description{Moniker: "validator"},
+			types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		require.NoError(t, err)
+		require.NoError(t, deliver(msg))
+	}
+	rotate := func(valAddr sdk.ValAddress, pk cryptotypes.PubKey) error {
+		msg, err := types.NewMsgRotateConsPubKey(valAddr, pk)
+		require.NoError(t, err)
+		return deliver(msg)
+	}
+	validatorUpdate := func(pk cryptotypes.PubKey, power int64) abci.ValidatorUpdate {
+		tmPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+		require.NoError(t, err)
+		return abci.ValidatorUpdate{PubKey: tmPk, Power: power}
+	}
+
+	createValidator(valAddr, PKs[0])
+	createValidator(otherValAddr, PKs[1])
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// the new key must not be used by another validator
+	require.ErrorIs(t, rotate(valAddr, PKs[1]), types.ErrValidatorPubKeyExists)
+
+	// rotate the key, paying the key rotation fee
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom)
+	feeCollectorBalance := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), bondDenom)
+	require.NoError(t, rotate(valAddr, PKs[2]))
+	require.Equal(t, balance.Sub(fee), app.BankKeeper.GetBalance(ctx, addrs[0], bondDenom))
+	require.Equal(t, feeCollectorBalance.Add(fee), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), bondDenom))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.Equal(t, sdk.GetConsAddress(PKs[2]), consAddr)
+
+	// the old consensus address still points to the validator until maturity
+	oldConsAddr := sdk.GetConsAddress(PKs[0])
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+	newConsAddr, found := app.StakingKeeper.GetRotatedConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, consAddr, newConsAddr)
+
+	history := app.StakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(t, history, 1)
+	require.Equal(t, fee, history[0].Fee)
+
+	// the key can only be rotated once per unbonding period
+	require.ErrorIs(t, rotate(valAddr, PKs[3]), types.ErrConsPubKeyRotationLimit)
+
+	// the old key is replaced by the new one in the validator set
+	updates, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{
+		validatorUpdate(PKs[0], 0),
+		validatorUpdate(PKs[2], 10),
+	}, updates)
+
+	updates, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// the rotation matures after the unbonding period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRotatedConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+
+	require.NoError(t, rotate(valAddr, PKs[3]))
+	require.Len(t, app.StakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr), 2)
+}
@@ -688,6 +688,13 @@ func (k Keeper) Delegate(
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// the delegations of other delegators are bounded by the validator bond factor
+	if !delegatorAddress.Equals(validator.GetOperator()) {
+		if err := k.checkValidatorBondFactor(ctx, validator.GetOperator()); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -1011,3 +1018,209 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation entries
+// of fromAddr with the given validator to toAddr. The moved entries keep their
+// creation height and completion time, so that they are slashed and released the
+// same way. It returns the amount transferred, which is less than wantAmt if the
+// unbonding balance is lower or toAddr reaches the maximum number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		amt := sdk.MinInt(entry.Balance, wantAmt)
+		if !amt.IsPositive() {
+			continue
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+		transferred = transferred.Add(amt)
+		wantAmt = wantAmt.Sub(amt)
+		modified = true
+
+		if amt.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amt), entry.Balance)
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares delegation shares of fromAddr with the
+// given validator to toAddr. The redelegation entries into the validator that the
+// remaining shares of fromAddr no longer cover are moved along, so that slashing
+// for infractions of their source validators applies to the new holder of the
+// shares. It returns the shares transferred, which are zero if toAddr would exceed
+// the maximum number of redelegation entries. The validator is jailed if fromAddr is
+// its operator and the transfer leaves it without enough self-bond.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	if !wantShares.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	// collect the redelegations into the validator, and bail out before any state
+	// change if moving all of their entries could exceed the maximum for toAddr
+	var redelegations []types.Redelegation
+	maxEntries := int(k.MaxEntries(ctx))
+	exceeded := false
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress != valAddr.String() {
+			return false
+		}
+
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		redTo, found := k.GetRedelegation(ctx, toAddr, valSrcAddr, valAddr)
+		if found && len(redTo.Entries)+len(red.Entries) > maxEntries {
+			exceeded = true
+			return true
+		}
+
+		redelegations = append(redelegations, red)
+		return false
+	})
+	if exceeded {
+		return sdk.ZeroDec(), nil
+	}
+
+	transferred := sdk.MinDec(delFrom.Shares, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// add the shares to the delegation of toAddr
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	var err error
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// remove the shares from the delegation of fromAddr
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if remaining.IsZero() {
+		err = k.RemoveDelegation(ctx, delFrom)
+	} else {
+		delFrom.Shares = remaining
+		k.SetDelegation(ctx, delFrom)
+		err = k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// If the delegation is the operator of the validator and the transfer decreases the
+	// validator's self-delegation below their minimum, or leaves a self-bond which an
+	// undelegation would be rejected for, we jail the validator. The transfer itself
+	// can't be rejected, as it claws back the delegations of vesting accounts.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		(validator.TokensFromShares(remaining).TruncateInt().LT(validator.MinSelfDelegation) ||
+			k.checkSelfBond(ctx, valAddr) != nil) {
+		k.jailValidator(ctx, validator)
+	}
+
+	// move the redelegation entries which exceed the remaining shares
+	excess := sdk.ZeroDec()
+	for _, red := range redelegations {
+		for _, entry := range red.Entries {
+			excess = excess.Add(entry.SharesDst)
+		}
+	}
+	excess = excess.Sub(remaining)
+
+	for _, red := range redelegations {
+		if !excess.IsPositive() {
+			break
+		}
+
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		for i := 0; i < len(red.Entries) && excess.IsPositive(); i++ {
+			entry := red.Entries[i]
+			shares := sdk.MinDec(entry.SharesDst, excess)
+			if !shares.IsPositive() {
+				continue
+			}
+
+			balance := sdk.NewDecFromInt(entry.InitialBalance).Mul(shares).Quo(entry.SharesDst).TruncateInt()
+			redTo := k.SetRedelegationEntry(ctx, toAddr, valSrcAddr, valAddr, entry.CreationHeight, entry.CompletionTime, balance, sdk.ZeroDec(), shares)
+			k.InsertRedelegationQueue(ctx, redTo, entry.CompletionTime)
+			excess = excess.Sub(shares)
+
+			if shares.Equal(entry.SharesDst) {
+				red.RemoveEntry(int64(i))
+				i--
+				continue
+			}
+
+			entry.SharesDst = entry.SharesDst.Sub(shares)
+			entry.InitialBalance = entry.InitialBalance.Sub(balance)
+			red.Entries[i] = entry
+		}
+
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+	}
+
+	return transferred, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -1001,3 +1002,109 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	tokens := func(power int64) math.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, power) }
+
+	// create two unbonding entries from the delegation of addrs[0] to valAddrs[1]
+	ctx = ctx.WithBlockHeight(1)
+	_, err := app.StakingKeeper.Undelegate(ctx, addrs[0], valAddrs[1], sdk.NewDecFromInt(tokens(4)))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	_, err = app.StakingKeeper.Undelegate(ctx, addrs[0], valAddrs[1], sdk.NewDecFromInt(tokens(3)))
+	require.NoError(t, err)
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 2)
+	entries := ubdFrom.Entries
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrs[0], addrs[2], valAddrs[1], tokens(5))
+	require.Equal(t, tokens(5), transferred)
+	require.Equal(t, tokens(2), app.StakingKeeper.GetDelegatorUnbonding(ctx, addrs[0]))
+	require.Equal(t, tokens(5), app.StakingKeeper.GetDelegatorUnbonding(ctx, addrs[2]))
+
+	ubdFrom, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, entries[1].CreationHeight, ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, tokens(2), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[2], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	for i, entry := range ubdTo.Entries {
+		require.Equal(t, entries[i].CreationHeight, entry.CreationHeight)
+		require.True(t, entries[i].CompletionTime.Equal(entry.CompletionTime))
+	}
+	require.Equal(t, tokens(4), ubdTo.Entries[0].Balance)
+	require.Equal(t, tokens(1), ubdTo.Entries[1].Balance)
+
+	// transfer more than the remaining balance
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrs[0], addrs[2], valAddrs[1], tokens(10))
+	require.Equal(t, tokens(2), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[0], valAddrs[1])
+	require.False(t, found)
+	require.Equal(t, tokens(7), app.StakingKeeper.GetDelegatorUnbonding(ctx, addrs[2]))
+
+	// nothing left to transfer
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrs[0], addrs[2], valAddrs[1], tokens(1))
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	shares := func(power int64) sdk.Dec {
+		return sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	// redelegate part of the delegation of addrs[0] from valAddrs[1] to valAddrs[0]
+	_, err := app.StakingKeeper.BeginRedelegation(ctx, addrs[0], valAddrs[1], valAddrs[0], shares(3))
+	require.NoError(t, err)
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, shares(12), delFrom.Shares)
+
+	// the remaining shares still cover part of the redelegation
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrs[0], addrs[2], valAddrs[0], shares(10))
+	require.NoError(t, err)
+	require.Equal(t, shares(10), transferred)
+
+	delFrom, found = app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, shares(2), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, shares(10), delTo.Shares)
+
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, addrs[0], valAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, redFrom.Entries, 1)
+	require.Equal(t, shares(2), redFrom.Entries[0].SharesDst)
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, addrs[2], valAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 1)
+	require.Equal(t, shares(1), redTo.Entries[0].SharesDst)
+	require.Equal(t, redFrom.Entries[0].CompletionTime, redTo.Entries[0].CompletionTime)
+
+	// transfer more than the remaining shares
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrs[0], addrs[2], valAddrs[0], shares(5))
+	require.NoError(t, err)
+	require.Equal(t, shares(2), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrs[0], valAddrs[1], valAddrs[0])
+	require.False(t, found)
+	redTo, found = app.StakingKeeper.GetRedelegation(ctx, addrs[2], valAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 2)
+
+	// the delegation doesn't exist anymore
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrs[0], addrs[2], valAddrs[0], shares(1))
+	require.NoError(t, err)
+	require.True(t, transferred.IsZero())
+}
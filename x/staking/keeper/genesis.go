@@ -29,6 +29,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
+		// validators exported before the introduction of liquid staking don't
+		// have liquid shares
+		if validator.LiquidShares.IsNil() {
+			validator.LiquidShares = sdk.ZeroDec()
+		}
+
 		k.SetValidator(ctx, validator)
 
 		// Manually set indices for the first time
@@ -96,6 +102,37 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, history := range data.ConsPubKeyRotationHistories {
+		k.SetConsPubKeyRotationHistory(ctx, history)
+
+		// restore the rotations which have not matured yet
+		maturity := history.Time.Add(data.Params.UnbondingTime)
+		if maturity.After(ctx.BlockTime()) {
+			oldPubKey, err := history.OldConsPubKey()
+			if err != nil {
+				panic(err)
+			}
+			newPubKey, err := history.NewConsPubKey()
+			if err != nil {
+				panic(err)
+			}
+			k.insertConsPubKeyRotationQueue(
+				ctx, history.GetOperator(), sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey), maturity,
+			)
+		}
+	}
+
+	for _, change := range data.PendingCommissionChanges {
+		k.SetPendingCommissionChange(ctx, change)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -190,13 +227,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+
+		ConsPubKeyRotationHistories: k.GetAllConsPubKeyRotationHistory(ctx),
+		PendingCommissionChanges:    k.GetAllPendingCommissionChanges(ctx),
 	}
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -87,3 +88,27 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate - call hook if registered
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, rotationFee)
+	}
+	return nil
+}
+
+// BeforeTokenizeSharesRedeemed - call hook if registered
+func (k Keeper) BeforeTokenizeSharesRedeemed(ctx sdk.Context, recordID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTokenizeSharesRedeemed(ctx, recordID)
+	}
+	return nil
+}
+
+// BeforeTokenizeShareRecordRemoved - call hook if registered
+func (k Keeper) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeTokenizeShareRecordRemoved(ctx, recordID)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+
+	var record types.TokenizeShareRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if err != nil {
+			panic(err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record and its indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetTokenizeShareRecordByIndexKey(record.Id)) {
+		return sdkerrors.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "id %d", record.Id)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(newOwner, id), []byte{})
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated through
+// tokenize share records to bonded validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	total := sdk.ZeroInt()
+	for _, validator := range k.GetAllValidators(ctx) {
+		if !validator.IsBonded() || validator.LiquidShares.IsZero() {
+			continue
+		}
+		total = total.Add(validator.TokensFromShares(validator.LiquidShares).TruncateInt())
+	}
+
+	return total
+}
+
+// checkLiquidStakingCaps checks that tokenizing the given shares and tokens of
+// a validator exceeds neither the global nor the validator liquid staking
+// cap. As the global cap is a fraction of the bonded tokens, it only applies
+// to the shares of bonded validators.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens math.Int) error {
+	params := k.GetParams(ctx)
+
+	if validator.IsBonded() {
+		totalBonded := k.TotalBondedTokens(ctx)
+		totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+		if !totalBonded.IsPositive() ||
+			sdk.NewDecFromInt(totalLiquid).Quo(sdk.NewDecFromInt(totalBonded)).GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	liquidShares := validator.LiquidShares.Add(shares)
+	if liquidShares.Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	return nil
+}
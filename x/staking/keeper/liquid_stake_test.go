@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	delAddr, ownerAddr, newOwnerAddr, holderAddr := addrs[0], addrs[1], addrs[2], addrs[3]
+	valAddr := sdk.ValAddress(addrs[0])
+
+	// create a bonded validator with an exchange rate of 1
+	selfBond := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	createMsg, err := types.NewMsgCreateValidator(
+		valAddr, PKs[0], sdk.NewCoin(bondDenom, selfBond), types.Description{Moniker: "validator"},
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), createMsg)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+	require.True(t, validator.LiquidShares.IsZero())
+
+	// shares must be delegated to be tokenized
+	tokenizeAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(
+		ownerAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), ownerAddr,
+	))
+	require.Error(t, err)
+
+	// tokenizing more than the validator liquid staking cap fails
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(3, 1)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(
+		delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), ownerAddr,
+	))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// tokenizing more than the global liquid staking cap fails
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(
+		delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), ownerAddr,
+	))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	// shares received from a redelegation in progress can't be tokenized
+	red := types.NewRedelegation(
+		delAddr, sdk.ValAddress(holderAddr), valAddr, ctx.BlockHeight(), ctx.BlockTime(), tokenizeAmount, sdk.NewDecFromInt(tokenizeAmount),
+	)
+	app.StakingKeeper.SetRedelegation(ctx, red)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(
+		delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), ownerAddr,
+	))
+	require.ErrorIs(t, err, types.ErrRedelegationInProgress)
+	app.StakingKeeper.RemoveRedelegation(ctx, red)
+
+	// tokenize part of the self delegation
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(
+		delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), ownerAddr,
+	))
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ownerAddr.String(), record.Owner)
+	require.Equal(t, valAddr.String(), record.Validator)
+	require.Equal(t, sdk.NewCoin(record.GetShareTokenDenom(), tokenizeAmount), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, record.GetShareTokenDenom()))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(selfBond.Sub(tokenizeAmount)), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), delegation.Shares)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmount), validator.LiquidShares)
+	require.Equal(t, selfBond, validator.Tokens)
+	require.Equal(t, tokenizeAmount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// only the liquid shares of bonded validators are counted
+	unbondedValidator := validator
+	unbondedValidator.Status = types.Unbonded
+	app.StakingKeeper.SetValidator(ctx, unbondedValidator)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(
+		record.Id, delAddr, newOwnerAddr,
+	))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(
+		record.Id, ownerAddr, newOwnerAddr,
+	))
+	require.NoError(t, err)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwnerAddr), 1)
+
+	// redeem a part of the share tokens from another holder
+	redeemAmount := sdk.NewCoin(record.GetShareTokenDenom(), tokenizeAmount.QuoRaw(4))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, holderAddr, sdk.NewCoins(redeemAmount)))
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(
+		holderAddr, redeemAmount.AddAmount(sdk.OneInt()),
+	))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(
+		holderAddr, redeemAmount,
+	))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, holderAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(redeemAmount.Amount), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, holderAddr, record.GetShareTokenDenom()).IsZero())
+
+	// redeem the rest of the share tokens, which deletes the record
+	remaining := app.BankKeeper.GetBalance(ctx, delAddr, record.GetShareTokenDenom())
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(
+		delAddr, remaining,
+	))
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(selfBond.Sub(redeemAmount.Amount)), delegation.Shares)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, validator.LiquidShares.IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking/migrations/v2"
 	"github.com/cosmos/cosmos-sdk/x/staking/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -34,5 +37,43 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 // Migrate3to4 migrates x/staking state from consensus version 3 to 4.
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
-	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, legacySubspace{m.legacySubspace})
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// legacySubspace wraps the legacy x/params subspace read by the v4 migration.
+// The subspace holds no GlobalMinSelfBond, ValidatorBondFactor,
+// GlobalLiquidStakingCap, ValidatorLiquidStakingCap and KeyRotationFee, so they
+// are set to their default values for the migrated params to be valid, as the
+// v5 migration does for chains already past v4.
+type legacySubspace struct {
+	exported.Subspace
+}
+
+func (s legacySubspace) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.Subspace.GetParamSet(ctx, ps)
+
+	params, ok := ps.(*types.Params)
+	if !ok {
+		return
+	}
+	if params.GlobalMinSelfBond.IsNil() {
+		params.GlobalMinSelfBond = types.DefaultGlobalMinSelfBond
+	}
+	if params.ValidatorBondFactor.IsNil() {
+		params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	}
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+	if params.KeyRotationFee.Amount.IsNil() {
+		params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFee.Amount)
+	}
 }
@@ -68,22 +68,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, sdkerrors.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := checkPubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -125,6 +111,10 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
+	if err := k.checkSelfBond(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
@@ -163,6 +153,13 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
+		// commission increases must be announced when there is a notice period
+		if k.CommissionChangeNoticePeriod(ctx) > 0 && msg.CommissionRate.GT(validator.Commission.Rate) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrCommissionIncreaseNotScheduled, "current rate %s, new rate %s", validator.Commission.Rate, msg.CommissionRate,
+			)
+		}
+
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
@@ -302,6 +299,12 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	if delegatorAddress.Equals(valSrcAddr) {
+		if err := k.checkSelfBond(ctx, valSrcAddr); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
@@ -364,6 +367,12 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
+	if delegatorAddress.Equals(addr) {
+		if err := k.checkSelfBond(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.Amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
@@ -515,3 +524,421 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// TokenizeShares defines a method for converting (part of) a delegation into
+// transferable share tokens. The tokenized delegation is transferred to the
+// module account of a new tokenize share record.
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	// tokenizing shares received from a redelegation would let the delegator
+	// escape slashing for infractions committed before the redelegation
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return nil, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+	record := types.TokenizeShareRecord{
+		Id:            recordID,
+		Owner:         msg.TokenizedShareOwner,
+		ModuleAccount: types.TokenizeShareRecordModuleAccount(recordID),
+		Validator:     msg.ValidatorAddress,
+	}
+
+	// unbond the shares from the delegator and delegate them back from the
+	// module account of the record
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+	if !returnAmount.IsPositive() {
+		return nil, types.ErrTinyTokenizeAmount
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), returnCoins); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	validator = k.mustGetValidator(ctx, valAddr)
+	validator.LiquidShares = validator.LiquidShares.Add(newShares)
+	k.SetValidator(ctx, validator)
+
+	// tokenizing a part of the self-bond moves it to the delegations of other
+	// delegators
+	if delegatorAddress.Equals(valAddr) {
+		if err := k.checkSelfBond(ctx, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	// mint the share tokens to the delegator
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.Coins{shareToken}); err != nil {
+		return nil, err
+	}
+
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareToken}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into
+// a delegation of the sender. The tokenize share record is deleted once all of
+// its share tokens are redeemed.
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := msg.Amount
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareToken)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	// if all the share tokens are redeemed, redeem the whole delegation
+	// including the decimal remainder of the shares
+	shares := sdk.NewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return nil, types.ErrNotEnoughDelegationShares
+	}
+	if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+		return nil, types.ErrTinyRedemptionAmount
+	}
+
+	// the rewards of the tokenized delegation belong to the record owner: they
+	// are withdrawn to the record module account by the hooks and sent to the
+	// owner before the shares are redeemed
+	if err := k.BeforeTokenizeSharesRedeemed(ctx, record.Id); err != nil {
+		return nil, err
+	}
+	if err := k.sendTokenizeShareRecordRewards(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// burn the share tokens
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{shareToken}); err != nil {
+		return nil, err
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	// unbond the shares from the module account of the record and delegate them
+	// back from the delegator
+	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// if the delegation is fully redeemed, the rewards left on the module
+	// account, including the ones accrued by the unbonding, are sent to the
+	// record owner
+	if _, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); !found {
+		if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+			return nil, err
+		}
+
+		if err := k.sendTokenizeShareRecordRewards(ctx, record); err != nil {
+			return nil, err
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.Coins{returnCoin}); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareToken.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: returnCoin}, nil
+}
+
+// sendTokenizeShareRecordRewards sends the rewards withdrawn to the module
+// account of a tokenize share record to the record owner.
+func (k msgServer) sendTokenizeShareRecordRewards(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	rewards := k.bankKeeper.GetAllBalances(ctx, record.GetModuleAddress())
+	if rewards.IsZero() {
+		return nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, record.GetModuleAddress(), owner, rewards)
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership
+// of a tokenize share record, and so the right to withdraw its rewards.
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, msg.TokenizeShareRecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != msg.Sender {
+		return nil, sdkerrors.Wrapf(types.ErrNotTokenizeShareRecordOwner, "expected %s, got %s", record.Owner, msg.Sender)
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, record.Id, newOwner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus public key of a
+// validator.
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	if err := checkPubKeyType(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(valAddr).String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// ScheduleCommissionChange defines a method for scheduling a change of the
+// commission rate of a validator after the commission change notice period.
+func (k msgServer) ScheduleCommissionChange(goCtx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	change, err := k.Keeper.ScheduleCommissionChange(ctx, validator, msg.CommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.CommissionRate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(valAddr).String()),
+		),
+	})
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: change.EffectiveTime}, nil
+}
+
+// checkPubKeyType checks that the consensus parameters allow the type of a
+// validator consensus public key.
+func checkPubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Validator == nil {
+		return nil
+	}
+
+	pkType := pk.Type()
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pkType == keyType {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
+}
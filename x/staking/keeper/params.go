@@ -49,6 +49,26 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// GlobalMinSelfBond - Minimum amount of tokens self-delegated by a validator operator
+func (k Keeper) GlobalMinSelfBond(ctx sdk.Context) math.Int {
+	return k.GetParams(ctx).GlobalMinSelfBond
+}
+
+// ValidatorBondFactor - Maximum ratio of the delegations to a validator to its self-bond
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFactor
+}
+
+// KeyRotationFee - Fee paid to rotate the consensus public key of a validator
+func (k Keeper) KeyRotationFee(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).KeyRotationFee
+}
+
+// CommissionChangeNoticePeriod - Duration between the scheduling of a commission change and its effect
+func (k Keeper) CommissionChangeNoticePeriod(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CommissionChangeNoticePeriod
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
@@ -32,6 +32,12 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// delete the old consensus addresses of all mature key rotations
+	k.DeleteMatureConsPubKeyRotations(ctx, ctx.BlockHeader().Time)
+
+	// apply the commission changes taking effect
+	k.ApplyMatureCommissionChanges(ctx, ctx.BlockHeader().Time)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -120,6 +126,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the old consensus public keys of the validators which rotated
+	// their keys in this block, which must be removed from the validator set.
+	rotatedPubKeys, err := k.getAndDeletePendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -169,8 +182,19 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// replace the old consensus public key of the validator if it was
+		// part of the validator set
+		oldPubKey, rotated := rotatedPubKeys[valAddrStr]
+		if rotated && found {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or consensus public key has changed
+		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) || rotated {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -195,7 +219,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// the validator set still contains the old consensus public key of a
+		// validator which rotated its key in this block
+		if oldPubKey, rotated := rotatedPubKeys[validator.OperatorAddress]; rotated {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
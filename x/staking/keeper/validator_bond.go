@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// getSelfBondAndDelegatedTokens returns the amount of tokens self-delegated to
+// a validator by its operator, and the amount of tokens delegated to it by
+// other delegators.
+func (k Keeper) getSelfBondAndDelegatedTokens(ctx sdk.Context, validator types.Validator) (selfBond, delegated math.Int) {
+	if validator.DelegatorShares.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	valAddr := validator.GetOperator()
+	selfShares := sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+		selfShares = delegation.Shares
+	}
+
+	selfBond = validator.TokensFromShares(selfShares).TruncateInt()
+	delegated = validator.TokensFromShares(validator.DelegatorShares.Sub(selfShares)).TruncateInt()
+
+	return selfBond, delegated
+}
+
+// GetValidatorBond returns the self-bond of a validator, along with the amount
+// of tokens which can still be delegated to it and the amount of self-bond
+// which can be unbonded.
+func (k Keeper) GetValidatorBond(ctx sdk.Context, validator types.Validator) types.ValidatorBond {
+	selfBond, delegated := k.getSelfBondAndDelegatedTokens(ctx, validator)
+
+	// the whole self-bond can always be unbonded, which jails the validator,
+	// but partial unbondings must leave enough self-bond
+	minSelfBond := k.GlobalMinSelfBond(ctx)
+	delegationHeadroom := sdk.NewInt(-1)
+	if factor := k.ValidatorBondFactor(ctx); !factor.IsNegative() {
+		delegationHeadroom = math.MaxInt(factor.MulInt(selfBond).TruncateInt().Sub(delegated), sdk.ZeroInt())
+
+		if delegated.IsPositive() {
+			if factor.IsZero() {
+				minSelfBond = selfBond
+			} else {
+				minSelfBond = math.MaxInt(minSelfBond, sdk.NewDecFromInt(delegated).Quo(factor).Ceil().TruncateInt())
+			}
+		}
+	}
+
+	return types.ValidatorBond{
+		ValidatorAddress:   validator.OperatorAddress,
+		SelfBond:           selfBond,
+		DelegatedTokens:    delegated,
+		DelegationHeadroom: delegationHeadroom,
+		SelfBondHeadroom:   math.MaxInt(selfBond.Sub(minSelfBond), sdk.ZeroInt()),
+	}
+}
+
+// checkValidatorBondFactor checks that the tokens delegated to a validator by
+// other delegators don't exceed the validator bond factor times its self-bond.
+func (k Keeper) checkValidatorBondFactor(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	factor := k.ValidatorBondFactor(ctx)
+	if factor.IsNegative() {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	selfBond, delegated := k.getSelfBondAndDelegatedTokens(ctx, validator)
+	if sdk.NewDecFromInt(delegated).GT(factor.MulInt(selfBond)) {
+		return sdkerrors.Wrapf(
+			types.ErrValidatorBondFactorExceeded, "delegated tokens %s, self-bond %s, validator bond factor %s",
+			delegated, selfBond, factor,
+		)
+	}
+
+	return nil
+}
+
+// checkSelfBond checks that the self-bond of a validator is neither below the
+// global minimum self-bond nor too low for the validator bond factor. A
+// jailed validator whose operator unbonded the whole self-bond passes the
+// check, as it can't unjail without bonding again.
+func (k Keeper) checkSelfBond(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	selfBond, _ := k.getSelfBondAndDelegatedTokens(ctx, validator)
+	if selfBond.IsZero() && validator.IsJailed() {
+		return nil
+	}
+
+	if minSelfBond := k.GlobalMinSelfBond(ctx); selfBond.LT(minSelfBond) {
+		return sdkerrors.Wrapf(types.ErrSelfBondBelowMinimum, "self-bond %s, global minimum self-bond %s", selfBond, minSelfBond)
+	}
+
+	return k.checkValidatorBondFactor(ctx, valAddr)
+}
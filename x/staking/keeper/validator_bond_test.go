@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidatorBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	tokens := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr, delAddr := sdk.ValAddress(addrs[0]), addrs[1]
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalMinSelfBond = tokens(5).Amount
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	// deliver handles a message as in a transaction, discarding its state
+	// changes on failure
+	deliver := func(msg sdk.Msg) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+	createValidator := func(valAddr sdk.ValAddress, pk int, selfBond sdk.Coin) error {
+		msg, err := types.NewMsgCreateValidator(
+			valAddr, PKs[pk], selfBond, types.Description{Moniker: "validator"},
+			types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+		)
+		require.NoError(t, err)
+		return deliver(msg)
+	}
+	queryBond := func() types.ValidatorBond {
+		res, err := querier.ValidatorBond(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondRequest{ValidatorAddr: valAddr.String()})
+		require.NoError(t, err)
+		return res.ValidatorBond
+	}
+
+	// the self-bond of a new validator must be at least the global minimum
+	err := createValidator(sdk.ValAddress(addrs[2]), 1, tokens(4))
+	require.ErrorIs(t, err, types.ErrSelfBondBelowMinimum)
+	require.NoError(t, createValidator(valAddr, 0, tokens(10)))
+
+	// delegations are capped to the validator bond factor times the self-bond
+	err = deliver(types.NewMsgDelegate(delAddr, valAddr, tokens(20)))
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorBond{
+		ValidatorAddress:   valAddr.String(),
+		SelfBond:           tokens(10).Amount,
+		DelegatedTokens:    tokens(20).Amount,
+		DelegationHeadroom: sdk.ZeroInt(),
+		SelfBondHeadroom:   sdk.ZeroInt(),
+	}, queryBond())
+
+	err = deliver(types.NewMsgDelegate(delAddr, valAddr, tokens(1)))
+	require.ErrorIs(t, err, types.ErrValidatorBondFactorExceeded)
+
+	// including the delegations made by other modules through the keeper
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.StakingKeeper.Delegate(cacheCtx, delAddr, tokens(1).Amount, types.Unbonded, validator, true)
+	require.ErrorIs(t, err, types.ErrValidatorBondFactorExceeded)
+
+	// the operator can always increase the self-bond
+	err = deliver(types.NewMsgDelegate(addrs[0], valAddr, tokens(5)))
+	require.NoError(t, err)
+	bond := queryBond()
+	require.Equal(t, tokens(10).Amount, bond.DelegationHeadroom)
+	require.Equal(t, tokens(5).Amount, bond.SelfBondHeadroom)
+
+	// the self-bond can't be unbonded below the validator bond factor
+	err = deliver(types.NewMsgUndelegate(addrs[0], valAddr, tokens(6)))
+	require.ErrorIs(t, err, types.ErrValidatorBondFactorExceeded)
+	err = deliver(types.NewMsgUndelegate(addrs[0], valAddr, tokens(5)))
+	require.NoError(t, err)
+
+	// transferring the self-bond below the validator bond factor jails the
+	// validator, as the transfer can't be rejected
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+	shares, err := validator.SharesFromTokens(tokens(1).Amount)
+	require.NoError(t, err)
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = app.StakingKeeper.TransferDelegation(cacheCtx, addrs[0], addrs[2], valAddr, shares)
+	require.NoError(t, err)
+	validator, found = app.StakingKeeper.GetValidator(cacheCtx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	// nor below the global minimum self-bond
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+	bond = queryBond()
+	require.Equal(t, sdk.NewInt(-1), bond.DelegationHeadroom)
+	require.Equal(t, tokens(5).Amount, bond.SelfBondHeadroom)
+
+	err = deliver(types.NewMsgUndelegate(addrs[0], valAddr, tokens(6)))
+	require.ErrorIs(t, err, types.ErrSelfBondBelowMinimum)
+
+	// but the whole self-bond can be unbonded, which jails the validator
+	err = deliver(types.NewMsgUndelegate(addrs[0], valAddr, tokens(10)))
+	require.NoError(t, err)
+	require.True(t, queryBond().SelfBond.IsZero())
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+}
@@ -0,0 +1,11 @@
+package v5
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	ValidatorsKey = []byte{0x21} // prefix for each key to a validator
+	ParamsKey     = []byte{0x51} // prefix for parameters for module x/staking
+)
@@ -0,0 +1,73 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration includes:
+//
+// - Setting the GlobalLiquidStakingCap, ValidatorLiquidStakingCap,
+// GlobalMinSelfBond, ValidatorBondFactor and KeyRotationFee params to their
+// default values.
+// - Setting the liquid shares of all the validators to zero.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	migrateValidators(store, cdc)
+
+	return nil
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	cdc.MustUnmarshal(bz, &params)
+
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+	if params.GlobalMinSelfBond.IsNil() {
+		params.GlobalMinSelfBond = types.DefaultGlobalMinSelfBond
+	}
+	if params.ValidatorBondFactor.IsNil() {
+		params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	}
+	if params.KeyRotationFee.Amount.IsNil() {
+		params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFee.Amount)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+func migrateValidators(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.Validator
+		cdc.MustUnmarshal(iterator.Value(), &validator)
+
+		validator.LiquidShares = sdk.ZeroDec()
+		store.Set(iterator.Key(), cdc.MustMarshal(&validator))
+	}
+}
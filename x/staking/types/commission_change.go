@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingCommissionChange creates a new PendingCommissionChange instance.
+//
+//nolint:interfacer
+func NewPendingCommissionChange(
+	valAddr sdk.ValAddress, commissionRate sdk.Dec, scheduleTime, effectiveTime time.Time,
+) PendingCommissionChange {
+	return PendingCommissionChange{
+		ValidatorAddress: valAddr.String(),
+		CommissionRate:   commissionRate,
+		ScheduleTime:     scheduleTime,
+		EffectiveTime:    effectiveTime,
+	}
+}
+
+// GetOperator returns the operator address of the validator which scheduled
+// the commission change.
+func (c PendingCommissionChange) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(c.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs a stateless validation of the pending commission change.
+func (c PendingCommissionChange) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid pending commission change validator: %w", err)
+	}
+	if c.CommissionRate.IsNil() || c.CommissionRate.IsNegative() || c.CommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid pending commission change rate: %s", c.CommissionRate)
+	}
+	if c.EffectiveTime.Before(c.ScheduleTime) {
+		return fmt.Errorf(
+			"pending commission change effective time %s is before its schedule time %s",
+			c.EffectiveTime, c.ScheduleTime,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*ConsPubKeyRotationHistory)(nil)
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory
+// instance.
+//
+//nolint:interfacer
+func NewConsPubKeyRotationHistory(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, rotationTime time.Time, fee sdk.Coin,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: valAddr.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            rotationTime,
+		Fee:             fee,
+	}, nil
+}
+
+// GetOperator returns the operator address of the validator whose consensus
+// public key was rotated.
+func (h ConsPubKeyRotationHistory) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(h.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus public key of the validator before the
+// rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus public key of the validator after the
+// rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.NewConsPubkey)
+}
+
+// Validate performs a stateless validation of the consensus public key
+// rotation.
+func (h ConsPubKeyRotationHistory) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(h.OperatorAddress); err != nil {
+		return fmt.Errorf("invalid consensus public key rotation operator: %w", err)
+	}
+	if _, err := h.OldConsPubKey(); err != nil {
+		return fmt.Errorf("invalid consensus public key rotation old public key: %w", err)
+	}
+	if _, err := h.NewConsPubKey(); err != nil {
+		return fmt.Errorf("invalid consensus public key rotation new public key: %w", err)
+	}
+	if err := h.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid consensus public key rotation fee: %w", err)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+func unpackConsPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pkAny.GetCachedValue())
+	}
+	return pk, nil
+}
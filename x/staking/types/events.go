@@ -2,14 +2,20 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeCancelUnbondingDelegation   = "cancel_unbonding_delegation"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeRotateConsPubKey            = "rotate_cons_pubkey"
+	EventTypeScheduleCommissionChange    = "schedule_commission_change"
+	EventTypeApplyCommissionChange       = "apply_commission_change"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,5 +26,9 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyEffectiveTime     = "effective_time"
 	AttributeValueCategory        = ModuleName
 )
@@ -44,6 +44,17 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id defines the id of the last created tokenize
+	// share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// cons_pub_key_rotation_histories defines the consensus public key
+	// rotations of the validators at genesis.
+	ConsPubKeyRotationHistories []ConsPubKeyRotationHistory `protobuf:"bytes,11,rep,name=cons_pub_key_rotation_histories,json=consPubKeyRotationHistories,proto3" json:"cons_pub_key_rotation_histories"`
+	// pending_commission_changes defines the commission changes scheduled by
+	// the validators at genesis.
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,12,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +139,34 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubKeyRotationHistories() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubKeyRotationHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +218,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xf6, 0xab, 0x73, 0x07, 0x42, 0xa6, 0x9b, 0xb2, 0x22, 0xd2, 0x32, 0x4d, 0xa8,
+	0x02, 0x96, 0x6a, 0xe3, 0x86, 0x38, 0x40, 0x87, 0x18, 0x03, 0x0e, 0x55, 0x36, 0x10, 0xe2, 0x12,
+	0xb9, 0x89, 0x49, 0xad, 0xb6, 0x76, 0xe4, 0xe7, 0x8e, 0x15, 0x89, 0x3b, 0x47, 0x4e, 0x9c, 0xf7,
+	0x47, 0xf0, 0x47, 0xec, 0x38, 0x71, 0x42, 0x1c, 0x26, 0xb4, 0x5d, 0xf8, 0x33, 0x50, 0x6c, 0xb7,
+	0x14, 0xba, 0xec, 0xd4, 0x5a, 0xef, 0xfb, 0xf9, 0xbc, 0x67, 0x25, 0x79, 0x68, 0x3d, 0x12, 0xd0,
+	0x17, 0xd0, 0x00, 0x45, 0xba, 0x8c, 0x27, 0x8d, 0x83, 0xcd, 0x36, 0x55, 0x64, 0xb3, 0x91, 0x50,
+	0x4e, 0x81, 0x81, 0x9f, 0x4a, 0xa1, 0x04, 0x5e, 0x31, 0x29, 0xdf, 0xa6, 0x7c, 0x9b, 0xaa, 0x94,
+	0x13, 0x91, 0x08, 0x1d, 0x69, 0x64, 0xff, 0x4c, 0xba, 0x92, 0xe7, 0x1c, 0xd1, 0x26, 0xb5, 0x6a,
+	0x52, 0xa1, 0xc1, 0x6d, 0x03, 0x7d, 0x58, 0xfb, 0x5a, 0x44, 0x4b, 0x3b, 0x66, 0x80, 0x3d, 0x45,
+	0x14, 0xc5, 0x8f, 0xd0, 0x7c, 0x4a, 0x24, 0xe9, 0x83, 0xeb, 0xd4, 0x9c, 0x7a, 0x69, 0xcb, 0xf3,
+	0x2f, 0x1e, 0xc8, 0x6f, 0xe9, 0x54, 0x73, 0xf6, 0xf8, 0xb4, 0x5a, 0x08, 0x2c, 0x83, 0xdf, 0xa2,
+	0xeb, 0x3d, 0x02, 0x2a, 0x54, 0x42, 0x91, 0x5e, 0x98, 0x8a, 0x0f, 0x54, 0xba, 0x57, 0x6a, 0x4e,
+	0x7d, 0xa9, 0xe9, 0x67, 0xb9, 0x9f, 0xa7, 0xd5, 0x3b, 0x09, 0x53, 0x9d, 0x41, 0xdb, 0x8f, 0x44,
+	0xdf, 0x4e, 0x62, 0x7f, 0x36, 0x20, 0xee, 0x36, 0xd4, 0x30, 0xa5, 0xe0, 0xef, 0x72, 0x15, 0x5c,
+	0xcb, 0x3c, 0xfb, 0x99, 0xa6, 0x95, 0x59, 0x70, 0x8c, 0x96, 0xb5, 0xf9, 0x80, 0xf4, 0x58, 0x4c,
+	0x94, 0x90, 0xc6, 0x0e, 0xee, 0x4c, 0x6d, 0xa6, 0x5e, 0xda, 0xba, 0x9b, 0x37, 0xe6, 0x2b, 0x02,
+	0xea, 0xcd, 0x88, 0xd1, 0x2a, 0x3b, 0xf2, 0x8d, 0xde, 0x54, 0x05, 0xf0, 0x0e, 0x42, 0xe3, 0x06,
+	0xe0, 0xce, 0x6a, 0xf5, 0xed, 0x3c, 0xf5, 0x18, 0xb6, 0xc6, 0x09, 0x14, 0xbf, 0x40, 0xa5, 0x98,
+	0xf6, 0x68, 0x42, 0x14, 0x13, 0x1c, 0xdc, 0x39, 0x6d, 0x5a, 0xcb, 0x33, 0x3d, 0x1d, 0x47, 0xad,
+	0x6a, 0x12, 0xc6, 0xef, 0xd1, 0xf2, 0x80, 0xb7, 0x05, 0x8f, 0x19, 0x4f, 0xc2, 0x49, 0xeb, 0xbc,
+	0xb6, 0xde, 0xcb, 0xb3, 0xbe, 0x1e, 0x41, 0x53, 0xfa, 0xf2, 0x60, 0xba, 0x04, 0xb8, 0x85, 0xae,
+	0x4a, 0x3a, 0xe9, 0x5f, 0xd0, 0xfe, 0xf5, 0x3c, 0x7f, 0x40, 0xe3, 0xff, 0xc5, 0xff, 0x0a, 0x70,
+	0x05, 0x15, 0xe9, 0x61, 0x2a, 0xa4, 0xa2, 0xb1, 0x5b, 0xac, 0x39, 0xf5, 0x62, 0x30, 0x3e, 0xe3,
+	0x04, 0xad, 0x28, 0xd1, 0xa5, 0x9c, 0x7d, 0xa4, 0x21, 0x74, 0x88, 0xa4, 0xa1, 0xa4, 0x91, 0x90,
+	0x31, 0xb8, 0x8b, 0x97, 0x5f, 0x6b, 0xdf, 0x52, 0x7b, 0x19, 0x14, 0x68, 0x66, 0x74, 0x2d, 0x35,
+	0x5d, 0x02, 0xfc, 0x18, 0xdd, 0xb2, 0xef, 0xe4, 0x05, 0xdd, 0x42, 0x16, 0xbb, 0xa8, 0xe6, 0xd4,
+	0x67, 0x83, 0x55, 0xf3, 0xc2, 0x4d, 0x09, 0x76, 0x63, 0xfc, 0x09, 0x55, 0x23, 0xc1, 0x21, 0x4c,
+	0x07, 0xed, 0xb0, 0x4b, 0x87, 0xa1, 0x14, 0x4a, 0x5f, 0x30, 0xec, 0x30, 0x50, 0x42, 0x32, 0x0a,
+	0x6e, 0x49, 0xcf, 0xbc, 0x99, 0x37, 0xf3, 0xb6, 0xe0, 0xd0, 0x1a, 0xb4, 0x5f, 0xd2, 0x61, 0x60,
+	0xd9, 0xe7, 0x1a, 0x1d, 0xda, 0xc9, 0x6f, 0x46, 0x39, 0x01, 0x46, 0x01, 0x03, 0xaa, 0xa4, 0xd4,
+	0x3c, 0xfd, 0x48, 0xf4, 0xfb, 0x0c, 0x20, 0xeb, 0x1d, 0x75, 0x08, 0x4f, 0x28, 0xb8, 0x4b, 0xba,
+	0x73, 0x23, 0xf7, 0x33, 0x35, 0xe4, 0xf6, 0x18, 0xdc, 0xd6, 0x9c, 0xed, 0xeb, 0xa6, 0x17, 0x97,
+	0x61, 0xad, 0x83, 0xf0, 0xf4, 0xa7, 0x83, 0xb7, 0xd0, 0x02, 0x89, 0x63, 0x49, 0xc1, 0xac, 0x87,
+	0xc5, 0xa6, 0xfb, 0xfd, 0xdb, 0x46, 0xd9, 0xb6, 0x7e, 0x62, 0x2a, 0x7b, 0x4a, 0x32, 0x9e, 0x04,
+	0xa3, 0x20, 0x2e, 0xa3, 0xb9, 0xbf, 0x8b, 0x60, 0x26, 0x30, 0x87, 0x87, 0xc5, 0xcf, 0x47, 0xd5,
+	0xc2, 0xef, 0xa3, 0x6a, 0xa1, 0xf9, 0xec, 0xf8, 0xcc, 0x73, 0x4e, 0xce, 0x3c, 0xe7, 0xd7, 0x99,
+	0xe7, 0x7c, 0x39, 0xf7, 0x0a, 0x27, 0xe7, 0x5e, 0xe1, 0xc7, 0xb9, 0x57, 0x78, 0x77, 0xff, 0xd2,
+	0x5d, 0x71, 0x38, 0xde, 0x7a, 0x7a, 0x6b, 0xb4, 0xe7, 0xf5, 0x46, 0x7b, 0xf0, 0x67, 0x00, 0x59,
+	0x25, 0x9d, 0x3b, 0x68, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +281,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ConsPubKeyRotationHistories) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotationHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotationHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +520,27 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubKeyRotationHistories) > 0 {
+		for _, e := range m.ConsPubKeyRotationHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -735,6 +851,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotationHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotationHistories = append(m.ConsPubKeyRotationHistories, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubKeyRotationHistories[len(m.ConsPubKeyRotationHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
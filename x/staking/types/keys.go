@@ -52,6 +52,20 @@ var (
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenize share record prefix
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // key for tokenize share record id by owner prefix
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for tokenize share record id by denom prefix
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for last tokenize share record id
+
+	ConsPubKeyRotationHistoryKey         = []byte{0x71} // prefix for the consensus public key rotation history of each validator
+	PendingConsPubKeyRotationKey         = []byte{0x72} // prefix for the consensus public key rotations of the current block
+	RotatedConsAddrKey                   = []byte{0x73} // prefix for the new consensus address of each rotated consensus address
+	ConsPubKeyRotationQueueKey           = []byte{0x74} // prefix for the timestamps in consensus public key rotation queue
+	ConsPubKeyRotationMaturityByValIndex = []byte{0x75} // prefix for the maturity time of the last consensus public key rotation of each validator
+
+	CommissionChangeQueueKey                   = []byte{0x81} // prefix for the timestamps in the commission change queue
+	PendingCommissionChangeEffectiveByValIndex = []byte{0x82} // prefix for the effective time of the pending commission change of each validator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -351,3 +365,106 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix for indexing
+// the tokenize share records of an owner.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the index key of a tokenize
+// share record by owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the index key of a tokenize share
+// record by share token denom.
+// VALUE: tokenize share record id (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the consensus public key
+// rotation of a validator at a height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationHistoriesKey(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetConsPubKeyRotationHistoriesKey returns the key prefix for indexing the
+// consensus public key rotations of a validator.
+func GetConsPubKeyRotationHistoriesKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetPendingConsPubKeyRotationKey returns the key of the consensus public key
+// rotation of a validator in the current block.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetRotatedConsAddrKey returns the key of the new consensus address of a
+// rotated consensus address.
+// VALUE: new consensus address ([]byte)
+func GetRotatedConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(RotatedConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetConsPubKeyRotationTimeKey returns the key prefix for indexing the
+// consensus public key rotations which mature at a time.
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// GetConsPubKeyRotationQueueKey returns the key of the consensus public key
+// rotation of a validator in the rotation queue.
+// VALUE: old consensus address ([]byte)
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(timestamp), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseConsPubKeyRotationQueueKey returns the validator operator address from
+// a key created from GetConsPubKeyRotationQueueKey.
+func ParseConsPubKeyRotationQueueKey(key []byte) sdk.ValAddress {
+	prefixL := len(GetConsPubKeyRotationTimeKey(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, prefixL+2)
+	return key[prefixL+1:] // remove prefix, time bytes and address length
+}
+
+// GetConsPubKeyRotationMaturityByValIndexKey returns the key of the maturity
+// time of the last consensus public key rotation of a validator.
+// VALUE: maturity time (sdk.FormatTimeBytes)
+func GetConsPubKeyRotationMaturityByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationMaturityByValIndex, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeTimeKey returns the key prefix for indexing the commission
+// changes which take effect at a time.
+func GetCommissionChangeTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(CommissionChangeQueueKey, bz...)
+}
+
+// GetCommissionChangeQueueKey returns the key of the pending commission change
+// of a validator in the commission change queue.
+// VALUE: staking/PendingCommissionChange
+func GetCommissionChangeQueueKey(timestamp time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetCommissionChangeTimeKey(timestamp), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetPendingCommissionChangeEffectiveByValIndexKey returns the key of the
+// effective time of the pending commission change of a validator.
+// VALUE: effective time (sdk.FormatTimeBytes)
+func GetPendingCommissionChangeEffectiveByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingCommissionChangeEffectiveByValIndex, address.MustLengthPrefix(valAddr)...)
+}
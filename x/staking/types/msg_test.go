@@ -201,6 +201,129 @@ func TestMsgUndelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		owner         sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), sdk.AccAddress(valAddr3), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, sdk.Coin{}, sdk.AccAddress(valAddr3), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty owner", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.owner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokensForShares
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("validator/1", 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("validator/1", 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), sdk.Coin{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin("validator/1", 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgTransferTokenizeShareRecord
+func TestMsgTransferTokenizeShareRecord(t *testing.T) {
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		newOwner   sdk.AccAddress
+		expectPass bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.AccAddress(valAddr2), true},
+		{"empty sender", sdk.AccAddress(emptyAddr), sdk.AccAddress(valAddr2), false},
+		{"empty new owner", sdk.AccAddress(valAddr1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTransferTokenizeShareRecord(1, tc.sender, tc.newOwner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		valAddr    sdk.ValAddress
+		pubkey     cryptotypes.PubKey
+		expectPass bool
+	}{
+		{"regular", valAddr1, pk1, true},
+		{"empty validator", emptyAddr, pk1, false},
+		{"empty pubkey", valAddr1, emptyPubkey, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgRotateConsPubKey(tc.valAddr, tc.pubkey)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgScheduleCommissionChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		valAddr    sdk.ValAddress
+		rate       sdk.Dec
+		expectPass bool
+	}{
+		{"regular", valAddr1, sdk.NewDecWithPrec(5, 2), true},
+		{"zero rate", valAddr1, sdk.ZeroDec(), true},
+		{"empty validator", emptyAddr, sdk.NewDecWithPrec(5, 2), false},
+		{"nil rate", valAddr1, sdk.Dec{}, false},
+		{"negative rate", valAddr1, sdk.NewDec(-1), false},
+		{"rate above one", valAddr1, sdk.NewDecWithPrec(11, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgScheduleCommissionChange(tc.valAddr, tc.rate)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func TestMsgUpdateParams(t *testing.T) {
 	msg := types.MsgUpdateParams{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -306,6 +429,65 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 			true,
 			"minimum commission rate cannot be greater than 100",
 		},
+		{
+			"liquid staking cap greater than one",
+			types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					UnbondingTime:             time.Hour * 24 * 7 * 3,
+					MaxEntries:                types.DefaultMaxEntries,
+					MaxValidators:             types.DefaultMaxValidators,
+					HistoricalEntries:         types.DefaultHistoricalEntries,
+					MinCommissionRate:         types.DefaultMinCommissionRate,
+					BondDenom:                 "denom",
+					GlobalLiquidStakingCap:    sdk.NewDec(2),
+					ValidatorLiquidStakingCap: types.DefaultValidatorLiquidStakingCap,
+				},
+			},
+			true,
+			"global liquid staking cap cannot be greater than 100%",
+		},
+		{
+			"invalid validator bond factor",
+			types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					UnbondingTime:             time.Hour * 24 * 7 * 3,
+					MaxEntries:                types.DefaultMaxEntries,
+					MaxValidators:             types.DefaultMaxValidators,
+					HistoricalEntries:         types.DefaultHistoricalEntries,
+					MinCommissionRate:         types.DefaultMinCommissionRate,
+					BondDenom:                 "denom",
+					GlobalLiquidStakingCap:    types.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: types.DefaultValidatorLiquidStakingCap,
+					GlobalMinSelfBond:         types.DefaultGlobalMinSelfBond,
+					ValidatorBondFactor:       sdk.NewDec(-2),
+				},
+			},
+			true,
+			"validator bond factor must be -1 (disabled) or non-negative",
+		},
+		{
+			"invalid key rotation fee",
+			types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					UnbondingTime:             time.Hour * 24 * 7 * 3,
+					MaxEntries:                types.DefaultMaxEntries,
+					MaxValidators:             types.DefaultMaxValidators,
+					HistoricalEntries:         types.DefaultHistoricalEntries,
+					MinCommissionRate:         types.DefaultMinCommissionRate,
+					BondDenom:                 "denom",
+					GlobalLiquidStakingCap:    types.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: types.DefaultValidatorLiquidStakingCap,
+					GlobalMinSelfBond:         types.DefaultGlobalMinSelfBond,
+					ValidatorBondFactor:       types.DefaultValidatorBondFactor,
+					KeyRotationFee:            sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)},
+				},
+			},
+			true,
+			"invalid key rotation fee",
+		},
 		{
 			"blank bonddenom",
 			types.MsgUpdateParams{
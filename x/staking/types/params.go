@@ -30,20 +30,59 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeNoticePeriod is set to 0, i.e. commission changes
+	// take effect immediately.
+	DefaultCommissionChangeNoticePeriod time.Duration = 0
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = sdk.ZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. all the bonded tokens
+	// can be tokenized.
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. all the shares of
+	// a validator can be tokenized.
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultGlobalMinSelfBond is set to 0, i.e. validators are only bound by
+	// their own minimum self delegation.
+	DefaultGlobalMinSelfBond = sdk.ZeroInt()
+
+	// DefaultValidatorBondFactor is set to -1, i.e. the delegations to a
+	// validator are not capped by its self-bond.
+	DefaultValidatorBondFactor = sdk.NewDec(-1)
+
+	// DefaultKeyRotationFee is set to 1000000 of the default bond denom.
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration,
+	maxValidators, maxEntries, historicalEntries uint32,
+	bondDenom string,
+	minCommissionRate, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec,
+	globalMinSelfBond math.Int, validatorBondFactor sdk.Dec,
+	keyRotationFee sdk.Coin,
+	commissionChangeNoticePeriod time.Duration,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:                unbondingTime,
+		MaxValidators:                maxValidators,
+		MaxEntries:                   maxEntries,
+		HistoricalEntries:            historicalEntries,
+		BondDenom:                    bondDenom,
+		MinCommissionRate:            minCommissionRate,
+		GlobalLiquidStakingCap:       globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:    validatorLiquidStakingCap,
+		GlobalMinSelfBond:            globalMinSelfBond,
+		ValidatorBondFactor:          validatorBondFactor,
+		KeyRotationFee:               keyRotationFee,
+		CommissionChangeNoticePeriod: commissionChangeNoticePeriod,
 	}
 }
 
@@ -56,6 +95,12 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultGlobalMinSelfBond,
+		DefaultValidatorBondFactor,
+		DefaultKeyRotationFee,
+		DefaultCommissionChangeNoticePeriod,
 	)
 }
 
@@ -107,6 +152,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateGlobalMinSelfBond(p.GlobalMinSelfBond); err != nil {
+		return err
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +272,58 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil", name)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateGlobalMinSelfBond(v math.Int) error {
+	if v.IsNil() {
+		return errors.New("global min self bond cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("global min self bond cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(v sdk.Dec) error {
+	if v.IsNil() {
+		return errors.New("validator bond factor cannot be nil")
+	}
+	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
+		return fmt.Errorf("validator bond factor must be -1 (disabled) or non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(v sdk.Coin) error {
+	if v.Amount.IsNil() {
+		return errors.New("key rotation fee cannot be nil")
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("commission change notice period cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -336,6 +336,9 @@ type Validator struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// liquid_shares defines the shares of the validator held by tokenize share
+	// record module accounts, i.e. converted into transferable share tokens.
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -848,6 +851,26 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that can be tokenized into share tokens.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the shares of a
+	// validator that can be tokenized into share tokens.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// global_min_self_bond is the chain-wide minimum amount of tokens that the
+	// operator of a validator must self-delegate to it.
+	GlobalMinSelfBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=global_min_self_bond,json=globalMinSelfBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_min_self_bond" yaml:"global_min_self_bond"`
+	// validator_bond_factor is the maximum ratio of the tokens delegated to a
+	// validator by other delegators to the self-bond of the validator. A
+	// negative value disables the cap.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// key_rotation_fee is the fee paid by the operator of a validator to rotate
+	// its consensus public key.
+	KeyRotationFee types2.Coin `protobuf:"bytes,11,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// commission_change_notice_period is the duration between the scheduling of
+	// a commission change and the time it takes effect. When positive,
+	// commission increases can only be scheduled.
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,12,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -917,6 +940,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1113,6 +1150,244 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a tokenized delegation: the delegation is
+// held by the record's module account and represented by share tokens of the
+// record's denom. The rewards of the delegation are claimable by the owner of
+// the record.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record, part of the share token denom.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account which can withdraw the rewards of the
+	// tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized
+	// delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// ValidatorBond represents the self-bond of a validator and how much it bounds
+// the delegations to the validator and the self-bond which can be unbonded.
+type ValidatorBond struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// self_bond is the amount of tokens self-delegated by the validator
+	// operator.
+	SelfBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=self_bond,json=selfBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"self_bond"`
+	// delegated_tokens is the amount of tokens delegated to the validator by
+	// other delegators.
+	DelegatedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegated_tokens,json=delegatedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_tokens"`
+	// delegation_headroom is the amount of tokens which can still be delegated
+	// to the validator by other delegators, or -1 if the validator bond factor
+	// is disabled.
+	DelegationHeadroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegation_headroom,json=delegationHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_headroom"`
+	// self_bond_headroom is the amount of self-bond which can be unbonded
+	// without the validator falling below the global minimum self-bond or the
+	// validator bond factor.
+	SelfBondHeadroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=self_bond_headroom,json=selfBondHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"self_bond_headroom"`
+}
+
+func (m *ValidatorBond) Reset()         { *m = ValidatorBond{} }
+func (m *ValidatorBond) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBond) ProtoMessage()    {}
+func (*ValidatorBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ValidatorBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBond.Merge(m, src)
+}
+func (m *ValidatorBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBond proto.InternalMessageInfo
+
+func (m *ValidatorBond) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// ConsPubKeyRotationHistory records the rotation of the consensus public key
+// of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address is the operator address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the
+	// rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the
+	// rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height at which the key was rotated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the key was rotated.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the key rotation fee paid for the rotation.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// PendingCommissionChange is a commission change scheduled by a validator,
+// which takes effect at effective_time.
+type PendingCommissionChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// commission_rate is the new commission rate of the validator.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// schedule_time is the block time at which the change was scheduled.
+	ScheduleTime time.Time `protobuf:"bytes,3,opt,name=schedule_time,json=scheduleTime,proto3,stdtime" json:"schedule_time"`
+	// effective_time is the time at which the change takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1135,6 +1410,10 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ValidatorBond)(nil), "cosmos.staking.v1beta1.ValidatorBond")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*PendingCommissionChange)(nil), "cosmos.staking.v1beta1.PendingCommissionChange")
 }
 
 func init() {
@@ -1142,112 +1421,145 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 2198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5d, 0x6c, 0x5b, 0x49,
+	0x15, 0xce, 0xb5, 0xdd, 0xc4, 0x3e, 0x8e, 0xe3, 0x64, 0x9a, 0xed, 0x3a, 0xa1, 0xc4, 0xc1, 0xbb,
+	0xec, 0x76, 0xd1, 0xd6, 0xa1, 0x45, 0x5a, 0x41, 0x84, 0x84, 0xea, 0x38, 0xa5, 0xa1, 0x6d, 0xf0,
+	0xde, 0xa4, 0x41, 0xcb, 0x22, 0xac, 0xf1, 0xbd, 0x13, 0x67, 0x9a, 0xeb, 0x3b, 0xe6, 0xce, 0xb8,
+	0x8d, 0x11, 0x48, 0x08, 0x5e, 0x4a, 0x05, 0xd2, 0x3e, 0xa1, 0x7d, 0xa9, 0xa8, 0xb4, 0x3c, 0xee,
+	0x63, 0xc5, 0x0b, 0x0f, 0xbc, 0xae, 0xf6, 0xa9, 0xda, 0x27, 0x16, 0x50, 0x40, 0xad, 0x84, 0x80,
+	0x27, 0xb4, 0x12, 0x8f, 0x20, 0x34, 0x3f, 0xf7, 0x27, 0xce, 0x4f, 0xe3, 0xc5, 0x2b, 0xad, 0xb4,
+	0x2f, 0x89, 0xef, 0xcc, 0x39, 0xdf, 0x3d, 0xf3, 0xcd, 0x99, 0x33, 0xe7, 0x9c, 0x0b, 0x2f, 0x3a,
+	0x8c, 0x77, 0x18, 0x5f, 0xe2, 0x02, 0xef, 0x52, 0xbf, 0xbd, 0x74, 0xe7, 0x52, 0x8b, 0x08, 0x7c,
+	0x29, 0x7c, 0xae, 0x76, 0x03, 0x26, 0x18, 0x3a, 0xa7, 0xa5, 0xaa, 0xe1, 0xa8, 0x91, 0x9a, 0x9f,
+	0x6d, 0xb3, 0x36, 0x53, 0x22, 0x4b, 0xf2, 0x97, 0x96, 0x9e, 0x9f, 0x6b, 0x33, 0xd6, 0xf6, 0xc8,
+	0x92, 0x7a, 0x6a, 0xf5, 0xb6, 0x97, 0xb0, 0xdf, 0x37, 0x53, 0x0b, 0x83, 0x53, 0x6e, 0x2f, 0xc0,
+	0x82, 0x32, 0xdf, 0xcc, 0x97, 0x07, 0xe7, 0x05, 0xed, 0x10, 0x2e, 0x70, 0xa7, 0x1b, 0x62, 0x6b,
+	0x4b, 0x9a, 0xfa, 0xa5, 0xc6, 0x2c, 0x83, 0x6d, 0x96, 0xd2, 0xc2, 0x9c, 0x44, 0xeb, 0x70, 0x18,
+	0x0d, 0xb1, 0xcf, 0x0b, 0xe2, 0xbb, 0x24, 0xe8, 0x50, 0x5f, 0x2c, 0x89, 0x7e, 0x97, 0x70, 0xfd,
+	0x57, 0xcf, 0x56, 0x7e, 0x6e, 0xc1, 0xd4, 0x35, 0xca, 0x05, 0x0b, 0xa8, 0x83, 0xbd, 0x35, 0x7f,
+	0x9b, 0xa1, 0xd7, 0x60, 0x7c, 0x87, 0x60, 0x97, 0x04, 0x25, 0x6b, 0xd1, 0xba, 0x90, 0xbf, 0x5c,
+	0xaa, 0xc6, 0x08, 0x55, 0xad, 0x7b, 0x4d, 0xcd, 0xd7, 0x32, 0xef, 0xed, 0x97, 0xc7, 0x6c, 0x23,
+	0x8d, 0xbe, 0x01, 0xe3, 0x77, 0xb0, 0xc7, 0x89, 0x28, 0xa5, 0x16, 0xd3, 0x17, 0xf2, 0x97, 0xbf,
+	0x50, 0x3d, 0x9a, 0xbe, 0xea, 0x16, 0xf6, 0xa8, 0x8b, 0x05, 0x8b, 0x00, 0xb4, 0x5a, 0xe5, 0xdd,
+	0x14, 0x14, 0x57, 0x58, 0xa7, 0x43, 0x39, 0xa7, 0xcc, 0xb7, 0xb1, 0x20, 0x1c, 0x35, 0x20, 0x13,
+	0x60, 0x41, 0x94, 0x29, 0xb9, 0xda, 0xd7, 0xa5, 0xfc, 0x1f, 0xf7, 0xcb, 0x2f, 0xb5, 0xa9, 0xd8,
+	0xe9, 0xb5, 0xaa, 0x0e, 0xeb, 0x18, 0x32, 0xcc, 0xbf, 0x8b, 0xdc, 0xdd, 0x35, 0xeb, 0xab, 0x13,
+	0xe7, 0x83, 0x47, 0x17, 0xc1, 0xd8, 0x50, 0x27, 0x8e, 0xad, 0x90, 0xd0, 0x77, 0x20, 0xdb, 0xc1,
+	0x7b, 0x4d, 0x85, 0x9a, 0x1a, 0x01, 0xea, 0x44, 0x07, 0xef, 0x49, 0x5b, 0x91, 0x0b, 0x45, 0x09,
+	0xec, 0xec, 0x60, 0xbf, 0x4d, 0x34, 0x7e, 0x7a, 0x04, 0xf8, 0x85, 0x0e, 0xde, 0x5b, 0x51, 0x98,
+	0xf2, 0x2d, 0xcb, 0xd9, 0xb7, 0x1f, 0x96, 0xc7, 0xfe, 0xfe, 0xb0, 0x6c, 0x55, 0x7e, 0x67, 0x01,
+	0xc4, 0x74, 0xa1, 0xef, 0xc1, 0xb4, 0x13, 0x3d, 0xa9, 0xd7, 0x73, 0xb3, 0x81, 0x2f, 0x1f, 0xb7,
+	0x11, 0x03, 0x64, 0xd7, 0xb2, 0xd2, 0xd0, 0xc7, 0xfb, 0x65, 0xcb, 0x2e, 0x3a, 0x03, 0xfb, 0xb0,
+	0x0a, 0xf9, 0x5e, 0xd7, 0xc5, 0x82, 0x34, 0xa5, 0x6b, 0x2a, 0xe2, 0xf2, 0x97, 0xe7, 0xab, 0xda,
+	0x6f, 0xab, 0xa1, 0xdf, 0x56, 0x37, 0x43, 0xbf, 0xd5, 0x58, 0x6f, 0xfd, 0xa5, 0x6c, 0xd9, 0xa0,
+	0x15, 0xe5, 0x54, 0xc2, 0xfa, 0x77, 0x2d, 0xc8, 0xd7, 0x09, 0x77, 0x02, 0xda, 0x95, 0x07, 0x01,
+	0x95, 0x60, 0xa2, 0xc3, 0x7c, 0xba, 0x6b, 0xdc, 0x2e, 0x67, 0x87, 0x8f, 0x68, 0x1e, 0xb2, 0xd4,
+	0x25, 0xbe, 0xa0, 0xa2, 0xaf, 0x37, 0xcc, 0x8e, 0x9e, 0xa5, 0xd6, 0x5d, 0xd2, 0xe2, 0x34, 0xe4,
+	0xda, 0x0e, 0x1f, 0xd1, 0x2b, 0x30, 0xcd, 0x89, 0xd3, 0x0b, 0xa8, 0xe8, 0x37, 0x1d, 0xe6, 0x0b,
+	0xec, 0x88, 0x52, 0x46, 0x89, 0x14, 0xc3, 0xf1, 0x15, 0x3d, 0x2c, 0x41, 0x5c, 0x22, 0x30, 0xf5,
+	0x78, 0xe9, 0x8c, 0x06, 0x31, 0x8f, 0x49, 0x73, 0x27, 0x20, 0x17, 0xf9, 0x2d, 0x5a, 0x81, 0x69,
+	0xd6, 0x25, 0x81, 0xfc, 0xdd, 0xc4, 0xae, 0x1b, 0x10, 0xce, 0x8d, 0x87, 0x96, 0x3e, 0x78, 0x74,
+	0x71, 0xd6, 0xd0, 0x7d, 0x45, 0xcf, 0x6c, 0x88, 0x80, 0xfa, 0x6d, 0xbb, 0x18, 0x6a, 0x98, 0x61,
+	0xf4, 0x86, 0xdc, 0x30, 0x9f, 0x13, 0x9f, 0xf7, 0x78, 0xb3, 0xdb, 0x6b, 0xed, 0x92, 0xbe, 0xe1,
+	0x75, 0xf6, 0x10, 0xaf, 0x57, 0xfc, 0x7e, 0xad, 0xf4, 0x7e, 0x0c, 0xed, 0x04, 0xfd, 0xae, 0x60,
+	0xd5, 0x46, 0xaf, 0x75, 0x9d, 0xf4, 0xed, 0x62, 0x84, 0xd3, 0x50, 0x30, 0xe8, 0x1c, 0x8c, 0xdf,
+	0xc6, 0xd4, 0x23, 0xae, 0x62, 0x25, 0x6b, 0x9b, 0x27, 0xb4, 0x0c, 0xe3, 0x5c, 0x60, 0xd1, 0xe3,
+	0x8a, 0x8a, 0xa9, 0xcb, 0x95, 0xe3, 0x3c, 0xa3, 0xc6, 0x7c, 0x77, 0x43, 0x49, 0xda, 0x46, 0x03,
+	0x6d, 0xc2, 0xb8, 0x60, 0xbb, 0xc4, 0x37, 0x24, 0x0d, 0xe5, 0xd5, 0x6b, 0xbe, 0x48, 0x78, 0xf5,
+	0x9a, 0x2f, 0x6c, 0x83, 0x85, 0xda, 0x30, 0xed, 0x12, 0x8f, 0xb4, 0x15, 0x95, 0x7c, 0x07, 0x07,
+	0x84, 0x97, 0xc6, 0x47, 0x70, 0x6a, 0x8a, 0x11, 0xea, 0x86, 0x02, 0x45, 0xd7, 0x21, 0xef, 0xc6,
+	0xee, 0x56, 0x9a, 0x50, 0x44, 0xbf, 0x70, 0xdc, 0xfa, 0x13, 0x9e, 0x69, 0x82, 0x54, 0x52, 0x5b,
+	0x3a, 0x57, 0xcf, 0x6f, 0x31, 0xdf, 0xa5, 0x7e, 0xbb, 0xb9, 0x43, 0x68, 0x7b, 0x47, 0x94, 0xb2,
+	0x8b, 0xd6, 0x85, 0xb4, 0x5d, 0x8c, 0xc6, 0xaf, 0xa9, 0x61, 0x74, 0x1d, 0xa6, 0x62, 0x51, 0x75,
+	0x76, 0x72, 0x43, 0x9c, 0x9d, 0x42, 0xa4, 0x2b, 0x67, 0xd1, 0x35, 0x80, 0xf8, 0x60, 0x96, 0x40,
+	0x01, 0x55, 0x9e, 0x7d, 0xba, 0xcd, 0x12, 0x12, 0xba, 0xc8, 0x83, 0xb3, 0x1d, 0xea, 0x37, 0x39,
+	0xf1, 0xb6, 0x9b, 0x86, 0x2a, 0x09, 0x99, 0x1f, 0xc1, 0xd6, 0xce, 0x74, 0xa8, 0xbf, 0x41, 0xbc,
+	0xed, 0x7a, 0x04, 0x8b, 0x30, 0x14, 0x3c, 0xfa, 0x83, 0x1e, 0x75, 0xc3, 0x2d, 0x9e, 0x1c, 0xc1,
+	0x16, 0x4f, 0x6a, 0x48, 0xbd, 0xbf, 0xcb, 0x93, 0xf7, 0x1e, 0x96, 0xc7, 0xcc, 0x71, 0x1d, 0xab,
+	0x34, 0x60, 0x72, 0x0b, 0x7b, 0xe6, 0xa4, 0x11, 0x8e, 0x5e, 0x83, 0x1c, 0x0e, 0x1f, 0x4a, 0xd6,
+	0x62, 0xfa, 0xc4, 0x93, 0x1a, 0x8b, 0xea, 0x00, 0xf0, 0x93, 0x3f, 0x2f, 0x5a, 0x95, 0xdf, 0x58,
+	0x30, 0x5e, 0xdf, 0x6a, 0x60, 0x1a, 0xa0, 0x55, 0x98, 0x89, 0x7d, 0xf6, 0xb4, 0xc7, 0x3f, 0x76,
+	0x73, 0x33, 0x2e, 0x61, 0xee, 0x84, 0x11, 0x25, 0x82, 0x49, 0x3d, 0x0b, 0x26, 0x52, 0x31, 0xe3,
+	0x03, 0x0b, 0x5f, 0x85, 0x09, 0x6d, 0x25, 0x47, 0xcb, 0x70, 0xa6, 0x2b, 0x7f, 0xa8, 0xf5, 0xe6,
+	0x2f, 0x2f, 0x1c, 0xeb, 0xeb, 0x4a, 0xde, 0xf8, 0x88, 0x56, 0xa9, 0xfc, 0xc7, 0x02, 0xa8, 0x6f,
+	0x6d, 0x6d, 0x06, 0xb4, 0xeb, 0x11, 0x31, 0xaa, 0x15, 0xdf, 0x80, 0xe7, 0xe2, 0x15, 0xf3, 0xc0,
+	0x39, 0xf5, 0xaa, 0xcf, 0x46, 0x6a, 0x1b, 0x81, 0x73, 0x24, 0x9a, 0xcb, 0x45, 0x84, 0x96, 0x3e,
+	0x35, 0x5a, 0x9d, 0x8b, 0xa3, 0x69, 0xdc, 0x80, 0x7c, 0xbc, 0x7c, 0x8e, 0xea, 0x90, 0x15, 0xe6,
+	0xb7, 0x61, 0xb3, 0x72, 0x3c, 0x9b, 0xa1, 0x9a, 0x61, 0x34, 0xd2, 0xac, 0xfc, 0x57, 0x92, 0x1a,
+	0x1f, 0x8a, 0x4f, 0x95, 0x1b, 0xc9, 0xf0, 0x6e, 0xce, 0xe6, 0x28, 0x92, 0x16, 0x83, 0x35, 0xc0,
+	0xea, 0xcf, 0x52, 0x70, 0xf6, 0x56, 0x18, 0xd0, 0x3e, 0xb5, 0x4c, 0x34, 0x60, 0x82, 0xf8, 0x22,
+	0xa0, 0x8a, 0x0a, 0xb9, 0xd7, 0x5f, 0x3e, 0x6e, 0xaf, 0x8f, 0x58, 0xcb, 0xaa, 0x2f, 0x82, 0xbe,
+	0xd9, 0xf9, 0x10, 0x66, 0x80, 0x85, 0x3f, 0xa5, 0xa0, 0x74, 0x9c, 0x26, 0x7a, 0x19, 0x8a, 0x4e,
+	0x40, 0xd4, 0x40, 0x78, 0xb1, 0x58, 0xea, 0x62, 0x99, 0x0a, 0x87, 0xcd, 0xbd, 0x72, 0x13, 0x64,
+	0x8e, 0x26, 0x1d, 0x4b, 0x8a, 0x0e, 0x9d, 0x94, 0x4d, 0xc5, 0xca, 0x72, 0x1a, 0x11, 0x28, 0x52,
+	0x9f, 0x0a, 0x8a, 0xbd, 0x66, 0x0b, 0x7b, 0xd8, 0x77, 0x3e, 0x4e, 0xf2, 0x7a, 0xf8, 0x2e, 0x98,
+	0x32, 0xa0, 0x35, 0x8d, 0x89, 0xb6, 0x60, 0x22, 0x84, 0xcf, 0x8c, 0x00, 0x3e, 0x04, 0x4b, 0x24,
+	0x6a, 0x1f, 0xa6, 0x60, 0xc6, 0x26, 0xee, 0x67, 0x8b, 0xd6, 0x37, 0x01, 0xf4, 0x81, 0x93, 0x71,
+	0xb0, 0x94, 0x19, 0xc1, 0x01, 0xce, 0x69, 0xbc, 0x3a, 0x17, 0x09, 0x6e, 0xdf, 0x4f, 0xc1, 0x64,
+	0x92, 0xdb, 0xcf, 0xc0, 0xbd, 0x80, 0xd6, 0xe2, 0x68, 0x90, 0x51, 0xd1, 0xe0, 0x95, 0xe3, 0xa2,
+	0xc1, 0x21, 0xaf, 0x3b, 0x39, 0x0c, 0xfc, 0x2d, 0x0b, 0xe3, 0x0d, 0x1c, 0xe0, 0x0e, 0x47, 0xdf,
+	0x3a, 0x94, 0x23, 0xea, 0xc2, 0x6d, 0xee, 0x90, 0xcf, 0xd5, 0x4d, 0xdf, 0x40, 0xbb, 0xdc, 0xdb,
+	0x47, 0xa4, 0x88, 0x5f, 0x84, 0x29, 0x59, 0x85, 0x46, 0x4b, 0xd1, 0x24, 0x16, 0x54, 0x19, 0x19,
+	0x15, 0x30, 0x1c, 0x95, 0x21, 0x2f, 0xc5, 0xe2, 0x40, 0x27, 0x65, 0xa0, 0x83, 0xf7, 0x56, 0xf5,
+	0x08, 0xba, 0x08, 0x68, 0x27, 0xea, 0x0b, 0x34, 0x63, 0x0a, 0xa4, 0xdc, 0x4c, 0x3c, 0x13, 0x8a,
+	0x7f, 0x1e, 0x40, 0x5a, 0xd1, 0x74, 0x89, 0xcf, 0x3a, 0xa6, 0x8c, 0xca, 0xc9, 0x91, 0xba, 0x1c,
+	0x40, 0x3f, 0xd2, 0xe9, 0xe6, 0x40, 0x81, 0x6a, 0x32, 0xfd, 0x1b, 0xc3, 0x79, 0xea, 0x47, 0xfb,
+	0xe5, 0xf9, 0x3e, 0xee, 0x78, 0xcb, 0x95, 0x23, 0x20, 0x2b, 0x2a, 0xfd, 0x3c, 0x58, 0xd8, 0xa2,
+	0x5f, 0x5a, 0x30, 0xd7, 0xf6, 0x58, 0x0b, 0x7b, 0xcd, 0x30, 0x0d, 0xd5, 0x7b, 0xd7, 0x74, 0x70,
+	0x57, 0x95, 0x02, 0xb9, 0x9a, 0x3d, 0xb4, 0x11, 0x8b, 0xda, 0x88, 0x63, 0x81, 0x2b, 0xf6, 0x39,
+	0x3d, 0x77, 0x43, 0xe7, 0xa9, 0x7a, 0x66, 0x05, 0x77, 0xd1, 0xaf, 0x2c, 0x38, 0x1f, 0xbb, 0xe8,
+	0x11, 0x26, 0x65, 0x95, 0x49, 0xb7, 0x86, 0x36, 0xe9, 0x05, 0x6d, 0xd2, 0x49, 0xd8, 0x15, 0x7b,
+	0x2e, 0x9a, 0x3e, 0x64, 0xd8, 0x2f, 0x2c, 0x98, 0x35, 0xeb, 0x89, 0xaa, 0x03, 0xb9, 0x89, 0xaa,
+	0x66, 0xc9, 0xd5, 0xde, 0x1c, 0x2e, 0x68, 0x7d, 0xb4, 0x5f, 0xfe, 0xdc, 0x01, 0x8e, 0x0e, 0x60,
+	0x56, 0x06, 0xcb, 0x06, 0x2d, 0x74, 0x53, 0x17, 0x0f, 0xb2, 0x00, 0x45, 0x3f, 0xb5, 0x92, 0x47,
+	0x59, 0xf9, 0xd7, 0x36, 0x76, 0x04, 0x0b, 0x54, 0xe9, 0x93, 0xab, 0xad, 0x0f, 0x4d, 0xd0, 0xf9,
+	0x41, 0x82, 0x12, 0xa0, 0x95, 0x44, 0x00, 0x90, 0xaf, 0xbf, 0xaa, 0x46, 0xd1, 0x1a, 0x4c, 0xef,
+	0x92, 0x7e, 0x33, 0x60, 0x42, 0x5f, 0x1f, 0xdb, 0x84, 0x94, 0xf2, 0xe6, 0x78, 0x1a, 0xf3, 0x65,
+	0xeb, 0x2d, 0x51, 0x76, 0xd1, 0xb0, 0xe0, 0x9a, 0xda, 0x25, 0x7d, 0xdb, 0xe8, 0x5d, 0x25, 0x04,
+	0xdd, 0x86, 0x72, 0xc2, 0x5d, 0x4d, 0xa3, 0xc8, 0x67, 0x82, 0x3a, 0xa4, 0xd9, 0x25, 0x01, 0x65,
+	0x6e, 0x69, 0xd2, 0x20, 0x9f, 0xe2, 0xe0, 0x9f, 0x8f, 0xb1, 0x74, 0x7f, 0x68, 0x5d, 0x21, 0x35,
+	0x14, 0x50, 0x22, 0x6a, 0xbf, 0x63, 0x01, 0x8a, 0xd3, 0x0c, 0x9b, 0xf0, 0x2e, 0xf3, 0xb9, 0xaa,
+	0x25, 0x13, 0x85, 0x9f, 0x75, 0x72, 0x2d, 0x19, 0xeb, 0x87, 0xb5, 0x64, 0xac, 0x8b, 0xbe, 0x16,
+	0x5f, 0xea, 0xa9, 0xd3, 0x11, 0x73, 0xe8, 0xde, 0x1e, 0xab, 0x7c, 0x68, 0xc1, 0xdc, 0xa1, 0x08,
+	0x1a, 0x19, 0xfb, 0x7d, 0x40, 0x41, 0x62, 0x52, 0xc5, 0xa3, 0xbe, 0x31, 0x7a, 0xe8, 0x80, 0x3c,
+	0x13, 0x0c, 0x4e, 0x7c, 0x62, 0x79, 0x49, 0x46, 0xed, 0xc0, 0xef, 0x2d, 0x98, 0x4d, 0x1a, 0x13,
+	0x2d, 0x6b, 0x1d, 0x26, 0x93, 0xb6, 0x98, 0x05, 0xbd, 0x78, 0x9a, 0x05, 0x99, 0xb5, 0x1c, 0xd0,
+	0x47, 0xaf, 0xc7, 0x97, 0x95, 0xee, 0xc1, 0x5e, 0x3a, 0x35, 0x37, 0xa1, 0x4d, 0x83, 0x97, 0x56,
+	0x26, 0xcc, 0xdc, 0x33, 0x0d, 0xc6, 0x3c, 0xf4, 0x63, 0x98, 0xf1, 0x99, 0x50, 0xc7, 0x86, 0xb8,
+	0x4d, 0xd3, 0x10, 0xd2, 0x37, 0xfe, 0xeb, 0xc3, 0x51, 0xf6, 0xcf, 0xfd, 0xf2, 0x61, 0xa8, 0x01,
+	0x1e, 0x8b, 0x3e, 0x13, 0x35, 0x35, 0xbf, 0xa9, 0xa6, 0x51, 0x00, 0x85, 0x83, 0xaf, 0xd6, 0x19,
+	0xc2, 0xcd, 0xa1, 0x5f, 0x5d, 0x38, 0xe9, 0xb5, 0x93, 0xad, 0xc4, 0x3b, 0x97, 0xb3, 0x72, 0x0f,
+	0xff, 0x25, 0xf7, 0xf1, 0x91, 0x05, 0x67, 0xd5, 0x20, 0xfd, 0x21, 0x51, 0x6d, 0x07, 0x9b, 0x38,
+	0x2c, 0x70, 0xd1, 0x14, 0xa4, 0xa8, 0xab, 0x58, 0xc8, 0xd8, 0x29, 0xea, 0xa2, 0x2a, 0x9c, 0x61,
+	0x77, 0x7d, 0x12, 0x3c, 0x33, 0x7f, 0xd1, 0x62, 0xea, 0xce, 0x66, 0x6e, 0xcf, 0x23, 0x4d, 0xec,
+	0x38, 0xac, 0xe7, 0x0b, 0xd3, 0xcc, 0x2c, 0xe8, 0xd1, 0x2b, 0x7a, 0x50, 0x36, 0x31, 0xa2, 0x00,
+	0x55, 0xca, 0x3c, 0x03, 0x3a, 0x16, 0xad, 0xfc, 0x3b, 0x0d, 0x85, 0xad, 0x64, 0x64, 0x3b, 0xba,
+	0x52, 0xb2, 0x86, 0xae, 0x94, 0xde, 0x80, 0x5c, 0x7c, 0x45, 0xa4, 0x46, 0x70, 0x6e, 0xb2, 0x3c,
+	0x0c, 0xfd, 0x71, 0x5f, 0x30, 0xde, 0xeb, 0x51, 0x64, 0xce, 0xc5, 0x08, 0xd5, 0x78, 0x54, 0x07,
+	0xce, 0x26, 0xe2, 0x8a, 0xfc, 0x94, 0x11, 0x30, 0xd6, 0x19, 0x49, 0x14, 0x40, 0x31, 0xf0, 0x35,
+	0x83, 0x8b, 0x6e, 0x03, 0x8a, 0x28, 0x8b, 0xdf, 0x36, 0x8a, 0x8e, 0xea, 0x74, 0xc8, 0x5d, 0xf8,
+	0xae, 0xca, 0xaf, 0xd3, 0x30, 0xb7, 0xc2, 0x7c, 0x6e, 0xba, 0xc4, 0xe6, 0x22, 0xd2, 0x5f, 0x7b,
+	0xfa, 0xa3, 0xe9, 0x61, 0x6f, 0x41, 0x91, 0x79, 0xae, 0x6c, 0xb0, 0xff, 0x9f, 0x2d, 0xec, 0x02,
+	0xf3, 0x5c, 0x63, 0xab, 0x6c, 0x60, 0x6f, 0x41, 0xd1, 0x27, 0x77, 0x0f, 0xe0, 0xa6, 0x3f, 0x1e,
+	0xae, 0x4f, 0xee, 0x26, 0x70, 0xcf, 0xc9, 0x6f, 0x5b, 0xaa, 0xfc, 0xcb, 0xa8, 0xf2, 0xcf, 0x3c,
+	0xa1, 0xaf, 0x42, 0x46, 0xe5, 0xdd, 0x67, 0x86, 0xa8, 0xf5, 0x94, 0x06, 0xba, 0x04, 0xe9, 0x6d,
+	0xa2, 0x33, 0xd9, 0x53, 0x5c, 0x7c, 0x52, 0x76, 0x39, 0x7b, 0x2f, 0xbc, 0xf4, 0xfe, 0x91, 0x82,
+	0xe7, 0x1b, 0x44, 0x25, 0xef, 0x2b, 0x03, 0x97, 0xf9, 0xa8, 0xce, 0x28, 0x81, 0xe2, 0x60, 0xd6,
+	0x3d, 0x8a, 0xaf, 0x5e, 0x53, 0x07, 0x3f, 0x10, 0xa1, 0x35, 0x28, 0x70, 0x67, 0x87, 0xa8, 0x20,
+	0xa6, 0x98, 0x4c, 0x0f, 0xc1, 0xe4, 0x64, 0xa8, 0x2a, 0x27, 0x65, 0xc7, 0x9c, 0x6c, 0x6f, 0x13,
+	0x47, 0xd0, 0x3b, 0x06, 0x2b, 0x33, 0x4c, 0xc7, 0x3c, 0xd2, 0xd5, 0x1f, 0x9c, 0x42, 0xae, 0xbf,
+	0xf4, 0x5b, 0x0b, 0x20, 0xfe, 0xac, 0x81, 0x5e, 0x85, 0xe7, 0x6b, 0xdf, 0x5e, 0xaf, 0x37, 0x37,
+	0x36, 0xaf, 0x6c, 0xde, 0xda, 0x68, 0xde, 0x5a, 0xdf, 0x68, 0xac, 0xae, 0xac, 0x5d, 0x5d, 0x5b,
+	0xad, 0x4f, 0x8f, 0xcd, 0x17, 0xef, 0x3f, 0x58, 0xcc, 0xdf, 0xf2, 0x79, 0x97, 0x38, 0x74, 0x9b,
+	0x12, 0x17, 0xbd, 0x04, 0xb3, 0x07, 0xa5, 0xe5, 0xd3, 0x6a, 0x7d, 0xda, 0x9a, 0x9f, 0xbc, 0xff,
+	0x60, 0x31, 0xab, 0xdb, 0x39, 0xc4, 0x45, 0x17, 0xe0, 0xb9, 0xc3, 0x72, 0x6b, 0xeb, 0xdf, 0x9c,
+	0x4e, 0xcd, 0x17, 0xee, 0x3f, 0x58, 0xcc, 0x45, 0x7d, 0x1f, 0x54, 0x01, 0x94, 0x94, 0x34, 0x78,
+	0xe9, 0x79, 0xb8, 0xff, 0x60, 0x71, 0x5c, 0xdf, 0x79, 0xf3, 0x99, 0x7b, 0xef, 0x2c, 0x8c, 0xd5,
+	0xae, 0xbe, 0xf7, 0x64, 0xc1, 0x7a, 0xfc, 0x64, 0xc1, 0xfa, 0xeb, 0x93, 0x05, 0xeb, 0xad, 0xa7,
+	0x0b, 0x63, 0x8f, 0x9f, 0x2e, 0x8c, 0xfd, 0xe1, 0xe9, 0xc2, 0xd8, 0x77, 0x5f, 0x3d, 0x71, 0xeb,
+	0xf6, 0xa2, 0xaf, 0xdb, 0x6a, 0x13, 0x5b, 0xe3, 0x8a, 0xb7, 0xaf, 0xfc, 0x6f, 0x00, 0xc0, 0x35,
+	0x2e, 0xb8, 0xfc, 0x1e, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,476 +1568,508 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7499 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbc, 0x6b, 0x70, 0x24, 0xd7,
-		0x75, 0x1f, 0x8e, 0x79, 0x60, 0x30, 0x73, 0x66, 0x30, 0x68, 0x5c, 0x60, 0x77, 0x67, 0x41, 0x12,
-		0x00, 0x87, 0x8f, 0x5d, 0xbe, 0xb0, 0xe4, 0x92, 0xbb, 0xcb, 0x9d, 0xb5, 0xc4, 0xff, 0xbc, 0x16,
-		0xc4, 0x2e, 0x1e, 0xc3, 0x1e, 0x60, 0xf9, 0xf0, 0xdf, 0xe9, 0x6a, 0xf4, 0x5c, 0x0c, 0x9a, 0xe8,
-		0xe9, 0x6e, 0x77, 0xf7, 0xec, 0x2e, 0x58, 0x4e, 0x8a, 0x2e, 0xe5, 0x21, 0x6d, 0x2a, 0x8e, 0x1c,
-		0xa7, 0x62, 0x59, 0xd6, 0x2a, 0x94, 0xe5, 0x44, 0x8e, 0xa2, 0x3c, 0x6c, 0x29, 0x4a, 0x1c, 0x57,
-		0x12, 0x25, 0xa9, 0x24, 0xb2, 0x3e, 0xa4, 0x64, 0x7f, 0x88, 0xed, 0x3c, 0x18, 0x87, 0x52, 0x25,
-		0x8c, 0xa2, 0xc4, 0x8e, 0xc2, 0x54, 0x25, 0xa5, 0x52, 0x2a, 0x75, 0x5f, 0xfd, 0x98, 0x07, 0x66,
-		0xb0, 0x59, 0xca, 0xae, 0xf2, 0x27, 0x4c, 0x9f, 0x7b, 0x7e, 0xbf, 0x3e, 0xf7, 0xdc, 0x73, 0xcf,
-		0x3d, 0xf7, 0x76, 0x37, 0xe0, 0x9f, 0x5e, 0x81, 0xe5, 0xb6, 0x65, 0xb5, 0x0d, 0x7c, 0xce, 0x76,
-		0x2c, 0xcf, 0xda, 0xed, 0xee, 0x9d, 0x6b, 0x61, 0x57, 0x73, 0x74, 0xdb, 0xb3, 0x9c, 0x15, 0x2a,
-		0x43, 0x33, 0x4c, 0x63, 0x45, 0x68, 0x14, 0x37, 0x60, 0xf6, 0xaa, 0x6e, 0xe0, 0x9a, 0xaf, 0xd8,
-		0xc4, 0x1e, 0x7a, 0x11, 0x92, 0x7b, 0xba, 0x81, 0x0b, 0xb1, 0xe5, 0xc4, 0xd9, 0xec, 0xf9, 0x47,
-		0x57, 0x7a, 0x40, 0x2b, 0x51, 0x44, 0x83, 0x88, 0x65, 0x8a, 0x28, 0x7e, 0x3b, 0x09, 0x73, 0x03,
-		0x5a, 0x11, 0x82, 0xa4, 0xa9, 0x76, 0x08, 0x63, 0xec, 0x6c, 0x46, 0xa6, 0xbf, 0x51, 0x01, 0xa6,
-		0x6c, 0x55, 0x3b, 0x50, 0xdb, 0xb8, 0x10, 0xa7, 0x62, 0x71, 0x89, 0x16, 0x01, 0x5a, 0xd8, 0xc6,
-		0x66, 0x0b, 0x9b, 0xda, 0x61, 0x21, 0xb1, 0x9c, 0x38, 0x9b, 0x91, 0x43, 0x12, 0xf4, 0x14, 0xcc,
-		0xda, 0xdd, 0x5d, 0x43, 0xd7, 0x94, 0x90, 0x1a, 0x2c, 0x27, 0xce, 0x4e, 0xca, 0x12, 0x6b, 0xa8,
-		0x05, 0xca, 0x67, 0x60, 0xe6, 0x16, 0x56, 0x0f, 0xc2, 0xaa, 0x59, 0xaa, 0x9a, 0x27, 0xe2, 0x90,
-		0x62, 0x15, 0x72, 0x1d, 0xec, 0xba, 0x6a, 0x1b, 0x2b, 0xde, 0xa1, 0x8d, 0x0b, 0x49, 0xda, 0xfb,
-		0xe5, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xcb, 0x51, 0xdb, 0x87, 0x36, 0x46, 0x65, 0xc8, 0x60, 0xb3,
-		0xdb, 0x61, 0x0c, 0x93, 0x43, 0xfc, 0x57, 0x37, 0xbb, 0x9d, 0x5e, 0x96, 0x34, 0x81, 0x71, 0x8a,
-		0x29, 0x17, 0x3b, 0x37, 0x75, 0x0d, 0x17, 0x52, 0x94, 0xe0, 0x4c, 0x1f, 0x41, 0x93, 0xb5, 0xf7,
-		0x72, 0x08, 0x1c, 0xaa, 0x42, 0x06, 0xdf, 0xf6, 0xb0, 0xe9, 0xea, 0x96, 0x59, 0x98, 0xa2, 0x24,
-		0x8f, 0x0d, 0x18, 0x45, 0x6c, 0xb4, 0x7a, 0x29, 0x02, 0x1c, 0xba, 0x08, 0x53, 0x96, 0xed, 0xe9,
-		0x96, 0xe9, 0x16, 0xd2, 0xcb, 0xb1, 0xb3, 0xd9, 0xf3, 0x0f, 0x0e, 0x0c, 0x84, 0x2d, 0xa6, 0x23,
-		0x0b, 0x65, 0xb4, 0x06, 0x92, 0x6b, 0x75, 0x1d, 0x0d, 0x2b, 0x9a, 0xd5, 0xc2, 0x8a, 0x6e, 0xee,
-		0x59, 0x85, 0x0c, 0x25, 0x58, 0xea, 0xef, 0x08, 0x55, 0xac, 0x5a, 0x2d, 0xbc, 0x66, 0xee, 0x59,
-		0x72, 0xde, 0x8d, 0x5c, 0xa3, 0x93, 0x90, 0x72, 0x0f, 0x4d, 0x4f, 0xbd, 0x5d, 0xc8, 0xd1, 0x08,
-		0xe1, 0x57, 0xc5, 0x5f, 0x4d, 0xc1, 0xcc, 0x38, 0x21, 0x76, 0x05, 0x26, 0xf7, 0x48, 0x2f, 0x0b,
-		0xf1, 0xe3, 0xf8, 0x80, 0x61, 0xa2, 0x4e, 0x4c, 0xdd, 0xa3, 0x13, 0xcb, 0x90, 0x35, 0xb1, 0xeb,
-		0xe1, 0x16, 0x8b, 0x88, 0xc4, 0x98, 0x31, 0x05, 0x0c, 0xd4, 0x1f, 0x52, 0xc9, 0x7b, 0x0a, 0xa9,
-		0xd7, 0x60, 0xc6, 0x37, 0x49, 0x71, 0x54, 0xb3, 0x2d, 0x62, 0xf3, 0xdc, 0x28, 0x4b, 0x56, 0xea,
-		0x02, 0x27, 0x13, 0x98, 0x9c, 0xc7, 0x91, 0x6b, 0x54, 0x03, 0xb0, 0x4c, 0x6c, 0xed, 0x29, 0x2d,
-		0xac, 0x19, 0x85, 0xf4, 0x10, 0x2f, 0x6d, 0x11, 0x95, 0x3e, 0x2f, 0x59, 0x4c, 0xaa, 0x19, 0xe8,
-		0x72, 0x10, 0x6a, 0x53, 0x43, 0x22, 0x65, 0x83, 0x4d, 0xb2, 0xbe, 0x68, 0xdb, 0x81, 0xbc, 0x83,
-		0x49, 0xdc, 0xe3, 0x16, 0xef, 0x59, 0x86, 0x1a, 0xb1, 0x32, 0xb2, 0x67, 0x32, 0x87, 0xb1, 0x8e,
-		0x4d, 0x3b, 0xe1, 0x4b, 0xf4, 0x08, 0xf8, 0x02, 0x85, 0x86, 0x15, 0xd0, 0x2c, 0x94, 0x13, 0xc2,
-		0x4d, 0xb5, 0x83, 0x17, 0xde, 0x82, 0x7c, 0xd4, 0x3d, 0x68, 0x1e, 0x26, 0x5d, 0x4f, 0x75, 0x3c,
-		0x1a, 0x85, 0x93, 0x32, 0xbb, 0x40, 0x12, 0x24, 0xb0, 0xd9, 0xa2, 0x59, 0x6e, 0x52, 0x26, 0x3f,
-		0xd1, 0xff, 0x17, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd,
-		0x70, 0x09, 0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x13, 0x03, 0xa9, 0xd1, 0x6b,
-		0x30, 0xdf, 0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x34,
-		0x35, 0x24, 0xe6, 0x76, 0xc2, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf,
-		0x9f, 0x92, 0xde, 0x7e, 0xfb, 0xed, 0xb7, 0xe3, 0xc5, 0x7f, 0x9c, 0x82, 0xf9, 0x41, 0x73, 0x66,
-		0xe0, 0xf4, 0x3d, 0x09, 0x29, 0xb3, 0xdb, 0xd9, 0xc5, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50,
-		0x19, 0x26, 0x0d, 0x75, 0x17, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x6c, 0xfe, 0xfc, 0x53, 0x63, 0xcd,
-		0xca, 0x95, 0x75, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7,
-		0x63, 0x20, 0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73,
-		0x9a, 0x08, 0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49,
-		0x60, 0xb5, 0xf0, 0x9e, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c,
-		0xe3, 0xc2, 0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d,
-		0x27, 0x65, 0x36, 0xd1, 0xd6, 0x88, 0x84, 0xdc, 0xfe, 0x4d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b,
-		0x10, 0x01, 0xbd, 0xfd, 0xa5, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x33, 0x30,
-		0x43, 0x35, 0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xd9, 0xb4, 0x9c, 0x67, 0xe2,
-		0x2d, 0x2e, 0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xfd, 0x7a, 0xa3, 0xae,
-		0xd4, 0xb6, 0x76, 0x2a, 0xeb, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x75, 0x7d, 0xab, 0xbc,
-		0x2d, 0xc5, 0xfd, 0xeb, 0xb5, 0xcd, 0xed, 0x8b, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xc3, 0x04, 0xc9,
-		0xb0, 0xc2, 0xf3, 0xe7, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbd, 0x56, 0xaf, 0x5d, 0x7c,
-		0x41, 0x4a, 0x45, 0x25, 0xcf, 0x9f, 0x97, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb5, 0xb5,
-		0x2e, 0xa5, 0x7d, 0xce, 0xe6, 0xb6, 0xbc, 0xb6, 0xb9, 0x2a, 0x65, 0x7c, 0xce, 0x55, 0x79, 0x6b,
-		0xa7, 0x21, 0x81, 0xcf, 0xb0, 0x51, 0x6f, 0x36, 0xcb, 0xab, 0x75, 0x29, 0xeb, 0x6b, 0x54, 0x5e,
-		0xdf, 0xae, 0x37, 0xa5, 0x5c, 0xc4, 0xac, 0xe7, 0xcf, 0x4b, 0xd3, 0xfe, 0x2d, 0xea, 0x9b, 0x3b,
-		0x1b, 0x52, 0x1e, 0xcd, 0xc2, 0x34, 0xbb, 0x85, 0x30, 0x62, 0xa6, 0x47, 0x74, 0xf1, 0x05, 0x49,
-		0x0a, 0x0c, 0x61, 0x2c, 0xb3, 0x11, 0xc1, 0xc5, 0x17, 0x24, 0x54, 0xac, 0xc2, 0x24, 0x0d, 0x43,
-		0x84, 0x20, 0xbf, 0x5e, 0xae, 0xd4, 0xd7, 0x95, 0xad, 0xc6, 0xf6, 0xda, 0xd6, 0x66, 0x79, 0x5d,
-		0x8a, 0x05, 0x32, 0xb9, 0xfe, 0xca, 0xce, 0x9a, 0x5c, 0xaf, 0x49, 0xf1, 0xb0, 0xac, 0x51, 0x2f,
-		0x6f, 0xd7, 0x6b, 0x52, 0xa2, 0xa8, 0xc1, 0xfc, 0xa0, 0x84, 0x3a, 0x70, 0x0a, 0x85, 0x62, 0x21,
-		0x3e, 0x24, 0x16, 0x28, 0x57, 0x6f, 0x2c, 0x14, 0xbf, 0x15, 0x87, 0xb9, 0x01, 0x8b, 0xca, 0xc0,
-		0x9b, 0xbc, 0x04, 0x93, 0x2c, 0x96, 0xd9, 0x32, 0xfb, 0xc4, 0xc0, 0xd5, 0x89, 0x46, 0x76, 0xdf,
-		0x52, 0x4b, 0x71, 0xe1, 0x52, 0x23, 0x31, 0xa4, 0xd4, 0x20, 0x14, 0x7d, 0x01, 0xfb, 0x63, 0x7d,
-		0xc9, 0x9f, 0xad, 0x8f, 0x17, 0xc7, 0x59, 0x1f, 0xa9, 0xec, 0x78, 0x8b, 0xc0, 0xe4, 0x80, 0x45,
-		0xe0, 0x0a, 0xcc, 0xf6, 0x11, 0x8d, 0x9d, 0x8c, 0x3f, 0x16, 0x83, 0xc2, 0x30, 0xe7, 0x8c, 0x48,
-		0x89, 0xf1, 0x48, 0x4a, 0xbc, 0xd2, 0xeb, 0xc1, 0x87, 0x87, 0x0f, 0x42, 0xdf, 0x58, 0x7f, 0x21,
-		0x06, 0x27, 0x07, 0x97, 0x94, 0x03, 0x6d, 0xf8, 0x28, 0xa4, 0x3a, 0xd8, 0xdb, 0xb7, 0x44, 0x59,
-		0xf5, 0xf8, 0x80, 0xc5, 0x9a, 0x34, 0xf7, 0x0e, 0x36, 0x47, 0x85, 0x57, 0xfb, 0xc4, 0xb0, 0xba,
-		0x90, 0x59, 0xd3, 0x67, 0xe9, 0x27, 0xe2, 0x70, 0x62, 0x20, 0xf9, 0x40, 0x43, 0x1f, 0x02, 0xd0,
-		0x4d, 0xbb, 0xeb, 0xb1, 0xd2, 0x89, 0x65, 0xe2, 0x0c, 0x95, 0xd0, 0xe4, 0x45, 0xb2, 0x6c, 0xd7,
-		0xf3, 0xdb, 0x13, 0xb4, 0x1d, 0x98, 0x88, 0x2a, 0xbc, 0x18, 0x18, 0x9a, 0xa4, 0x86, 0x2e, 0x0e,
-		0xe9, 0x69, 0x5f, 0x60, 0x3e, 0x0b, 0x92, 0x66, 0xe8, 0xd8, 0xf4, 0x14, 0xd7, 0x73, 0xb0, 0xda,
-		0xd1, 0xcd, 0x36, 0x5d, 0x6a, 0xd2, 0xa5, 0xc9, 0x3d, 0xd5, 0x70, 0xb1, 0x3c, 0xc3, 0x9a, 0x9b,
-		0xa2, 0x95, 0x20, 0x68, 0x00, 0x39, 0x21, 0x44, 0x2a, 0x82, 0x60, 0xcd, 0x3e, 0xa2, 0xf8, 0xd3,
-		0x19, 0xc8, 0x86, 0x0a, 0x70, 0xf4, 0x30, 0xe4, 0xde, 0x54, 0x6f, 0xaa, 0x8a, 0xd8, 0x54, 0x31,
-		0x4f, 0x64, 0x89, 0xac, 0xc1, 0x37, 0x56, 0xcf, 0xc2, 0x3c, 0x55, 0xb1, 0xba, 0x1e, 0x76, 0x14,
-		0xcd, 0x50, 0x5d, 0x97, 0x3a, 0x2d, 0x4d, 0x55, 0x11, 0x69, 0xdb, 0x22, 0x4d, 0x55, 0xd1, 0x82,
-		0x2e, 0xc0, 0x1c, 0x45, 0x74, 0xba, 0x86, 0xa7, 0xdb, 0x06, 0x56, 0xc8, 0x36, 0xcf, 0xa5, 0x4b,
-		0x8e, 0x6f, 0xd9, 0x2c, 0xd1, 0xd8, 0xe0, 0x0a, 0xc4, 0x22, 0x17, 0xd5, 0xe0, 0x21, 0x0a, 0x6b,
-		0x63, 0x13, 0x3b, 0xaa, 0x87, 0x15, 0xfc, 0xe3, 0x5d, 0xd5, 0x70, 0x15, 0xd5, 0x6c, 0x29, 0xfb,
-		0xaa, 0xbb, 0x5f, 0x98, 0x27, 0x04, 0x95, 0x78, 0x21, 0x26, 0x9f, 0x26, 0x8a, 0xab, 0x5c, 0xaf,
-		0x4e, 0xd5, 0xca, 0x66, 0xeb, 0x65, 0xd5, 0xdd, 0x47, 0x25, 0x38, 0x49, 0x59, 0x5c, 0xcf, 0xd1,
-		0xcd, 0xb6, 0xa2, 0xed, 0x63, 0xed, 0x40, 0xe9, 0x7a, 0x7b, 0x2f, 0x16, 0x1e, 0x08, 0xdf, 0x9f,
-		0x5a, 0xd8, 0xa4, 0x3a, 0x55, 0xa2, 0xb2, 0xe3, 0xed, 0xbd, 0x88, 0x9a, 0x90, 0x23, 0x83, 0xd1,
-		0xd1, 0xdf, 0xc2, 0xca, 0x9e, 0xe5, 0xd0, 0x35, 0x34, 0x3f, 0x20, 0x35, 0x85, 0x3c, 0xb8, 0xb2,
-		0xc5, 0x01, 0x1b, 0x56, 0x0b, 0x97, 0x26, 0x9b, 0x8d, 0x7a, 0xbd, 0x26, 0x67, 0x05, 0xcb, 0x55,
-		0xcb, 0x21, 0x01, 0xd5, 0xb6, 0x7c, 0x07, 0x67, 0x59, 0x40, 0xb5, 0x2d, 0xe1, 0xde, 0x0b, 0x30,
-		0xa7, 0x69, 0xac, 0xcf, 0xba, 0xa6, 0xf0, 0xcd, 0x98, 0x5b, 0x90, 0x22, 0xce, 0xd2, 0xb4, 0x55,
-		0xa6, 0xc0, 0x63, 0xdc, 0x45, 0x97, 0xe1, 0x44, 0xe0, 0xac, 0x30, 0x70, 0xb6, 0xaf, 0x97, 0xbd,
-		0xd0, 0x0b, 0x30, 0x67, 0x1f, 0xf6, 0x03, 0x51, 0xe4, 0x8e, 0xf6, 0x61, 0x2f, 0xec, 0x12, 0xcc,
-		0xdb, 0xfb, 0x76, 0x3f, 0xee, 0xc9, 0x30, 0x0e, 0xd9, 0xfb, 0x76, 0x2f, 0xf0, 0x31, 0xba, 0x33,
-		0x77, 0xb0, 0xa6, 0x7a, 0xb8, 0x55, 0x38, 0x15, 0x56, 0x0f, 0x35, 0xa0, 0x15, 0x90, 0x34, 0x4d,
-		0xc1, 0xa6, 0xba, 0x6b, 0x60, 0x45, 0x75, 0xb0, 0xa9, 0xba, 0x85, 0x25, 0xaa, 0x9c, 0xf4, 0x9c,
-		0x2e, 0x96, 0xf3, 0x9a, 0x56, 0xa7, 0x8d, 0x65, 0xda, 0x86, 0x9e, 0x84, 0x59, 0x6b, 0xf7, 0x4d,
-		0x8d, 0x45, 0xa4, 0x62, 0x3b, 0x78, 0x4f, 0xbf, 0x5d, 0x78, 0x94, 0xba, 0x77, 0x86, 0x34, 0xd0,
-		0x78, 0x6c, 0x50, 0x31, 0x7a, 0x02, 0x24, 0xcd, 0xdd, 0x57, 0x1d, 0x9b, 0xa6, 0x64, 0xd7, 0x56,
-		0x35, 0x5c, 0x78, 0x8c, 0xa9, 0x32, 0xf9, 0xa6, 0x10, 0x93, 0x19, 0xe1, 0xde, 0xd2, 0xf7, 0x3c,
-		0xc1, 0x78, 0x86, 0xcd, 0x08, 0x2a, 0xe3, 0x6c, 0x67, 0x41, 0x22, 0x9e, 0x88, 0xdc, 0xf8, 0x2c,
-		0x55, 0xcb, 0xdb, 0xfb, 0x76, 0xf8, 0xbe, 0x8f, 0xc0, 0x34, 0xd1, 0x0c, 0x6e, 0xfa, 0x04, 0x2b,
-		0xdc, 0xec, 0xfd, 0xd0, 0x1d, 0x5f, 0x80, 0x93, 0x44, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a, 0x6a,
-		0x48, 0xfb, 0x69, 0xaa, 0x4d, 0xdc, 0xbe, 0xc1, 0x1b, 0x23, 0x76, 0x3a, 0xdd, 0xdd, 0x43, 0x3f,
-		0xb0, 0x9e, 0x61, 0x76, 0x12, 0x99, 0x08, 0xad, 0x0f, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17, 0x8e,
-		0x7b, 0x94, 0x01, 0x16, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xad, 0x1a, 0x29, 0x5f, 0xde, 0xa8,
-		0x4b, 0x71, 0x52, 0x46, 0xad, 0xaf, 0x6d, 0xd7, 0x15, 0x79, 0x67, 0x73, 0x7b, 0x6d, 0xa3, 0x2e,
-		0x25, 0x42, 0x85, 0xfd, 0xb5, 0x64, 0xfa, 0x71, 0xe9, 0x0c, 0xa9, 0x1a, 0xf2, 0xd1, 0x9d, 0x1a,
-		0xfa, 0x11, 0x38, 0x25, 0x8e, 0x55, 0x5c, 0xec, 0x29, 0xb7, 0x74, 0x87, 0x4e, 0xc8, 0x8e, 0xca,
-		0x16, 0x47, 0x3f, 0x7e, 0xe6, 0xb9, 0x56, 0x13, 0x7b, 0xaf, 0xea, 0x0e, 0x99, 0x6e, 0x1d, 0xd5,
-		0x43, 0xeb, 0xb0, 0x64, 0x5a, 0x8a, 0xeb, 0xa9, 0x66, 0x4b, 0x75, 0x5a, 0x4a, 0x70, 0xa0, 0xa5,
-		0xa8, 0x9a, 0x86, 0x5d, 0xd7, 0x62, 0x0b, 0xa1, 0xcf, 0xf2, 0xa0, 0x69, 0x35, 0xb9, 0x72, 0xb0,
-		0x42, 0x94, 0xb9, 0x6a, 0x4f, 0xf8, 0x26, 0x86, 0x85, 0xef, 0x03, 0x90, 0xe9, 0xa8, 0xb6, 0x82,
-		0x4d, 0xcf, 0x39, 0xa4, 0xf5, 0x79, 0x5a, 0x4e, 0x77, 0x54, 0xbb, 0x4e, 0xae, 0x7f, 0x28, 0xdb,
-		0xa4, 0x6b, 0xc9, 0x74, 0x52, 0x9a, 0xbc, 0x96, 0x4c, 0x4f, 0x4a, 0xa9, 0x6b, 0xc9, 0x74, 0x4a,
-		0x9a, 0xba, 0x96, 0x4c, 0xa7, 0xa5, 0xcc, 0xb5, 0x64, 0x3a, 0x23, 0x41, 0xf1, 0xbd, 0x04, 0xe4,
-		0xc2, 0x15, 0x3c, 0xd9, 0x10, 0x69, 0x74, 0x0d, 0x8b, 0xd1, 0x2c, 0xf7, 0xc8, 0x91, 0xf5, 0xfe,
-		0x4a, 0x95, 0x2c, 0x6e, 0xa5, 0x14, 0x2b, 0x97, 0x65, 0x86, 0x24, 0x85, 0x05, 0x09, 0x3f, 0xcc,
-		0xca, 0x93, 0xb4, 0xcc, 0xaf, 0xd0, 0x2a, 0xa4, 0xde, 0x74, 0x29, 0x77, 0x8a, 0x72, 0x3f, 0x7a,
-		0x34, 0xf7, 0xb5, 0x26, 0x25, 0xcf, 0x5c, 0x6b, 0x2a, 0x9b, 0x5b, 0xf2, 0x46, 0x79, 0x5d, 0xe6,
-		0x70, 0x74, 0x1a, 0x92, 0x86, 0xfa, 0xd6, 0x61, 0x74, 0x19, 0xa4, 0xa2, 0x71, 0x87, 0xe5, 0x34,
-		0x24, 0x6f, 0x61, 0xf5, 0x20, 0xba, 0xf8, 0x50, 0xd1, 0x87, 0x38, 0x3d, 0xce, 0xc1, 0x24, 0xf5,
-		0x17, 0x02, 0xe0, 0x1e, 0x93, 0x26, 0x50, 0x1a, 0x92, 0xd5, 0x2d, 0x99, 0x4c, 0x11, 0x09, 0x72,
-		0x4c, 0xaa, 0x34, 0xd6, 0xea, 0xd5, 0xba, 0x14, 0x2f, 0x5e, 0x80, 0x14, 0x73, 0x02, 0x99, 0x3e,
-		0xbe, 0x1b, 0xa4, 0x09, 0x7e, 0xc9, 0x39, 0x62, 0xa2, 0x75, 0x67, 0xa3, 0x52, 0x97, 0xa5, 0x78,
-		0xdf, 0xe0, 0x17, 0x5d, 0xc8, 0x85, 0x2b, 0xf3, 0x1f, 0xce, 0xf6, 0xfc, 0x6b, 0x31, 0xc8, 0x86,
-		0x2a, 0x6d, 0x52, 0x22, 0xa9, 0x86, 0x61, 0xdd, 0x52, 0x54, 0x43, 0x57, 0x5d, 0x1e, 0x1a, 0x40,
-		0x45, 0x65, 0x22, 0x19, 0x77, 0xe8, 0x7e, 0x48, 0x93, 0x66, 0x52, 0x4a, 0x15, 0x3f, 0x1b, 0x03,
-		0xa9, 0xb7, 0xd4, 0xed, 0x31, 0x33, 0xf6, 0x07, 0x69, 0x66, 0xf1, 0x33, 0x31, 0xc8, 0x47, 0xeb,
-		0xdb, 0x1e, 0xf3, 0x1e, 0xfe, 0x03, 0x35, 0xef, 0x77, 0xe3, 0x30, 0x1d, 0xa9, 0x6a, 0xc7, 0xb5,
-		0xee, 0xc7, 0x61, 0x56, 0x6f, 0xe1, 0x8e, 0x6d, 0x79, 0xd8, 0xd4, 0x0e, 0x15, 0x03, 0xdf, 0xc4,
-		0x46, 0xa1, 0x48, 0x93, 0xc6, 0xb9, 0xa3, 0xeb, 0xe6, 0x95, 0xb5, 0x00, 0xb7, 0x4e, 0x60, 0xa5,
-		0xb9, 0xb5, 0x5a, 0x7d, 0xa3, 0xb1, 0xb5, 0x5d, 0xdf, 0xac, 0xbe, 0xae, 0xec, 0x6c, 0x5e, 0xdf,
-		0xdc, 0x7a, 0x75, 0x53, 0x96, 0xf4, 0x1e, 0xb5, 0x0f, 0x71, 0xda, 0x37, 0x40, 0xea, 0x35, 0x0a,
-		0x9d, 0x82, 0x41, 0x66, 0x49, 0x13, 0x68, 0x0e, 0x66, 0x36, 0xb7, 0x94, 0xe6, 0x5a, 0xad, 0xae,
-		0xd4, 0xaf, 0x5e, 0xad, 0x57, 0xb7, 0x9b, 0xec, 0x24, 0xc4, 0xd7, 0xde, 0x8e, 0x4c, 0xf0, 0xe2,
-		0xa7, 0x13, 0x30, 0x37, 0xc0, 0x12, 0x54, 0xe6, 0x7b, 0x18, 0xb6, 0xad, 0x7a, 0x66, 0x1c, 0xeb,
-		0x57, 0x48, 0x15, 0xd1, 0x50, 0x1d, 0x8f, 0x6f, 0x79, 0x9e, 0x00, 0xe2, 0x25, 0xd3, 0xd3, 0xf7,
-		0x74, 0xec, 0xf0, 0x13, 0x26, 0xb6, 0xb1, 0x99, 0x09, 0xe4, 0xec, 0x90, 0xe9, 0x69, 0x40, 0xb6,
-		0xe5, 0xea, 0x9e, 0x7e, 0x13, 0x2b, 0xba, 0x29, 0x8e, 0xa3, 0xc8, 0x46, 0x27, 0x29, 0x4b, 0xa2,
-		0x65, 0xcd, 0xf4, 0x7c, 0x6d, 0x13, 0xb7, 0xd5, 0x1e, 0x6d, 0x92, 0xcc, 0x13, 0xb2, 0x24, 0x5a,
-		0x7c, 0xed, 0x87, 0x21, 0xd7, 0xb2, 0xba, 0xa4, 0xfa, 0x63, 0x7a, 0x64, 0xed, 0x88, 0xc9, 0x59,
-		0x26, 0xf3, 0x55, 0x78, 0x5d, 0x1f, 0x9c, 0x83, 0xe5, 0xe4, 0x2c, 0x93, 0x31, 0x95, 0x33, 0x30,
-		0xa3, 0xb6, 0xdb, 0x0e, 0x21, 0x17, 0x44, 0x6c, 0xa7, 0x92, 0xf7, 0xc5, 0x54, 0x71, 0xe1, 0x1a,
-		0xa4, 0x85, 0x1f, 0xc8, 0xe2, 0x4d, 0x3c, 0xa1, 0xd8, 0x6c, 0xfb, 0x1d, 0x3f, 0x9b, 0x91, 0xd3,
-		0xa6, 0x68, 0x7c, 0x18, 0x72, 0xba, 0xab, 0x04, 0xc7, 0xfa, 0xf1, 0xe5, 0xf8, 0xd9, 0xb4, 0x9c,
-		0xd5, 0x5d, 0xff, 0x48, 0xb4, 0xf8, 0x85, 0x38, 0xe4, 0xa3, 0x8f, 0x25, 0x50, 0x0d, 0xd2, 0x86,
-		0xa5, 0xa9, 0x34, 0xb4, 0xd8, 0x33, 0xb1, 0xb3, 0x23, 0x9e, 0x64, 0xac, 0xac, 0x73, 0x7d, 0xd9,
-		0x47, 0x2e, 0xfc, 0xcb, 0x18, 0xa4, 0x85, 0x18, 0x9d, 0x84, 0xa4, 0xad, 0x7a, 0xfb, 0x94, 0x6e,
-		0xb2, 0x12, 0x97, 0x62, 0x32, 0xbd, 0x26, 0x72, 0xd7, 0x56, 0x4d, 0x1a, 0x02, 0x5c, 0x4e, 0xae,
-		0xc9, 0xb8, 0x1a, 0x58, 0x6d, 0xd1, 0x6d, 0x90, 0xd5, 0xe9, 0x60, 0xd3, 0x73, 0xc5, 0xb8, 0x72,
-		0x79, 0x95, 0x8b, 0xd1, 0x53, 0x30, 0xeb, 0x39, 0xaa, 0x6e, 0x44, 0x74, 0x93, 0x54, 0x57, 0x12,
-		0x0d, 0xbe, 0x72, 0x09, 0x4e, 0x0b, 0xde, 0x16, 0xf6, 0x54, 0x6d, 0x1f, 0xb7, 0x02, 0x50, 0x8a,
-		0x1e, 0x77, 0x9c, 0xe2, 0x0a, 0x35, 0xde, 0x2e, 0xb0, 0xc5, 0xdf, 0x88, 0xc1, 0xac, 0xd8, 0xb8,
-		0xb5, 0x7c, 0x67, 0x6d, 0x00, 0xa8, 0xa6, 0x69, 0x79, 0x61, 0x77, 0xf5, 0x87, 0x72, 0x1f, 0x6e,
-		0xa5, 0xec, 0x83, 0xe4, 0x10, 0xc1, 0x42, 0x07, 0x20, 0x68, 0x19, 0xea, 0xb6, 0x25, 0xc8, 0xf2,
-		0x67, 0x4e, 0xf4, 0xc1, 0x25, 0xdb, 0xea, 0x03, 0x13, 0x91, 0x1d, 0x1e, 0x9a, 0x87, 0xc9, 0x5d,
-		0xdc, 0xd6, 0x4d, 0x7e, 0x92, 0xcc, 0x2e, 0xc4, 0x81, 0x4c, 0xd2, 0x3f, 0x90, 0xa9, 0xfc, 0x09,
-		0x98, 0xd3, 0xac, 0x4e, 0xaf, 0xb9, 0x15, 0xa9, 0xe7, 0xb8, 0xc1, 0x7d, 0x39, 0xf6, 0xc6, 0x33,
-		0x5c, 0xa9, 0x6d, 0x19, 0xaa, 0xd9, 0x5e, 0xb1, 0x9c, 0x76, 0xf0, 0xe0, 0x95, 0x54, 0x3c, 0x6e,
-		0xe8, 0xf1, 0xab, 0xbd, 0xfb, 0xbf, 0x62, 0xb1, 0x5f, 0x88, 0x27, 0x56, 0x1b, 0x95, 0x2f, 0xc6,
-		0x17, 0x56, 0x19, 0xb0, 0x21, 0x9c, 0x21, 0xe3, 0x3d, 0x03, 0x6b, 0xa4, 0x83, 0xf0, 0x9d, 0xa7,
-		0x60, 0xbe, 0x6d, 0xb5, 0x2d, 0xca, 0x74, 0x8e, 0xfc, 0xe2, 0x4f, 0x6e, 0x33, 0xbe, 0x74, 0x61,
-		0xe4, 0x63, 0xde, 0xd2, 0x26, 0xcc, 0x71, 0x65, 0x85, 0x3e, 0x3a, 0x62, 0x1b, 0x1b, 0x74, 0xe4,
-		0xa9, 0x5a, 0xe1, 0x97, 0xbf, 0x4d, 0x97, 0x6f, 0x79, 0x96, 0x43, 0x49, 0x1b, 0xdb, 0xfb, 0x94,
-		0x64, 0x38, 0x11, 0xe1, 0x63, 0x93, 0x14, 0x3b, 0x23, 0x18, 0xff, 0x19, 0x67, 0x9c, 0x0b, 0x31,
-		0x36, 0x39, 0xb4, 0x54, 0x85, 0xe9, 0xe3, 0x70, 0xfd, 0x73, 0xce, 0x95, 0xc3, 0x61, 0x92, 0x55,
-		0x98, 0xa1, 0x24, 0x5a, 0xd7, 0xf5, 0xac, 0x0e, 0xcd, 0x80, 0x47, 0xd3, 0xfc, 0x8b, 0x6f, 0xb3,
-		0x59, 0x93, 0x27, 0xb0, 0xaa, 0x8f, 0x2a, 0x95, 0x80, 0x3e, 0x2d, 0x6b, 0x61, 0xcd, 0x18, 0xc1,
-		0xf0, 0x75, 0x6e, 0x88, 0xaf, 0x5f, 0xba, 0x01, 0xf3, 0xe4, 0x37, 0x4d, 0x50, 0x61, 0x4b, 0x46,
-		0x1f, 0xc1, 0x15, 0x7e, 0xe3, 0x63, 0x6c, 0x62, 0xce, 0xf9, 0x04, 0x21, 0x9b, 0x42, 0xa3, 0xd8,
-		0xc6, 0x9e, 0x87, 0x1d, 0x57, 0x51, 0x8d, 0x41, 0xe6, 0x85, 0xce, 0x30, 0x0a, 0x3f, 0xf7, 0xdd,
-		0xe8, 0x28, 0xae, 0x32, 0x64, 0xd9, 0x30, 0x4a, 0x3b, 0x70, 0x6a, 0x40, 0x54, 0x8c, 0xc1, 0xf9,
-		0x69, 0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f,
-		0xcf, 0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2d, 0x97,
-		0x9f, 0x1b, 0x8d, 0x41, 0xf7, 0x19, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x86,
-		0xf4, 0x9e, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5,
-		0x2d, 0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x96, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a,
-		0x64, 0x01, 0x1b, 0x4d, 0xf1, 0x97, 0x05, 0x85, 0xc0, 0x70, 0x8a, 0x63, 0xb8, 0xf5, 0x1d, 0x41,
-		0xe1, 0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb4, 0xcc, 0x71, 0x8c, 0xf8, 0x1c, 0x67,
-		0x00, 0x0e, 0x21, 0x04, 0x57, 0x20, 0x33, 0xee, 0x40, 0xfc, 0x95, 0xef, 0x8a, 0xe9, 0x21, 0x46,
-		0x60, 0x15, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xca, 0x29, 0xf2, 0x21, 0x18,
-		0xef, 0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee,
-		0x62, 0x53, 0xdb, 0x1f, 0x8f, 0xe1, 0x97, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3,
-		0x3a, 0xee, 0xbe, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x8d, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7,
-		0x3c, 0x0e, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x87,
-		0xed, 0xaf, 0x8b, 0xa9, 0xc7, 0xb0, 0x1b, 0x61, 0xc6, 0x2b, 0x90, 0x71, 0xf5, 0xb7, 0xc6, 0xa2,
-		0xf9, 0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe1, 0xf4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf,
-		0xc1, 0xc9, 0x4e, 0x0e, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2e, 0xe5, 0xdf, 0x14, 0x29, 0x01, 0xf7,
-		0x70, 0x35, 0xc8, 0xae, 0xc1, 0x55, 0xf7, 0x8e, 0xe7, 0xb5, 0xbf, 0x25, 0xbc, 0xc6, 0xb0, 0x11,
-		0xaf, 0x6d, 0xc3, 0x49, 0xce, 0x78, 0xbc, 0x71, 0xfd, 0xdb, 0x22, 0xb1, 0x32, 0xf4, 0x4e, 0x74,
-		0x74, 0x7f, 0x14, 0x16, 0x7c, 0x77, 0x8a, 0xf2, 0xd4, 0x55, 0x3a, 0xaa, 0x3d, 0x06, 0xf3, 0x2f,
-		0x73, 0x66, 0x91, 0xf1, 0xfd, 0xfa, 0xd6, 0xdd, 0x50, 0x6d, 0x42, 0xfe, 0x1a, 0x14, 0x04, 0x79,
-		0xd7, 0x74, 0xb0, 0x66, 0xb5, 0x4d, 0xfd, 0x2d, 0xdc, 0x1a, 0x83, 0xfa, 0x57, 0x7a, 0x86, 0x6a,
-		0x27, 0x04, 0x27, 0xcc, 0x6b, 0x20, 0xf9, 0xb5, 0x8a, 0xa2, 0x77, 0x6c, 0xcb, 0xf1, 0x46, 0x30,
-		0x7e, 0x59, 0x8c, 0x94, 0x8f, 0x5b, 0xa3, 0xb0, 0x52, 0x1d, 0xd8, 0x93, 0xe7, 0x71, 0x43, 0xf2,
-		0x2b, 0x9c, 0x68, 0x3a, 0x40, 0xf1, 0xc4, 0xa1, 0x59, 0x1d, 0x5b, 0x75, 0xc6, 0xc9, 0x7f, 0x7f,
-		0x47, 0x24, 0x0e, 0x0e, 0xe1, 0x89, 0x83, 0x54, 0x74, 0x64, 0xb5, 0x1f, 0x83, 0xe1, 0xab, 0x22,
-		0x71, 0x08, 0x0c, 0xa7, 0x10, 0x05, 0xc3, 0x18, 0x14, 0x7f, 0x57, 0x50, 0x08, 0x0c, 0xa1, 0x78,
-		0x25, 0x58, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xd1, 0x54, 0x7f, 0xef, 0xbb,
-		0xd1, 0x22, 0x4c, 0x0e, 0x41, 0x49, 0x26, 0xe2, 0xc7, 0xae, 0x74, 0xcf, 0x34, 0xda, 0xb0, 0x5f,
-		0x15, 0x99, 0x28, 0x04, 0x23, 0xb6, 0x85, 0x2a, 0x44, 0xe2, 0x76, 0x8d, 0xec, 0x14, 0xc6, 0xa0,
-		0xfb, 0xfb, 0x3d, 0xc6, 0x35, 0x05, 0x96, 0x70, 0x86, 0xea, 0x9f, 0xae, 0x79, 0x80, 0x0f, 0xc7,
-		0x8a, 0xce, 0x5f, 0xeb, 0xa9, 0x7f, 0x76, 0x18, 0x92, 0xe5, 0x90, 0x99, 0x9e, 0x7a, 0x0a, 0x8d,
-		0x7a, 0xcf, 0xa8, 0xf0, 0x93, 0x1f, 0xf0, 0xfe, 0x46, 0xcb, 0xa9, 0xd2, 0x3a, 0x09, 0xf2, 0x68,
-		0xd1, 0x33, 0x9a, 0xec, 0x63, 0x1f, 0xf8, 0x71, 0x1e, 0xa9, 0x79, 0x4a, 0x57, 0x61, 0x3a, 0x52,
-		0xf0, 0x8c, 0xa6, 0xfa, 0x93, 0x9c, 0x2a, 0x17, 0xae, 0x77, 0x4a, 0x17, 0x20, 0x49, 0x8a, 0x97,
-		0xd1, 0xf0, 0x3f, 0xc5, 0xe1, 0x54, 0xbd, 0xf4, 0x11, 0x48, 0x8b, 0xa2, 0x65, 0x34, 0xf4, 0x4f,
-		0x73, 0xa8, 0x0f, 0x21, 0x70, 0x51, 0xb0, 0x8c, 0x86, 0xff, 0x19, 0x01, 0x17, 0x10, 0x02, 0x1f,
-		0xdf, 0x85, 0x5f, 0xfb, 0xb3, 0x49, 0xbe, 0xe8, 0x08, 0xdf, 0x5d, 0x81, 0x29, 0x5e, 0xa9, 0x8c,
-		0x46, 0x7f, 0x82, 0xdf, 0x5c, 0x20, 0x4a, 0x97, 0x60, 0x72, 0x4c, 0x87, 0xff, 0x39, 0x0e, 0x65,
-		0xfa, 0xa5, 0x2a, 0x64, 0x43, 0xd5, 0xc9, 0x68, 0xf8, 0x4f, 0x71, 0x78, 0x18, 0x45, 0x4c, 0xe7,
-		0xd5, 0xc9, 0x68, 0x82, 0x3f, 0x2f, 0x4c, 0xe7, 0x08, 0xe2, 0x36, 0x51, 0x98, 0x8c, 0x46, 0x7f,
-		0x52, 0x78, 0x5d, 0x40, 0x4a, 0x2f, 0x41, 0xc6, 0x5f, 0x6c, 0x46, 0xe3, 0x7f, 0x9a, 0xe3, 0x03,
-		0x0c, 0xf1, 0x40, 0x68, 0xb1, 0x1b, 0x4d, 0xf1, 0x17, 0x84, 0x07, 0x42, 0x28, 0x32, 0x8d, 0x7a,
-		0x0b, 0x98, 0xd1, 0x4c, 0x3f, 0x23, 0xa6, 0x51, 0x4f, 0xfd, 0x42, 0x46, 0x93, 0xe6, 0xfc, 0xd1,
-		0x14, 0x7f, 0x51, 0x8c, 0x26, 0xd5, 0x27, 0x66, 0xf4, 0x56, 0x04, 0xa3, 0x39, 0x7e, 0x56, 0x98,
-		0xd1, 0x53, 0x10, 0x94, 0x1a, 0x80, 0xfa, 0xab, 0x81, 0xd1, 0x7c, 0x9f, 0xe2, 0x7c, 0xb3, 0x7d,
-		0xc5, 0x40, 0xe9, 0x55, 0x38, 0x39, 0xb8, 0x12, 0x18, 0xcd, 0xfa, 0x73, 0x1f, 0xf4, 0xec, 0xdd,
-		0xc2, 0x85, 0x40, 0x69, 0x3b, 0x58, 0x52, 0xc2, 0x55, 0xc0, 0x68, 0xda, 0x4f, 0x7f, 0x10, 0x4d,
-		0xdc, 0xe1, 0x22, 0xa0, 0x54, 0x06, 0x08, 0x16, 0xe0, 0xd1, 0x5c, 0x9f, 0xe1, 0x5c, 0x21, 0x10,
-		0x99, 0x1a, 0x7c, 0xfd, 0x1d, 0x8d, 0xbf, 0x2b, 0xa6, 0x06, 0x47, 0x90, 0xa9, 0x21, 0x96, 0xde,
-		0xd1, 0xe8, 0xcf, 0x8a, 0xa9, 0x21, 0x20, 0x24, 0xb2, 0x43, 0xab, 0xdb, 0x68, 0x86, 0xcf, 0x89,
-		0xc8, 0x0e, 0xa1, 0x4a, 0x9b, 0x30, 0xdb, 0xb7, 0x20, 0x8e, 0xa6, 0xfa, 0x05, 0x4e, 0x25, 0xf5,
-		0xae, 0x87, 0xe1, 0xc5, 0x8b, 0x2f, 0x86, 0xa3, 0xd9, 0x3e, 0xdf, 0xb3, 0x78, 0xf1, 0xb5, 0xb0,
-		0x74, 0x05, 0xd2, 0x66, 0xd7, 0x30, 0xc8, 0xe4, 0x41, 0x47, 0xbf, 0x1b, 0x58, 0xf8, 0xcf, 0xdf,
-		0xe7, 0xde, 0x11, 0x80, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x5d, 0xdc, 0x1a, 0x85, 0xfc, 0xce, 0xf7,
-		0x45, 0xc2, 0x24, 0xda, 0xa5, 0x97, 0x00, 0xd8, 0xd1, 0x08, 0x7d, 0x18, 0x38, 0x02, 0xfb, 0x5f,
-		0xbe, 0xcf, 0x5f, 0xc6, 0x09, 0x20, 0x01, 0x01, 0x7b, 0xb5, 0xe7, 0x68, 0x82, 0xef, 0x46, 0x09,
-		0xe8, 0x88, 0x5c, 0x86, 0xa9, 0x37, 0x5d, 0xcb, 0xf4, 0xd4, 0xf6, 0x28, 0xf4, 0x7f, 0xe5, 0x68,
-		0xa1, 0x4f, 0x1c, 0xd6, 0xb1, 0x1c, 0xec, 0xa9, 0x6d, 0x77, 0x14, 0xf6, 0xbf, 0x71, 0xac, 0x0f,
-		0x20, 0x60, 0x4d, 0x75, 0xbd, 0x71, 0xfa, 0xfd, 0x7b, 0x02, 0x2c, 0x00, 0xc4, 0x68, 0xf2, 0xfb,
-		0x00, 0x1f, 0x8e, 0xc2, 0xfe, 0xbe, 0x30, 0x9a, 0xeb, 0x97, 0x3e, 0x02, 0x19, 0xf2, 0x93, 0xbd,
-		0x61, 0x37, 0x02, 0xfc, 0xdf, 0x39, 0x38, 0x40, 0x90, 0x3b, 0xbb, 0x5e, 0xcb, 0xd3, 0x47, 0x3b,
-		0xfb, 0x7b, 0x7c, 0xa4, 0x85, 0x7e, 0xa9, 0x0c, 0x59, 0xd7, 0x6b, 0xb5, 0xba, 0xbc, 0x3e, 0x1d,
-		0x01, 0xff, 0x1f, 0xdf, 0xf7, 0x8f, 0x2c, 0x7c, 0x0c, 0x19, 0xed, 0x5b, 0x07, 0x9e, 0x6d, 0xd1,
-		0x07, 0x1e, 0xa3, 0x18, 0x3e, 0xe0, 0x0c, 0x21, 0x48, 0xa9, 0x0a, 0x39, 0xd2, 0x17, 0x07, 0xdb,
-		0x98, 0x3e, 0x9d, 0x1a, 0x41, 0xf1, 0x3f, 0xb9, 0x03, 0x22, 0xa0, 0xca, 0x8f, 0x7d, 0xfd, 0xbd,
-		0xc5, 0xd8, 0x37, 0xdf, 0x5b, 0x8c, 0xfd, 0xee, 0x7b, 0x8b, 0xb1, 0x4f, 0x7e, 0x6b, 0x71, 0xe2,
-		0x9b, 0xdf, 0x5a, 0x9c, 0xf8, 0xed, 0x6f, 0x2d, 0x4e, 0x0c, 0x3e, 0x25, 0x86, 0x55, 0x6b, 0xd5,
-		0x62, 0xe7, 0xc3, 0x6f, 0x14, 0xdb, 0xba, 0xb7, 0xdf, 0xdd, 0x5d, 0xd1, 0xac, 0x0e, 0x3d, 0xc6,
-		0x0d, 0x4e, 0x6b, 0xfd, 0x4d, 0x0e, 0xfc, 0x20, 0x46, 0x36, 0xcc, 0xd1, 0xb3, 0x5c, 0xd5, 0x3c,
-		0x1c, 0xf6, 0xad, 0xce, 0x45, 0x48, 0x94, 0xcd, 0x43, 0x74, 0x9a, 0x65, 0x37, 0xa5, 0xeb, 0x18,
-		0xfc, 0x1d, 0xaf, 0x29, 0x72, 0xbd, 0xe3, 0x18, 0x68, 0x3e, 0x78, 0x11, 0x33, 0x76, 0x36, 0xc7,
-		0xdf, 0xae, 0xac, 0xfc, 0x54, 0xec, 0x78, 0xdd, 0x48, 0x97, 0xcd, 0x43, 0xda, 0x8b, 0x46, 0xec,
-		0x8d, 0xa7, 0x47, 0x1e, 0x72, 0x1f, 0x98, 0xd6, 0x2d, 0x93, 0x98, 0x6d, 0xef, 0x8a, 0x03, 0xee,
-		0xc5, 0xde, 0x03, 0xee, 0x57, 0xb1, 0x61, 0x5c, 0x27, 0x7a, 0xdb, 0x04, 0xb2, 0x9b, 0x62, 0xaf,
-		0x13, 0xc3, 0xcf, 0xc4, 0x61, 0xb1, 0xef, 0x2c, 0x9b, 0x47, 0xc0, 0x30, 0x27, 0x94, 0x20, 0x5d,
-		0x13, 0x81, 0x55, 0x80, 0x29, 0x17, 0x6b, 0x96, 0xd9, 0x72, 0xa9, 0x23, 0x12, 0xb2, 0xb8, 0x24,
-		0x8e, 0x30, 0x55, 0xd3, 0x72, 0xf9, 0x5b, 0x92, 0xec, 0xa2, 0xf2, 0xf3, 0xc7, 0x74, 0xc4, 0xb4,
-		0xb8, 0x93, 0xf0, 0xc6, 0x73, 0x63, 0x7a, 0x43, 0x74, 0x22, 0x72, 0xec, 0x3f, 0xae, 0x57, 0x7e,
-		0x36, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0xd3, 0xca, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x57, 0x20,
-		0xb3, 0x2d, 0x74, 0x8e, 0xed, 0x97, 0xbb, 0xc7, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xf9,
-		0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c, 0xf3, 0xbf, 0x53, 0x70, 0x5a, 0xb3, 0xdc, 0x8e, 0xe5,
-		0x2a, 0xec, 0xf9, 0x08, 0xbb, 0xe0, 0x3e, 0xc9, 0x85, 0x9b, 0x46, 0x3f, 0x24, 0x29, 0x5e, 0x87,
-		0xb9, 0x35, 0x92, 0x2a, 0xc8, 0x16, 0x28, 0x78, 0xbc, 0x33, 0xf0, 0x45, 0xd2, 0xe5, 0x48, 0xb5,
-		0xcf, 0x1f, 0x2f, 0x85, 0x45, 0xc5, 0x9f, 0x8c, 0x81, 0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x5f,
-		0xa9, 0xd0, 0x25, 0x00, 0xfa, 0x01, 0x52, 0xf0, 0xc5, 0x50, 0xfe, 0x7c, 0x61, 0x25, 0xdc, 0xb9,
-		0x15, 0x76, 0x27, 0xfa, 0x39, 0x42, 0x86, 0xea, 0x92, 0x9f, 0x4f, 0xbe, 0x06, 0x10, 0x34, 0xa0,
-		0x07, 0xe0, 0x54, 0xb3, 0x5a, 0x5e, 0x2f, 0xcb, 0x0a, 0x7b, 0xb3, 0x7d, 0xb3, 0xd9, 0xa8, 0x57,
-		0xd7, 0xae, 0xae, 0xd5, 0x6b, 0xd2, 0x04, 0x3a, 0x09, 0x28, 0xdc, 0xe8, 0xbf, 0x94, 0x72, 0x02,
-		0x66, 0xc3, 0x72, 0xf6, 0x7a, 0x7c, 0x9c, 0x94, 0x89, 0x7a, 0xc7, 0x36, 0x30, 0x7d, 0xee, 0xa7,
-		0xe8, 0xc2, 0x6b, 0xa3, 0x2b, 0x90, 0x5f, 0xff, 0x57, 0xec, 0x95, 0xe9, 0xb9, 0x00, 0xee, 0xfb,
-		0xbc, 0xb4, 0x0e, 0xb3, 0xaa, 0xa6, 0x61, 0x3b, 0x42, 0x39, 0x22, 0x4f, 0x13, 0x42, 0xfa, 0x24,
-		0x93, 0x23, 0x03, 0xb6, 0x4b, 0x90, 0x72, 0x69, 0xef, 0x47, 0x51, 0x7c, 0x83, 0x53, 0x70, 0xf5,
-		0x92, 0x09, 0xb3, 0xa4, 0xec, 0x53, 0x1d, 0x1c, 0x32, 0xe3, 0xe8, 0x43, 0x86, 0x7f, 0xf0, 0xe5,
-		0x67, 0xe9, 0x73, 0xcd, 0x87, 0xa3, 0xc3, 0x32, 0x20, 0x9c, 0x64, 0x89, 0x73, 0x07, 0x86, 0x62,
-		0xc8, 0x8b, 0xfb, 0x71, 0x83, 0x8f, 0xbe, 0xd9, 0x3f, 0xe4, 0x37, 0x5b, 0x1c, 0x14, 0x03, 0xa1,
-		0x3b, 0x4d, 0x73, 0x56, 0xd6, 0x50, 0xa9, 0x0f, 0x9b, 0xd3, 0x6f, 0x3c, 0x15, 0x5a, 0x9a, 0x18,
-		0x25, 0xff, 0xf3, 0x0c, 0x65, 0xbe, 0x12, 0xbe, 0x8d, 0x3f, 0xf7, 0x7e, 0x2b, 0x01, 0x8b, 0x5c,
-		0x79, 0x57, 0x75, 0xf1, 0xb9, 0x9b, 0xcf, 0xed, 0x62, 0x4f, 0x7d, 0xee, 0x9c, 0x66, 0xe9, 0x22,
-		0x57, 0xcf, 0xf1, 0xe9, 0x48, 0xda, 0x57, 0x78, 0xfb, 0xc2, 0xc0, 0xa7, 0x99, 0x0b, 0xc3, 0xa7,
-		0x71, 0x71, 0x07, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa, 0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3,
-		0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4, 0xd8, 0xcc, 0xa9, 0x9c, 0xfe, 0xfa, 0xbb,
-		0x4b, 0x13, 0xff, 0xfa, 0xdd, 0xa5, 0xc4, 0x9a, 0xe9, 0xfd, 0xe6, 0x57, 0x9e, 0x01, 0x4e, 0xb5,
-		0x66, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x67, 0x29, 0x56, 0x7c, 0x0d, 0xa6, 0x6a, 0x58,
-		0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac, 0xf5, 0x30, 0x5f, 0x82, 0xf4, 0x9a, 0xe9,
-		0xb1, 0xb7, 0xd0, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x17, 0x1b, 0x8f, 0xb4, 0x8d, 0x68, 0x11, 0x60,
-		0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff, 0xad, 0x89, 0x56, 0xa5, 0xf6, 0xdb, 0xff,
-		0x61, 0x71, 0xe2, 0xed, 0xf7, 0x16, 0x27, 0x86, 0x0e, 0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x03,
-		0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2, 0x1f, 0x27, 0x39, 0x1d, 0xdd, 0xf4, 0xce,
-		0x69, 0xce, 0xa1, 0xed, 0xd1, 0x72, 0xc5, 0xda, 0xe3, 0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6,
-		0xc1, 0xc3, 0x5a, 0xdc, 0x83, 0xc9, 0x06, 0xc1, 0x11, 0x17, 0x7b, 0x96, 0xa7, 0x1a, 0x7c, 0xfd,
-		0x61, 0x17, 0x44, 0xca, 0x3e, 0x68, 0x8a, 0x33, 0xa9, 0x2e, 0xbe, 0x65, 0x32, 0xb0, 0xba, 0xc7,
-		0xde, 0x0b, 0x4f, 0xd0, 0xc2, 0x25, 0x4d, 0x04, 0xf4, 0x15, 0xf0, 0x79, 0x98, 0x54, 0xbb, 0xec,
-		0x05, 0x86, 0x04, 0xa9, 0x68, 0xe8, 0x45, 0xf1, 0x3a, 0x4c, 0xf1, 0xc7, 0xa8, 0x48, 0x82, 0xc4,
-		0x01, 0x3e, 0xa4, 0xf7, 0xc9, 0xc9, 0xe4, 0x27, 0x5a, 0x81, 0x49, 0x6a, 0x3c, 0xff, 0xe0, 0xa5,
-		0xb0, 0xd2, 0x67, 0xfd, 0x0a, 0x35, 0x52, 0x66, 0x6a, 0xc5, 0x6b, 0x90, 0xae, 0x59, 0x1d, 0xdd,
-		0xb4, 0xa2, 0x6c, 0x19, 0xc6, 0x46, 0x6d, 0xb6, 0xbb, 0x3c, 0x2a, 0x64, 0x76, 0x81, 0x4e, 0x42,
-		0x8a, 0x7d, 0x27, 0xc0, 0x5f, 0xc2, 0xe0, 0x57, 0xc5, 0x2a, 0x4c, 0x51, 0xee, 0x2d, 0x9b, 0x24,
-		0x7f, 0xff, 0x95, 0xcc, 0x0c, 0xff, 0x6a, 0x8c, 0xd3, 0xc7, 0x03, 0x63, 0x11, 0x24, 0x5b, 0xaa,
-		0xa7, 0xf2, 0x7e, 0xd3, 0xdf, 0xc5, 0x8f, 0x42, 0x9a, 0x93, 0xb8, 0xe8, 0x3c, 0x24, 0x2c, 0xdb,
-		0xe5, 0xaf, 0x51, 0x2c, 0x0c, 0xeb, 0xca, 0x96, 0x5d, 0x49, 0x92, 0x98, 0x91, 0x89, 0x72, 0x45,
-		0x1e, 0x1a, 0x16, 0x2f, 0x86, 0xc2, 0x22, 0x34, 0xe4, 0xa1, 0x9f, 0x6c, 0x48, 0xfb, 0xc2, 0xc1,
-		0x0f, 0x96, 0xcf, 0xc5, 0x61, 0x31, 0xd4, 0x7a, 0x13, 0x3b, 0xae, 0x6e, 0x99, 0x2c, 0xa2, 0x78,
-		0xb4, 0xa0, 0x90, 0x91, 0xbc, 0x7d, 0x48, 0xb8, 0x7c, 0x04, 0x12, 0x65, 0xdb, 0x46, 0x0b, 0x90,
-		0xa6, 0xd7, 0x9a, 0xc5, 0xe2, 0x25, 0x29, 0xfb, 0xd7, 0xa4, 0xcd, 0xb5, 0xf6, 0xbc, 0x5b, 0xaa,
-		0xe3, 0x7f, 0x4a, 0x27, 0xae, 0x8b, 0x97, 0x21, 0x53, 0xb5, 0x4c, 0x17, 0x9b, 0x6e, 0x97, 0x56,
-		0x36, 0xbb, 0x86, 0xa5, 0x1d, 0x70, 0x06, 0x76, 0x41, 0x1c, 0xae, 0xda, 0x36, 0x45, 0x26, 0x65,
-		0xf2, 0x93, 0xcd, 0xd9, 0x4a, 0x73, 0xa8, 0x8b, 0x2e, 0x1f, 0xdf, 0x45, 0xbc, 0x93, 0xbe, 0x8f,
-		0x7e, 0x10, 0x83, 0x07, 0xfb, 0x27, 0xd4, 0x01, 0x3e, 0x74, 0x8f, 0x3b, 0x9f, 0x5e, 0x83, 0x4c,
-		0x83, 0x7e, 0xcf, 0x7e, 0x1d, 0x1f, 0xa2, 0x05, 0x98, 0xc2, 0xad, 0xf3, 0x17, 0x2e, 0x3c, 0x77,
-		0x99, 0x45, 0xfb, 0xcb, 0x13, 0xb2, 0x10, 0xa0, 0x45, 0xc8, 0xb8, 0x58, 0xb3, 0xcf, 0x5f, 0xb8,
-		0x78, 0xf0, 0x1c, 0x0b, 0xaf, 0x97, 0x27, 0xe4, 0x40, 0x54, 0x4a, 0x93, 0x5e, 0xbf, 0xff, 0xb9,
-		0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6, 0xc8, 0xa7, 0x27, 0x61, 0x39, 0x8c,
-		0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0x9f, 0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6,
-		0x90, 0x95, 0xe2, 0x48, 0x4f, 0x16, 0x7f, 0x25, 0x06, 0xb9, 0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74,
-		0x05, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde, 0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75,
-		0x74, 0x89, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0xf3, 0xaa, 0x11, 0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88,
-		0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad, 0x5b, 0xfc, 0xa3, 0xd5, 0x84, 0x2c,
-		0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c, 0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5,
-		0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x15, 0x98, 0xb2, 0xbb, 0xbb, 0x8a, 0xc8, 0x18,
-		0xd9, 0xf3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06, 0x48, 0xd9, 0xdd, 0x5d, 0x12, 0x2d,
-		0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4, 0xdf, 0x28, 0xf0, 0x1e, 0x28, 0xb6,
-		0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd2, 0x77, 0xa1, 0x12, 0xb2, 0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0x3c,
-		0x80, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0x2f, 0x04, 0xf6, 0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2,
-		0x78, 0x9f, 0x65, 0x95, 0x57, 0x86, 0x46, 0xe7, 0xa5, 0xe3, 0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd,
-		0xd3, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d, 0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xa3,
-		0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a, 0x5e, 0x86, 0xe9, 0x86, 0xea, 0x78,
-		0x4d, 0xec, 0xbd, 0x8c, 0xd5, 0x16, 0x76, 0xa2, 0xab, 0xee, 0xb4, 0x58, 0x75, 0x11, 0x24, 0xe9,
-		0xd2, 0xca, 0x56, 0x1d, 0xfa, 0xbb, 0xb8, 0x0f, 0x49, 0xfa, 0x3e, 0xa4, 0xbf, 0x22, 0x73, 0x04,
-		0x5b, 0x91, 0x49, 0x2e, 0x3d, 0xf4, 0xb0, 0x2b, 0x8e, 0x11, 0xe8, 0x05, 0x7a, 0x41, 0xac, 0xab,
-		0x89, 0xa3, 0xd7, 0x55, 0x1e, 0x88, 0x7c, 0x75, 0x35, 0x60, 0xaa, 0x42, 0x52, 0xf1, 0x5a, 0xcd,
-		0x37, 0x24, 0x16, 0x18, 0x82, 0x36, 0x60, 0xc6, 0x56, 0x1d, 0x8f, 0x7e, 0x1a, 0xb2, 0x4f, 0x7b,
-		0xc1, 0x63, 0x7d, 0xa9, 0x7f, 0xe6, 0x45, 0x3a, 0xcb, 0xef, 0x32, 0x6d, 0x87, 0x85, 0xc5, 0xff,
-		0x98, 0x84, 0x14, 0x77, 0xc6, 0x47, 0x60, 0x8a, 0xbb, 0x95, 0x47, 0xe7, 0x43, 0x2b, 0xfd, 0x0b,
-		0xd3, 0x8a, 0xbf, 0x80, 0x70, 0x3e, 0x81, 0x41, 0x8f, 0x43, 0x5a, 0xdb, 0x57, 0x75, 0x53, 0xd1,
-		0x5b, 0xbc, 0x20, 0xcc, 0xbe, 0xf7, 0xee, 0xd2, 0x54, 0x95, 0xc8, 0xd6, 0x6a, 0xf2, 0x14, 0x6d,
-		0x5c, 0x6b, 0x91, 0x4a, 0x60, 0x1f, 0xeb, 0xed, 0x7d, 0x8f, 0xcf, 0x30, 0x7e, 0x85, 0x5e, 0x84,
-		0x24, 0x09, 0x08, 0xfe, 0xe1, 0xe0, 0x42, 0x5f, 0x85, 0xef, 0x6f, 0xa1, 0x2b, 0x69, 0x72, 0xe3,
-		0x4f, 0xfe, 0xfb, 0xa5, 0x98, 0x4c, 0x11, 0xa8, 0x0a, 0xd3, 0x86, 0xea, 0x7a, 0x0a, 0x5d, 0xc1,
-		0xc8, 0xed, 0x27, 0x29, 0xc5, 0xe9, 0x7e, 0x87, 0x70, 0xc7, 0x72, 0xd3, 0xb3, 0x04, 0xc5, 0x44,
-		0x2d, 0x74, 0x16, 0x24, 0x4a, 0xa2, 0x59, 0x9d, 0x8e, 0xee, 0xb1, 0xda, 0x2a, 0x45, 0xfd, 0x9e,
-		0x27, 0xf2, 0x2a, 0x15, 0xd3, 0x0a, 0xeb, 0x01, 0xc8, 0xd0, 0x4f, 0x95, 0xa8, 0x0a, 0x7b, 0x09,
-		0x37, 0x4d, 0x04, 0xb4, 0xf1, 0x0c, 0xcc, 0x04, 0xf9, 0x91, 0xa9, 0xa4, 0x19, 0x4b, 0x20, 0xa6,
-		0x8a, 0xcf, 0xc2, 0xbc, 0x89, 0x6f, 0xd3, 0xd7, 0x82, 0x23, 0xda, 0x19, 0xaa, 0x8d, 0x48, 0xdb,
-		0x8d, 0x28, 0xe2, 0x31, 0xc8, 0x6b, 0xc2, 0xf9, 0x4c, 0x17, 0xa8, 0xee, 0xb4, 0x2f, 0xa5, 0x6a,
-		0xa7, 0x21, 0xad, 0xda, 0x36, 0x53, 0xc8, 0xf2, 0xfc, 0x68, 0xdb, 0xb4, 0xe9, 0x49, 0x98, 0xa5,
-		0x7d, 0x74, 0xb0, 0xdb, 0x35, 0x3c, 0x4e, 0x92, 0xa3, 0x3a, 0x33, 0xa4, 0x41, 0x66, 0x72, 0xaa,
-		0xfb, 0x08, 0x4c, 0xe3, 0x9b, 0x7a, 0x0b, 0x9b, 0x1a, 0x66, 0x7a, 0xd3, 0x54, 0x2f, 0x27, 0x84,
-		0x54, 0xe9, 0x09, 0xf0, 0xf3, 0x9e, 0x22, 0x72, 0x72, 0x9e, 0xf1, 0x09, 0x79, 0x99, 0x89, 0x8b,
-		0x05, 0x48, 0xd6, 0x54, 0x4f, 0x25, 0x05, 0x86, 0x77, 0x9b, 0x2d, 0x34, 0x39, 0x99, 0xfc, 0x2c,
-		0xbe, 0x1f, 0x87, 0xe4, 0x0d, 0xcb, 0xc3, 0xe8, 0xf9, 0x50, 0x01, 0x98, 0x1f, 0x14, 0xcf, 0x4d,
-		0xbd, 0x6d, 0xe2, 0xd6, 0x86, 0xdb, 0x0e, 0xfd, 0x5f, 0x81, 0x20, 0x9c, 0xe2, 0x91, 0x70, 0x9a,
-		0x87, 0x49, 0xc7, 0xea, 0x9a, 0x2d, 0xf1, 0xfe, 0x2a, 0xbd, 0x40, 0x75, 0x48, 0xfb, 0x51, 0x92,
-		0x1c, 0x15, 0x25, 0x33, 0x24, 0x4a, 0x48, 0x0c, 0x73, 0x81, 0x3c, 0xb5, 0xcb, 0x83, 0xa5, 0x02,
-		0x19, 0x3f, 0x79, 0xf1, 0x68, 0x1b, 0x2f, 0x60, 0x03, 0x18, 0x59, 0x4c, 0xfc, 0xb1, 0xf7, 0x9d,
-		0xc7, 0x22, 0x4e, 0xf2, 0x1b, 0xb8, 0xf7, 0x22, 0x61, 0xc5, 0xff, 0xc7, 0xc1, 0x14, 0xed, 0x57,
-		0x10, 0x56, 0xec, 0xff, 0x1c, 0x3c, 0x08, 0x19, 0x57, 0x6f, 0x9b, 0xaa, 0xd7, 0x75, 0x30, 0x8f,
-		0xbc, 0x40, 0x50, 0xfc, 0x5a, 0x0c, 0x52, 0x2c, 0x92, 0x43, 0x7e, 0x8b, 0x0d, 0xf6, 0x5b, 0x7c,
-		0x98, 0xdf, 0x12, 0xf7, 0xee, 0xb7, 0x32, 0x80, 0x6f, 0x8c, 0xcb, 0x3f, 0x3d, 0x1f, 0x50, 0x31,
-		0x30, 0x13, 0x9b, 0x7a, 0x9b, 0x4f, 0xd4, 0x10, 0xa8, 0xf8, 0xef, 0x62, 0xa4, 0x88, 0xe5, 0xed,
-		0xa8, 0x0c, 0xd3, 0xc2, 0x2e, 0x65, 0xcf, 0x50, 0xdb, 0x3c, 0x76, 0x1e, 0x1a, 0x6a, 0xdc, 0x55,
-		0x43, 0x6d, 0xcb, 0x59, 0x6e, 0x0f, 0xb9, 0x18, 0x3c, 0x0e, 0xf1, 0x21, 0xe3, 0x10, 0x19, 0xf8,
-		0xc4, 0xbd, 0x0d, 0x7c, 0x64, 0x88, 0x92, 0xbd, 0x43, 0xf4, 0xe5, 0x38, 0xdd, 0xcc, 0xd8, 0x96,
-		0xab, 0x1a, 0x3f, 0x8c, 0x19, 0xf1, 0x00, 0x64, 0x6c, 0xcb, 0x50, 0x58, 0x0b, 0x7b, 0xaf, 0x3b,
-		0x6d, 0x5b, 0x86, 0xdc, 0x37, 0xec, 0x93, 0xf7, 0x69, 0xba, 0xa4, 0xee, 0x83, 0xd7, 0xa6, 0x7a,
-		0xbd, 0xe6, 0x40, 0x8e, 0xb9, 0x82, 0xaf, 0x65, 0xcf, 0x12, 0x1f, 0xd0, 0xc5, 0x31, 0xd6, 0xbf,
-		0xf6, 0x32, 0xb3, 0x99, 0xa6, 0xcc, 0xf5, 0x08, 0x82, 0xa5, 0xfe, 0x41, 0xbb, 0xe0, 0x70, 0x58,
-		0xca, 0x5c, 0xaf, 0xf8, 0x97, 0x62, 0x00, 0xeb, 0xc4, 0xb3, 0xb4, 0xbf, 0x64, 0x15, 0x72, 0xa9,
-		0x09, 0x4a, 0xe4, 0xce, 0x8b, 0xc3, 0x06, 0x8d, 0xdf, 0x3f, 0xe7, 0x86, 0xed, 0xae, 0xc2, 0x74,
-		0x10, 0x8c, 0x2e, 0x16, 0xc6, 0x2c, 0x1e, 0x51, 0x55, 0x37, 0xb1, 0x27, 0xe7, 0x6e, 0x86, 0xae,
-		0x8a, 0xff, 0x24, 0x06, 0x19, 0x6a, 0xd3, 0x06, 0xf6, 0xd4, 0xc8, 0x18, 0xc6, 0xee, 0x7d, 0x0c,
-		0x1f, 0x02, 0x60, 0x34, 0xae, 0xfe, 0x16, 0xe6, 0x91, 0x95, 0xa1, 0x92, 0xa6, 0xfe, 0x16, 0x46,
-		0x17, 0x7d, 0x87, 0x27, 0x8e, 0x76, 0xb8, 0xa8, 0xba, 0xb9, 0xdb, 0x4f, 0xc1, 0x14, 0xfd, 0x57,
-		0x4d, 0xb7, 0x5d, 0x5e, 0x48, 0xa7, 0xcc, 0x6e, 0x67, 0xfb, 0xb6, 0x5b, 0x7c, 0x13, 0xa6, 0xb6,
-		0x6f, 0xb3, 0xb3, 0x91, 0x07, 0x20, 0xe3, 0x58, 0x16, 0x5f, 0x93, 0x59, 0x2d, 0x94, 0x26, 0x02,
-		0xba, 0x04, 0x89, 0xf3, 0x80, 0x78, 0x70, 0x1e, 0x10, 0x1c, 0x68, 0x24, 0xc6, 0x3a, 0xd0, 0x78,
-		0xf2, 0xb7, 0x62, 0x90, 0x0d, 0xe5, 0x07, 0xf4, 0x1c, 0x9c, 0xa8, 0xac, 0x6f, 0x55, 0xaf, 0x2b,
-		0x6b, 0x35, 0xe5, 0xea, 0x7a, 0x79, 0x35, 0xf8, 0x72, 0x69, 0xe1, 0xe4, 0x9d, 0xbb, 0xcb, 0x28,
-		0xa4, 0xbb, 0x63, 0xd2, 0x73, 0x7a, 0x74, 0x0e, 0xe6, 0xa3, 0x90, 0x72, 0xa5, 0x59, 0xdf, 0xdc,
-		0x96, 0x62, 0x0b, 0x27, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21, 0xca, 0xbb, 0x2e, 0x36, 0xbd, 0x7e,
-		0x40, 0x75, 0x6b, 0x63, 0x63, 0x6d, 0x5b, 0x8a, 0xf7, 0x01, 0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3,
-		0x80, 0xcd, 0xb5, 0x75, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d, 0xce, 0x87, 0xb4, 0x37, 0x75, 0x63,
-		0x21, 0xfd, 0xf1, 0xcf, 0x2f, 0x4e, 0xfc, 0xd2, 0x2f, 0x2e, 0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39,
-		0x02, 0x3d, 0x0d, 0xa7, 0x9a, 0x6b, 0xab, 0x9b, 0xf5, 0x9a, 0xb2, 0xd1, 0x5c, 0x15, 0x27, 0xdd,
-		0xa2, 0x77, 0x33, 0x77, 0xee, 0x2e, 0x67, 0x79, 0x97, 0x86, 0x69, 0x37, 0xe4, 0xfa, 0x8d, 0xad,
-		0xed, 0xba, 0x14, 0x63, 0xda, 0x0d, 0x07, 0xdf, 0xb4, 0x3c, 0xf6, 0xbf, 0xdc, 0x9e, 0x85, 0xd3,
-		0x03, 0xb4, 0xfd, 0x8e, 0xcd, 0xde, 0xb9, 0xbb, 0x3c, 0xdd, 0x70, 0x30, 0x9b, 0x3f, 0x14, 0xb1,
-		0x02, 0x85, 0x7e, 0xc4, 0x56, 0x63, 0xab, 0x59, 0x5e, 0x97, 0x96, 0x17, 0xa4, 0x3b, 0x77, 0x97,
-		0x73, 0x22, 0x19, 0x12, 0xfd, 0xa0, 0x67, 0x1f, 0xe6, 0x8e, 0xe7, 0xfd, 0xa7, 0xe0, 0x51, 0x7e,
-		0x06, 0xe8, 0x7a, 0xea, 0x81, 0x6e, 0xb6, 0xfd, 0xc3, 0x5b, 0x7e, 0xcd, 0x77, 0x3e, 0x27, 0xf9,
-		0x39, 0xa3, 0x90, 0x8e, 0x38, 0xc2, 0x1d, 0xfa, 0xe4, 0x72, 0x61, 0xc4, 0x43, 0xbd, 0xd1, 0x5b,
-		0xa7, 0xe1, 0xc7, 0xc3, 0x0b, 0x23, 0x0e, 0xa1, 0x17, 0x8e, 0xdc, 0xdc, 0x15, 0x3f, 0x11, 0x83,
-		0xfc, 0xcb, 0xba, 0xeb, 0x59, 0x8e, 0xae, 0xa9, 0x06, 0xfd, 0x5e, 0xe9, 0xe2, 0xb8, 0xb9, 0xb5,
-		0x67, 0xaa, 0xbf, 0x04, 0xa9, 0x9b, 0xaa, 0xc1, 0x92, 0x5a, 0xf8, 0x59, 0x40, 0xaf, 0xfb, 0x82,
-		0xd4, 0x26, 0x08, 0x18, 0xac, 0xf8, 0xa5, 0x38, 0xcc, 0xd0, 0xc9, 0xe0, 0xb2, 0x7f, 0xc5, 0x45,
-		0xf6, 0x58, 0x0d, 0x48, 0x3a, 0xaa, 0xc7, 0x0f, 0x0d, 0x2b, 0x3f, 0xc2, 0xcf, 0x81, 0x1f, 0x1f,
-		0x7d, 0x9a, 0xbb, 0xd2, 0x7f, 0x54, 0x4c, 0x99, 0xd0, 0xab, 0x90, 0xee, 0xa8, 0xb7, 0x15, 0xca,
-		0x1a, 0xbf, 0x0f, 0xac, 0x53, 0x1d, 0xf5, 0x36, 0xb1, 0x15, 0xb5, 0x60, 0x86, 0x10, 0x6b, 0xfb,
-		0xaa, 0xd9, 0xc6, 0x8c, 0x3f, 0x71, 0x1f, 0xf8, 0xa7, 0x3b, 0xea, 0xed, 0x2a, 0xe5, 0x24, 0x77,
-		0x29, 0xa5, 0x3f, 0xf5, 0xce, 0xd2, 0x04, 0x3d, 0x66, 0xff, 0xb5, 0x18, 0x40, 0xe0, 0x2e, 0xf4,
-		0xff, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0, 0x33, 0xc3, 0x06, 0xa2, 0xc7, 0xd9,
-		0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb, 0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76,
-		0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x63, 0x2c, 0xf2, 0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd,
-		0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa, 0x63, 0x99, 0xfa, 0x01, 0x0f, 0xbb,
-		0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0x97, 0x9a, 0xde, 0xa1, 0x38, 0xf1, 0x14, 0xd7, 0x04,
-		0x75, 0x0b, 0xef, 0xba, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9, 0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1,
-		0xbd, 0x43, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xcd, 0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98,
-		0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2, 0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4f,
-		0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25, 0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7e,
-		0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x97, 0x5a, 0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6,
-		0xeb, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x06, 0xc7, 0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b,
-		0x87, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a, 0x3e, 0x24, 0xa3, 0xc5, 0x79, 0x1a,
-		0x94, 0x86, 0x94, 0x88, 0x6f, 0xaa, 0xba, 0x21, 0x3e, 0x40, 0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca,
-		0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x14, 0x57, 0x1c, 0x16, 0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9,
-		0xa6, 0xcc, 0x11, 0x68, 0x1b, 0x52, 0x9e, 0x75, 0x80, 0x4d, 0xee, 0xa4, 0x63, 0x45, 0xf5, 0x80,
-		0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7, 0x59, 0x41, 0xb4, 0xaf, 0x92, 0x7d,
-		0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49, 0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6,
-		0x5f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3, 0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40,
-		0xea, 0x9a, 0xbb, 0x96, 0x49, 0x3f, 0x13, 0xe5, 0xc5, 0x78, 0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc,
-		0x65, 0x56, 0x95, 0x5f, 0x87, 0x7c, 0xa0, 0x4a, 0xe7, 0x4e, 0xe6, 0x18, 0x73, 0x67, 0xda, 0xc7,
-		0x92, 0x56, 0xf4, 0x32, 0x40, 0x30, 0x31, 0xe9, 0xf1, 0x40, 0x76, 0xf8, 0x18, 0x06, 0xb3, 0x5b,
-		0x6c, 0xb3, 0x02, 0x2c, 0x32, 0x60, 0xae, 0xa3, 0x9b, 0x8a, 0x8b, 0x8d, 0x3d, 0x85, 0xbb, 0x8a,
-		0x50, 0x66, 0xef, 0xc3, 0xd0, 0xce, 0x76, 0x74, 0xb3, 0x89, 0x8d, 0xbd, 0x9a, 0x4f, 0x5b, 0xca,
-		0x7d, 0xfc, 0x9d, 0xa5, 0x09, 0x3e, 0x97, 0x26, 0x8a, 0x0d, 0x7a, 0x44, 0xcd, 0xa7, 0x01, 0x76,
-		0xd1, 0x45, 0xc8, 0xa8, 0xe2, 0x82, 0x1e, 0x1c, 0x1c, 0x35, 0x8d, 0x02, 0x55, 0x36, 0x3b, 0xdf,
-		0xfe, 0xb7, 0xcb, 0xb1, 0xe2, 0x2f, 0xc6, 0x20, 0x55, 0xbb, 0xd1, 0x50, 0x75, 0x07, 0xd5, 0x61,
-		0x36, 0x08, 0xa8, 0x71, 0xe7, 0x66, 0x10, 0x83, 0x62, 0x72, 0xd6, 0x87, 0xed, 0x1a, 0x8f, 0xa4,
-		0xe9, 0xdd, 0x4f, 0xf6, 0x74, 0xbc, 0x0e, 0x53, 0xcc, 0x4a, 0x17, 0x95, 0x60, 0xd2, 0x26, 0x3f,
-		0xf8, 0x89, 0xfc, 0xe2, 0xd0, 0x40, 0xa4, 0xfa, 0xfe, 0x09, 0x22, 0x81, 0x14, 0x7f, 0x10, 0x03,
-		0xa8, 0xdd, 0xb8, 0xb1, 0xed, 0xe8, 0xb6, 0x81, 0xbd, 0xfb, 0xd5, 0xe3, 0x75, 0x38, 0x11, 0xda,
-		0x9a, 0x38, 0xda, 0xd8, 0xbd, 0x9e, 0x0b, 0x36, 0x27, 0x8e, 0x36, 0x90, 0xad, 0xe5, 0x7a, 0x3e,
-		0x5b, 0x62, 0x6c, 0xb6, 0x9a, 0xeb, 0x0d, 0x76, 0x63, 0x13, 0xb2, 0x41, 0xf7, 0x5d, 0x54, 0x83,
-		0xb4, 0xc7, 0x7f, 0x73, 0x6f, 0x16, 0x87, 0x7b, 0x53, 0xc0, 0xb8, 0x47, 0x7d, 0x64, 0xf1, 0xff,
-		0x10, 0xa7, 0xfa, 0x11, 0xfb, 0x87, 0x2b, 0x8c, 0x48, 0xee, 0xe5, 0xb9, 0xf1, 0x7e, 0x54, 0x14,
-		0x9c, 0xab, 0xc7, 0xab, 0x1f, 0x8b, 0xc3, 0xdc, 0x8e, 0xc8, 0x36, 0x7f, 0x68, 0x3d, 0xd1, 0x80,
-		0x29, 0x6c, 0x7a, 0x8e, 0x4e, 0x5d, 0x41, 0xc6, 0xfa, 0xd9, 0x61, 0x63, 0x3d, 0xa0, 0x2f, 0xf4,
-		0xff, 0x15, 0x89, 0x73, 0x6d, 0x4e, 0xd3, 0xe3, 0x85, 0x7f, 0x13, 0x87, 0xc2, 0x30, 0x24, 0x3a,
-		0x03, 0x33, 0x9a, 0x83, 0xa9, 0x40, 0x89, 0x1c, 0xae, 0xe5, 0x85, 0x98, 0x27, 0xfd, 0x0d, 0x20,
-		0x05, 0x14, 0x09, 0x2c, 0xa2, 0x7a, 0xec, 0x8a, 0x29, 0x1f, 0x80, 0x69, 0xda, 0xc7, 0x30, 0xa3,
-		0x9b, 0xba, 0xa7, 0xab, 0x86, 0xb2, 0xab, 0x1a, 0xaa, 0xa9, 0xdd, 0x4b, 0x65, 0xd9, 0x9f, 0xa8,
-		0xf3, 0x9c, 0xb4, 0xc2, 0x38, 0xd1, 0x0d, 0x98, 0x12, 0xf4, 0xc9, 0xfb, 0x40, 0x2f, 0xc8, 0x42,
-		0x55, 0xd4, 0xef, 0xc4, 0x61, 0x56, 0xc6, 0xad, 0x3f, 0x5a, 0x6e, 0xfd, 0x51, 0x00, 0x36, 0xe1,
-		0x48, 0x1e, 0xbc, 0x07, 0xcf, 0xf6, 0x4f, 0xe0, 0x0c, 0xe3, 0xab, 0xb9, 0x5e, 0xc8, 0xb7, 0xdf,
-		0x88, 0x43, 0x2e, 0xec, 0xdb, 0x3f, 0x02, 0xeb, 0x02, 0x5a, 0x0b, 0xb2, 0x41, 0x92, 0xff, 0xa7,
-		0xd5, 0x21, 0xd9, 0xa0, 0x2f, 0xea, 0x8e, 0x4e, 0x03, 0xdf, 0x8b, 0x43, 0xaa, 0xa1, 0x3a, 0x6a,
-		0xc7, 0x45, 0xd7, 0xfa, 0x0a, 0x38, 0x71, 0xca, 0xd6, 0xf7, 0xff, 0xb4, 0xf9, 0xa6, 0x9e, 0x85,
-		0xdc, 0xa7, 0x06, 0xd4, 0x6f, 0x8f, 0x41, 0x9e, 0x6c, 0x11, 0x43, 0x0f, 0xe4, 0xe3, 0xf4, 0x31,
-		0x23, 0xd9, 0xe3, 0x05, 0x4f, 0x83, 0xd0, 0x12, 0x64, 0x89, 0x5a, 0x90, 0xe8, 0x88, 0x0e, 0x74,
-		0xd4, 0xdb, 0x75, 0x26, 0x41, 0xcf, 0x00, 0xda, 0xf7, 0x37, 0xed, 0x4a, 0xe0, 0x02, 0xa2, 0x37,
-		0x1b, 0xb4, 0x08, 0xf5, 0x87, 0x00, 0x88, 0x15, 0x0a, 0x7b, 0xc9, 0x8b, 0xed, 0x71, 0x32, 0x44,
-		0x52, 0xa3, 0x2f, 0x7a, 0xfd, 0x04, 0xab, 0x05, 0x7b, 0x76, 0x8f, 0xbc, 0x0c, 0x5f, 0x3f, 0x5e,
-		0xa4, 0x7e, 0xef, 0xdd, 0xa5, 0x85, 0x43, 0xb5, 0x63, 0x94, 0x8a, 0x03, 0x28, 0x8b, 0xb4, 0x36,
-		0x8c, 0xee, 0x3a, 0x43, 0x11, 0xfc, 0xf9, 0x18, 0xa0, 0x20, 0xe5, 0xca, 0xd8, 0xb5, 0xc9, 0xb6,
-		0x86, 0x14, 0xbd, 0xa1, 0x0a, 0x35, 0x76, 0x74, 0xd1, 0x1b, 0xe0, 0x45, 0xd1, 0x1b, 0x9a, 0x11,
-		0x97, 0x83, 0x04, 0x17, 0xe7, 0x63, 0x38, 0xe0, 0x0d, 0xbd, 0x95, 0xaa, 0xa5, 0x0b, 0x74, 0x5f,
-		0x0e, 0x9b, 0x28, 0xfe, 0x4e, 0x0c, 0x4e, 0xf7, 0x45, 0x93, 0x6f, 0xec, 0x1f, 0x03, 0xe4, 0x84,
-		0x1a, 0xf9, 0xbf, 0xcc, 0x63, 0x46, 0x1f, 0x3b, 0x38, 0x67, 0x9d, 0xbe, 0x5c, 0xf9, 0x61, 0xe5,
-		0x68, 0xf6, 0xe6, 0xde, 0x3f, 0x8a, 0xc1, 0x7c, 0xd8, 0x18, 0xbf, 0x5b, 0x9b, 0x90, 0x0b, 0xdb,
-		0xc2, 0x3b, 0xf4, 0xe8, 0x38, 0x1d, 0xe2, 0x7d, 0x89, 0xe0, 0xd1, 0x2b, 0xc1, 0xc4, 0x65, 0x87,
-		0x45, 0xcf, 0x8d, 0xed, 0x1b, 0x61, 0x53, 0xef, 0x04, 0x4e, 0x8a, 0x2a, 0x26, 0xd9, 0xb0, 0x2c,
-		0x03, 0xfd, 0x71, 0x98, 0x35, 0x2d, 0x4f, 0x21, 0x51, 0x8e, 0x5b, 0x0a, 0xdf, 0xb9, 0xb2, 0xec,
-		0xf7, 0xca, 0xf1, 0x5c, 0xf6, 0x9d, 0x77, 0x97, 0xfa, 0xa9, 0x7a, 0xfc, 0x38, 0x63, 0x5a, 0x5e,
-		0x85, 0xb6, 0x6f, 0xb3, 0x7d, 0xad, 0x03, 0xd3, 0xd1, 0x5b, 0xb3, 0x6c, 0xb9, 0x71, 0xec, 0x5b,
-		0x4f, 0x1f, 0x75, 0xdb, 0xdc, 0x6e, 0xe8, 0x9e, 0xec, 0x9d, 0xa6, 0xdf, 0x7f, 0x67, 0x29, 0xf6,
-		0xe4, 0x57, 0x63, 0x00, 0xc1, 0x16, 0x1e, 0x3d, 0x0d, 0xa7, 0x2a, 0x5b, 0x9b, 0x35, 0xa5, 0xb9,
-		0x5d, 0xde, 0xde, 0x69, 0x46, 0xdf, 0x7c, 0x16, 0x67, 0xc2, 0xae, 0x8d, 0x35, 0x7d, 0x4f, 0xc7,
-		0x2d, 0xf4, 0x38, 0xcc, 0x47, 0xb5, 0xc9, 0x55, 0xbd, 0x26, 0xc5, 0x16, 0x72, 0x77, 0xee, 0x2e,
-		0xa7, 0x59, 0x75, 0x84, 0x5b, 0xe8, 0x2c, 0x9c, 0xe8, 0xd7, 0x5b, 0xdb, 0x5c, 0x95, 0xe2, 0x0b,
-		0xd3, 0x77, 0xee, 0x2e, 0x67, 0xfc, 0x32, 0x0a, 0x15, 0x01, 0x85, 0x35, 0x39, 0x5f, 0x62, 0x01,
-		0xee, 0xdc, 0x5d, 0x4e, 0x31, 0xb7, 0x2d, 0x24, 0x3f, 0xfe, 0xf9, 0xc5, 0x89, 0xca, 0xd5, 0xa1,
-		0xa7, 0xbe, 0x4f, 0x1f, 0xe9, 0xb1, 0xdb, 0xfe, 0x49, 0x6e, 0xe4, 0xa8, 0xf7, 0xff, 0x06, 0x00,
-		0x00, 0xff, 0xff, 0xdf, 0xc6, 0xe4, 0x92, 0x04, 0x66, 0x00, 0x00,
+		// 8014 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x70, 0x1c, 0xd9,
+		0x75, 0x1f, 0xce, 0x79, 0x00, 0x98, 0x39, 0x98, 0x47, 0xa3, 0x01, 0x92, 0x43, 0x70, 0x17, 0xc0,
+		0xce, 0xbe, 0xb8, 0x2f, 0x70, 0xc9, 0x5d, 0x92, 0xcb, 0xa1, 0x57, 0xfb, 0xc7, 0x60, 0x86, 0x24,
+		0xb8, 0x78, 0xcc, 0xf6, 0x00, 0xdc, 0x87, 0xfe, 0x4e, 0x57, 0xa3, 0xe7, 0x62, 0xd0, 0x8b, 0x9e,
+		0xee, 0x56, 0x77, 0x0f, 0x49, 0x6c, 0x39, 0xa9, 0x75, 0x94, 0x87, 0xc4, 0xc4, 0x8e, 0x6c, 0xa7,
+		0x6c, 0x59, 0x16, 0x65, 0xc9, 0x72, 0x22, 0x47, 0x51, 0x1e, 0xb6, 0x14, 0x25, 0x8e, 0x2b, 0x89,
+		0x93, 0xaa, 0x24, 0x8a, 0x3e, 0xa4, 0x64, 0x7f, 0x88, 0xed, 0x3c, 0x36, 0xce, 0x4a, 0xe5, 0xc8,
+		0x8a, 0x12, 0x3b, 0xf2, 0xba, 0x2a, 0x29, 0x45, 0xa9, 0xd4, 0xb9, 0x8f, 0xee, 0x9e, 0x17, 0x66,
+		0x40, 0x63, 0x65, 0x57, 0xf9, 0x13, 0xe6, 0x9e, 0x7b, 0xce, 0xaf, 0xcf, 0x3d, 0xf7, 0xdc, 0x7b,
+		0xcf, 0x3d, 0xf7, 0x76, 0x03, 0xfe, 0xe0, 0x0a, 0x2c, 0x34, 0x6d, 0xbb, 0x69, 0x92, 0xb3, 0x8e,
+		0x6b, 0xfb, 0xf6, 0x76, 0x7b, 0xe7, 0x6c, 0x83, 0x78, 0xba, 0x6b, 0x38, 0xbe, 0xed, 0x2e, 0x52,
+		0x9a, 0x9c, 0x67, 0x1c, 0x8b, 0x82, 0xa3, 0xb8, 0x06, 0x53, 0x57, 0x0d, 0x93, 0x54, 0x02, 0xc6,
+		0x3a, 0xf1, 0xe5, 0x17, 0x20, 0xb9, 0x63, 0x98, 0xa4, 0x10, 0x5b, 0x48, 0x9c, 0x99, 0x3c, 0xff,
+		0xc8, 0x62, 0x97, 0xd0, 0x62, 0xa7, 0x44, 0x0d, 0xc9, 0x0a, 0x95, 0x28, 0x7e, 0x23, 0x09, 0xd3,
+		0x7d, 0x6a, 0x65, 0x19, 0x92, 0x96, 0xd6, 0x42, 0xc4, 0xd8, 0x99, 0xb4, 0x42, 0x7f, 0xcb, 0x05,
+		0x98, 0x70, 0x34, 0x7d, 0x4f, 0x6b, 0x92, 0x42, 0x9c, 0x92, 0x45, 0x51, 0x9e, 0x03, 0x68, 0x10,
+		0x87, 0x58, 0x0d, 0x62, 0xe9, 0xfb, 0x85, 0xc4, 0x42, 0xe2, 0x4c, 0x5a, 0x89, 0x50, 0xe4, 0xa7,
+		0x60, 0xca, 0x69, 0x6f, 0x9b, 0x86, 0xae, 0x46, 0xd8, 0x60, 0x21, 0x71, 0x66, 0x4c, 0x91, 0x58,
+		0x45, 0x25, 0x64, 0x7e, 0x1c, 0xf2, 0xb7, 0x89, 0xb6, 0x17, 0x65, 0x9d, 0xa4, 0xac, 0x39, 0x24,
+		0x47, 0x18, 0x97, 0x21, 0xd3, 0x22, 0x9e, 0xa7, 0x35, 0x89, 0xea, 0xef, 0x3b, 0xa4, 0x90, 0xa4,
+		0xad, 0x5f, 0xe8, 0x69, 0x7d, 0x77, 0xcb, 0x27, 0xb9, 0xd4, 0xe6, 0xbe, 0x43, 0xe4, 0x25, 0x48,
+		0x13, 0xab, 0xdd, 0x62, 0x08, 0x63, 0x03, 0xec, 0x57, 0xb5, 0xda, 0xad, 0x6e, 0x94, 0x14, 0x8a,
+		0x71, 0x88, 0x09, 0x8f, 0xb8, 0xb7, 0x0c, 0x9d, 0x14, 0xc6, 0x29, 0xc0, 0xe3, 0x3d, 0x00, 0x75,
+		0x56, 0xdf, 0x8d, 0x21, 0xe4, 0xe4, 0x65, 0x48, 0x93, 0x3b, 0x3e, 0xb1, 0x3c, 0xc3, 0xb6, 0x0a,
+		0x13, 0x14, 0xe4, 0xd1, 0x3e, 0xbd, 0x48, 0xcc, 0x46, 0x37, 0x44, 0x28, 0x27, 0x5f, 0x84, 0x09,
+		0xdb, 0xf1, 0x0d, 0xdb, 0xf2, 0x0a, 0xa9, 0x85, 0xd8, 0x99, 0xc9, 0xf3, 0x0f, 0xf4, 0x75, 0x84,
+		0x0d, 0xc6, 0xa3, 0x08, 0x66, 0x79, 0x05, 0x24, 0xcf, 0x6e, 0xbb, 0x3a, 0x51, 0x75, 0xbb, 0x41,
+		0x54, 0xc3, 0xda, 0xb1, 0x0b, 0x69, 0x0a, 0x30, 0xdf, 0xdb, 0x10, 0xca, 0xb8, 0x6c, 0x37, 0xc8,
+		0x8a, 0xb5, 0x63, 0x2b, 0x39, 0xaf, 0xa3, 0x2c, 0x9f, 0x80, 0x71, 0x6f, 0xdf, 0xf2, 0xb5, 0x3b,
+		0x85, 0x0c, 0xf5, 0x10, 0x5e, 0x2a, 0xfe, 0xf2, 0x38, 0xe4, 0x47, 0x71, 0xb1, 0x2b, 0x30, 0xb6,
+		0x83, 0xad, 0x2c, 0xc4, 0x0f, 0x63, 0x03, 0x26, 0xd3, 0x69, 0xc4, 0xf1, 0xfb, 0x34, 0xe2, 0x12,
+		0x4c, 0x5a, 0xc4, 0xf3, 0x49, 0x83, 0x79, 0x44, 0x62, 0x44, 0x9f, 0x02, 0x26, 0xd4, 0xeb, 0x52,
+		0xc9, 0xfb, 0x72, 0xa9, 0xd7, 0x20, 0x1f, 0xa8, 0xa4, 0xba, 0x9a, 0xd5, 0x14, 0xbe, 0x79, 0x76,
+		0x98, 0x26, 0x8b, 0x55, 0x21, 0xa7, 0xa0, 0x98, 0x92, 0x23, 0x1d, 0x65, 0xb9, 0x02, 0x60, 0x5b,
+		0xc4, 0xde, 0x51, 0x1b, 0x44, 0x37, 0x0b, 0xa9, 0x01, 0x56, 0xda, 0x40, 0x96, 0x1e, 0x2b, 0xd9,
+		0x8c, 0xaa, 0x9b, 0xf2, 0xe5, 0xd0, 0xd5, 0x26, 0x06, 0x78, 0xca, 0x1a, 0x1b, 0x64, 0x3d, 0xde,
+		0xb6, 0x05, 0x39, 0x97, 0xa0, 0xdf, 0x93, 0x06, 0x6f, 0x59, 0x9a, 0x2a, 0xb1, 0x38, 0xb4, 0x65,
+		0x0a, 0x17, 0x63, 0x0d, 0xcb, 0xba, 0xd1, 0xa2, 0xfc, 0x30, 0x04, 0x04, 0x95, 0xba, 0x15, 0xd0,
+		0x59, 0x28, 0x23, 0x88, 0xeb, 0x5a, 0x8b, 0xcc, 0xbe, 0x05, 0xb9, 0x4e, 0xf3, 0xc8, 0x33, 0x30,
+		0xe6, 0xf9, 0x9a, 0xeb, 0x53, 0x2f, 0x1c, 0x53, 0x58, 0x41, 0x96, 0x20, 0x41, 0xac, 0x06, 0x9d,
+		0xe5, 0xc6, 0x14, 0xfc, 0x29, 0xff, 0x7f, 0x61, 0x83, 0x13, 0xb4, 0xc1, 0x8f, 0xf5, 0xf6, 0x68,
+		0x07, 0x72, 0x77, 0xbb, 0x67, 0x2f, 0x41, 0xb6, 0xa3, 0x01, 0xa3, 0x3e, 0xba, 0xf8, 0x43, 0x70,
+		0xbc, 0x2f, 0xb4, 0xfc, 0x1a, 0xcc, 0xb4, 0x2d, 0xc3, 0xf2, 0x89, 0xeb, 0xb8, 0x04, 0x3d, 0x96,
+		0x3d, 0xaa, 0xf0, 0x5f, 0x27, 0x06, 0xf8, 0xdc, 0x56, 0x94, 0x9b, 0xa1, 0x28, 0xd3, 0xed, 0x5e,
+		0xe2, 0x93, 0xe9, 0xd4, 0x37, 0x27, 0xa4, 0xb7, 0xdf, 0x7e, 0xfb, 0xed, 0x78, 0xf1, 0x9f, 0x8f,
+		0xc3, 0x4c, 0xbf, 0x31, 0xd3, 0x77, 0xf8, 0x9e, 0x80, 0x71, 0xab, 0xdd, 0xda, 0x26, 0x2e, 0x35,
+		0xd2, 0x98, 0xc2, 0x4b, 0xf2, 0x12, 0x8c, 0x99, 0xda, 0x36, 0x31, 0x0b, 0xc9, 0x85, 0xd8, 0x99,
+		0xdc, 0xf9, 0xa7, 0x46, 0x1a, 0x95, 0x8b, 0xab, 0x28, 0xa2, 0x30, 0x49, 0xf9, 0x03, 0x90, 0xe4,
+		0x53, 0x34, 0x22, 0x3c, 0x39, 0x1a, 0x02, 0x8e, 0x25, 0x85, 0xca, 0xc9, 0xa7, 0x21, 0x8d, 0x7f,
+		0x99, 0x6f, 0x8c, 0x53, 0x9d, 0x53, 0x48, 0x40, 0xbf, 0x90, 0x67, 0x21, 0x45, 0x87, 0x49, 0x83,
+		0x88, 0xa5, 0x2d, 0x28, 0xa3, 0x63, 0x35, 0xc8, 0x8e, 0xd6, 0x36, 0x7d, 0xf5, 0x96, 0x66, 0xb6,
+		0x09, 0x75, 0xf8, 0xb4, 0x92, 0xe1, 0xc4, 0x9b, 0x48, 0x93, 0xe7, 0x61, 0x92, 0x8d, 0x2a, 0xc3,
+		0x6a, 0x90, 0x3b, 0x74, 0xf6, 0x1c, 0x53, 0xd8, 0x40, 0x5b, 0x41, 0x0a, 0x3e, 0xfe, 0x4d, 0xcf,
+		0xb6, 0x84, 0x6b, 0xd2, 0x47, 0x20, 0x81, 0x3e, 0xfe, 0x52, 0xf7, 0xc4, 0xfd, 0x60, 0xff, 0xe6,
+		0xf5, 0x8c, 0xa5, 0xc7, 0x21, 0x4f, 0x39, 0x9e, 0xe3, 0x5d, 0xaf, 0x99, 0x85, 0xa9, 0x85, 0xd8,
+		0x99, 0x94, 0x92, 0x63, 0xe4, 0x0d, 0x4e, 0x2d, 0x7e, 0x39, 0x0e, 0x49, 0x3a, 0xb1, 0xe4, 0x61,
+		0x72, 0xf3, 0xf5, 0x5a, 0x55, 0xad, 0x6c, 0x6c, 0x95, 0x57, 0xab, 0x52, 0x4c, 0xce, 0x01, 0x50,
+		0xc2, 0xd5, 0xd5, 0x8d, 0xa5, 0x4d, 0x29, 0x1e, 0x94, 0x57, 0xd6, 0x37, 0x2f, 0x3e, 0x2f, 0x25,
+		0x02, 0x81, 0x2d, 0x46, 0x48, 0x46, 0x19, 0x9e, 0x3b, 0x2f, 0x8d, 0xc9, 0x12, 0x64, 0x18, 0xc0,
+		0xca, 0x6b, 0xd5, 0xca, 0xc5, 0xe7, 0xa5, 0xf1, 0x4e, 0xca, 0x73, 0xe7, 0xa5, 0x09, 0x39, 0x0b,
+		0x69, 0x4a, 0x29, 0x6f, 0x6c, 0xac, 0x4a, 0xa9, 0x00, 0xb3, 0xbe, 0xa9, 0xac, 0xac, 0x5f, 0x93,
+		0xd2, 0x01, 0xe6, 0x35, 0x65, 0x63, 0xab, 0x26, 0x41, 0x80, 0xb0, 0x56, 0xad, 0xd7, 0x97, 0xae,
+		0x55, 0xa5, 0xc9, 0x80, 0xa3, 0xfc, 0xfa, 0x66, 0xb5, 0x2e, 0x65, 0x3a, 0xd4, 0x7a, 0xee, 0xbc,
+		0x94, 0x0d, 0x1e, 0x51, 0x5d, 0xdf, 0x5a, 0x93, 0x72, 0xf2, 0x14, 0x64, 0xd9, 0x23, 0x84, 0x12,
+		0xf9, 0x2e, 0xd2, 0xc5, 0xe7, 0x25, 0x29, 0x54, 0x84, 0xa1, 0x4c, 0x75, 0x10, 0x2e, 0x3e, 0x2f,
+		0xc9, 0xc5, 0x65, 0x18, 0xa3, 0x6e, 0x28, 0xcb, 0x90, 0x5b, 0x5d, 0x2a, 0x57, 0x57, 0xd5, 0x8d,
+		0xda, 0xe6, 0xca, 0xc6, 0xfa, 0xd2, 0xaa, 0x14, 0x0b, 0x69, 0x4a, 0xf5, 0x95, 0xad, 0x15, 0xa5,
+		0x5a, 0x91, 0xe2, 0x51, 0x5a, 0xad, 0xba, 0xb4, 0x59, 0xad, 0x48, 0x89, 0xa2, 0x0e, 0x33, 0xfd,
+		0x26, 0xd4, 0xbe, 0x43, 0x28, 0xe2, 0x0b, 0xf1, 0x01, 0xbe, 0x40, 0xb1, 0xba, 0x7d, 0xa1, 0xf8,
+		0xf5, 0x38, 0x4c, 0xf7, 0x59, 0x54, 0xfa, 0x3e, 0xe4, 0x25, 0x18, 0x63, 0xbe, 0xcc, 0x96, 0xd9,
+		0x27, 0xfa, 0xae, 0x4e, 0xd4, 0xb3, 0x7b, 0x96, 0x5a, 0x2a, 0x17, 0x0d, 0x35, 0x12, 0x03, 0x42,
+		0x0d, 0x84, 0xe8, 0x71, 0xd8, 0x1f, 0xec, 0x99, 0xfc, 0xd9, 0xfa, 0x78, 0x71, 0x94, 0xf5, 0x91,
+		0xd2, 0x0e, 0xb7, 0x08, 0x8c, 0xf5, 0x59, 0x04, 0xae, 0xc0, 0x54, 0x0f, 0xd0, 0xc8, 0x93, 0xf1,
+		0x87, 0x63, 0x50, 0x18, 0x64, 0x9c, 0x21, 0x53, 0x62, 0xbc, 0x63, 0x4a, 0xbc, 0xd2, 0x6d, 0xc1,
+		0x87, 0x06, 0x77, 0x42, 0x4f, 0x5f, 0x7f, 0x2e, 0x06, 0x27, 0xfa, 0x87, 0x94, 0x7d, 0x75, 0xf8,
+		0x00, 0x8c, 0xb7, 0x88, 0xbf, 0x6b, 0x8b, 0xb0, 0xea, 0xb1, 0x3e, 0x8b, 0x35, 0x56, 0x77, 0x77,
+		0x36, 0x97, 0x92, 0x2f, 0x77, 0xeb, 0x3a, 0x3f, 0x28, 0xc0, 0xed, 0xd1, 0xf4, 0xa3, 0x71, 0x38,
+		0xde, 0x17, 0xbc, 0xaf, 0xa2, 0x0f, 0x02, 0x18, 0x96, 0xd3, 0xf6, 0x59, 0xe8, 0xc4, 0x66, 0xe2,
+		0x34, 0xa5, 0xd0, 0xc9, 0x0b, 0x67, 0xd9, 0xb6, 0x1f, 0xd4, 0x27, 0x68, 0x3d, 0x30, 0x12, 0x65,
+		0x78, 0x21, 0x54, 0x34, 0x49, 0x15, 0x9d, 0x1b, 0xd0, 0xd2, 0x1e, 0xc7, 0x7c, 0x16, 0x24, 0xdd,
+		0x34, 0x88, 0xe5, 0xab, 0x9e, 0xef, 0x12, 0xad, 0x65, 0x58, 0x4d, 0xba, 0xd4, 0xa4, 0x4a, 0x63,
+		0x3b, 0x9a, 0xe9, 0x11, 0x25, 0xcf, 0xaa, 0xeb, 0xa2, 0x16, 0x25, 0xa8, 0x03, 0xb9, 0x11, 0x89,
+		0xf1, 0x0e, 0x09, 0x56, 0x1d, 0x48, 0x14, 0x7f, 0x2c, 0x0d, 0x93, 0x91, 0x00, 0x5c, 0x7e, 0x08,
+		0x32, 0x6f, 0x6a, 0xb7, 0x34, 0x55, 0x6c, 0xaa, 0x98, 0x25, 0x26, 0x91, 0x56, 0x63, 0x24, 0xf9,
+		0x59, 0x98, 0xa1, 0x2c, 0x76, 0xdb, 0x27, 0xae, 0xaa, 0x9b, 0x9a, 0xe7, 0x51, 0xa3, 0xa5, 0x28,
+		0xab, 0x8c, 0x75, 0x1b, 0x58, 0xb5, 0x2c, 0x6a, 0xe4, 0x0b, 0x30, 0x4d, 0x25, 0x5a, 0x6d, 0xd3,
+		0x37, 0x1c, 0x93, 0xa8, 0xb8, 0xcd, 0xf3, 0x0a, 0x10, 0xd5, 0x6c, 0x0a, 0x39, 0xd6, 0x38, 0x03,
+		0x6a, 0xe4, 0xc9, 0x15, 0x78, 0x90, 0x8a, 0x35, 0x89, 0x45, 0x5c, 0xcd, 0x27, 0x2a, 0xf9, 0x50,
+		0x5b, 0x33, 0x3d, 0x55, 0xb3, 0x1a, 0xea, 0xae, 0xe6, 0xed, 0x16, 0x66, 0x10, 0xa0, 0x1c, 0x2f,
+		0xc4, 0x94, 0x53, 0xc8, 0x78, 0x8d, 0xf3, 0x55, 0x29, 0xdb, 0x92, 0xd5, 0xb8, 0xae, 0x79, 0xbb,
+		0x72, 0x09, 0x4e, 0x50, 0x14, 0xcf, 0x77, 0x0d, 0xab, 0xa9, 0xea, 0xbb, 0x44, 0xdf, 0x53, 0xdb,
+		0xfe, 0xce, 0x0b, 0x85, 0xd3, 0xd1, 0xe7, 0x53, 0x0d, 0xeb, 0x94, 0x67, 0x19, 0x59, 0xb6, 0xfc,
+		0x9d, 0x17, 0xe4, 0x3a, 0x64, 0xb0, 0x33, 0x5a, 0xc6, 0x5b, 0x44, 0xdd, 0xb1, 0x5d, 0xba, 0x86,
+		0xe6, 0xfa, 0x4c, 0x4d, 0x11, 0x0b, 0x2e, 0x6e, 0x70, 0x81, 0x35, 0xbb, 0x41, 0x4a, 0x63, 0xf5,
+		0x5a, 0xb5, 0x5a, 0x51, 0x26, 0x05, 0xca, 0x55, 0xdb, 0x45, 0x87, 0x6a, 0xda, 0x81, 0x81, 0x27,
+		0x99, 0x43, 0x35, 0x6d, 0x61, 0xde, 0x0b, 0x30, 0xad, 0xeb, 0xac, 0xcd, 0x86, 0xae, 0xf2, 0xcd,
+		0x98, 0x57, 0x90, 0x3a, 0x8c, 0xa5, 0xeb, 0xd7, 0x18, 0x03, 0xf7, 0x71, 0x4f, 0xbe, 0x0c, 0xc7,
+		0x43, 0x63, 0x45, 0x05, 0xa7, 0x7a, 0x5a, 0xd9, 0x2d, 0x7a, 0x01, 0xa6, 0x9d, 0xfd, 0x5e, 0x41,
+		0xb9, 0xe3, 0x89, 0xce, 0x7e, 0xb7, 0xd8, 0x25, 0x98, 0x71, 0x76, 0x9d, 0x5e, 0xb9, 0x27, 0xa3,
+		0x72, 0xb2, 0xb3, 0xeb, 0x74, 0x0b, 0x3e, 0x4a, 0x77, 0xe6, 0x2e, 0xd1, 0x35, 0x9f, 0x34, 0x0a,
+		0x27, 0xa3, 0xec, 0x91, 0x0a, 0x79, 0x11, 0x24, 0x5d, 0x57, 0x89, 0xa5, 0x6d, 0x9b, 0x44, 0xd5,
+		0x5c, 0x62, 0x69, 0x5e, 0x61, 0x9e, 0x32, 0x27, 0x7d, 0xb7, 0x4d, 0x94, 0x9c, 0xae, 0x57, 0x69,
+		0xe5, 0x12, 0xad, 0x93, 0x9f, 0x84, 0x29, 0x7b, 0xfb, 0x4d, 0x9d, 0x79, 0xa4, 0xea, 0xb8, 0x64,
+		0xc7, 0xb8, 0x53, 0x78, 0x84, 0x9a, 0x37, 0x8f, 0x15, 0xd4, 0x1f, 0x6b, 0x94, 0x2c, 0x3f, 0x01,
+		0x92, 0xee, 0xed, 0x6a, 0xae, 0x43, 0xa7, 0x64, 0xcf, 0xd1, 0x74, 0x52, 0x78, 0x94, 0xb1, 0x32,
+		0xfa, 0xba, 0x20, 0xe3, 0x88, 0xf0, 0x6e, 0x1b, 0x3b, 0xbe, 0x40, 0x7c, 0x9c, 0x8d, 0x08, 0x4a,
+		0xe3, 0x68, 0x67, 0x40, 0x42, 0x4b, 0x74, 0x3c, 0xf8, 0x0c, 0x65, 0xcb, 0x39, 0xbb, 0x4e, 0xf4,
+		0xb9, 0x0f, 0x43, 0xd6, 0xd9, 0x8d, 0x3e, 0xf4, 0x09, 0x16, 0xb8, 0x39, 0xbb, 0x91, 0x27, 0x3e,
+		0x0f, 0x27, 0x90, 0xa9, 0x45, 0x7c, 0xad, 0xa1, 0xf9, 0x5a, 0x84, 0xfb, 0x69, 0xca, 0x8d, 0x66,
+		0x5f, 0xe3, 0x95, 0x1d, 0x7a, 0xba, 0xed, 0xed, 0xfd, 0xc0, 0xb1, 0x9e, 0x61, 0x7a, 0x22, 0x4d,
+		0xb8, 0xd6, 0xfb, 0x16, 0x9c, 0x17, 0x4b, 0x90, 0x89, 0xfa, 0xbd, 0x9c, 0x06, 0xe6, 0xf9, 0x52,
+		0x0c, 0x83, 0xa0, 0xe5, 0x8d, 0x0a, 0x86, 0x2f, 0x6f, 0x54, 0xa5, 0x38, 0x86, 0x51, 0xab, 0x2b,
+		0x9b, 0x55, 0x55, 0xd9, 0x5a, 0xdf, 0x5c, 0x59, 0xab, 0x4a, 0x89, 0x48, 0x60, 0x7f, 0x23, 0x99,
+		0x7a, 0x4c, 0x7a, 0x1c, 0xa3, 0x86, 0x5c, 0xe7, 0x4e, 0x4d, 0xfe, 0x01, 0x38, 0x29, 0xd2, 0x2a,
+		0x1e, 0xf1, 0xd5, 0xdb, 0x86, 0x4b, 0x07, 0x64, 0x4b, 0x63, 0x8b, 0x63, 0xe0, 0x3f, 0x33, 0x9c,
+		0xab, 0x4e, 0xfc, 0x57, 0x0d, 0x17, 0x87, 0x5b, 0x4b, 0xf3, 0xe5, 0x55, 0x98, 0xb7, 0x6c, 0xd5,
+		0xf3, 0x35, 0xab, 0xa1, 0xb9, 0x0d, 0x35, 0x4c, 0x68, 0xa9, 0x9a, 0xae, 0x13, 0xcf, 0xb3, 0xd9,
+		0x42, 0x18, 0xa0, 0x3c, 0x60, 0xd9, 0x75, 0xce, 0x1c, 0xae, 0x10, 0x4b, 0x9c, 0xb5, 0xcb, 0x7d,
+		0x13, 0x83, 0xdc, 0xf7, 0x34, 0xa4, 0x5b, 0x9a, 0xa3, 0x12, 0xcb, 0x77, 0xf7, 0x69, 0x7c, 0x9e,
+		0x52, 0x52, 0x2d, 0xcd, 0xa9, 0x62, 0xf9, 0xfb, 0xb2, 0x4d, 0xba, 0x91, 0x4c, 0x25, 0xa5, 0xb1,
+		0x1b, 0xc9, 0xd4, 0x98, 0x34, 0x7e, 0x23, 0x99, 0x1a, 0x97, 0x26, 0x6e, 0x24, 0x53, 0x29, 0x29,
+		0x7d, 0x23, 0x99, 0x4a, 0x4b, 0x50, 0xfc, 0xf1, 0x24, 0x64, 0xa2, 0x11, 0x3c, 0x6e, 0x88, 0x74,
+		0xba, 0x86, 0xc5, 0xe8, 0x2c, 0xf7, 0xf0, 0x81, 0xf1, 0xfe, 0xe2, 0x32, 0x2e, 0x6e, 0xa5, 0x71,
+		0x16, 0x2e, 0x2b, 0x4c, 0x12, 0x03, 0x0b, 0x74, 0x3f, 0xc2, 0xc2, 0x93, 0x94, 0xc2, 0x4b, 0xf2,
+		0x35, 0x18, 0x7f, 0xd3, 0xa3, 0xd8, 0xe3, 0x14, 0xfb, 0x91, 0x83, 0xb1, 0x6f, 0xd4, 0x29, 0x78,
+		0xfa, 0x46, 0x5d, 0x5d, 0xdf, 0x50, 0xd6, 0x96, 0x56, 0x15, 0x2e, 0x2e, 0x9f, 0x82, 0xa4, 0xa9,
+		0xbd, 0xb5, 0xdf, 0xb9, 0x0c, 0x52, 0x92, 0xbc, 0x08, 0xf9, 0xb6, 0x75, 0x8b, 0xb8, 0xc6, 0x8e,
+		0x41, 0x1a, 0x2a, 0xe5, 0xca, 0x47, 0xb9, 0x72, 0x61, 0xed, 0x2a, 0xf2, 0x8f, 0xd8, 0x8d, 0xa7,
+		0x20, 0x89, 0x29, 0xbe, 0xce, 0xc5, 0x8a, 0x92, 0xde, 0xc7, 0xe1, 0x74, 0x16, 0xc6, 0xa8, 0x7d,
+		0x65, 0x00, 0x6e, 0x61, 0xe9, 0x98, 0x9c, 0x82, 0xe4, 0xf2, 0x86, 0x82, 0x43, 0x4a, 0x82, 0x0c,
+		0xa3, 0xaa, 0xb5, 0x95, 0xea, 0x72, 0x55, 0x8a, 0x17, 0x2f, 0xc0, 0x38, 0x33, 0x1a, 0x0e, 0xb7,
+		0xc0, 0x6c, 0xd2, 0x31, 0x5e, 0xe4, 0x18, 0x31, 0x51, 0xbb, 0xb5, 0x56, 0xae, 0x2a, 0x52, 0xbc,
+		0xc7, 0x59, 0x8a, 0x1e, 0x64, 0xa2, 0x91, 0xfc, 0xf7, 0x67, 0x3b, 0xff, 0xab, 0x31, 0x98, 0x8c,
+		0x44, 0xe6, 0x18, 0x52, 0x69, 0xa6, 0x69, 0xdf, 0x56, 0x35, 0xd3, 0xd0, 0x3c, 0xee, 0x4a, 0x40,
+		0x49, 0x4b, 0x48, 0x19, 0xb5, 0xeb, 0xbe, 0x4f, 0x83, 0x6c, 0x4c, 0x1a, 0x2f, 0x7e, 0x2a, 0x06,
+		0x52, 0x77, 0x68, 0xdc, 0xa5, 0x66, 0xec, 0x8f, 0x53, 0xcd, 0xe2, 0x27, 0x63, 0x90, 0xeb, 0x8c,
+		0x87, 0xbb, 0xd4, 0x7b, 0xe8, 0x8f, 0x55, 0xbd, 0xdf, 0x8e, 0x43, 0xb6, 0x23, 0x0a, 0x1e, 0x55,
+		0xbb, 0x0f, 0xc1, 0x94, 0xd1, 0x20, 0x2d, 0xc7, 0xf6, 0x31, 0xfd, 0xae, 0x9a, 0xe4, 0x16, 0x31,
+		0x0b, 0x45, 0x3a, 0xc9, 0x9c, 0x3d, 0x38, 0xce, 0x5e, 0x5c, 0x09, 0xe5, 0x56, 0x51, 0xac, 0x34,
+		0xbd, 0x52, 0xa9, 0xae, 0xd5, 0x36, 0x36, 0xab, 0xeb, 0xcb, 0xaf, 0xab, 0x5b, 0xeb, 0x2f, 0xaf,
+		0x6f, 0xbc, 0xba, 0xae, 0x48, 0x46, 0x17, 0xdb, 0xfb, 0x38, 0xec, 0x6b, 0x20, 0x75, 0x2b, 0x25,
+		0x9f, 0x84, 0x7e, 0x6a, 0x49, 0xc7, 0xe4, 0x69, 0xc8, 0xaf, 0x6f, 0xa8, 0xf5, 0x95, 0x4a, 0x55,
+		0xad, 0x5e, 0xbd, 0x5a, 0x5d, 0xde, 0xac, 0xb3, 0xcc, 0x49, 0xc0, 0xbd, 0xd9, 0x31, 0xc0, 0x8b,
+		0x9f, 0x48, 0xc0, 0x74, 0x1f, 0x4d, 0xe4, 0x25, 0xbe, 0xe7, 0x61, 0xdb, 0xb0, 0x67, 0x46, 0xd1,
+		0x7e, 0x11, 0xa3, 0x8e, 0x9a, 0xe6, 0xfa, 0x7c, 0x8b, 0xf4, 0x04, 0xa0, 0x95, 0x2c, 0x1f, 0x27,
+		0x57, 0x97, 0x67, 0xa4, 0xd8, 0x46, 0x28, 0x1f, 0xd2, 0x59, 0x52, 0xea, 0x69, 0x90, 0x1d, 0xdb,
+		0x33, 0x7c, 0xe3, 0x16, 0x26, 0xf5, 0x45, 0xfa, 0x0a, 0x37, 0x46, 0x49, 0x45, 0x12, 0x35, 0x2b,
+		0x96, 0x1f, 0x70, 0x5b, 0xa4, 0xa9, 0x75, 0x71, 0xe3, 0xe4, 0x9f, 0x50, 0x24, 0x51, 0x13, 0x70,
+		0x3f, 0x04, 0x99, 0x86, 0xdd, 0xc6, 0x68, 0x91, 0xf1, 0xe1, 0x5a, 0x13, 0x53, 0x26, 0x19, 0x2d,
+		0x60, 0xe1, 0xfb, 0x80, 0x30, 0x6f, 0x96, 0x51, 0x26, 0x19, 0x8d, 0xb1, 0x3c, 0x0e, 0x79, 0xad,
+		0xd9, 0x74, 0x11, 0x5c, 0x00, 0xb1, 0x9d, 0x4d, 0x2e, 0x20, 0x53, 0xc6, 0xd9, 0x1b, 0x90, 0x12,
+		0x76, 0xc0, 0xc5, 0x1e, 0x2d, 0xa1, 0x3a, 0x6c, 0xbb, 0x1e, 0xc7, 0x54, 0x9a, 0x25, 0x2a, 0x1f,
+		0x82, 0x8c, 0xe1, 0xa9, 0xe1, 0x31, 0x40, 0x7c, 0x21, 0x7e, 0x26, 0xa5, 0x4c, 0x1a, 0x5e, 0x90,
+		0x42, 0x2d, 0x7e, 0x2e, 0x0e, 0xb9, 0xce, 0x63, 0x0c, 0xb9, 0x02, 0x29, 0xd3, 0xd6, 0x35, 0xea,
+		0x5a, 0xec, 0x0c, 0xed, 0xcc, 0x90, 0x93, 0x8f, 0xc5, 0x55, 0xce, 0xaf, 0x04, 0x92, 0xb3, 0xff,
+		0x36, 0x06, 0x29, 0x41, 0x96, 0x4f, 0x40, 0xd2, 0xd1, 0xfc, 0x5d, 0x0a, 0x37, 0x56, 0x8e, 0x4b,
+		0x31, 0x85, 0x96, 0x91, 0xee, 0x39, 0x9a, 0x55, 0x88, 0x87, 0x74, 0x2c, 0x63, 0xbf, 0x9a, 0x44,
+		0x6b, 0xd0, 0x6d, 0x93, 0xdd, 0x6a, 0x11, 0xcb, 0xf7, 0x44, 0xbf, 0x72, 0xfa, 0x32, 0x27, 0xe3,
+		0x69, 0x9a, 0xef, 0x6a, 0x86, 0xd9, 0xc1, 0x9b, 0xa4, 0xbc, 0x92, 0xa8, 0x08, 0x98, 0x4b, 0x70,
+		0x4a, 0xe0, 0x36, 0x88, 0xaf, 0xe9, 0xbb, 0xa4, 0x11, 0x0a, 0x8d, 0xd3, 0xf4, 0xc8, 0x49, 0xce,
+		0x50, 0xe1, 0xf5, 0x42, 0xb6, 0xf8, 0x6b, 0x31, 0x98, 0x12, 0x1b, 0xbd, 0x46, 0x60, 0xac, 0x35,
+		0x00, 0xcd, 0xb2, 0x6c, 0x3f, 0x6a, 0xae, 0x5e, 0x57, 0xee, 0x91, 0x5b, 0x5c, 0x0a, 0x84, 0x94,
+		0x08, 0xc0, 0x6c, 0x0b, 0x20, 0xac, 0x19, 0x68, 0xb6, 0x79, 0x98, 0xe4, 0x67, 0x54, 0xf4, 0xa0,
+		0x93, 0xa5, 0x06, 0x80, 0x91, 0x70, 0x47, 0x88, 0x09, 0x9c, 0x6d, 0xd2, 0x34, 0x2c, 0x9e, 0x79,
+		0x66, 0x05, 0x91, 0xc0, 0x49, 0x06, 0x09, 0x9c, 0xf2, 0x9f, 0x83, 0x69, 0xdd, 0x6e, 0x75, 0xab,
+		0x5b, 0x96, 0xba, 0xd2, 0x13, 0xde, 0xf5, 0xd8, 0x1b, 0xcf, 0x70, 0xa6, 0xa6, 0x6d, 0x6a, 0x56,
+		0x73, 0xd1, 0x76, 0x9b, 0xe1, 0x41, 0x2d, 0x46, 0x48, 0x5e, 0xe4, 0xb8, 0xd6, 0xd9, 0xfe, 0x5f,
+		0xb1, 0xd8, 0xcf, 0xc5, 0x13, 0xd7, 0x6a, 0xe5, 0xcf, 0xc7, 0x67, 0xaf, 0x31, 0xc1, 0x9a, 0x30,
+		0x86, 0x42, 0x76, 0x4c, 0xa2, 0x63, 0x03, 0xe1, 0x5b, 0x4f, 0xc1, 0x4c, 0xd3, 0x6e, 0xda, 0x14,
+		0xe9, 0x2c, 0xfe, 0xe2, 0x27, 0xbd, 0xe9, 0x80, 0x3a, 0x3b, 0xf4, 0x58, 0xb8, 0xb4, 0x0e, 0xd3,
+		0x9c, 0x59, 0xa5, 0x47, 0x4d, 0x6c, 0x23, 0x24, 0x1f, 0x98, 0x85, 0x2b, 0xfc, 0xe2, 0x37, 0xe8,
+		0xf2, 0xad, 0x4c, 0x71, 0x51, 0xac, 0x63, 0x7b, 0xa5, 0x92, 0x02, 0xc7, 0x3b, 0xf0, 0xd8, 0x20,
+		0x25, 0xee, 0x10, 0xc4, 0x7f, 0xc9, 0x11, 0xa7, 0x23, 0x88, 0x75, 0x2e, 0x5a, 0x5a, 0x86, 0xec,
+		0x61, 0xb0, 0xfe, 0x15, 0xc7, 0xca, 0x90, 0x28, 0xc8, 0x35, 0xc8, 0x53, 0x10, 0xbd, 0xed, 0xf9,
+		0x76, 0x8b, 0xce, 0x80, 0x07, 0xc3, 0xfc, 0xeb, 0x6f, 0xb0, 0x51, 0x93, 0x43, 0xb1, 0xe5, 0x40,
+		0xaa, 0x54, 0x02, 0x7a, 0xba, 0x86, 0xa7, 0x5e, 0x43, 0x10, 0xbe, 0xc2, 0x15, 0x09, 0xf8, 0x4b,
+		0x37, 0x61, 0x06, 0x7f, 0xd3, 0x09, 0x2a, 0xaa, 0xc9, 0xf0, 0x94, 0x5d, 0xe1, 0xd7, 0x3e, 0xcc,
+		0x06, 0xe6, 0x74, 0x00, 0x10, 0xd1, 0x29, 0xd2, 0x8b, 0x4d, 0xe2, 0xfb, 0xc4, 0xf5, 0x54, 0xcd,
+		0xec, 0xa7, 0x5e, 0x24, 0xe7, 0x51, 0xf8, 0xe9, 0x6f, 0x77, 0xf6, 0xe2, 0x35, 0x26, 0xb9, 0x64,
+		0x9a, 0xa5, 0x2d, 0x38, 0xd9, 0xc7, 0x2b, 0x46, 0xc0, 0xfc, 0x04, 0xc7, 0x9c, 0xe9, 0xf1, 0x0c,
+		0x84, 0xad, 0x81, 0xa0, 0x07, 0x7d, 0x39, 0x02, 0xe6, 0xcf, 0x70, 0x4c, 0x99, 0xcb, 0x8a, 0x2e,
+		0x45, 0xc4, 0x1b, 0x30, 0x75, 0x8b, 0xb8, 0xdb, 0xb6, 0xc7, 0xf3, 0x4c, 0x23, 0xc0, 0x7d, 0x92,
+		0xc3, 0xe5, 0xb9, 0x20, 0x4d, 0x3c, 0x21, 0xd6, 0x65, 0x48, 0xed, 0x68, 0x3a, 0x19, 0x01, 0xe2,
+		0x1e, 0x87, 0x98, 0x40, 0x7e, 0x14, 0x5d, 0x82, 0x4c, 0xd3, 0xe6, 0x6b, 0xd4, 0x70, 0xf1, 0x4f,
+		0x71, 0xf1, 0x49, 0x21, 0xc3, 0x21, 0x1c, 0xdb, 0x69, 0x9b, 0xb8, 0x80, 0x0d, 0x87, 0xf8, 0x59,
+		0x01, 0x21, 0x64, 0x38, 0xc4, 0x21, 0xcc, 0xfa, 0x69, 0x01, 0xe1, 0x45, 0xec, 0xf9, 0x12, 0x1e,
+		0x3f, 0x99, 0xfb, 0xb6, 0x35, 0x8a, 0x12, 0x9f, 0xe1, 0x08, 0xc0, 0x45, 0x10, 0xe0, 0x0a, 0xa4,
+		0x47, 0xed, 0x88, 0xbf, 0xf1, 0x6d, 0x31, 0x3c, 0x44, 0x0f, 0x5c, 0x83, 0xbc, 0x98, 0xa0, 0xf0,
+		0xb8, 0x7a, 0x38, 0xc4, 0xdf, 0xe4, 0x10, 0xb9, 0x88, 0x18, 0x6f, 0x86, 0x4f, 0x3c, 0xbf, 0x49,
+		0x46, 0x01, 0xf9, 0x9c, 0x68, 0x06, 0x17, 0xe1, 0xa6, 0xdc, 0x26, 0x96, 0xbe, 0x3b, 0x1a, 0xc2,
+		0x2f, 0x08, 0x53, 0x0a, 0x19, 0x84, 0x58, 0x86, 0x6c, 0x4b, 0x73, 0xbd, 0x5d, 0xcd, 0x1c, 0xa9,
+		0x3b, 0xfe, 0x16, 0xc7, 0xc8, 0x04, 0x42, 0xdc, 0x22, 0x6d, 0xeb, 0x30, 0x30, 0x9f, 0x17, 0x16,
+		0x69, 0x5b, 0x1d, 0x40, 0x35, 0x98, 0xf1, 0x7c, 0x9a, 0x94, 0x3b, 0x0c, 0xda, 0xdf, 0x16, 0x43,
+		0x8f, 0xc9, 0xae, 0x45, 0x11, 0xaf, 0x40, 0xda, 0x33, 0xde, 0x1a, 0x09, 0xe6, 0x0b, 0xa2, 0xa7,
+		0xa9, 0x00, 0x0a, 0xbf, 0x0e, 0xa7, 0xfa, 0x2e, 0x13, 0x23, 0x80, 0xfd, 0x1d, 0x0e, 0x76, 0xa2,
+		0xcf, 0x52, 0xc1, 0xa7, 0x84, 0xc3, 0x42, 0xfe, 0x5d, 0x31, 0x25, 0x90, 0x2e, 0xac, 0x1a, 0xee,
+		0x1a, 0x3c, 0x6d, 0xe7, 0x70, 0x56, 0xfb, 0x7b, 0xc2, 0x6a, 0x4c, 0xb6, 0xc3, 0x6a, 0x9b, 0x70,
+		0x82, 0x23, 0x1e, 0xae, 0x5f, 0xff, 0xbe, 0x98, 0x58, 0x99, 0xf4, 0x56, 0x67, 0xef, 0x7e, 0x10,
+		0x66, 0x03, 0x73, 0x8a, 0xf0, 0xd4, 0x53, 0x31, 0x93, 0x35, 0x1c, 0xf9, 0x17, 0x39, 0xb2, 0x98,
+		0xf1, 0x83, 0xf8, 0xd6, 0x5b, 0xd3, 0x1c, 0x04, 0x7f, 0x0d, 0x0a, 0x02, 0xbc, 0x6d, 0xb9, 0x44,
+		0xb7, 0x9b, 0x96, 0xf1, 0x16, 0x69, 0x8c, 0x00, 0xfd, 0x4b, 0x5d, 0x5d, 0xb5, 0x15, 0x11, 0x47,
+		0xe4, 0x15, 0x90, 0x82, 0x58, 0x45, 0x35, 0x5a, 0x8e, 0xed, 0xfa, 0x43, 0x10, 0xbf, 0x28, 0x7a,
+		0x2a, 0x90, 0x5b, 0xa1, 0x62, 0xa5, 0x2a, 0xb0, 0x93, 0xea, 0x51, 0x5d, 0xf2, 0x4b, 0x1c, 0x28,
+		0x1b, 0x4a, 0xf1, 0x89, 0x43, 0xb7, 0x5b, 0x8e, 0xe6, 0x8e, 0x32, 0xff, 0xfd, 0x03, 0x31, 0x71,
+		0x70, 0x11, 0x3e, 0x71, 0x60, 0x44, 0x87, 0xab, 0xfd, 0x08, 0x08, 0x5f, 0x16, 0x13, 0x87, 0x90,
+		0xe1, 0x10, 0x22, 0x60, 0x18, 0x01, 0xe2, 0x1f, 0x0a, 0x08, 0x21, 0x83, 0x10, 0xaf, 0x84, 0x0b,
+		0xad, 0x4b, 0x9a, 0x86, 0xe7, 0xbb, 0x2c, 0x28, 0x3e, 0x18, 0xea, 0x1f, 0x7d, 0xbb, 0x33, 0x08,
+		0x53, 0x22, 0xa2, 0x38, 0x13, 0xf1, 0x34, 0x2d, 0xdd, 0x33, 0x0d, 0x57, 0xec, 0x97, 0xc5, 0x4c,
+		0x14, 0x11, 0x43, 0xdd, 0x22, 0x11, 0x22, 0x9a, 0x5d, 0xc7, 0x9d, 0xc2, 0x08, 0x70, 0xff, 0xb8,
+		0x4b, 0xb9, 0xba, 0x90, 0x45, 0xcc, 0x48, 0xfc, 0xd3, 0xb6, 0xf6, 0xc8, 0xfe, 0x48, 0xde, 0xf9,
+		0x2b, 0x5d, 0xf1, 0xcf, 0x16, 0x93, 0x64, 0x73, 0x48, 0xbe, 0x2b, 0x9e, 0x92, 0x87, 0xdd, 0x4b,
+		0x2a, 0xfc, 0xf0, 0x7b, 0xbc, 0xbd, 0x9d, 0xe1, 0x54, 0x69, 0x15, 0x24, 0x4e, 0x09, 0x03, 0xd8,
+		0xa1, 0x60, 0x1f, 0x7e, 0x2f, 0xf0, 0xf3, 0x8e, 0x98, 0xa7, 0x74, 0x15, 0xb2, 0x1d, 0x01, 0xcf,
+		0x70, 0xa8, 0xbf, 0xc0, 0xa1, 0x32, 0xd1, 0x78, 0xa7, 0x74, 0x01, 0x92, 0x18, 0xbc, 0x0c, 0x17,
+		0xff, 0x8b, 0x5c, 0x9c, 0xb2, 0x97, 0x5e, 0x84, 0x94, 0x08, 0x5a, 0x86, 0x8b, 0xfe, 0x25, 0x2e,
+		0x1a, 0x88, 0xa0, 0xb8, 0x08, 0x58, 0x86, 0x8b, 0xff, 0x65, 0x21, 0x2e, 0x44, 0x50, 0x7c, 0x74,
+		0x13, 0xfe, 0xea, 0x5f, 0x49, 0x32, 0x71, 0x21, 0x52, 0xc2, 0x93, 0x72, 0x16, 0xa9, 0x0c, 0x97,
+		0xfe, 0x28, 0x7f, 0xb8, 0x90, 0x28, 0x5d, 0x82, 0xb1, 0x11, 0x0d, 0xfe, 0x23, 0x5c, 0x94, 0xf1,
+		0x97, 0x96, 0x61, 0x32, 0x12, 0x9d, 0x0c, 0x17, 0xff, 0x51, 0x2e, 0x1e, 0x95, 0x42, 0xd5, 0x79,
+		0x74, 0x32, 0x1c, 0xe0, 0xaf, 0x09, 0xd5, 0xb9, 0x04, 0x9a, 0x4d, 0x04, 0x26, 0xc3, 0xa5, 0x3f,
+		0x26, 0xac, 0x2e, 0x44, 0x4a, 0x2f, 0x41, 0x3a, 0x58, 0x6c, 0x86, 0xcb, 0xff, 0x18, 0x97, 0x0f,
+		0x65, 0xd0, 0x02, 0x6d, 0xeb, 0x10, 0x10, 0x3f, 0x2e, 0x2c, 0x10, 0x91, 0xc2, 0x61, 0xd4, 0x1d,
+		0xc0, 0x0c, 0x47, 0xfa, 0x09, 0x31, 0x8c, 0xba, 0xe2, 0x17, 0xec, 0x4d, 0x3a, 0xe7, 0x0f, 0x87,
+		0xf8, 0xeb, 0xa2, 0x37, 0x29, 0x3f, 0xaa, 0xd1, 0x1d, 0x11, 0x0c, 0xc7, 0xf8, 0x29, 0xa1, 0x46,
+		0x57, 0x40, 0x50, 0xaa, 0x81, 0xdc, 0x1b, 0x0d, 0x0c, 0xc7, 0xfb, 0x38, 0xc7, 0x9b, 0xea, 0x09,
+		0x06, 0x4a, 0xaf, 0xc2, 0x89, 0xfe, 0x91, 0xc0, 0x70, 0xd4, 0x9f, 0x7e, 0xaf, 0x6b, 0xef, 0x16,
+		0x0d, 0x04, 0x4a, 0x9b, 0x30, 0xd3, 0x2f, 0x0a, 0x18, 0x0e, 0xfb, 0x89, 0xf7, 0x3a, 0x27, 0xee,
+		0x68, 0x10, 0x50, 0x5a, 0x02, 0x08, 0x17, 0xe0, 0xe1, 0x58, 0x9f, 0xe4, 0x58, 0x11, 0x21, 0x1c,
+		0x1a, 0x7c, 0xfd, 0x1d, 0x2e, 0x7f, 0x4f, 0x0c, 0x0d, 0x2e, 0x81, 0x43, 0x43, 0x2c, 0xbd, 0xc3,
+		0xa5, 0x3f, 0x25, 0x86, 0x86, 0x10, 0x41, 0xcf, 0x8e, 0xac, 0x6e, 0xc3, 0x11, 0x3e, 0x23, 0x3c,
+		0x3b, 0x22, 0x55, 0x5a, 0x87, 0xa9, 0x9e, 0x05, 0x71, 0x38, 0xd4, 0xcf, 0x71, 0x28, 0xa9, 0x7b,
+		0x3d, 0x8c, 0x2e, 0x5e, 0x7c, 0x31, 0x1c, 0x8e, 0xf6, 0xd9, 0xae, 0xc5, 0x8b, 0xaf, 0x85, 0xa5,
+		0x2b, 0x90, 0xb2, 0xda, 0xa6, 0x89, 0x83, 0x47, 0x3e, 0xf8, 0x2e, 0x61, 0xe1, 0x77, 0xbf, 0xcb,
+		0xad, 0x23, 0x04, 0x4a, 0x17, 0x60, 0x8c, 0xb4, 0xb6, 0x49, 0x63, 0x98, 0xe4, 0xb7, 0xbe, 0x2b,
+		0x26, 0x4c, 0xe4, 0x2e, 0xbd, 0x04, 0xc0, 0x52, 0x23, 0xf4, 0xf0, 0x70, 0x88, 0xec, 0x7f, 0xfb,
+		0x2e, 0xbf, 0xbc, 0x13, 0x8a, 0x84, 0x00, 0xec, 0x2a, 0xd0, 0xc1, 0x00, 0xdf, 0xee, 0x04, 0xa0,
+		0x3d, 0x72, 0x19, 0x26, 0xf0, 0x4a, 0xa5, 0xaf, 0x35, 0x87, 0x49, 0xff, 0x77, 0x2e, 0x2d, 0xf8,
+		0xd1, 0x60, 0x2d, 0xdb, 0x25, 0xbe, 0xd6, 0xf4, 0x86, 0xc9, 0xfe, 0x0f, 0x2e, 0x1b, 0x08, 0xa0,
+		0xb0, 0xae, 0x79, 0xfe, 0x28, 0xed, 0xfe, 0x3d, 0x21, 0x2c, 0x04, 0x50, 0x69, 0xfc, 0xbd, 0x47,
+		0xf6, 0x87, 0xc9, 0xfe, 0xbe, 0x50, 0x9a, 0xf3, 0x97, 0x5e, 0x84, 0x34, 0xfe, 0x64, 0x37, 0xf2,
+		0x86, 0x08, 0xff, 0x4f, 0x2e, 0x1c, 0x4a, 0xe0, 0x93, 0x3d, 0xbf, 0xe1, 0x1b, 0xc3, 0x8d, 0xfd,
+		0x1d, 0xde, 0xd3, 0x82, 0xbf, 0xb4, 0x04, 0x93, 0x9e, 0xdf, 0x68, 0xb4, 0x79, 0x7c, 0x3a, 0x44,
+		0xfc, 0x0f, 0xbe, 0x1b, 0xa4, 0x2c, 0x02, 0x19, 0xec, 0xed, 0xdb, 0x7b, 0xbe, 0x63, 0xd3, 0x03,
+		0x8f, 0x61, 0x08, 0xef, 0x71, 0x84, 0x88, 0x48, 0x69, 0x19, 0x32, 0xd8, 0x16, 0x97, 0x38, 0x84,
+		0x9e, 0x4e, 0x0d, 0x81, 0xf8, 0x43, 0x6e, 0x80, 0x0e, 0xa1, 0xf2, 0x0f, 0x7e, 0xe5, 0xdd, 0xb9,
+		0xd8, 0xd7, 0xde, 0x9d, 0x8b, 0xfd, 0xf6, 0xbb, 0x73, 0xb1, 0x8f, 0x7d, 0x7d, 0xee, 0xd8, 0xd7,
+		0xbe, 0x3e, 0x77, 0xec, 0x37, 0xbf, 0x3e, 0x77, 0xac, 0x7f, 0x96, 0x18, 0xae, 0xd9, 0xd7, 0x6c,
+		0x96, 0x1f, 0x7e, 0xa3, 0xd8, 0x34, 0xfc, 0xdd, 0xf6, 0xf6, 0xa2, 0x6e, 0xb7, 0x68, 0x1a, 0x37,
+		0xcc, 0xd6, 0x06, 0x9b, 0x1c, 0xf8, 0x5e, 0x0c, 0x4e, 0x31, 0x8c, 0xb0, 0x56, 0xb3, 0xf6, 0x07,
+		0xbd, 0xdb, 0x73, 0x11, 0x12, 0x4b, 0xd6, 0xbe, 0x7c, 0x8a, 0xcd, 0x6e, 0x6a, 0xdb, 0x35, 0xf9,
+		0x9d, 0xb0, 0x09, 0x2c, 0x6f, 0xb9, 0x26, 0x66, 0xb9, 0xc5, 0xc5, 0x4d, 0x3c, 0x4c, 0x61, 0x85,
+		0xf2, 0x8f, 0xc6, 0x0e, 0xd7, 0x8c, 0xd4, 0x92, 0xb5, 0x4f, 0x5b, 0x51, 0x8b, 0xbd, 0xf1, 0xf4,
+		0xd0, 0x24, 0xf7, 0x9e, 0x65, 0xdf, 0xb6, 0x50, 0x6d, 0x67, 0x5b, 0x24, 0xb8, 0xe7, 0xba, 0x13,
+		0xdc, 0xaf, 0x12, 0xd3, 0x7c, 0x19, 0xf9, 0xf0, 0x5c, 0xdc, 0xdb, 0x1e, 0x67, 0xd7, 0x8f, 0xe1,
+		0x27, 0xe2, 0x30, 0xd7, 0x93, 0xcb, 0xe6, 0x1e, 0x30, 0xc8, 0x08, 0x25, 0x48, 0x55, 0x84, 0x63,
+		0x15, 0xf0, 0xcd, 0x1a, 0xdd, 0xb6, 0x1a, 0x1e, 0x35, 0x44, 0x42, 0x11, 0x45, 0x34, 0x84, 0xa5,
+		0x59, 0xb6, 0xc7, 0x6f, 0x55, 0xb2, 0x42, 0xf9, 0x67, 0x0e, 0x69, 0x88, 0xac, 0x78, 0x92, 0xb0,
+		0xc6, 0xb9, 0x11, 0xad, 0x21, 0x1a, 0xd1, 0x91, 0xf6, 0x1f, 0xd5, 0x2a, 0x3f, 0x15, 0x87, 0xf9,
+		0x6e, 0xab, 0xe0, 0xb0, 0xf2, 0x7c, 0xad, 0xe5, 0x0c, 0x32, 0xcb, 0x15, 0x48, 0x6f, 0x0a, 0x9e,
+		0x43, 0xdb, 0xe5, 0xde, 0x21, 0xed, 0x92, 0x0b, 0x1e, 0x25, 0x0c, 0x73, 0x7e, 0x44, 0xc3, 0x04,
+		0xed, 0xb8, 0x2f, 0xcb, 0xfc, 0xef, 0x71, 0x38, 0xa5, 0xdb, 0x5e, 0xcb, 0xf6, 0x54, 0x76, 0x3e,
+		0xc2, 0x0a, 0xdc, 0x26, 0x99, 0x68, 0xd5, 0xf0, 0x43, 0x92, 0xe2, 0xcb, 0x30, 0xbd, 0x82, 0x53,
+		0x05, 0x6e, 0x81, 0xc2, 0xe3, 0x9d, 0xbe, 0x17, 0x4f, 0x17, 0x3a, 0xa2, 0x7d, 0x7e, 0xbc, 0x14,
+		0x25, 0x15, 0x7f, 0x38, 0x06, 0x52, 0x5d, 0xd7, 0x4c, 0xcd, 0xfd, 0xa3, 0x42, 0xc9, 0x97, 0x00,
+		0xe8, 0x0b, 0x4b, 0xe1, 0x1b, 0x46, 0xb9, 0xf3, 0x85, 0xc5, 0x68, 0xe3, 0x16, 0xd9, 0x93, 0xe8,
+		0xeb, 0x0b, 0x69, 0xca, 0x8b, 0x3f, 0x9f, 0x7c, 0x0d, 0x20, 0xac, 0x90, 0x4f, 0xc3, 0xc9, 0xfa,
+		0xf2, 0xd2, 0xea, 0x92, 0xa2, 0xb2, 0x9b, 0xf0, 0xeb, 0xf5, 0x5a, 0x75, 0x79, 0xe5, 0xea, 0x4a,
+		0xb5, 0x22, 0x1d, 0x93, 0x4f, 0x80, 0x1c, 0xad, 0x0c, 0x2e, 0xa5, 0x1c, 0x87, 0xa9, 0x28, 0x9d,
+		0x5d, 0xa7, 0x8f, 0x63, 0x98, 0x68, 0xb4, 0x1c, 0x93, 0xd0, 0x73, 0x3f, 0xd5, 0x10, 0x56, 0x1b,
+		0x1e, 0x81, 0xfc, 0x9b, 0x7f, 0xc7, 0xae, 0x58, 0x4f, 0x87, 0xe2, 0x81, 0xcd, 0x4b, 0xab, 0x30,
+		0x85, 0x97, 0xbe, 0x9c, 0x0e, 0xc8, 0x21, 0xf3, 0x34, 0x02, 0xd2, 0x93, 0x4c, 0x2e, 0x19, 0xa2,
+		0x5d, 0x82, 0x71, 0x8f, 0xb6, 0x7e, 0x18, 0xc4, 0x57, 0x39, 0x04, 0x67, 0x2f, 0x59, 0x30, 0x85,
+		0x61, 0x1f, 0x66, 0x87, 0x42, 0x35, 0x0e, 0x4e, 0x32, 0xfc, 0x93, 0x2f, 0x3e, 0x4b, 0xcf, 0x35,
+		0x1f, 0xea, 0xec, 0x96, 0x3e, 0xee, 0xa4, 0x48, 0x1c, 0x3b, 0x54, 0x94, 0x40, 0x4e, 0x3c, 0x8f,
+		0x2b, 0x7c, 0xf0, 0xc3, 0xfe, 0x29, 0x7f, 0xd8, 0x5c, 0x3f, 0x1f, 0x88, 0x3c, 0x29, 0xcb, 0x51,
+		0x59, 0x45, 0xb9, 0x3a, 0x68, 0x4c, 0xbf, 0xf1, 0x54, 0x64, 0x69, 0x62, 0x90, 0xfc, 0xcf, 0x33,
+		0x14, 0xf9, 0x4a, 0xf4, 0x31, 0xc1, 0xd8, 0xfb, 0x8d, 0x04, 0xcc, 0x71, 0xe6, 0x6d, 0xcd, 0x23,
+		0x67, 0x6f, 0x9d, 0xdb, 0x26, 0xbe, 0x76, 0xee, 0xac, 0x6e, 0x1b, 0x62, 0xae, 0x9e, 0xe6, 0xc3,
+		0x11, 0xeb, 0x17, 0x79, 0xfd, 0x6c, 0xdf, 0xd3, 0xcc, 0xd9, 0xc1, 0xc3, 0xb8, 0xb8, 0x05, 0xc9,
+		0x65, 0xdb, 0xb0, 0x70, 0xaa, 0x6a, 0x10, 0xcb, 0x6e, 0xf1, 0xd1, 0xc3, 0x0a, 0xf2, 0x39, 0x18,
+		0xd7, 0x5a, 0x76, 0xdb, 0xf2, 0xd9, 0xc8, 0x29, 0x9f, 0xfa, 0xca, 0x3b, 0xf3, 0xc7, 0xfe, 0xfd,
+		0x3b, 0xf3, 0x89, 0x15, 0xcb, 0xff, 0xf5, 0x2f, 0x3d, 0x03, 0x1c, 0x6a, 0xc5, 0xf2, 0x15, 0xce,
+		0x58, 0x4a, 0x7e, 0xf3, 0xd3, 0xf3, 0xb1, 0xe2, 0x6b, 0x30, 0x51, 0x21, 0xfa, 0xfd, 0x20, 0x57,
+		0x88, 0x1e, 0x41, 0xae, 0x10, 0xbd, 0x0b, 0xf9, 0x12, 0xa4, 0x56, 0x2c, 0x9f, 0xdd, 0x5a, 0x7f,
+		0x0a, 0x12, 0x86, 0xc5, 0x2e, 0x42, 0x1e, 0xa8, 0x1b, 0x72, 0xa1, 0x60, 0x85, 0xe8, 0x81, 0x60,
+		0x83, 0xe8, 0x85, 0xd8, 0xb0, 0x47, 0x23, 0x57, 0xb9, 0xf2, 0x9b, 0xff, 0x65, 0xee, 0xd8, 0xdb,
+		0xef, 0xce, 0x1d, 0x1b, 0xd8, 0xc5, 0xc5, 0x81, 0x5d, 0xec, 0x35, 0xf6, 0xd8, 0x8c, 0x1c, 0xf4,
+		0xec, 0xe7, 0x93, 0xf0, 0x20, 0x7d, 0x99, 0xc9, 0x6d, 0x19, 0x96, 0x7f, 0x56, 0x77, 0xf7, 0x1d,
+		0x9f, 0x86, 0x2b, 0xf6, 0x0e, 0xef, 0xd8, 0xa9, 0xb0, 0x7a, 0x91, 0x55, 0xf7, 0xef, 0xd6, 0xe2,
+		0x0e, 0x8c, 0xd5, 0x50, 0x0e, 0x4d, 0xec, 0xdb, 0xbe, 0x66, 0xf2, 0xf5, 0x87, 0x15, 0x90, 0xca,
+		0x5e, 0x80, 0x8a, 0x33, 0xaa, 0x21, 0xde, 0x7d, 0x32, 0x89, 0xb6, 0xc3, 0xee, 0x91, 0x27, 0x68,
+		0xe0, 0x92, 0x42, 0x02, 0xbd, 0x32, 0x3e, 0x03, 0x63, 0x5a, 0x9b, 0x5d, 0x60, 0x48, 0x60, 0x44,
+		0x43, 0x0b, 0xc5, 0x97, 0x61, 0x82, 0x1f, 0xa3, 0xe2, 0x11, 0xfe, 0x1e, 0xd9, 0xa7, 0xcf, 0xc9,
+		0x28, 0xf8, 0x53, 0x5e, 0x84, 0x31, 0xaa, 0x3c, 0x7f, 0x41, 0xa6, 0xb0, 0xd8, 0xa3, 0xfd, 0x22,
+		0x55, 0x52, 0x61, 0x6c, 0xc5, 0x1b, 0x90, 0xaa, 0xd8, 0x2d, 0xc3, 0xb2, 0x3b, 0xd1, 0xd2, 0x0c,
+		0x8d, 0xea, 0xec, 0xb4, 0xb9, 0x57, 0x28, 0xac, 0x80, 0xb7, 0x2b, 0xd9, 0x7b, 0x05, 0xfc, 0x12,
+		0x06, 0x2f, 0x15, 0x97, 0x61, 0x82, 0x62, 0x6f, 0x38, 0x38, 0xf9, 0x07, 0x57, 0x38, 0xd3, 0xfc,
+		0x2d, 0x33, 0x0e, 0x1f, 0x0f, 0x95, 0x95, 0x21, 0xd9, 0xd0, 0x7c, 0x8d, 0xb7, 0x9b, 0xfe, 0x2e,
+		0x7e, 0x00, 0x52, 0x1c, 0xc4, 0x93, 0xcf, 0x43, 0xc2, 0x76, 0x3c, 0x7e, 0x8d, 0x62, 0x76, 0x50,
+		0x53, 0x36, 0x9c, 0x72, 0x12, 0x7d, 0x46, 0x41, 0xe6, 0xb2, 0x32, 0xd0, 0x2d, 0x5e, 0x88, 0xb8,
+		0x45, 0xa4, 0xcb, 0x23, 0x3f, 0x59, 0x97, 0xf6, 0xb8, 0x43, 0xe0, 0x2c, 0x9f, 0x89, 0xc3, 0x5c,
+		0xa4, 0xf6, 0x16, 0x71, 0x3d, 0xc3, 0xb6, 0x98, 0x47, 0x71, 0x6f, 0x91, 0x23, 0x4a, 0xf2, 0xfa,
+		0x01, 0xee, 0xf2, 0x22, 0x24, 0x96, 0x1c, 0x07, 0x5f, 0xaf, 0xa3, 0x65, 0xdd, 0x66, 0xfe, 0x92,
+		0x54, 0x82, 0x32, 0xd6, 0x79, 0xf6, 0x8e, 0x7f, 0x5b, 0x73, 0x83, 0x57, 0xef, 0x44, 0xb9, 0x78,
+		0x19, 0xd2, 0xcb, 0xb6, 0xe5, 0x11, 0xcb, 0x6b, 0xd3, 0xc8, 0x66, 0xdb, 0xb4, 0xf5, 0x3d, 0x8e,
+		0xc0, 0x0a, 0x68, 0x70, 0xcd, 0x71, 0xa8, 0x64, 0x52, 0xc1, 0x9f, 0x6c, 0xcc, 0x96, 0xeb, 0x03,
+		0x4d, 0x74, 0xf9, 0xf0, 0x26, 0xe2, 0x8d, 0x0c, 0x6c, 0xf4, 0xbd, 0x18, 0x3c, 0xd0, 0x3b, 0xa0,
+		0xf6, 0xc8, 0xbe, 0x77, 0xd8, 0xf1, 0xf4, 0x1a, 0xa4, 0x6b, 0xf4, 0xfd, 0xf7, 0x97, 0xc9, 0xbe,
+		0x3c, 0x0b, 0x13, 0xa4, 0x71, 0xfe, 0xc2, 0x85, 0x73, 0x97, 0x99, 0xb7, 0x5f, 0x3f, 0xa6, 0x08,
+		0x82, 0x3c, 0x07, 0x69, 0x8f, 0xe8, 0xce, 0xf9, 0x0b, 0x17, 0xf7, 0xce, 0x31, 0xf7, 0xba, 0x7e,
+		0x4c, 0x09, 0x49, 0xa5, 0x14, 0xb6, 0xfa, 0x9b, 0x9f, 0x99, 0x8f, 0x95, 0xc7, 0x20, 0xe1, 0xb5,
+		0x5b, 0xef, 0xab, 0x8f, 0x7c, 0x62, 0x0c, 0x16, 0xa2, 0x92, 0x34, 0xfe, 0xbb, 0xa5, 0x99, 0x46,
+		0x43, 0x0b, 0xbf, 0x5c, 0x20, 0x45, 0x6c, 0x40, 0x39, 0x06, 0xac, 0x14, 0x07, 0x5a, 0xb2, 0xf8,
+		0x4b, 0x31, 0xc8, 0xdc, 0x14, 0xc8, 0xf8, 0xa9, 0x83, 0x2b, 0x00, 0xc1, 0x93, 0xc4, 0xb0, 0x39,
+		0xbd, 0xd8, 0xfd, 0xac, 0xc5, 0x40, 0x46, 0x89, 0xb0, 0xcb, 0x97, 0xa8, 0x23, 0x3a, 0xb6, 0xc7,
+		0x5f, 0xc7, 0x1a, 0x22, 0x1a, 0x30, 0xe3, 0xe5, 0x38, 0x3a, 0xc3, 0xa9, 0xb7, 0x6c, 0x1f, 0x6f,
+		0x0b, 0x38, 0xf6, 0x6d, 0xfe, 0x92, 0x6b, 0x42, 0x91, 0x68, 0xcd, 0x4d, 0x5a, 0x51, 0x43, 0x3a,
+		0x2a, 0x9d, 0x0e, 0x50, 0x30, 0x58, 0xd7, 0x1a, 0x0d, 0x97, 0x78, 0x1e, 0x9f, 0xc4, 0x44, 0x11,
+		0xdf, 0x01, 0x73, 0xda, 0xdb, 0xaa, 0x98, 0x31, 0xf0, 0x2d, 0xba, 0x3e, 0xe3, 0x5f, 0xf8, 0x07,
+		0x9f, 0x01, 0xc6, 0x9d, 0xf6, 0x36, 0x7a, 0xcb, 0x43, 0x90, 0xe9, 0xa3, 0xcc, 0xe4, 0xad, 0x50,
+		0x0f, 0xfa, 0xd9, 0x05, 0xde, 0x02, 0xd5, 0x71, 0x0d, 0xdb, 0x35, 0xfc, 0x7d, 0x7a, 0x17, 0x2a,
+		0xa1, 0x48, 0xa2, 0xa2, 0xc6, 0xe9, 0xc5, 0x3d, 0xc8, 0xd7, 0x69, 0x10, 0x17, 0x6a, 0x7e, 0x21,
+		0xd4, 0x2f, 0x36, 0x5c, 0xbf, 0x81, 0x9a, 0xc5, 0x7b, 0x34, 0x2b, 0xbf, 0x32, 0xd0, 0x3b, 0x2f,
+		0x1d, 0xde, 0x3b, 0x3b, 0x57, 0xbb, 0xdf, 0x3b, 0x05, 0x0f, 0x74, 0x57, 0x76, 0x4c, 0x5f, 0xa3,
+		0x3a, 0xe6, 0xb0, 0x3d, 0xda, 0xec, 0xc1, 0x8b, 0xea, 0xec, 0x90, 0x69, 0x74, 0x76, 0xe8, 0x10,
+		0x2a, 0x5e, 0x86, 0x2c, 0x5e, 0x6a, 0xac, 0x13, 0xff, 0x3a, 0xd1, 0x1a, 0xc4, 0xed, 0x5c, 0x75,
+		0xb3, 0x62, 0xd5, 0x95, 0x21, 0x49, 0x97, 0x56, 0xb6, 0xea, 0xd0, 0xdf, 0xc5, 0x5d, 0x48, 0xa2,
+		0x68, 0xb8, 0x22, 0x73, 0x09, 0x5a, 0x40, 0xea, 0xf6, 0xbe, 0x4f, 0x3c, 0x91, 0x46, 0xa0, 0x05,
+		0xf9, 0x79, 0xb1, 0xae, 0x26, 0x0e, 0x5e, 0x57, 0xb9, 0x23, 0xf2, 0xd5, 0xd5, 0x84, 0x89, 0x32,
+		0x4e, 0xc5, 0x2b, 0x95, 0x40, 0x91, 0x58, 0xa8, 0x88, 0xbc, 0x06, 0x79, 0x47, 0x73, 0x7d, 0xfa,
+		0x2a, 0xc9, 0x2e, 0x6d, 0x05, 0xf7, 0xf5, 0xf9, 0xde, 0x91, 0xd7, 0xd1, 0x58, 0xfe, 0x94, 0xac,
+		0x13, 0x25, 0x16, 0x7f, 0x27, 0x09, 0xe3, 0xdc, 0x18, 0x2f, 0xc2, 0x04, 0x37, 0x2b, 0xf7, 0xce,
+		0x07, 0x17, 0x7b, 0x17, 0xa6, 0xc5, 0x60, 0x01, 0xe1, 0x78, 0x42, 0x46, 0x7e, 0x0c, 0x52, 0xfa,
+		0xae, 0x66, 0x58, 0xaa, 0xd1, 0xe0, 0x01, 0xe1, 0xe4, 0xbb, 0xef, 0xcc, 0x4f, 0x2c, 0x23, 0x6d,
+		0xa5, 0xa2, 0x4c, 0xd0, 0xca, 0x95, 0x06, 0x46, 0x02, 0xbb, 0xc4, 0x68, 0xee, 0xfa, 0x7c, 0x84,
+		0xf1, 0x12, 0x7e, 0x73, 0x05, 0x1d, 0x82, 0xbf, 0x68, 0x38, 0xdb, 0x13, 0xe1, 0x07, 0x5b, 0xe8,
+		0x72, 0x0a, 0x1f, 0xfc, 0xb1, 0xff, 0x3c, 0x1f, 0x53, 0xa8, 0x84, 0xbc, 0x0c, 0x59, 0x53, 0xf3,
+		0x7c, 0x95, 0xae, 0x60, 0xf8, 0xf8, 0x31, 0x0a, 0x71, 0xaa, 0xd7, 0x20, 0xdc, 0xb0, 0x5c, 0xf5,
+		0x49, 0x94, 0x62, 0xa4, 0x06, 0xbe, 0x07, 0x45, 0x41, 0xf0, 0x2e, 0xa7, 0xe1, 0xb3, 0xd8, 0x6a,
+		0x9c, 0xda, 0x3d, 0x87, 0xf4, 0x65, 0x4a, 0xa6, 0x11, 0xd6, 0x69, 0x48, 0xd3, 0x57, 0x9b, 0x28,
+		0x0b, 0xbb, 0x84, 0x9b, 0x42, 0x02, 0xad, 0x7c, 0x1c, 0xf2, 0xe1, 0xfc, 0xc8, 0x58, 0x52, 0x0c,
+		0x25, 0x24, 0x53, 0xc6, 0x67, 0x61, 0xc6, 0x22, 0x77, 0x7c, 0x35, 0x24, 0x33, 0xee, 0x34, 0xe5,
+		0x96, 0xb1, 0xee, 0x66, 0xa7, 0xc4, 0xa3, 0x90, 0xd3, 0x85, 0xf1, 0x19, 0x2f, 0x50, 0xde, 0x6c,
+		0x40, 0xa5, 0x6c, 0xa7, 0x20, 0xa5, 0x39, 0x0e, 0x63, 0x98, 0xe4, 0xf3, 0xa3, 0xe3, 0xd0, 0xaa,
+		0x27, 0x61, 0x8a, 0xb6, 0xd1, 0x25, 0x5e, 0xdb, 0xf4, 0x39, 0x48, 0x86, 0xf2, 0xe4, 0xb1, 0x42,
+		0x61, 0x74, 0xca, 0xfb, 0x30, 0x64, 0xc9, 0x2d, 0xa3, 0x41, 0x2c, 0x9d, 0x30, 0xbe, 0x2c, 0xe5,
+		0xcb, 0x08, 0x22, 0x65, 0x7a, 0x02, 0x82, 0x79, 0x4f, 0x15, 0x73, 0x72, 0x8e, 0xe1, 0x09, 0xfa,
+		0x12, 0x23, 0x17, 0x0b, 0x90, 0xac, 0x68, 0xbe, 0x86, 0x01, 0x86, 0x7f, 0x87, 0x2d, 0x34, 0x19,
+		0x05, 0x7f, 0x16, 0xbf, 0x19, 0x87, 0xe4, 0x4d, 0xdb, 0x27, 0xf2, 0x73, 0x91, 0x00, 0x30, 0xd7,
+		0xcf, 0x9f, 0xeb, 0x46, 0xd3, 0x22, 0x8d, 0x35, 0xaf, 0x19, 0xf9, 0x0e, 0x41, 0xe8, 0x4e, 0xf1,
+		0x0e, 0x77, 0x9a, 0x81, 0x31, 0xd7, 0x6e, 0x5b, 0x0d, 0x71, 0x7f, 0x95, 0x16, 0xe4, 0x2a, 0xa4,
+		0x02, 0x2f, 0x49, 0x0e, 0xf3, 0x92, 0x3c, 0x7a, 0x09, 0xfa, 0x30, 0x27, 0x28, 0x13, 0xdb, 0xdc,
+		0x59, 0xca, 0x90, 0x0e, 0x26, 0xaf, 0xc2, 0xd8, 0x21, 0x1c, 0x36, 0x14, 0xc3, 0xc5, 0x24, 0xe8,
+		0xfb, 0xc0, 0x78, 0xcc, 0xe3, 0xa4, 0xa0, 0x82, 0x5b, 0xaf, 0xc3, 0xad, 0xf8, 0x37, 0x11, 0x26,
+		0x68, 0xbb, 0x42, 0xb7, 0x62, 0xdf, 0x45, 0x78, 0x00, 0xaf, 0x23, 0x35, 0x2d, 0xcd, 0x6f, 0xbb,
+		0x84, 0x7b, 0x5e, 0x48, 0xc0, 0xb7, 0x55, 0xc6, 0x99, 0x27, 0x47, 0xec, 0x16, 0xeb, 0x6f, 0xb7,
+		0xf8, 0x20, 0xbb, 0x25, 0xee, 0xdf, 0x6e, 0x4b, 0x00, 0x81, 0x32, 0x1e, 0x7f, 0x55, 0xbd, 0x4f,
+		0xc4, 0xc0, 0x54, 0xac, 0x1b, 0x4d, 0x3e, 0x50, 0x23, 0x42, 0xc5, 0xff, 0x14, 0x83, 0x74, 0x50,
+		0x2f, 0x2f, 0x41, 0x56, 0xe8, 0xa5, 0xee, 0x98, 0x5a, 0x93, 0xfb, 0xce, 0x83, 0x03, 0x95, 0xbb,
+		0x6a, 0x6a, 0x4d, 0x65, 0x92, 0xeb, 0x83, 0x85, 0xfe, 0xfd, 0x10, 0x1f, 0xd0, 0x0f, 0x1d, 0x1d,
+		0x9f, 0xb8, 0xbf, 0x8e, 0xef, 0xe8, 0xa2, 0x64, 0x77, 0x17, 0x7d, 0x31, 0x4e, 0x37, 0x33, 0x8e,
+		0xed, 0x69, 0xe6, 0xf7, 0x63, 0x44, 0x9c, 0x86, 0xb4, 0x63, 0x9b, 0x2a, 0xab, 0x61, 0xf7, 0xba,
+		0x53, 0x8e, 0x6d, 0x2a, 0x3d, 0xdd, 0x3e, 0x76, 0x44, 0xc3, 0x65, 0xfc, 0x08, 0xac, 0x36, 0xd1,
+		0x6d, 0x35, 0x17, 0x32, 0xcc, 0x14, 0x7c, 0x2d, 0x7b, 0x16, 0x6d, 0x80, 0xbf, 0x0a, 0xb1, 0xde,
+		0xb5, 0x97, 0xa9, 0xcd, 0x38, 0x95, 0xf1, 0xdd, 0x40, 0x82, 0x4d, 0xfd, 0x85, 0xf8, 0x20, 0x09,
+		0xe6, 0x76, 0x0a, 0xe7, 0x2b, 0xfe, 0x64, 0x0c, 0x60, 0x15, 0x2d, 0x4b, 0xdb, 0x8b, 0xab, 0x90,
+		0x47, 0x55, 0x50, 0x3b, 0x9e, 0x3c, 0x37, 0xa8, 0xd3, 0xf8, 0xf3, 0x33, 0x5e, 0x54, 0xef, 0x65,
+		0xc8, 0x86, 0xce, 0xe8, 0x11, 0xa1, 0xcc, 0xdc, 0x01, 0x51, 0x75, 0x9d, 0xf8, 0x4a, 0xe6, 0x56,
+		0xa4, 0x54, 0xfc, 0x17, 0x31, 0x48, 0x53, 0x9d, 0xf0, 0x45, 0xdb, 0x8e, 0x3e, 0x8c, 0xdd, 0x7f,
+		0x1f, 0x3e, 0x08, 0xc0, 0x60, 0xf0, 0x70, 0x96, 0x7b, 0x56, 0x9a, 0x52, 0xf0, 0xc8, 0x55, 0xbe,
+		0x18, 0x18, 0x3c, 0x71, 0xb0, 0xc1, 0x45, 0xd4, 0xcd, 0xcd, 0x7e, 0x12, 0x26, 0xe8, 0xa7, 0x9d,
+		0xee, 0x78, 0x3c, 0x90, 0xc6, 0xef, 0x39, 0x6c, 0xde, 0xf1, 0x8a, 0x6f, 0xc2, 0xc4, 0xe6, 0x1d,
+		0x96, 0x1b, 0x39, 0x0d, 0x69, 0xd7, 0xb6, 0xf9, 0x9a, 0xcc, 0x62, 0xa1, 0x14, 0x12, 0xe8, 0x12,
+		0x24, 0xf2, 0x01, 0xf1, 0x30, 0x1f, 0x10, 0x26, 0x34, 0x12, 0x23, 0x25, 0x34, 0x9e, 0xfc, 0x8d,
+		0x18, 0x4c, 0x46, 0xe6, 0x07, 0xf9, 0x1c, 0x1c, 0x2f, 0xaf, 0x6e, 0x2c, 0xbf, 0xac, 0xae, 0x54,
+		0xd4, 0xab, 0xab, 0x4b, 0xd7, 0xc2, 0x37, 0x97, 0x66, 0x4f, 0xdc, 0xbd, 0xb7, 0x20, 0x47, 0x78,
+		0xb7, 0x2c, 0x9a, 0xa7, 0x97, 0xcf, 0xc2, 0x4c, 0xa7, 0xc8, 0x52, 0xb9, 0x8e, 0xaf, 0x31, 0xc5,
+		0x66, 0x8f, 0xdf, 0xbd, 0xb7, 0x30, 0x15, 0x91, 0x58, 0xda, 0xf6, 0x88, 0xe5, 0xf7, 0x0a, 0x2c,
+		0x6f, 0xac, 0xad, 0xad, 0x6c, 0x4a, 0xf1, 0x1e, 0x01, 0x3e, 0x61, 0x3f, 0x01, 0x53, 0x9d, 0x02,
+		0xeb, 0x2b, 0xab, 0x52, 0x62, 0x56, 0xbe, 0x7b, 0x6f, 0x21, 0x17, 0xe1, 0x5e, 0x37, 0xcc, 0xd9,
+		0xd4, 0x47, 0x3e, 0x3b, 0x77, 0xec, 0x17, 0x7e, 0x7e, 0x2e, 0x86, 0x2d, 0xcb, 0x76, 0xcc, 0x11,
+		0xf2, 0xd3, 0x70, 0xb2, 0xbe, 0x72, 0x6d, 0xbd, 0x5a, 0x51, 0xd7, 0xea, 0xd7, 0x44, 0xa6, 0x5b,
+		0xb4, 0x2e, 0x7f, 0xf7, 0xde, 0xc2, 0x24, 0x6f, 0xd2, 0x20, 0xee, 0x9a, 0x52, 0xbd, 0xb9, 0xb1,
+		0x59, 0x95, 0x62, 0x8c, 0xbb, 0xe6, 0x92, 0x5b, 0xb6, 0xcf, 0xbe, 0xfd, 0xf6, 0x2c, 0x9c, 0xea,
+		0xc3, 0x1d, 0x34, 0x6c, 0xea, 0xee, 0xbd, 0x85, 0x6c, 0xcd, 0x25, 0x6c, 0xfc, 0x50, 0x89, 0x45,
+		0x28, 0xf4, 0x4a, 0x6c, 0xd4, 0x36, 0xea, 0x4b, 0xab, 0xd2, 0xc2, 0xac, 0x74, 0xf7, 0xde, 0x42,
+		0x46, 0x4c, 0x86, 0xc8, 0x1f, 0xb6, 0xec, 0xfd, 0xdc, 0xf1, 0xfc, 0x9f, 0x17, 0xe1, 0x11, 0x9e,
+		0x03, 0xf4, 0x7c, 0x6d, 0xcf, 0xb0, 0x9a, 0x41, 0xf2, 0x96, 0x97, 0xf9, 0xce, 0xe7, 0x04, 0xe3,
+		0x5a, 0x14, 0xd4, 0x21, 0x29, 0xdc, 0x81, 0x27, 0x97, 0xb3, 0x43, 0x0e, 0xf5, 0x86, 0x6f, 0x9d,
+		0x06, 0xa7, 0x87, 0x67, 0x87, 0x24, 0xa1, 0x67, 0x0f, 0xdc, 0xdc, 0x15, 0x3f, 0x1a, 0x83, 0xdc,
+		0x75, 0xc3, 0xf3, 0x6d, 0xd7, 0xd0, 0x35, 0x93, 0xbe, 0xaf, 0x74, 0x71, 0xd4, 0xb9, 0xb5, 0x6b,
+		0xa8, 0xbf, 0x04, 0xe3, 0xb7, 0x34, 0x93, 0x4d, 0x6a, 0xd1, 0xb3, 0x80, 0x6e, 0xf3, 0x85, 0x53,
+		0x9b, 0x00, 0x60, 0x62, 0xc5, 0x2f, 0xc4, 0x21, 0x4f, 0x07, 0x83, 0xc7, 0x3e, 0xdd, 0x85, 0x7b,
+		0xac, 0x1a, 0x24, 0x5d, 0xcd, 0xe7, 0x49, 0xc3, 0xf2, 0x0f, 0xf0, 0x3c, 0xf0, 0x63, 0xc3, 0xb3,
+		0xb9, 0x8b, 0xbd, 0xa9, 0x62, 0x8a, 0x24, 0xbf, 0x0a, 0xa9, 0x96, 0x76, 0x47, 0xa5, 0xa8, 0xf1,
+		0x23, 0x40, 0x9d, 0x68, 0x69, 0x77, 0x50, 0x57, 0xb9, 0x01, 0x79, 0x04, 0xd6, 0x77, 0x35, 0xab,
+		0x49, 0x18, 0x7e, 0xe2, 0x08, 0xf0, 0xb3, 0x2d, 0xed, 0xce, 0x32, 0xc5, 0xc4, 0xa7, 0x94, 0x52,
+		0x1f, 0xff, 0xf4, 0xfc, 0x31, 0x9a, 0x66, 0xff, 0x95, 0x18, 0x40, 0x68, 0x2e, 0xf9, 0xff, 0x07,
+		0x49, 0x0f, 0x4a, 0xf4, 0xf1, 0x1e, 0xef, 0xc0, 0xc7, 0x07, 0x75, 0x44, 0x97, 0xb1, 0xd9, 0xc2,
+		0xfc, 0xb5, 0x77, 0xe6, 0x63, 0x4a, 0x5e, 0xef, 0xea, 0x87, 0x2a, 0x4c, 0xb6, 0x9d, 0x86, 0xe6,
+		0x13, 0x95, 0x6e, 0xe2, 0xe2, 0x87, 0x58, 0xe4, 0x81, 0x09, 0x62, 0x55, 0x44, 0xfb, 0x2f, 0xc4,
+		0x60, 0xb2, 0x12, 0x39, 0xe4, 0x2b, 0xc0, 0x44, 0xcb, 0xb6, 0x8c, 0x3d, 0xee, 0x76, 0x69, 0x45,
+		0x14, 0x31, 0xe3, 0xc9, 0xde, 0xd4, 0xf4, 0xf7, 0x45, 0xc6, 0x53, 0x94, 0x51, 0xea, 0x36, 0xd9,
+		0xf6, 0x0c, 0x61, 0x6b, 0x45, 0x14, 0x71, 0xeb, 0xe2, 0x11, 0xbd, 0x8d, 0xa9, 0x1a, 0x55, 0xb7,
+		0x2d, 0x5f, 0xd3, 0x7d, 0xfe, 0xce, 0x5f, 0x5e, 0xd0, 0x97, 0x19, 0x19, 0x41, 0x1a, 0xc4, 0xd7,
+		0x0c, 0xd3, 0x2b, 0xb0, 0x83, 0x30, 0x51, 0x8c, 0xaa, 0x3b, 0x11, 0x4d, 0x51, 0x2d, 0x83, 0x64,
+		0x3b, 0xc4, 0xed, 0x08, 0x29, 0x99, 0x87, 0x16, 0x7e, 0xfd, 0x4b, 0xcf, 0xcc, 0x70, 0x73, 0xf3,
+		0xa0, 0x92, 0x5d, 0x6a, 0x55, 0xf2, 0x42, 0x82, 0x93, 0xe5, 0xd7, 0x41, 0x0a, 0x76, 0x76, 0xaa,
+		0xd3, 0xde, 0x0e, 0xd3, 0x5a, 0x33, 0x3d, 0x76, 0x5d, 0xb2, 0xf6, 0xcb, 0x85, 0xaf, 0x86, 0xd0,
+		0x61, 0x2e, 0x09, 0x13, 0x49, 0xf9, 0x00, 0xa7, 0x46, 0x61, 0x30, 0x44, 0x7c, 0x53, 0x33, 0x4c,
+		0xf1, 0x02, 0xba, 0xc2, 0x4b, 0x72, 0x09, 0xc6, 0x3d, 0x5f, 0xf3, 0xdb, 0x1e, 0xff, 0xb0, 0x5c,
+		0x71, 0x90, 0x67, 0x94, 0x6d, 0xab, 0x51, 0xa7, 0x9c, 0x0a, 0x97, 0x90, 0x37, 0x61, 0xdc, 0xb7,
+		0xf7, 0x88, 0xc5, 0x8d, 0x74, 0x28, 0xaf, 0xee, 0x73, 0x16, 0xc5, 0xb0, 0xe4, 0x26, 0x48, 0x0d,
+		0x62, 0x92, 0x26, 0x0b, 0x88, 0x76, 0x35, 0xdc, 0x37, 0x8c, 0x1f, 0xc1, 0xa8, 0xc9, 0x07, 0xa8,
+		0x75, 0x0a, 0x2a, 0xbf, 0xdc, 0x79, 0xcc, 0xcc, 0xbe, 0xc2, 0xf8, 0xf0, 0xa0, 0xf6, 0x47, 0x3c,
+		0x53, 0x24, 0x13, 0x22, 0xd2, 0xe8, 0x5c, 0x6d, 0x6b, 0xdb, 0xb6, 0xe8, 0x6b, 0xa2, 0x3c, 0x18,
+		0x4f, 0xd1, 0xf0, 0x26, 0x1f, 0xd0, 0xaf, 0x53, 0xb2, 0xfc, 0x32, 0xe4, 0x42, 0x56, 0x3a, 0x76,
+		0xd2, 0x87, 0x18, 0x3b, 0xd9, 0x40, 0x16, 0x6b, 0xe5, 0xeb, 0x00, 0xe1, 0xc0, 0xa4, 0xe9, 0x81,
+		0xc9, 0xf3, 0xc5, 0xe1, 0xa3, 0x5b, 0x6c, 0xb3, 0x42, 0x59, 0xd9, 0x84, 0xe9, 0x96, 0x61, 0xa9,
+		0x1e, 0x31, 0x77, 0x54, 0x6e, 0x2a, 0x84, 0x9c, 0x3c, 0x82, 0xae, 0x9d, 0x6a, 0x19, 0x56, 0x9d,
+		0x98, 0x3b, 0x95, 0x00, 0x56, 0xd6, 0x20, 0x6b, 0x1a, 0x1f, 0x6a, 0x1b, 0x0d, 0xd1, 0xc5, 0x99,
+		0x23, 0xe8, 0xe2, 0x0c, 0x83, 0x64, 0xfd, 0x5b, 0xca, 0x7c, 0xe4, 0xd3, 0xf3, 0xc7, 0xf8, 0x70,
+		0x3d, 0x56, 0xac, 0xd1, 0x2c, 0x38, 0x1f, 0x69, 0xc4, 0x93, 0x2f, 0x42, 0x5a, 0x13, 0x05, 0x9a,
+		0x9b, 0x38, 0x68, 0xa4, 0x86, 0xac, 0x6c, 0x02, 0x78, 0xfb, 0x3f, 0x2e, 0xc4, 0x8a, 0x3f, 0x1f,
+		0x83, 0xf1, 0xca, 0xcd, 0x9a, 0x66, 0xb8, 0x72, 0x15, 0xcf, 0xc7, 0x85, 0xcf, 0x8e, 0x3a, 0xfc,
+		0x43, 0x37, 0xe7, 0x74, 0x84, 0xe9, 0xbf, 0x31, 0x3d, 0x10, 0xa6, 0x7b, 0xcb, 0xda, 0xd5, 0xf0,
+		0x2a, 0x4c, 0x30, 0x2d, 0xf1, 0x4d, 0xe6, 0x31, 0x07, 0x7f, 0x14, 0x62, 0x1d, 0xa7, 0xe5, 0xbd,
+		0xbe, 0x4e, 0xf9, 0x83, 0x24, 0x25, 0x8a, 0x14, 0xbf, 0x17, 0x03, 0xa8, 0xdc, 0xbc, 0xb9, 0xe9,
+		0x1a, 0x8e, 0x49, 0xfc, 0xa3, 0x6a, 0xf1, 0x2a, 0x1c, 0x0f, 0x5b, 0xec, 0xb9, 0xfa, 0xc8, 0xad,
+		0x9e, 0x0e, 0xf7, 0x3f, 0xae, 0xde, 0x17, 0xad, 0xe1, 0xf9, 0x01, 0x5a, 0x62, 0x64, 0xb4, 0x8a,
+		0xe7, 0xf7, 0x37, 0x63, 0x1d, 0x26, 0xc3, 0xe6, 0xe3, 0xd7, 0xbe, 0x52, 0x3e, 0xff, 0xcd, 0xad,
+		0x59, 0x1c, 0x6c, 0x4d, 0x21, 0xc6, 0x2d, 0x1a, 0x48, 0x16, 0xff, 0x2f, 0x1a, 0x35, 0x1c, 0x14,
+		0x7f, 0xa2, 0xdc, 0x08, 0xa7, 0x77, 0x3e, 0x36, 0x8f, 0x22, 0x68, 0xe1, 0x58, 0x5d, 0x56, 0xfd,
+		0x70, 0x1c, 0x3f, 0xf3, 0xc0, 0x27, 0xb4, 0x3f, 0xb1, 0x96, 0xa8, 0xc1, 0x04, 0xb1, 0x7c, 0xd7,
+		0xa0, 0xa6, 0xc0, 0xbe, 0x7e, 0x76, 0x50, 0x5f, 0xf7, 0x69, 0x0b, 0xfd, 0x84, 0x92, 0x48, 0x9d,
+		0x73, 0x98, 0x2e, 0x2b, 0xfc, 0x87, 0x38, 0x14, 0x06, 0x49, 0x62, 0x22, 0x50, 0x77, 0x09, 0x25,
+		0xa8, 0x1d, 0xf9, 0xbb, 0x9c, 0x20, 0xf3, 0x75, 0x65, 0x0d, 0x30, 0x46, 0x43, 0xc7, 0x42, 0xd6,
+		0x43, 0x07, 0x65, 0xb9, 0x50, 0x18, 0xab, 0x65, 0x02, 0x79, 0xc3, 0x32, 0x7c, 0x43, 0x33, 0xd5,
+		0x6d, 0xcd, 0xd4, 0x2c, 0xfd, 0x7e, 0x82, 0xd7, 0xde, 0xb5, 0x20, 0xc7, 0x41, 0xcb, 0x0c, 0x53,
+		0xbe, 0x09, 0x13, 0x02, 0x3e, 0x79, 0x04, 0xf0, 0x02, 0x2c, 0x12, 0xa8, 0xfd, 0x56, 0x1c, 0xa6,
+		0x14, 0xd2, 0xf8, 0xd3, 0x65, 0xd6, 0x0f, 0x02, 0xb0, 0x01, 0x87, 0xf3, 0x60, 0x21, 0x79, 0x04,
+		0x03, 0x38, 0xcd, 0xf0, 0x2a, 0x9e, 0x1f, 0xb1, 0xed, 0x57, 0xe3, 0x90, 0x89, 0xda, 0xf6, 0x4f,
+		0xc1, 0xba, 0x20, 0xaf, 0x84, 0xb3, 0x41, 0x92, 0x7f, 0xfc, 0x75, 0xc0, 0x6c, 0xd0, 0xe3, 0x75,
+		0x07, 0x4f, 0x03, 0xbf, 0x93, 0x82, 0xf1, 0x9a, 0xe6, 0x6a, 0x2d, 0x4f, 0xbe, 0xd1, 0x13, 0x23,
+		0x8a, 0x44, 0x5e, 0xcf, 0x27, 0xbe, 0x79, 0xde, 0x80, 0xb9, 0xdc, 0xc7, 0xfb, 0x84, 0x88, 0x8f,
+		0x42, 0x0e, 0x77, 0xa1, 0x91, 0x33, 0xff, 0x38, 0x3d, 0xc9, 0xc4, 0x6d, 0x64, 0x78, 0xe0, 0x84,
+		0xdf, 0x07, 0x41, 0xb6, 0x70, 0xa2, 0x43, 0x1e, 0x68, 0x69, 0x77, 0xaa, 0x8c, 0x22, 0x3f, 0x03,
+		0xf2, 0x6e, 0x90, 0x17, 0x50, 0x43, 0x13, 0x20, 0xdf, 0x54, 0x58, 0x23, 0xd8, 0x31, 0x7d, 0x68,
+		0x5b, 0x0d, 0x95, 0xdd, 0x23, 0x63, 0xdb, 0xa8, 0x34, 0x52, 0x2a, 0x48, 0x90, 0x7f, 0x88, 0x85,
+		0x9b, 0x5d, 0x1b, 0x54, 0x1e, 0xe9, 0xaf, 0x1e, 0xce, 0x53, 0xbf, 0xf3, 0xce, 0xfc, 0xec, 0xbe,
+		0xd6, 0x32, 0x4b, 0xc5, 0x3e, 0x90, 0x45, 0x1a, 0x7e, 0x76, 0x6e, 0x6c, 0xe5, 0x1f, 0xc1, 0x6b,
+		0xe5, 0xa6, 0xbd, 0xad, 0x99, 0xaa, 0x08, 0x43, 0x59, 0xdf, 0xa9, 0xba, 0xe6, 0xb0, 0xef, 0x53,
+		0x97, 0x95, 0x43, 0x2b, 0xb1, 0xc0, 0x94, 0x18, 0x08, 0x5c, 0x54, 0x4e, 0xb0, 0xba, 0x55, 0x16,
+		0xa7, 0xb2, 0x9a, 0x65, 0xcd, 0x91, 0x7f, 0x32, 0x06, 0x0f, 0x84, 0x2e, 0xda, 0x47, 0x25, 0xfa,
+		0x51, 0x9f, 0xf2, 0xd6, 0xa1, 0x55, 0x7a, 0x98, 0xa9, 0x74, 0x10, 0x76, 0x51, 0x39, 0x15, 0x54,
+		0xf7, 0x28, 0xf6, 0x57, 0x63, 0x30, 0xc3, 0xdb, 0x13, 0xec, 0x0e, 0xb0, 0x13, 0xe9, 0x9e, 0x25,
+		0x5d, 0xfe, 0xe0, 0xe1, 0x26, 0xad, 0xef, 0xbc, 0x33, 0x7f, 0xba, 0xc3, 0x46, 0x1d, 0x98, 0xc5,
+		0xee, 0x6d, 0x03, 0x63, 0x5a, 0x63, 0x9b, 0x07, 0xdc, 0x80, 0xca, 0x7f, 0x3e, 0x16, 0x1d, 0xca,
+		0xd4, 0xbf, 0x76, 0x34, 0xdd, 0xb7, 0x5d, 0xf6, 0x45, 0xf0, 0xf2, 0xfa, 0xa1, 0x0d, 0xf4, 0x40,
+		0xb7, 0x81, 0x22, 0xa0, 0xc5, 0xc8, 0x04, 0x80, 0x8f, 0xbf, 0x4a, 0xa9, 0xf8, 0xdf, 0x1e, 0xf6,
+		0xc8, 0xbe, 0xea, 0xf2, 0x2f, 0xee, 0xa8, 0x3b, 0x84, 0x7d, 0x18, 0x15, 0x87, 0x67, 0x9f, 0xfb,
+		0x9d, 0x8b, 0x78, 0xa3, 0x92, 0x8f, 0xfc, 0xdc, 0x1e, 0xd9, 0x57, 0xb8, 0xdc, 0x55, 0x42, 0xe4,
+		0x37, 0x61, 0x3e, 0xe2, 0xae, 0x3c, 0x51, 0x64, 0xd9, 0xbe, 0xa1, 0x13, 0xd5, 0x21, 0xae, 0x61,
+		0x37, 0x0a, 0x19, 0x8e, 0x3c, 0xc2, 0xc0, 0x7f, 0x20, 0xc4, 0x62, 0xf9, 0xa1, 0x75, 0x8a, 0x54,
+		0xa3, 0x40, 0x91, 0x59, 0xfb, 0xb3, 0x31, 0x90, 0xc3, 0x30, 0x43, 0x21, 0x9e, 0x63, 0x5b, 0x1e,
+		0xdd, 0x4b, 0x46, 0x36, 0x7e, 0xb1, 0x83, 0xf7, 0x92, 0xa1, 0xbc, 0xd8, 0x4b, 0x86, 0xb2, 0xf8,
+		0xb9, 0x63, 0xb1, 0xb8, 0xc5, 0x47, 0x33, 0x4c, 0xcf, 0xba, 0x7d, 0xac, 0xf8, 0x5b, 0x31, 0x38,
+		0xd5, 0x33, 0x83, 0x06, 0xca, 0xfe, 0x19, 0x90, 0xdd, 0x48, 0x25, 0xff, 0x72, 0x25, 0x53, 0xfa,
+		0xd0, 0x13, 0xf2, 0x94, 0xdb, 0x5d, 0xf1, 0xbe, 0xc5, 0x25, 0xec, 0x42, 0xec, 0x3f, 0x8b, 0xc1,
+		0x4c, 0x54, 0x99, 0xa0, 0x59, 0xeb, 0x90, 0x89, 0xea, 0xc2, 0x1b, 0xf4, 0xc8, 0x28, 0x0d, 0xe2,
+		0x6d, 0xe9, 0x90, 0x97, 0x5f, 0x09, 0x17, 0x2b, 0x96, 0x83, 0x3d, 0x37, 0xb2, 0x6d, 0x84, 0x4e,
+		0xdd, 0x8b, 0x56, 0x52, 0x44, 0xee, 0xc9, 0x9a, 0x6d, 0x9b, 0xf2, 0x9f, 0x85, 0x29, 0xcb, 0xf6,
+		0xe9, 0xb0, 0x21, 0x0d, 0x95, 0x27, 0x84, 0xd8, 0x8a, 0xff, 0xca, 0xe1, 0x4c, 0xf6, 0xad, 0x77,
+		0xe6, 0x7b, 0xa1, 0xba, 0xec, 0x98, 0xb7, 0x6c, 0xbf, 0x4c, 0xeb, 0x37, 0x69, 0xb5, 0xec, 0x42,
+		0xb6, 0xf3, 0xd1, 0x2c, 0x42, 0x58, 0x3b, 0xf4, 0xa3, 0xb3, 0x07, 0x3d, 0x36, 0xb3, 0x1d, 0x79,
+		0x26, 0xbb, 0x2a, 0xf8, 0xfb, 0xd8, 0x8f, 0x5f, 0x8a, 0xc1, 0x34, 0x25, 0x1a, 0x6f, 0x11, 0x9a,
+		0x76, 0x50, 0x88, 0x6e, 0xbb, 0x0d, 0x39, 0x07, 0x71, 0x7e, 0xf8, 0x96, 0x54, 0xe2, 0x06, 0x7e,
+		0x1d, 0x78, 0xcc, 0xbe, 0x6d, 0xf1, 0x9b, 0x3b, 0x07, 0x45, 0x1c, 0x8c, 0x8d, 0xae, 0xd9, 0x76,
+		0xa3, 0x6d, 0x12, 0xfc, 0xe6, 0x2b, 0xbd, 0x71, 0xcd, 0x92, 0x99, 0x59, 0x46, 0x5d, 0x62, 0x44,
+		0x4c, 0x62, 0x04, 0x13, 0x54, 0x21, 0x39, 0x04, 0x3a, 0x64, 0x2d, 0xfe, 0x61, 0x02, 0xb2, 0x37,
+		0xa3, 0x33, 0x5b, 0xff, 0x9d, 0x52, 0xec, 0xd0, 0x3b, 0xa5, 0xd7, 0x21, 0x1d, 0x4c, 0xe7, 0x85,
+		0xf8, 0x11, 0x8c, 0x9b, 0x94, 0x27, 0xa6, 0xfe, 0x30, 0x2f, 0x18, 0xf6, 0xf5, 0x51, 0x44, 0xce,
+		0xf9, 0x00, 0x95, 0x7b, 0x54, 0x0b, 0xa6, 0x23, 0xf3, 0x0a, 0x1e, 0x65, 0xb8, 0xb6, 0xdd, 0x3a,
+		0x92, 0x59, 0x40, 0x0e, 0x81, 0xaf, 0x73, 0x5c, 0xf9, 0x4d, 0x90, 0x03, 0x93, 0x85, 0x4f, 0x3b,
+		0x8a, 0x8c, 0xaa, 0x24, 0x6c, 0x27, 0x9e, 0x55, 0xfc, 0xd9, 0x04, 0x9c, 0xc2, 0xeb, 0x5c, 0x3c,
+		0x4b, 0xcc, 0x17, 0x22, 0x76, 0xda, 0xb3, 0x7f, 0x34, 0x39, 0xec, 0x9b, 0x90, 0xb7, 0x4d, 0xfc,
+		0x40, 0x9e, 0xf5, 0x47, 0x4c, 0x61, 0x67, 0x6d, 0xb3, 0xc1, 0x75, 0xc5, 0x04, 0xf6, 0x4d, 0xc8,
+		0x5b, 0xe4, 0x76, 0x07, 0x6e, 0xe2, 0xfe, 0x70, 0x2d, 0x72, 0x3b, 0x82, 0x1b, 0xde, 0x9d, 0x48,
+		0xf6, 0xbd, 0x9c, 0x36, 0x76, 0xe8, 0xcb, 0x69, 0xe7, 0x20, 0xb1, 0x43, 0x58, 0x24, 0x3b, 0xc2,
+		0xc2, 0x87, 0xbc, 0xa5, 0xd4, 0x47, 0xc4, 0xa2, 0xf7, 0xbb, 0x71, 0x38, 0x59, 0x23, 0x96, 0xf8,
+		0x5a, 0x61, 0x74, 0x31, 0x3f, 0xaa, 0x31, 0x4a, 0x20, 0xdf, 0x1d, 0x75, 0x1f, 0xc5, 0xa9, 0x57,
+		0xae, 0xf3, 0x80, 0x48, 0x5e, 0x81, 0xac, 0x87, 0xdf, 0x42, 0xc4, 0x49, 0x8c, 0x5a, 0xf2, 0x30,
+		0x97, 0x67, 0x32, 0x42, 0x14, 0x2b, 0x31, 0x63, 0x4e, 0x76, 0x76, 0x88, 0x4e, 0xbf, 0xac, 0x79,
+		0xe8, 0x2b, 0x83, 0xd9, 0x40, 0x96, 0x1d, 0x38, 0x09, 0x5b, 0x3f, 0xf9, 0xe5, 0x18, 0x40, 0x78,
+		0xac, 0x81, 0x27, 0xdf, 0xe5, 0x8d, 0xf5, 0x8a, 0x5a, 0xdf, 0x5c, 0xda, 0xdc, 0xaa, 0x77, 0xbe,
+		0x0d, 0x26, 0xce, 0xc9, 0x3d, 0x87, 0xe8, 0xf4, 0xa3, 0xcc, 0xf2, 0x63, 0x30, 0xd3, 0xc9, 0x8d,
+		0x25, 0xfc, 0x84, 0xf8, 0x6c, 0xe6, 0xee, 0xbd, 0x85, 0x14, 0x4b, 0xe7, 0x10, 0xbc, 0x65, 0x78,
+		0xbc, 0x97, 0x0f, 0xdf, 0x24, 0x8b, 0xcf, 0x66, 0xef, 0xde, 0x5b, 0x48, 0x07, 0x79, 0x1f, 0xb9,
+		0x08, 0x72, 0x94, 0x93, 0xe3, 0x25, 0x66, 0xe1, 0xee, 0xbd, 0x85, 0x71, 0xb6, 0xe6, 0xcd, 0x26,
+		0xf1, 0x34, 0xbc, 0x7c, 0x75, 0xe0, 0x49, 0xf8, 0xd3, 0x07, 0x76, 0xdd, 0x9d, 0xe0, 0x74, 0xbb,
+		0xe3, 0xf8, 0xfb, 0xff, 0x0d, 0x00, 0x66, 0x95, 0x03, 0xc4, 0x48, 0x6f, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1937,6 +2281,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.GlobalMinSelfBond.Equal(that1.GlobalMinSelfBond) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2212,6 +2574,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2787,6 +3159,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalMinSelfBond.Size()
+		i -= size
+		if _, err := m.GlobalMinSelfBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -2819,12 +3249,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3006,57 +3436,309 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.SelfBondHeadroom.Size()
+		i -= size
+		if _, err := m.SelfBondHeadroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegationHeadroom.Size()
+		i -= size
+		if _, err := m.DelegationHeadroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegatedTokens.Size()
+		i -= size
+		if _, err := m.DelegatedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SelfBond.Size()
+		i -= size
+		if _, err := m.SelfBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintStaking(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x22
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ScheduleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ScheduleTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
 	n += 1 + l + sovStaking(uint64(l))
 	return n
@@ -3126,6 +3808,8 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3340,6 +4024,18 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalMinSelfBond.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3399,34 +4095,126 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
 }
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *ValidatorBond) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.SelfBond.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegationHeadroom.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SelfBondHeadroom.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ScheduleTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -4374,6 +5162,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5201,7 +6023,1051 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMinSelfBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalMinSelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5224,34 +7090,15 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5278,41 +7125,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5371,7 +7184,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5394,17 +7207,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			m.CreationHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5414,14 +7227,28 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5448,13 +7275,64 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5482,13 +7360,13 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5516,7 +7394,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5541,7 +7419,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5564,17 +7442,17 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5584,27 +7462,14 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5632,11 +7497,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5664,13 +7529,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5680,25 +7545,23 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5721,7 +7584,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5744,17 +7607,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5764,30 +7627,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBond", wireType)
 			}
-			m.MaxValidators = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5797,35 +7659,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedTokens", wireType)
 			}
-			m.HistoricalEntries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5835,14 +7693,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationHeadroom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5870,11 +7743,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.DelegationHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondHeadroom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5902,7 +7777,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfBondHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5927,7 +7802,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5950,17 +7825,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5970,28 +7845,27 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6018,63 +7892,71 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6101,15 +7983,15 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6119,23 +8001,22 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6160,7 +8041,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6183,17 +8064,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6203,30 +8084,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6236,81 +8116,31 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6320,31 +8150,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6354,23 +8183,22 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex